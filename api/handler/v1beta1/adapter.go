@@ -2,10 +2,12 @@ package v1beta1
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
 	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/pkg/evaluator"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -223,12 +225,13 @@ func (a *adapter) ToRole(role *domain.Role) (*guardianv1beta1.Role, error) {
 
 func (a *adapter) FromPolicyProto(p *guardianv1beta1.Policy, createdBy string) *domain.Policy {
 	policy := &domain.Policy{
-		ID:          p.GetId(),
-		Title:       p.GetTitle(),
-		Version:     uint(p.GetVersion()),
-		Description: p.GetDescription(),
-		Labels:      p.GetLabels(),
-		CreatedBy:   p.GetCreatedBy(),
+		ID:                 p.GetId(),
+		Title:              p.GetTitle(),
+		Version:            uint(p.GetVersion()),
+		Description:        p.GetDescription(),
+		Labels:             p.GetLabels(),
+		CreatedBy:          p.GetCreatedBy(),
+		ExpressionLanguage: evaluator.Language(p.GetExpressionLanguage()),
 	}
 	if createdBy != "" {
 		policy.CreatedBy = createdBy
@@ -238,14 +241,21 @@ func (a *adapter) FromPolicyProto(p *guardianv1beta1.Policy, createdBy string) *
 		var steps []*domain.Step
 		for _, s := range p.GetSteps() {
 			steps = append(steps, &domain.Step{
-				Name:            s.GetName(),
-				Description:     s.GetDescription(),
-				When:            s.GetWhen(),
-				Strategy:        domain.ApprovalStepStrategy(s.GetStrategy()),
-				RejectionReason: s.GetRejectionReason(),
-				ApproveIf:       s.GetApproveIf(),
-				AllowFailed:     s.GetAllowFailed(),
-				Approvers:       s.GetApprovers(),
+				Name:               s.GetName(),
+				Description:        s.GetDescription(),
+				When:               s.GetWhen(),
+				Strategy:           domain.ApprovalStepStrategy(s.GetStrategy()),
+				RejectionReason:    s.GetRejectionReason(),
+				ApproveIf:          s.GetApproveIf(),
+				AllowFailed:        s.GetAllowFailed(),
+				Approvers:          s.GetApprovers(),
+				MinApprovals:       int(s.GetMinApprovals()),
+				FailOnAnyRejection: s.GetFailOnAnyRejection(),
+				Timeout:            s.GetTimeout(),
+				EscalateTo:         s.GetEscalateTo(),
+				OnTimeout:          domain.StepTimeoutAction(s.GetOnTimeout()),
+				ParallelGroup:      s.GetParallelGroup(),
+				Webhook:            s.GetWebhook(),
 			})
 		}
 		policy.Steps = steps
@@ -254,25 +264,7 @@ func (a *adapter) FromPolicyProto(p *guardianv1beta1.Policy, createdBy string) *
 	if p.GetRequirements() != nil {
 		var requirements []*domain.Requirement
 		for _, r := range p.GetRequirements() {
-			var on *domain.RequirementTrigger
-			if r.GetOn() != nil {
-				var conditions []*domain.Condition
-				if r.GetOn().GetConditions() != nil {
-					for _, c := range r.GetOn().GetConditions() {
-						conditions = append(conditions, a.fromConditionProto(c))
-					}
-				}
-
-				on = &domain.RequirementTrigger{
-					ProviderType: r.GetOn().GetProviderType(),
-					ProviderURN:  r.GetOn().GetProviderUrn(),
-					ResourceType: r.GetOn().GetResourceType(),
-					ResourceURN:  r.GetOn().GetResourceUrn(),
-					Role:         r.GetOn().GetRole(),
-					Conditions:   conditions,
-					Expression:   r.GetOn().GetExpression(),
-				}
-			}
+			on := a.fromRequirementTriggerProto(r.GetOn())
 
 			var additionalAppeals []*domain.AdditionalAppeal
 			if r.GetAppeals() != nil {
@@ -334,12 +326,20 @@ func (a *adapter) FromPolicyProto(p *guardianv1beta1.Policy, createdBy string) *
 		}
 
 		policy.AppealConfig = &domain.PolicyAppealConfig{
-			DurationOptions:              durationOptions,
-			AllowOnBehalf:                p.GetAppeal().GetAllowOnBehalf(),
-			Questions:                    questions,
-			AllowPermanentAccess:         p.GetAppeal().GetAllowPermanentAccess(),
-			AllowActiveAccessExtensionIn: p.GetAppeal().GetAllowActiveAccessExtensionIn(),
-			AllowCreatorDetailsFailure:   p.GetAppeal().GetAllowCreatorDetailsFailure(),
+			DurationOptions:               durationOptions,
+			AllowOnBehalf:                 p.GetAppeal().GetAllowOnBehalf(),
+			Questions:                     questions,
+			AllowPermanentAccess:          p.GetAppeal().GetAllowPermanentAccess(),
+			AllowActiveAccessExtensionIn:  p.GetAppeal().GetAllowActiveAccessExtensionIn(),
+			AllowCreatorDetailsFailure:    p.GetAppeal().GetAllowCreatorDetailsFailure(),
+			PendingTTL:                    p.GetAppeal().GetPendingTtl(),
+			AllowBreakGlass:               p.GetAppeal().GetAllowBreakGlass(),
+			BreakGlassMaxDuration:         p.GetAppeal().GetBreakGlassMaxDuration(),
+			BreakGlassReviewers:           p.GetAppeal().GetBreakGlassReviewers(),
+			MaxScheduleAhead:              p.GetAppeal().GetMaxScheduleAhead(),
+			DisallowSelfApproval:          p.GetAppeal().GetDisallowSelfApproval(),
+			DisallowRepeatedApprover:      p.GetAppeal().GetDisallowRepeatedApprover(),
+			SelfApprovalFallbackApprovers: p.GetAppeal().GetSelfApprovalFallbackApprovers(),
 		}
 	}
 
@@ -355,26 +355,34 @@ func (a *adapter) FromPolicyProto(p *guardianv1beta1.Policy, createdBy string) *
 
 func (a *adapter) ToPolicyProto(p *domain.Policy) (*guardianv1beta1.Policy, error) {
 	policyProto := &guardianv1beta1.Policy{
-		Id:          p.ID,
-		Version:     uint32(p.Version),
-		Title:       p.Title,
-		Description: p.Description,
-		Labels:      p.Labels,
-		CreatedBy:   p.CreatedBy,
+		Id:                 p.ID,
+		Version:            uint32(p.Version),
+		Title:              p.Title,
+		Description:        p.Description,
+		Labels:             p.Labels,
+		CreatedBy:          p.CreatedBy,
+		ExpressionLanguage: string(p.ExpressionLanguage),
 	}
 
 	if p.Steps != nil {
 		var steps []*guardianv1beta1.Policy_ApprovalStep
 		for _, s := range p.Steps {
 			steps = append(steps, &guardianv1beta1.Policy_ApprovalStep{
-				Name:            s.Name,
-				Description:     s.Description,
-				When:            s.When,
-				Strategy:        string(s.Strategy),
-				RejectionReason: s.RejectionReason,
-				ApproveIf:       s.ApproveIf,
-				AllowFailed:     s.AllowFailed,
-				Approvers:       s.Approvers,
+				Name:               s.Name,
+				Description:        s.Description,
+				When:               s.When,
+				Strategy:           string(s.Strategy),
+				RejectionReason:    s.RejectionReason,
+				ApproveIf:          s.ApproveIf,
+				AllowFailed:        s.AllowFailed,
+				Approvers:          s.Approvers,
+				MinApprovals:       uint32(s.MinApprovals),
+				FailOnAnyRejection: s.FailOnAnyRejection,
+				Timeout:            s.Timeout,
+				EscalateTo:         s.EscalateTo,
+				OnTimeout:          string(s.OnTimeout),
+				ParallelGroup:      s.ParallelGroup,
+				Webhook:            s.Webhook,
 			})
 		}
 		policyProto.Steps = steps
@@ -383,59 +391,13 @@ func (a *adapter) ToPolicyProto(p *domain.Policy) (*guardianv1beta1.Policy, erro
 	if p.Requirements != nil {
 		var requirements []*guardianv1beta1.Policy_Requirement
 		for _, r := range p.Requirements {
-			var on *guardianv1beta1.Policy_Requirement_RequirementTrigger
-			if r.On != nil {
-				var conditions []*guardianv1beta1.Condition
-				if r.On.Conditions != nil {
-					for _, c := range r.On.Conditions {
-						condition, err := a.toConditionProto(c)
-						if err != nil {
-							return nil, err
-						}
-						conditions = append(conditions, condition)
-					}
-				}
-
-				on = &guardianv1beta1.Policy_Requirement_RequirementTrigger{
-					ProviderType: r.On.ProviderType,
-					ProviderUrn:  r.On.ProviderURN,
-					ResourceType: r.On.ResourceType,
-					ResourceUrn:  r.On.ResourceURN,
-					Role:         r.On.Role,
-					Conditions:   conditions,
-					Expression:   r.On.Expression,
-				}
-			}
-
-			var additionalAppeals []*guardianv1beta1.Policy_Requirement_AdditionalAppeal
-			if r.Appeals != nil {
-				for _, aa := range r.Appeals {
-					var resource *guardianv1beta1.Policy_Requirement_AdditionalAppeal_ResourceIdentifier
-					if aa.Resource != nil {
-						resource = &guardianv1beta1.Policy_Requirement_AdditionalAppeal_ResourceIdentifier{
-							ProviderType: aa.Resource.ProviderType,
-							ProviderUrn:  aa.Resource.ProviderURN,
-							Type:         aa.Resource.Type,
-							Urn:          aa.Resource.URN,
-							Id:           aa.Resource.ID,
-						}
-					}
-
-					additionalAppeals = append(additionalAppeals, &guardianv1beta1.Policy_Requirement_AdditionalAppeal{
-						Resource: resource,
-						Role:     aa.Role,
-						Options:  a.toAppealOptionsProto(aa.Options),
-						Policy:   a.toPolicyConfigProto(aa.Policy),
-					})
-				}
+			requirement, err := a.toRequirementProto(r)
+			if err != nil {
+				return nil, err
 			}
-
-			requirements = append(requirements, &guardianv1beta1.Policy_Requirement{
-				On:      on,
-				Appeals: additionalAppeals,
-			})
-			policyProto.Requirements = requirements
+			requirements = append(requirements, requirement)
 		}
+		policyProto.Requirements = requirements
 	}
 
 	if p.HasIAMConfig() {
@@ -463,6 +425,111 @@ func (a *adapter) ToPolicyProto(p *domain.Policy) (*guardianv1beta1.Policy, erro
 	return policyProto, nil
 }
 
+func (a *adapter) toRequirementProto(r *domain.Requirement) (*guardianv1beta1.Policy_Requirement, error) {
+	on, err := a.toRequirementTriggerProto(r.On)
+	if err != nil {
+		return nil, err
+	}
+
+	var additionalAppeals []*guardianv1beta1.Policy_Requirement_AdditionalAppeal
+	if r.Appeals != nil {
+		for _, aa := range r.Appeals {
+			var resource *guardianv1beta1.Policy_Requirement_AdditionalAppeal_ResourceIdentifier
+			if aa.Resource != nil {
+				resource = &guardianv1beta1.Policy_Requirement_AdditionalAppeal_ResourceIdentifier{
+					ProviderType: aa.Resource.ProviderType,
+					ProviderUrn:  aa.Resource.ProviderURN,
+					Type:         aa.Resource.Type,
+					Urn:          aa.Resource.URN,
+					Id:           aa.Resource.ID,
+				}
+			}
+
+			additionalAppeals = append(additionalAppeals, &guardianv1beta1.Policy_Requirement_AdditionalAppeal{
+				Resource: resource,
+				Role:     aa.Role,
+				Options:  a.toAppealOptionsProto(aa.Options),
+				Policy:   a.toPolicyConfigProto(aa.Policy),
+			})
+		}
+	}
+
+	return &guardianv1beta1.Policy_Requirement{
+		On:      on,
+		Appeals: additionalAppeals,
+	}, nil
+}
+
+func (a *adapter) fromRequirementTriggerProto(t *guardianv1beta1.Policy_Requirement_RequirementTrigger) *domain.RequirementTrigger {
+	if t == nil {
+		return nil
+	}
+
+	var conditions []*domain.Condition
+	if t.GetConditions() != nil {
+		for _, c := range t.GetConditions() {
+			conditions = append(conditions, a.fromConditionProto(c))
+		}
+	}
+
+	return &domain.RequirementTrigger{
+		ProviderType: t.GetProviderType(),
+		ProviderURN:  t.GetProviderUrn(),
+		ResourceType: t.GetResourceType(),
+		ResourceURN:  t.GetResourceUrn(),
+		Role:         t.GetRole(),
+		Conditions:   conditions,
+		Expression:   t.GetExpression(),
+	}
+}
+
+func (a *adapter) toRequirementTriggerProto(t *domain.RequirementTrigger) (*guardianv1beta1.Policy_Requirement_RequirementTrigger, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	var conditions []*guardianv1beta1.Condition
+	if t.Conditions != nil {
+		for _, c := range t.Conditions {
+			condition, err := a.toConditionProto(c)
+			if err != nil {
+				return nil, err
+			}
+			conditions = append(conditions, condition)
+		}
+	}
+
+	return &guardianv1beta1.Policy_Requirement_RequirementTrigger{
+		ProviderType: t.ProviderType,
+		ProviderUrn:  t.ProviderURN,
+		ResourceType: t.ResourceType,
+		ResourceUrn:  t.ResourceURN,
+		Role:         t.Role,
+		Conditions:   conditions,
+		Expression:   t.Expression,
+	}, nil
+}
+
+func (a *adapter) ToAppealSimulationProto(simulation *domain.AppealSimulation) (*guardianv1beta1.AppealSimulation, error) {
+	appealProto, err := a.ToAppealProto(simulation.Appeal)
+	if err != nil {
+		return nil, err
+	}
+
+	simulationProto := &guardianv1beta1.AppealSimulation{
+		Appeal: appealProto,
+	}
+	for _, r := range simulation.MatchedRequirements {
+		requirement, err := a.toRequirementProto(r)
+		if err != nil {
+			return nil, err
+		}
+		simulationProto.MatchedRequirements = append(simulationProto.MatchedRequirements, requirement)
+	}
+
+	return simulationProto, nil
+}
+
 func (a *adapter) ToPolicyAppealConfigProto(p *domain.Policy) *guardianv1beta1.PolicyAppealConfig {
 	if p.AppealConfig == nil {
 		return nil
@@ -483,6 +550,14 @@ func (a *adapter) ToPolicyAppealConfigProto(p *domain.Policy) *guardianv1beta1.P
 	policyAppealConfigProto.AllowPermanentAccess = p.AppealConfig.AllowPermanentAccess
 	policyAppealConfigProto.AllowActiveAccessExtensionIn = p.AppealConfig.AllowActiveAccessExtensionIn
 	policyAppealConfigProto.AllowCreatorDetailsFailure = p.AppealConfig.AllowCreatorDetailsFailure
+	policyAppealConfigProto.PendingTtl = p.AppealConfig.PendingTTL
+	policyAppealConfigProto.AllowBreakGlass = p.AppealConfig.AllowBreakGlass
+	policyAppealConfigProto.BreakGlassMaxDuration = p.AppealConfig.BreakGlassMaxDuration
+	policyAppealConfigProto.BreakGlassReviewers = p.AppealConfig.BreakGlassReviewers
+	policyAppealConfigProto.MaxScheduleAhead = p.AppealConfig.MaxScheduleAhead
+	policyAppealConfigProto.DisallowSelfApproval = p.AppealConfig.DisallowSelfApproval
+	policyAppealConfigProto.DisallowRepeatedApprover = p.AppealConfig.DisallowRepeatedApprover
+	policyAppealConfigProto.SelfApprovalFallbackApprovers = p.AppealConfig.SelfApprovalFallbackApprovers
 
 	for _, q := range p.AppealConfig.Questions {
 		policyAppealConfigProto.Questions = append(policyAppealConfigProto.Questions, &guardianv1beta1.PolicyAppealConfig_Question{
@@ -591,6 +666,7 @@ func (a *adapter) ToAppealProto(appeal *domain.Appeal) (*guardianv1beta1.Appeal,
 		Options:       a.toAppealOptionsProto(appeal.Options),
 		Labels:        appeal.Labels,
 		Description:   appeal.Description,
+		BundleId:      appeal.BundleID,
 	}
 
 	if appeal.Resource != nil {
@@ -643,9 +719,58 @@ func (a *adapter) ToAppealProto(appeal *domain.Appeal) (*guardianv1beta1.Appeal,
 	}
 	appealProto.Grant = grantProto
 
+	for i, r := range appeal.Revisions {
+		revisionProto, err := a.toAppealRevisionProto(r)
+		if err != nil {
+			return nil, fmt.Errorf("parsing revisions[%d]: %w", i, err)
+		}
+		appealProto.Revisions = append(appealProto.Revisions, revisionProto)
+	}
+
+	if appeal.Risk != nil {
+		appealProto.Risk = &guardianv1beta1.AppealRisk{
+			Score:   appeal.Risk.Score,
+			Level:   appeal.Risk.Level,
+			Factors: appeal.Risk.Factors,
+		}
+	}
+
 	return appealProto, nil
 }
 
+func (a *adapter) toAppealRevisionProto(r *domain.AppealRevision) (*guardianv1beta1.AppealRevision, error) {
+	revisionProto := &guardianv1beta1.AppealRevision{
+		Status:      r.Status,
+		Role:        r.Role,
+		Permissions: r.Permissions,
+		Options:     a.toAppealOptionsProto(r.Options),
+		Description: r.Description,
+		UpdatedBy:   r.UpdatedBy,
+	}
+
+	if r.Details != nil {
+		details, err := structpb.NewStruct(r.Details)
+		if err != nil {
+			return nil, err
+		}
+		revisionProto.Details = details
+	}
+
+	for _, approval := range r.Approvals {
+		approvalProto, err := a.ToApprovalProto(approval)
+		if err != nil {
+			return nil, err
+		}
+		revisionProto.Approvals = append(revisionProto.Approvals, approvalProto)
+	}
+
+	if !r.UpdatedAt.IsZero() {
+		revisionProto.UpdatedAt = timestamppb.New(r.UpdatedAt)
+	}
+
+	return revisionProto, nil
+}
+
 func (a *adapter) FromCreateAppealProto(ca *guardianv1beta1.CreateAppealRequest, authenticatedUser string) ([]*domain.Appeal, error) {
 	var appeals []*domain.Appeal
 
@@ -661,7 +786,14 @@ func (a *adapter) FromCreateAppealProto(ca *guardianv1beta1.CreateAppealRequest,
 
 		if r.GetOptions() != nil {
 			var options *domain.AppealOptions
-			if err := mapstructure.Decode(r.GetOptions().AsMap(), &options); err != nil {
+			decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+				DecodeHook: mapstructure.StringToTimeHookFunc(time.RFC3339),
+				Result:     &options,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if err := decoder.Decode(r.GetOptions().AsMap()); err != nil {
 				return nil, err
 			}
 			appeal.Options = options
@@ -677,6 +809,45 @@ func (a *adapter) FromCreateAppealProto(ca *guardianv1beta1.CreateAppealRequest,
 	return appeals, nil
 }
 
+// FromSimulateAppealProto returns the hypothetical appeal of the simulation request along with the identifier of
+// the resource being requested
+func (a *adapter) FromSimulateAppealProto(req *guardianv1beta1.SimulateAppealRequest, authenticatedUser string) (*domain.Appeal, *domain.ResourceIdentifier) {
+	appeal := &domain.Appeal{
+		PolicyID:      req.GetPolicyId(),
+		PolicyVersion: uint(req.GetPolicyVersion()),
+		AccountID:     req.GetAccountId(),
+		AccountType:   req.GetAccountType(),
+		CreatedBy:     req.GetCreatedBy(),
+		Role:          req.GetRole(),
+		Options:       a.fromAppealOptionsProto(req.GetOptions()),
+	}
+	if appeal.CreatedBy == "" {
+		appeal.CreatedBy = authenticatedUser
+	}
+	if appeal.AccountID == "" {
+		appeal.AccountID = appeal.CreatedBy
+	}
+	if req.GetDetails() != nil {
+		appeal.Details = req.GetDetails().AsMap()
+	}
+	if req.GetCreator() != nil {
+		appeal.Creator = req.GetCreator().AsInterface()
+	}
+
+	var ri *domain.ResourceIdentifier
+	if r := req.GetResource(); r != nil {
+		ri = &domain.ResourceIdentifier{
+			ProviderType: r.GetProviderType(),
+			ProviderURN:  r.GetProviderUrn(),
+			Type:         r.GetType(),
+			URN:          r.GetUrn(),
+			ID:           r.GetId(),
+		}
+	}
+
+	return appeal, ri
+}
+
 func (a *adapter) ToApprovalProto(approval *domain.Approval) (*guardianv1beta1.Approval, error) {
 	approvalProto := &guardianv1beta1.Approval{
 		Id:            approval.ID,
@@ -687,10 +858,25 @@ func (a *adapter) ToApprovalProto(approval *domain.Approval) (*guardianv1beta1.A
 		PolicyId:      approval.PolicyID,
 		PolicyVersion: uint32(approval.PolicyVersion),
 		Approvers:     approval.Approvers,
+		MinApprovals:  uint32(approval.MinApprovals),
+		ParallelGroup: approval.ParallelGroup,
 		CreatedAt:     timestamppb.New(approval.CreatedAt),
 		UpdatedAt:     timestamppb.New(approval.UpdatedAt),
 	}
 
+	for _, d := range approval.Decisions {
+		decisionProto := &guardianv1beta1.ApprovalDecision{
+			Actor:      d.Actor,
+			OnBehalfOf: d.OnBehalfOf,
+			Action:     d.Action,
+			Reason:     d.Reason,
+		}
+		if !d.CreatedAt.IsZero() {
+			decisionProto.CreatedAt = timestamppb.New(d.CreatedAt)
+		}
+		approvalProto.Decisions = append(approvalProto.Decisions, decisionProto)
+	}
+
 	if approval.Appeal != nil {
 		appeal, err := a.ToAppealProto(approval.Appeal)
 		if err != nil {
@@ -709,6 +895,9 @@ func (a *adapter) ToApprovalProto(approval *domain.Approval) (*guardianv1beta1.A
 	if !approval.UpdatedAt.IsZero() {
 		approvalProto.UpdatedAt = timestamppb.New(approval.UpdatedAt)
 	}
+	if approval.EscalatedAt != nil {
+		approvalProto.EscalatedAt = timestamppb.New(*approval.EscalatedAt)
+	}
 
 	return approvalProto, nil
 }
@@ -736,6 +925,10 @@ func (a *adapter) FromGrantProto(g *guardianv1beta1.Grant) *domain.Grant {
 		Resource:         a.FromResourceProto(g.GetResource()),
 	}
 
+	if g.GetStartDate() != nil {
+		t := g.GetStartDate().AsTime()
+		grant.StartDate = &t
+	}
 	if g.GetExpirationDate() != nil {
 		t := g.GetExpirationDate().AsTime()
 		grant.ExpirationDate = &t
@@ -777,6 +970,9 @@ func (a *adapter) ToGrantProto(grant *domain.Grant) (*guardianv1beta1.Grant, err
 		Owner:            grant.Owner,
 	}
 
+	if grant.StartDate != nil {
+		grantProto.StartDate = timestamppb.New(*grant.StartDate)
+	}
 	if grant.ExpirationDate != nil {
 		grantProto.ExpirationDate = timestamppb.New(*grant.ExpirationDate)
 	}
@@ -807,6 +1003,27 @@ func (a *adapter) ToGrantProto(grant *domain.Grant) (*guardianv1beta1.Grant, err
 	return grantProto, nil
 }
 
+func (a *adapter) ToGrantRecommendationProto(r *domain.GrantRecommendation) (*guardianv1beta1.GrantRecommendation, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	grantProto, err := a.ToGrantProto(r.Grant)
+	if err != nil {
+		return nil, fmt.Errorf("parsing grant: %w", err)
+	}
+
+	return &guardianv1beta1.GrantRecommendation{
+		Grant:                  grantProto,
+		Type:                   string(r.Type),
+		RecommendedRole:        r.RecommendedRole,
+		RecommendedPermissions: r.RecommendedPermissions,
+		UsedPermissions:        r.UsedPermissions,
+		ActivitiesCount:        int32(r.ActivitiesCount),
+		Reason:                 r.Reason,
+	}, nil
+}
+
 func (a *adapter) ToActivityProto(activity *domain.Activity) (*guardianv1beta1.ProviderActivity, error) {
 	if activity == nil {
 		return nil, nil
@@ -906,13 +1123,18 @@ func (a *adapter) fromAppealOptionsProto(o *guardianv1beta1.AppealOptions) *doma
 	}
 
 	options := &domain.AppealOptions{
-		Duration: o.GetDuration(),
+		Duration:   o.GetDuration(),
+		BreakGlass: o.GetBreakGlass(),
 	}
 
 	if o.GetExpirationDate() != nil {
 		expDate := o.GetExpirationDate().AsTime()
 		options.ExpirationDate = &expDate
 	}
+	if o.GetStartDate() != nil {
+		startDate := o.GetStartDate().AsTime()
+		options.StartDate = &startDate
+	}
 
 	return options
 }
@@ -923,12 +1145,17 @@ func (a *adapter) toAppealOptionsProto(o *domain.AppealOptions) *guardianv1beta1
 	}
 
 	optionsProto := &guardianv1beta1.AppealOptions{
-		Duration: o.Duration,
+		Duration:       o.Duration,
+		BreakGlass:     o.BreakGlass,
+		ReplaceGrantId: o.ReplaceGrantID,
 	}
 
 	if o.ExpirationDate != nil {
 		optionsProto.ExpirationDate = timestamppb.New(*o.ExpirationDate)
 	}
+	if o.StartDate != nil {
+		optionsProto.StartDate = timestamppb.New(*o.StartDate)
+	}
 
 	return optionsProto
 }
@@ -954,3 +1181,408 @@ func (a *adapter) toPolicyConfigProto(c *domain.PolicyConfig) *guardianv1beta1.P
 		Version: int32(c.Version),
 	}
 }
+
+func (a *adapter) FromDelegationProto(d *guardianv1beta1.Delegation) *domain.Delegation {
+	if d == nil {
+		return nil
+	}
+
+	delegation := &domain.Delegation{
+		ID:           d.GetId(),
+		Delegator:    d.GetDelegator(),
+		Delegate:     d.GetDelegate(),
+		PolicyID:     d.GetPolicyId(),
+		ProviderType: d.GetProviderType(),
+		ProviderURN:  d.GetProviderUrn(),
+		Reason:       d.GetReason(),
+		CreatedBy:    d.GetCreatedBy(),
+	}
+
+	if d.GetStartDate() != nil {
+		delegation.StartDate = d.GetStartDate().AsTime()
+	}
+	if d.GetEndDate() != nil {
+		delegation.EndDate = d.GetEndDate().AsTime()
+	}
+	if d.GetCreatedAt() != nil {
+		delegation.CreatedAt = d.GetCreatedAt().AsTime()
+	}
+	if d.GetUpdatedAt() != nil {
+		delegation.UpdatedAt = d.GetUpdatedAt().AsTime()
+	}
+
+	return delegation
+}
+
+func (a *adapter) ToDelegationProto(d *domain.Delegation) *guardianv1beta1.Delegation {
+	if d == nil {
+		return nil
+	}
+
+	delegationProto := &guardianv1beta1.Delegation{
+		Id:           d.ID,
+		Delegator:    d.Delegator,
+		Delegate:     d.Delegate,
+		PolicyId:     d.PolicyID,
+		ProviderType: d.ProviderType,
+		ProviderUrn:  d.ProviderURN,
+		Reason:       d.Reason,
+		CreatedBy:    d.CreatedBy,
+	}
+
+	if !d.StartDate.IsZero() {
+		delegationProto.StartDate = timestamppb.New(d.StartDate)
+	}
+	if !d.EndDate.IsZero() {
+		delegationProto.EndDate = timestamppb.New(d.EndDate)
+	}
+	if !d.CreatedAt.IsZero() {
+		delegationProto.CreatedAt = timestamppb.New(d.CreatedAt)
+	}
+	if !d.UpdatedAt.IsZero() {
+		delegationProto.UpdatedAt = timestamppb.New(d.UpdatedAt)
+	}
+
+	return delegationProto
+}
+
+func (a *adapter) FromBundleProto(b *guardianv1beta1.Bundle) *domain.Bundle {
+	if b == nil {
+		return nil
+	}
+
+	bundle := &domain.Bundle{
+		ID:             b.GetId(),
+		Name:           b.GetName(),
+		Description:    b.GetDescription(),
+		RequiredPolicy: a.fromPolicyConfigProto(b.GetRequiredPolicy()),
+		Labels:         b.GetLabels(),
+		CreatedBy:      b.GetCreatedBy(),
+	}
+
+	for _, item := range b.GetItems() {
+		bundleItem := &domain.BundleItem{
+			Role:     item.GetRole(),
+			Duration: item.GetDuration(),
+		}
+		if r := item.GetResource(); r != nil {
+			bundleItem.Resource = &domain.ResourceIdentifier{
+				ProviderType: r.GetProviderType(),
+				ProviderURN:  r.GetProviderUrn(),
+				Type:         r.GetType(),
+				URN:          r.GetUrn(),
+				ID:           r.GetId(),
+			}
+		}
+		bundle.Items = append(bundle.Items, bundleItem)
+	}
+
+	if b.GetCreatedAt() != nil {
+		bundle.CreatedAt = b.GetCreatedAt().AsTime()
+	}
+	if b.GetUpdatedAt() != nil {
+		bundle.UpdatedAt = b.GetUpdatedAt().AsTime()
+	}
+
+	return bundle
+}
+
+func (a *adapter) ToBundleProto(b *domain.Bundle) *guardianv1beta1.Bundle {
+	if b == nil {
+		return nil
+	}
+
+	bundleProto := &guardianv1beta1.Bundle{
+		Id:             b.ID,
+		Name:           b.Name,
+		Description:    b.Description,
+		RequiredPolicy: a.toPolicyConfigProto(b.RequiredPolicy),
+		Labels:         b.Labels,
+		CreatedBy:      b.CreatedBy,
+	}
+
+	for _, item := range b.Items {
+		itemProto := &guardianv1beta1.Bundle_Item{
+			Role:     item.Role,
+			Duration: item.Duration,
+		}
+		if item.Resource != nil {
+			itemProto.Resource = &guardianv1beta1.Bundle_Item_ResourceIdentifier{
+				ProviderType: item.Resource.ProviderType,
+				ProviderUrn:  item.Resource.ProviderURN,
+				Type:         item.Resource.Type,
+				Urn:          item.Resource.URN,
+				Id:           item.Resource.ID,
+			}
+		}
+		bundleProto.Items = append(bundleProto.Items, itemProto)
+	}
+
+	if !b.CreatedAt.IsZero() {
+		bundleProto.CreatedAt = timestamppb.New(b.CreatedAt)
+	}
+	if !b.UpdatedAt.IsZero() {
+		bundleProto.UpdatedAt = timestamppb.New(b.UpdatedAt)
+	}
+
+	return bundleProto
+}
+
+func (a *adapter) FromReviewCampaignProto(c *guardianv1beta1.ReviewCampaign) *domain.ReviewCampaign {
+	if c == nil {
+		return nil
+	}
+
+	campaign := &domain.ReviewCampaign{
+		ID:               c.GetId(),
+		Name:             c.GetName(),
+		Description:      c.GetDescription(),
+		ReviewerStrategy: domain.ReviewerStrategy(c.GetReviewerStrategy()),
+		ResourceOwnerKey: c.GetResourceOwnerKey(),
+		DefaultAction:    domain.ReviewDecision(c.GetDefaultAction()),
+		Status:           c.GetStatus(),
+		CreatedBy:        c.GetCreatedBy(),
+		ClosedBy:         c.GetClosedBy(),
+	}
+
+	if scope := c.GetScope(); scope != nil {
+		campaign.Scope = &domain.ReviewCampaignScope{
+			AccountIDs:    scope.GetAccountIds(),
+			AccountTypes:  scope.GetAccountTypes(),
+			ResourceIDs:   scope.GetResourceIds(),
+			Roles:         scope.GetRoles(),
+			ProviderTypes: scope.GetProviderTypes(),
+			ProviderURNs:  scope.GetProviderUrns(),
+			ResourceTypes: scope.GetResourceTypes(),
+			ResourceURNs:  scope.GetResourceUrns(),
+			Owner:         scope.GetOwner(),
+		}
+	}
+
+	if c.GetClosedAt() != nil {
+		closedAt := c.GetClosedAt().AsTime()
+		campaign.ClosedAt = &closedAt
+	}
+	if c.GetCreatedAt() != nil {
+		campaign.CreatedAt = c.GetCreatedAt().AsTime()
+	}
+	if c.GetUpdatedAt() != nil {
+		campaign.UpdatedAt = c.GetUpdatedAt().AsTime()
+	}
+
+	return campaign
+}
+
+func (a *adapter) ToReviewCampaignProto(c *domain.ReviewCampaign) *guardianv1beta1.ReviewCampaign {
+	if c == nil {
+		return nil
+	}
+
+	campaignProto := &guardianv1beta1.ReviewCampaign{
+		Id:               c.ID,
+		Name:             c.Name,
+		Description:      c.Description,
+		ReviewerStrategy: string(c.ReviewerStrategy),
+		ResourceOwnerKey: c.ResourceOwnerKey,
+		DefaultAction:    string(c.DefaultAction),
+		Status:           c.Status,
+		CreatedBy:        c.CreatedBy,
+		ClosedBy:         c.ClosedBy,
+	}
+
+	if c.Scope != nil {
+		campaignProto.Scope = &guardianv1beta1.ReviewCampaign_Scope{
+			AccountIds:    c.Scope.AccountIDs,
+			AccountTypes:  c.Scope.AccountTypes,
+			ResourceIds:   c.Scope.ResourceIDs,
+			Roles:         c.Scope.Roles,
+			ProviderTypes: c.Scope.ProviderTypes,
+			ProviderUrns:  c.Scope.ProviderURNs,
+			ResourceTypes: c.Scope.ResourceTypes,
+			ResourceUrns:  c.Scope.ResourceURNs,
+			Owner:         c.Scope.Owner,
+		}
+	}
+
+	if c.ClosedAt != nil {
+		campaignProto.ClosedAt = timestamppb.New(*c.ClosedAt)
+	}
+	if !c.CreatedAt.IsZero() {
+		campaignProto.CreatedAt = timestamppb.New(c.CreatedAt)
+	}
+	if !c.UpdatedAt.IsZero() {
+		campaignProto.UpdatedAt = timestamppb.New(c.UpdatedAt)
+	}
+
+	return campaignProto
+}
+
+func (a *adapter) ToReviewItemProto(i *domain.ReviewItem) *guardianv1beta1.ReviewItem {
+	if i == nil {
+		return nil
+	}
+
+	itemProto := &guardianv1beta1.ReviewItem{
+		Id:           i.ID,
+		CampaignId:   i.CampaignID,
+		GrantId:      i.GrantID,
+		AccountId:    i.AccountID,
+		AccountType:  i.AccountType,
+		ResourceId:   i.ResourceID,
+		ResourceUrn:  i.ResourceURN,
+		ProviderType: i.ProviderType,
+		Role:         i.Role,
+		Reviewers:    i.Reviewers,
+		Decision:     string(i.Decision),
+		Reason:       i.Reason,
+		DecidedBy:    i.DecidedBy,
+		Defaulted:    i.Defaulted,
+	}
+
+	if i.DecidedAt != nil {
+		itemProto.DecidedAt = timestamppb.New(*i.DecidedAt)
+	}
+	if !i.CreatedAt.IsZero() {
+		itemProto.CreatedAt = timestamppb.New(i.CreatedAt)
+	}
+	if !i.UpdatedAt.IsZero() {
+		itemProto.UpdatedAt = timestamppb.New(i.UpdatedAt)
+	}
+
+	return itemProto
+}
+
+func (a *adapter) FromReviewItemProto(i *guardianv1beta1.ReviewItem) *domain.ReviewItem {
+	if i == nil {
+		return nil
+	}
+
+	item := &domain.ReviewItem{
+		ID:           i.GetId(),
+		CampaignID:   i.GetCampaignId(),
+		GrantID:      i.GetGrantId(),
+		AccountID:    i.GetAccountId(),
+		AccountType:  i.GetAccountType(),
+		ResourceID:   i.GetResourceId(),
+		ResourceURN:  i.GetResourceUrn(),
+		ProviderType: i.GetProviderType(),
+		Role:         i.GetRole(),
+		Reviewers:    i.GetReviewers(),
+		Decision:     domain.ReviewDecision(i.GetDecision()),
+		Reason:       i.GetReason(),
+		DecidedBy:    i.GetDecidedBy(),
+		Defaulted:    i.GetDefaulted(),
+	}
+
+	if i.GetDecidedAt() != nil {
+		decidedAt := i.GetDecidedAt().AsTime()
+		item.DecidedAt = &decidedAt
+	}
+	if i.GetCreatedAt() != nil {
+		item.CreatedAt = i.GetCreatedAt().AsTime()
+	}
+	if i.GetUpdatedAt() != nil {
+		item.UpdatedAt = i.GetUpdatedAt().AsTime()
+	}
+
+	return item
+}
+
+func (a *adapter) FromSoDRuleProto(r *guardianv1beta1.SoDRule) *domain.SoDRule {
+	if r == nil {
+		return nil
+	}
+
+	rule := &domain.SoDRule{
+		ID:          r.GetId(),
+		Name:        r.GetName(),
+		Description: r.GetDescription(),
+		Action:      domain.SoDRuleAction(r.GetAction()),
+		Approvers:   r.GetApprovers(),
+		CreatedBy:   r.GetCreatedBy(),
+	}
+
+	for _, selector := range r.GetSelectors() {
+		rule.Selectors = append(rule.Selectors, a.fromRequirementTriggerProto(selector))
+	}
+
+	if r.GetCreatedAt() != nil {
+		rule.CreatedAt = r.GetCreatedAt().AsTime()
+	}
+	if r.GetUpdatedAt() != nil {
+		rule.UpdatedAt = r.GetUpdatedAt().AsTime()
+	}
+
+	return rule
+}
+
+func (a *adapter) ToSoDRuleProto(r *domain.SoDRule) (*guardianv1beta1.SoDRule, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	ruleProto := &guardianv1beta1.SoDRule{
+		Id:          r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+		Action:      string(r.Action),
+		Approvers:   r.Approvers,
+		CreatedBy:   r.CreatedBy,
+	}
+
+	for _, selector := range r.Selectors {
+		selectorProto, err := a.toRequirementTriggerProto(selector)
+		if err != nil {
+			return nil, err
+		}
+		ruleProto.Selectors = append(ruleProto.Selectors, selectorProto)
+	}
+
+	if !r.CreatedAt.IsZero() {
+		ruleProto.CreatedAt = timestamppb.New(r.CreatedAt)
+	}
+	if !r.UpdatedAt.IsZero() {
+		ruleProto.UpdatedAt = timestamppb.New(r.UpdatedAt)
+	}
+
+	return ruleProto, nil
+}
+
+func (a *adapter) ToSoDViolationProto(v *domain.SoDViolation) (*guardianv1beta1.SoDViolation, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	ruleProto, err := a.ToSoDRuleProto(v.Rule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &guardianv1beta1.SoDViolation{
+		Rule:      ruleProto,
+		AccountId: v.AccountID,
+		GrantIds:  v.GrantIDs,
+	}, nil
+}
+
+func (a *adapter) ToCommentProto(c *domain.Comment) *guardianv1beta1.AppealComment {
+	if c == nil {
+		return nil
+	}
+
+	commentProto := &guardianv1beta1.AppealComment{
+		Id:        c.ID,
+		AppealId:  c.AppealID,
+		CreatedBy: c.CreatedBy,
+		Body:      c.Body,
+	}
+
+	if !c.CreatedAt.IsZero() {
+		commentProto.CreatedAt = timestamppb.New(c.CreatedAt)
+	}
+	if !c.UpdatedAt.IsZero() {
+		commentProto.UpdatedAt = timestamppb.New(c.UpdatedAt)
+	}
+
+	return commentProto
+}
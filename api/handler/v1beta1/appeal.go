@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 
+	"github.com/go-playground/validator/v10"
 	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
 	"github.com/raystack/guardian/core/appeal"
+	"github.com/raystack/guardian/core/provider"
 	"github.com/raystack/guardian/domain"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
@@ -95,16 +97,42 @@ func (s *GRPCServer) CreateAppeal(ctx context.Context, req *guardianv1beta1.Crea
 		return nil, status.Error(codes.Unauthenticated, err.Error())
 	}
 
-	appeals, err := s.adapter.FromCreateAppealProto(req, authenticatedUser)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot deserialize payload: %v", err)
-	}
+	var appeals []*domain.Appeal
+	if req.GetBundleId() != "" {
+		if len(req.GetResources()) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "resources can't be specified along with bundle_id")
+		}
+		appeals, err = s.bundleService.CreateAppeals(ctx, req.GetBundleId(), &domain.Appeal{
+			AccountID:   req.GetAccountId(),
+			AccountType: req.GetAccountType(),
+			CreatedBy:   authenticatedUser,
+			Description: req.GetDescription(),
+		})
+		if err != nil {
+			if errors.Is(err, appeal.ErrAppealDuplicate) {
+				return nil, status.Errorf(codes.AlreadyExists, "appeal already exists: %v", err)
+			}
+			if errors.Is(err, appeal.ErrSoDViolation) || errors.Is(err, domain.ErrNoApproversLeft) ||
+				errors.Is(err, appeal.ErrBundlePolicyMismatch) {
+				return nil, status.Errorf(codes.FailedPrecondition, "failed to create bundle appeals: %v", err)
+			}
+			return nil, s.bundleErrorToStatus(err, "failed to create bundle appeals")
+		}
+	} else {
+		appeals, err = s.adapter.FromCreateAppealProto(req, authenticatedUser)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot deserialize payload: %v", err)
+		}
 
-	if err := s.appealService.Create(ctx, appeals); err != nil {
-		if errors.Is(err, appeal.ErrAppealDuplicate) {
-			return nil, status.Errorf(codes.AlreadyExists, "appeal already exists: %v", err)
+		if err := s.appealService.Create(ctx, appeals); err != nil {
+			if errors.Is(err, appeal.ErrAppealDuplicate) {
+				return nil, status.Errorf(codes.AlreadyExists, "appeal already exists: %v", err)
+			}
+			if errors.Is(err, appeal.ErrSoDViolation) || errors.Is(err, domain.ErrNoApproversLeft) {
+				return nil, status.Errorf(codes.FailedPrecondition, "failed to create appeal: %v", err)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to create appeal: %v", err)
 		}
-		return nil, status.Errorf(codes.Internal, "failed to create appeal: %v", err)
 	}
 
 	appealProtos := []*guardianv1beta1.Appeal{}
@@ -159,6 +187,7 @@ func (s *GRPCServer) CancelAppeal(ctx context.Context, req *guardianv1beta1.Canc
 		case appeal.ErrAppealNotFound:
 			return nil, status.Errorf(codes.NotFound, "appeal not found: %v", id)
 		case appeal.ErrAppealStatusCanceled,
+			appeal.ErrAppealStatusExpired,
 			appeal.ErrAppealStatusApproved,
 			appeal.ErrAppealStatusRejected,
 			appeal.ErrAppealStatusUnrecognized:
@@ -178,6 +207,126 @@ func (s *GRPCServer) CancelAppeal(ctx context.Context, req *guardianv1beta1.Canc
 	}, nil
 }
 
+func (s *GRPCServer) UpdateAppeal(ctx context.Context, req *guardianv1beta1.UpdateAppealRequest) (*guardianv1beta1.UpdateAppealResponse, error) {
+	actor, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	a, err := s.appealService.UpdateAppeal(ctx, domain.AppealUpdate{
+		AppealID:    req.GetId(),
+		Actor:       actor,
+		Description: req.GetDescription(),
+		Role:        req.GetRole(),
+		Duration:    req.GetDuration(),
+		Details:     req.GetDetails().AsMap(),
+	})
+	if err != nil {
+		return nil, s.appealUpdateErrorToStatus(err)
+	}
+
+	appealProto, err := s.adapter.ToAppealProto(a)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse appeal: %v", err)
+	}
+
+	return &guardianv1beta1.UpdateAppealResponse{
+		Appeal: appealProto,
+	}, nil
+}
+
+func (s *GRPCServer) appealUpdateErrorToStatus(err error) error {
+	var validationErrs validator.ValidationErrors
+	switch {
+	case errors.Is(err, appeal.ErrAppealNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, appeal.ErrAppealUpdateForbidden):
+		return status.Errorf(codes.PermissionDenied, "permission denied: %v", err)
+	case errors.Is(err, appeal.ErrAppealDuplicate):
+		return status.Errorf(codes.AlreadyExists, "appeal already exists: %v", err)
+	case errors.Is(err, appeal.ErrSoDViolation),
+		errors.Is(err, domain.ErrNoApproversLeft),
+		errors.Is(err, appeal.ErrAppealFoundActiveGrant),
+		errors.Is(err, appeal.ErrGrantNotEligibleForExtension):
+		return status.Errorf(codes.FailedPrecondition, "failed to update appeal: %v", err)
+	case errors.As(err, new(appeal.InvalidError)),
+		errors.As(err, &validationErrs),
+		errors.Is(err, appeal.ErrAppealIDEmptyParam),
+		errors.Is(err, appeal.ErrAppealStatusCanceled),
+		errors.Is(err, appeal.ErrAppealStatusExpired),
+		errors.Is(err, appeal.ErrAppealStatusApproved),
+		errors.Is(err, appeal.ErrAppealStatusRejected),
+		errors.Is(err, appeal.ErrAppealStatusUnrecognized),
+		errors.Is(err, appeal.ErrOptionsDurationNotFound),
+		errors.Is(err, provider.ErrInvalidRole),
+		errors.Is(err, provider.ErrOptionsDurationNotFound),
+		errors.Is(err, provider.ErrDurationIsRequired):
+		return status.Errorf(codes.InvalidArgument, "unable to process the request: %v", err)
+	default:
+		return status.Errorf(codes.Internal, "failed to update appeal: %v", err)
+	}
+}
+
+func (s *GRPCServer) ListAppealComments(ctx context.Context, req *guardianv1beta1.ListAppealCommentsRequest) (*guardianv1beta1.ListAppealCommentsResponse, error) {
+	actor, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	comments, err := s.appealService.ListComments(ctx, actor, domain.ListCommentsFilter{
+		AppealID: req.GetAppealId(),
+		OrderBy:  req.GetOrderBy(),
+	})
+	if err != nil {
+		return nil, s.appealCommentErrorToStatus(err)
+	}
+
+	commentProtos := []*guardianv1beta1.AppealComment{}
+	for _, c := range comments {
+		commentProtos = append(commentProtos, s.adapter.ToCommentProto(c))
+	}
+
+	return &guardianv1beta1.ListAppealCommentsResponse{
+		Comments: commentProtos,
+	}, nil
+}
+
+func (s *GRPCServer) CreateAppealComment(ctx context.Context, req *guardianv1beta1.CreateAppealCommentRequest) (*guardianv1beta1.CreateAppealCommentResponse, error) {
+	actor, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	c := &domain.Comment{
+		AppealID:  req.GetAppealId(),
+		CreatedBy: actor,
+		Body:      req.GetBody(),
+	}
+	if err := s.appealService.CreateComment(ctx, c); err != nil {
+		return nil, s.appealCommentErrorToStatus(err)
+	}
+
+	return &guardianv1beta1.CreateAppealCommentResponse{
+		Comment: s.adapter.ToCommentProto(c),
+	}, nil
+}
+
+func (s *GRPCServer) appealCommentErrorToStatus(err error) error {
+	var validationErrs validator.ValidationErrors
+	switch {
+	case errors.Is(err, appeal.ErrAppealNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, appeal.ErrCommentForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.As(err, new(appeal.InvalidError)),
+		errors.As(err, &validationErrs),
+		errors.Is(err, appeal.ErrAppealIDEmptyParam):
+		return status.Errorf(codes.InvalidArgument, "unable to process the request: %v", err)
+	default:
+		return status.Errorf(codes.Internal, "failed to process appeal comments: %v", err)
+	}
+}
+
 func (s *GRPCServer) listAppeals(ctx context.Context, filters *domain.ListAppealsFilter) ([]*guardianv1beta1.Appeal, int64, error) {
 	eg, ctx := errgroup.WithContext(ctx)
 	var appeals []*domain.Appeal
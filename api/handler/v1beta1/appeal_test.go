@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
 	"github.com/raystack/guardian/core/appeal"
+	"github.com/raystack/guardian/core/provider"
 	"github.com/raystack/guardian/domain"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
@@ -528,6 +529,33 @@ func (s *GrpcHandlersSuite) TestCreateAppeal() {
 		s.appealService.AssertExpectations(s.T())
 	})
 
+	s.Run("should return failed precondition error if appeal violates a separation of duties rule", func() {
+		s.setup()
+
+		s.appealService.EXPECT().Create(mock.AnythingOfType("*context.valueCtx"), mock.Anything).Return(appeal.ErrSoDViolation).Once()
+
+		req := &guardianv1beta1.CreateAppealRequest{}
+		res, err := s.grpcServer.CreateAppeal(s.ctx, req)
+
+		s.Equal(codes.FailedPrecondition, status.Code(err))
+		s.Nil(res)
+		s.appealService.AssertExpectations(s.T())
+	})
+
+	s.Run("should return failed precondition error if a step has no approvers left after excluding the creator", func() {
+		s.setup()
+
+		expectedError := fmt.Errorf("populating approvals: %w", domain.ErrNoApproversLeft)
+		s.appealService.EXPECT().Create(mock.AnythingOfType("*context.valueCtx"), mock.Anything).Return(expectedError).Once()
+
+		req := &guardianv1beta1.CreateAppealRequest{}
+		res, err := s.grpcServer.CreateAppeal(s.ctx, req)
+
+		s.Equal(codes.FailedPrecondition, status.Code(err))
+		s.Nil(res)
+		s.appealService.AssertExpectations(s.T())
+	})
+
 	s.Run("should return internal error if appeal service returns an error", func() {
 		s.setup()
 
@@ -605,6 +633,11 @@ func (s *GrpcHandlersSuite) TestGetAppeal() {
 					UpdatedAt: timeNow,
 				},
 			},
+			Risk: &domain.AppealRisk{
+				Score:   40,
+				Level:   domain.RiskLevelMedium,
+				Factors: []string{"sensitive_resource"},
+			},
 			CreatedAt: timeNow,
 			UpdatedAt: timeNow,
 		}
@@ -648,6 +681,11 @@ func (s *GrpcHandlersSuite) TestGetAppeal() {
 				},
 				CreatedAt: timestamppb.New(timeNow),
 				UpdatedAt: timestamppb.New(timeNow),
+				Risk: &guardianv1beta1.AppealRisk{
+					Score:   40,
+					Level:   domain.RiskLevelMedium,
+					Factors: []string{"sensitive_resource"},
+				},
 			},
 		}
 		s.appealService.EXPECT().GetByID(mock.AnythingOfType("context.backgroundCtx"), expectedID).Return(expectedAppeal, nil).Once()
@@ -891,3 +929,320 @@ func (s *GrpcHandlersSuite) TestCancelAppeal() {
 		s.appealService.AssertExpectations(s.T())
 	})
 }
+
+func (s *GrpcHandlersSuite) TestUpdateAppeal() {
+	s.Run("should return the updated appeal on success", func() {
+		s.setup()
+		timeNow := time.Now()
+
+		expectedID := uuid.New().String()
+		details, err := structpb.NewStruct(map[string]interface{}{
+			"__policy_questions": map[string]interface{}{"team": "data"},
+		})
+		s.Require().NoError(err)
+		expectedUpdate := domain.AppealUpdate{
+			AppealID:    expectedID,
+			Actor:       "test@example.com",
+			Description: "test-description",
+			Role:        "test-role",
+			Duration:    "24h",
+			Details: map[string]interface{}{
+				"__policy_questions": map[string]interface{}{"team": "data"},
+			},
+		}
+		expectedAppeal := &domain.Appeal{
+			ID:          expectedID,
+			Status:      domain.AppealStatusPending,
+			Role:        "test-role",
+			Description: "test-description",
+			Revisions: []*domain.AppealRevision{
+				{
+					Status:      domain.AppealStatusNeedsInfo,
+					Role:        "previous-role",
+					Description: "previous-description",
+					Approvals: []*domain.Approval{
+						{
+							Name:   "test-approval-name",
+							Status: domain.ApprovalStatusPending,
+							Decisions: []*domain.ApprovalDecision{
+								{
+									Actor:     "approver@example.com",
+									Action:    domain.AppealActionNameRequestInfo,
+									Reason:    "why?",
+									CreatedAt: timeNow,
+								},
+							},
+							CreatedAt: timeNow,
+							UpdatedAt: timeNow,
+						},
+					},
+					UpdatedBy: "test@example.com",
+					UpdatedAt: timeNow,
+				},
+			},
+		}
+		expectedResponse := &guardianv1beta1.UpdateAppealResponse{
+			Appeal: &guardianv1beta1.Appeal{
+				Id:          expectedID,
+				Status:      domain.AppealStatusPending,
+				Role:        "test-role",
+				Description: "test-description",
+				Revisions: []*guardianv1beta1.AppealRevision{
+					{
+						Status:      domain.AppealStatusNeedsInfo,
+						Role:        "previous-role",
+						Description: "previous-description",
+						Approvals: []*guardianv1beta1.Approval{
+							{
+								Name:   "test-approval-name",
+								Status: domain.ApprovalStatusPending,
+								Decisions: []*guardianv1beta1.ApprovalDecision{
+									{
+										Actor:     "approver@example.com",
+										Action:    domain.AppealActionNameRequestInfo,
+										Reason:    "why?",
+										CreatedAt: timestamppb.New(timeNow),
+									},
+								},
+								CreatedAt: timestamppb.New(timeNow),
+								UpdatedAt: timestamppb.New(timeNow),
+							},
+						},
+						UpdatedBy: "test@example.com",
+						UpdatedAt: timestamppb.New(timeNow),
+					},
+				},
+			},
+		}
+		s.appealService.EXPECT().UpdateAppeal(mock.AnythingOfType("*context.valueCtx"), expectedUpdate).Return(expectedAppeal, nil).Once()
+
+		req := &guardianv1beta1.UpdateAppealRequest{
+			Id:          expectedID,
+			Description: "test-description",
+			Role:        "test-role",
+			Duration:    "24h",
+			Details:     details,
+		}
+		res, err := s.grpcServer.UpdateAppeal(s.ctx, req)
+
+		s.NoError(err)
+		s.Equal(expectedResponse, res)
+		s.appealService.AssertExpectations(s.T())
+	})
+
+	s.Run("should return unauthenticated error if user is not authenticated", func() {
+		s.setup()
+
+		res, err := s.grpcServer.UpdateAppeal(context.Background(), &guardianv1beta1.UpdateAppealRequest{})
+
+		s.Equal(codes.Unauthenticated, status.Code(err))
+		s.Nil(res)
+	})
+
+	s.Run("should return error if appeal service returns an error", func() {
+		testCases := []struct {
+			name               string
+			expectedError      error
+			expectedStatusCode codes.Code
+		}{
+			{
+				"should return not found error if appeal not found",
+				appeal.ErrAppealNotFound,
+				codes.NotFound,
+			},
+			{
+				"should return permission denied error if user is not the creator",
+				appeal.ErrAppealUpdateForbidden,
+				codes.PermissionDenied,
+			},
+			{
+				"should return invalid argument error if appeal is no longer pending",
+				appeal.ErrAppealStatusApproved,
+				codes.InvalidArgument,
+			},
+			{
+				"should return invalid argument error if role is invalid",
+				fmt.Errorf("validating appeal based on provider: %w", provider.ErrInvalidRole),
+				codes.InvalidArgument,
+			},
+			{
+				"should return already exists error if another appeal has the same role",
+				appeal.ErrAppealDuplicate,
+				codes.AlreadyExists,
+			},
+			{
+				"should return failed precondition error if appeal violates a separation of duties rule",
+				fmt.Errorf("%w: %q", appeal.ErrSoDViolation, "test-rule"),
+				codes.FailedPrecondition,
+			},
+			{
+				"should return internal error if appeal service returns a unexpected error",
+				errors.New("unexpected error"),
+				codes.Internal,
+			},
+		}
+
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				s.setup()
+
+				s.appealService.EXPECT().UpdateAppeal(mock.AnythingOfType("*context.valueCtx"), mock.Anything).
+					Return(nil, tc.expectedError).Once()
+
+				res, err := s.grpcServer.UpdateAppeal(s.ctx, &guardianv1beta1.UpdateAppealRequest{
+					Id: uuid.New().String(),
+				})
+
+				s.Equal(tc.expectedStatusCode, status.Code(err))
+				s.Nil(res)
+				s.appealService.AssertExpectations(s.T())
+			})
+		}
+	})
+}
+
+func (s *GrpcHandlersSuite) TestCreateAppealComment() {
+	s.Run("should return the created comment on success", func() {
+		s.setup()
+		timeNow := time.Now()
+
+		appealID := uuid.New().String()
+		expectedComment := &domain.Comment{
+			AppealID:  appealID,
+			CreatedBy: "test@example.com",
+			Body:      "is read access enough?",
+		}
+		s.appealService.EXPECT().CreateComment(mock.AnythingOfType("*context.valueCtx"), expectedComment).
+			Run(func(_ context.Context, c *domain.Comment) {
+				c.ID = "comment-id"
+				c.CreatedAt = timeNow
+				c.UpdatedAt = timeNow
+			}).Return(nil).Once()
+
+		res, err := s.grpcServer.CreateAppealComment(s.ctx, &guardianv1beta1.CreateAppealCommentRequest{
+			AppealId: appealID,
+			Body:     "is read access enough?",
+		})
+
+		s.NoError(err)
+		s.Equal(&guardianv1beta1.CreateAppealCommentResponse{
+			Comment: &guardianv1beta1.AppealComment{
+				Id:        "comment-id",
+				AppealId:  appealID,
+				CreatedBy: "test@example.com",
+				Body:      "is read access enough?",
+				CreatedAt: timestamppb.New(timeNow),
+				UpdatedAt: timestamppb.New(timeNow),
+			},
+		}, res)
+		s.appealService.AssertExpectations(s.T())
+	})
+
+	s.Run("should return unauthenticated error if user is not authenticated", func() {
+		s.setup()
+
+		res, err := s.grpcServer.CreateAppealComment(context.Background(), &guardianv1beta1.CreateAppealCommentRequest{})
+
+		s.Equal(codes.Unauthenticated, status.Code(err))
+		s.Nil(res)
+	})
+
+	s.Run("should return error if appeal service returns an error", func() {
+		testCases := []struct {
+			name               string
+			expectedError      error
+			expectedStatusCode codes.Code
+		}{
+			{
+				"should return not found error if appeal not found",
+				fmt.Errorf("getting appeal details: %w", appeal.ErrAppealNotFound),
+				codes.NotFound,
+			},
+			{
+				"should return invalid argument error if appeal id is invalid",
+				appeal.InvalidError{AppealID: "invalid"},
+				codes.InvalidArgument,
+			},
+			{
+				"should return internal error if appeal service returns a unexpected error",
+				errors.New("unexpected error"),
+				codes.Internal,
+			},
+		}
+
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				s.setup()
+
+				s.appealService.EXPECT().CreateComment(mock.AnythingOfType("*context.valueCtx"), mock.Anything).
+					Return(tc.expectedError).Once()
+
+				res, err := s.grpcServer.CreateAppealComment(s.ctx, &guardianv1beta1.CreateAppealCommentRequest{
+					AppealId: uuid.New().String(),
+					Body:     "hello",
+				})
+
+				s.Equal(tc.expectedStatusCode, status.Code(err))
+				s.Nil(res)
+				s.appealService.AssertExpectations(s.T())
+			})
+		}
+	})
+}
+
+func (s *GrpcHandlersSuite) TestListAppealComments() {
+	s.Run("should return comments of the appeal on success", func() {
+		s.setup()
+
+		appealID := uuid.New().String()
+		expectedFilter := domain.ListCommentsFilter{
+			AppealID: appealID,
+			OrderBy:  []string{"created_at:desc"},
+		}
+		s.appealService.EXPECT().ListComments(mock.Anything, "test@example.com", expectedFilter).
+			Return([]*domain.Comment{
+				{ID: "comment-id", AppealID: appealID, CreatedBy: "user@example.com", Body: "hello"},
+			}, nil).Once()
+
+		res, err := s.grpcServer.ListAppealComments(s.ctx, &guardianv1beta1.ListAppealCommentsRequest{
+			AppealId: appealID,
+			OrderBy:  []string{"created_at:desc"},
+		})
+
+		s.NoError(err)
+		s.Equal(&guardianv1beta1.ListAppealCommentsResponse{
+			Comments: []*guardianv1beta1.AppealComment{
+				{Id: "comment-id", AppealId: appealID, CreatedBy: "user@example.com", Body: "hello"},
+			},
+		}, res)
+		s.appealService.AssertExpectations(s.T())
+	})
+
+	s.Run("should return not found error if appeal not found", func() {
+		s.setup()
+
+		s.appealService.EXPECT().ListComments(mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, appeal.ErrAppealNotFound).Once()
+
+		res, err := s.grpcServer.ListAppealComments(s.ctx, &guardianv1beta1.ListAppealCommentsRequest{
+			AppealId: uuid.New().String(),
+		})
+
+		s.Equal(codes.NotFound, status.Code(err))
+		s.Nil(res)
+	})
+
+	s.Run("should return permission denied error if the user is not a participant of the appeal", func() {
+		s.setup()
+
+		s.appealService.EXPECT().ListComments(mock.Anything, "test@example.com", mock.Anything).
+			Return(nil, appeal.ErrCommentForbidden).Once()
+
+		res, err := s.grpcServer.ListAppealComments(s.ctx, &guardianv1beta1.ListAppealCommentsRequest{
+			AppealId: uuid.New().String(),
+		})
+
+		s.Equal(codes.PermissionDenied, status.Code(err))
+		s.Nil(res)
+	})
+}
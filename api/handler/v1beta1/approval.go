@@ -3,9 +3,11 @@ package v1beta1
 import (
 	"context"
 	"errors"
+	"time"
 
 	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
 	"github.com/raystack/guardian/core/appeal"
+	"github.com/raystack/guardian/core/provider"
 	"github.com/raystack/guardian/domain"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
@@ -18,6 +20,15 @@ func (s *GRPCServer) ListUserApprovals(ctx context.Context, req *guardianv1beta1
 		return nil, status.Error(codes.Unauthenticated, err.Error())
 	}
 
+	now := time.Now()
+	delegations, err := s.delegationService.Find(ctx, domain.ListDelegationsFilter{
+		Delegates: []string{user},
+		ActiveAt:  &now,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get delegation list: %v", err)
+	}
+
 	approvals, total, err := s.listApprovals(ctx, &domain.ListApprovalsFilter{
 		Q:              req.GetQ(),
 		AccountID:      req.GetAccountId(),
@@ -29,6 +40,7 @@ func (s *GRPCServer) ListUserApprovals(ctx context.Context, req *guardianv1beta1
 		Size:           int(req.GetSize()),
 		Offset:         int(req.GetOffset()),
 		AppealStatuses: req.GetAppealStatuses(),
+		Delegations:    delegations,
 	})
 	if err != nil {
 		return nil, err
@@ -76,10 +88,21 @@ func (s *GRPCServer) UpdateApproval(ctx context.Context, req *guardianv1beta1.Up
 		Actor:        actor,
 		Action:       req.GetAction().GetAction(),
 		Reason:       req.GetAction().GetReason(),
+		Role:         req.GetAction().GetRole(),
+		Duration:     req.GetAction().GetDuration(),
 	})
 	if err != nil {
+		if errors.Is(err, appeal.ErrDurationOverrideTooLong) ||
+			errors.Is(err, appeal.ErrRoleOverrideNotAllowed) ||
+			errors.Is(err, appeal.ErrOptionsDurationNotFound) ||
+			errors.Is(err, provider.ErrInvalidRole) ||
+			errors.Is(err, provider.ErrOptionsDurationNotFound) {
+			return nil, status.Errorf(codes.InvalidArgument, "unable to process the request: %v", err)
+		}
+
 		switch err {
 		case appeal.ErrAppealStatusCanceled,
+			appeal.ErrAppealStatusExpired,
 			appeal.ErrAppealStatusApproved,
 			appeal.ErrAppealStatusRejected,
 			appeal.ErrAppealStatusUnrecognized,
@@ -88,10 +111,17 @@ func (s *GRPCServer) UpdateApproval(ctx context.Context, req *guardianv1beta1.Up
 			appeal.ErrApprovalStatusApproved,
 			appeal.ErrApprovalStatusRejected,
 			appeal.ErrApprovalStatusSkipped,
-			appeal.ErrActionInvalidValue:
+			appeal.ErrAppealStatusNeedsInfo,
+			appeal.ErrActionInvalidValue,
+			appeal.ErrInfoRequestQuestionRequired,
+			appeal.ErrApprovalOverrideNotAllowed,
+			domain.ErrApproverAlreadyDecided:
 			return nil, status.Errorf(codes.InvalidArgument, "unable to process the request: %v", err)
 		case appeal.ErrActionForbidden:
 			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		case domain.ErrSelfApprovalNotAllowed,
+			domain.ErrRepeatedApproverNotAllowed:
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied: %v", err)
 		case appeal.ErrApprovalNotFound:
 			return nil, status.Errorf(codes.NotFound, "approval not found: %v", id)
 		default:
@@ -109,6 +139,57 @@ func (s *GRPCServer) UpdateApproval(ctx context.Context, req *guardianv1beta1.Up
 	}, nil
 }
 
+func (s *GRPCServer) UpdateExternalApproval(ctx context.Context, req *guardianv1beta1.UpdateExternalApprovalRequest) (*guardianv1beta1.UpdateExternalApprovalResponse, error) {
+	actor, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	id := req.GetId()
+	a, err := s.appealService.UpdateExternalApproval(ctx, domain.ApprovalAction{
+		AppealID:     id,
+		ApprovalName: req.GetApprovalName(),
+		Actor:        actor,
+		Action:       req.GetDecision(),
+		Reason:       req.GetReason(),
+	})
+	if err != nil {
+		switch err {
+		case appeal.ErrAppealStatusCanceled,
+			appeal.ErrAppealStatusExpired,
+			appeal.ErrAppealStatusApproved,
+			appeal.ErrAppealStatusRejected,
+			appeal.ErrAppealStatusUnrecognized,
+			appeal.ErrAppealStatusNeedsInfo,
+			appeal.ErrApprovalStatusUnrecognized,
+			appeal.ErrApprovalStatusApproved,
+			appeal.ErrApprovalStatusRejected,
+			appeal.ErrApprovalStatusSkipped,
+			appeal.ErrAppealStatusBlocked,
+			appeal.ErrApprovalNotExternal,
+			appeal.ErrActionInvalidValue:
+			return nil, status.Errorf(codes.InvalidArgument, "unable to process the request: %v", err)
+		case appeal.ErrActionForbidden:
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		case appeal.ErrAppealNotFound:
+			return nil, status.Errorf(codes.NotFound, "appeal not found: %v", id)
+		case appeal.ErrApprovalNotFound:
+			return nil, status.Errorf(codes.NotFound, "approval not found: %v", req.GetApprovalName())
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to update external approval: %v", err)
+		}
+	}
+
+	appealProto, err := s.adapter.ToAppealProto(a)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse appeal: %v", err)
+	}
+
+	return &guardianv1beta1.UpdateExternalApprovalResponse{
+		Appeal: appealProto,
+	}, nil
+}
+
 func (s *GRPCServer) AddApprover(ctx context.Context, req *guardianv1beta1.AddApproverRequest) (*guardianv1beta1.AddApproverResponse, error) {
 	a, err := s.appealService.AddApprover(ctx, req.GetAppealId(), req.GetApprovalId(), req.GetEmail())
 	switch {
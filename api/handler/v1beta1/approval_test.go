@@ -24,11 +24,15 @@ func (s *GrpcHandlersSuite) TestListUserApprovals() {
 		timeNow := time.Now()
 
 		expectedUser := "test@example.com"
+		expectedDelegations := []*domain.Delegation{
+			{Delegator: "delegator@example.com", Delegate: expectedUser},
+		}
 		expectedFilters := &domain.ListApprovalsFilter{
-			CreatedBy: expectedUser,
-			AccountID: "test-account-id",
-			Statuses:  []string{"active", "pending"},
-			OrderBy:   []string{"test-order"},
+			CreatedBy:   expectedUser,
+			AccountID:   "test-account-id",
+			Statuses:    []string{"active", "pending"},
+			OrderBy:     []string{"test-order"},
+			Delegations: expectedDelegations,
 		}
 		expectedApprovals := []*domain.Approval{
 			{
@@ -109,6 +113,9 @@ func (s *GrpcHandlersSuite) TestListUserApprovals() {
 			},
 			Total: 1,
 		}
+		s.delegationService.EXPECT().Find(mock.AnythingOfType("*context.valueCtx"), mock.MatchedBy(func(filter domain.ListDelegationsFilter) bool {
+			return s.Equal([]string{expectedUser}, filter.Delegates) && filter.ActiveAt != nil
+		})).Return(expectedDelegations, nil).Once()
 		s.approvalService.EXPECT().ListApprovals(mock.AnythingOfType("*context.cancelCtx"), expectedFilters).
 			Return(expectedApprovals, nil).Once()
 		s.approvalService.EXPECT().GetApprovalsTotalCount(mock.AnythingOfType("*context.cancelCtx"), expectedFilters).
@@ -124,6 +131,7 @@ func (s *GrpcHandlersSuite) TestListUserApprovals() {
 		s.NoError(err)
 		s.Equal(expectedResponse, res)
 		s.approvalService.AssertExpectations(s.T())
+		s.delegationService.AssertExpectations(s.T())
 	})
 
 	s.Run("should return unathenticated error if request is not authenticated", func() {
@@ -140,10 +148,25 @@ func (s *GrpcHandlersSuite) TestListUserApprovals() {
 		s.approvalService.AssertExpectations(s.T())
 	})
 
+	s.Run("should return internal error if delegationService.Find returns an error", func() {
+		s.setup()
+
+		s.delegationService.EXPECT().Find(mock.AnythingOfType("*context.valueCtx"), mock.Anything).
+			Return(nil, errors.New("random error")).Once()
+
+		req := &guardianv1beta1.ListUserApprovalsRequest{}
+		res, err := s.grpcServer.ListUserApprovals(s.ctx, req)
+
+		s.Equal(codes.Internal, status.Code(err))
+		s.Nil(res)
+		s.delegationService.AssertExpectations(s.T())
+	})
+
 	s.Run("should return internal error if approvalService.ListApprovals returns an error", func() {
 		s.setup()
 
 		expectedError := errors.New("random error")
+		s.delegationService.EXPECT().Find(mock.AnythingOfType("*context.valueCtx"), mock.Anything).Return(nil, nil).Once()
 		s.approvalService.EXPECT().ListApprovals(mock.AnythingOfType("*context.cancelCtx"), mock.Anything).
 			Return(nil, expectedError).Once()
 		s.approvalService.EXPECT().GetApprovalsTotalCount(mock.AnythingOfType("*context.cancelCtx"), mock.Anything).
@@ -160,6 +183,7 @@ func (s *GrpcHandlersSuite) TestListUserApprovals() {
 	s.Run("should return internal error if approvalService.GetApprovalsTotalCount returns an error", func() {
 		s.setup()
 
+		s.delegationService.EXPECT().Find(mock.AnythingOfType("*context.valueCtx"), mock.Anything).Return(nil, nil).Once()
 		s.approvalService.EXPECT().ListApprovals(mock.AnythingOfType("*context.cancelCtx"), mock.Anything).
 			Return([]*domain.Approval{}, nil).Once()
 		expectedError := errors.New("random error")
@@ -186,6 +210,7 @@ func (s *GrpcHandlersSuite) TestListUserApprovals() {
 				},
 			},
 		}
+		s.delegationService.EXPECT().Find(mock.AnythingOfType("*context.valueCtx"), mock.Anything).Return(nil, nil).Once()
 		s.approvalService.EXPECT().ListApprovals(mock.AnythingOfType("*context.cancelCtx"), mock.Anything).
 			Return(invalidApprovals, nil).Once()
 		s.approvalService.EXPECT().GetApprovalsTotalCount(mock.AnythingOfType("*context.cancelCtx"), mock.Anything).
@@ -543,6 +568,36 @@ func (s *GrpcHandlersSuite) TestUpdateApproval() {
 				appeal.ErrActionForbidden,
 				codes.PermissionDenied,
 			},
+			{
+				"should return invalid error if appeal is waiting for more information",
+				appeal.ErrAppealStatusNeedsInfo,
+				codes.InvalidArgument,
+			},
+			{
+				"should return invalid error if question for the creator is empty",
+				appeal.ErrInfoRequestQuestionRequired,
+				codes.InvalidArgument,
+			},
+			{
+				"should return invalid error if overrides are set for other actions than approve",
+				appeal.ErrApprovalOverrideNotAllowed,
+				codes.InvalidArgument,
+			},
+			{
+				"should return invalid error if duration override is longer than requested",
+				fmt.Errorf("%w: %q is longer than %q", appeal.ErrDurationOverrideTooLong, "48h", "24h"),
+				codes.InvalidArgument,
+			},
+			{
+				"should return permission denied error if the policy disallows self-approval",
+				domain.ErrSelfApprovalNotAllowed,
+				codes.PermissionDenied,
+			},
+			{
+				"should return permission denied error if the policy disallows repeated approvers",
+				domain.ErrRepeatedApproverNotAllowed,
+				codes.PermissionDenied,
+			},
 			{
 				"should return not found error if appeal not found",
 				appeal.ErrApprovalNotFound,
@@ -593,6 +648,161 @@ func (s *GrpcHandlersSuite) TestUpdateApproval() {
 	})
 }
 
+func (s *GrpcHandlersSuite) TestUpdateExternalApproval() {
+	s.Run("should return appeal details on success", func() {
+		s.setup()
+		timeNow := time.Now()
+
+		expectedUser := "test@example.com"
+		expectedID := "test-appeal-id"
+		expectedApprovalName := "nda_check"
+		expectedAppeal := &domain.Appeal{
+			ID:            expectedID,
+			ResourceID:    "test-resource-id",
+			PolicyID:      "test-policy-id",
+			PolicyVersion: 1,
+			Status:        domain.AppealStatusApproved,
+			AccountID:     "test-account-id",
+			Role:          "test-role",
+			Approvals: []*domain.Approval{
+				{
+					ID:        "test-approval-id",
+					Name:      expectedApprovalName,
+					Status:    domain.ApprovalStatusApproved,
+					Actor:     &expectedUser,
+					Reason:    "nda signed",
+					CreatedAt: timeNow,
+					UpdatedAt: timeNow,
+				},
+			},
+			CreatedAt: timeNow,
+			UpdatedAt: timeNow,
+		}
+		expectedResponse := &guardianv1beta1.UpdateExternalApprovalResponse{
+			Appeal: &guardianv1beta1.Appeal{
+				Id:            expectedID,
+				ResourceId:    "test-resource-id",
+				PolicyId:      "test-policy-id",
+				PolicyVersion: 1,
+				Status:        domain.AppealStatusApproved,
+				AccountId:     "test-account-id",
+				Role:          "test-role",
+				Approvals: []*guardianv1beta1.Approval{
+					{
+						Id:        "test-approval-id",
+						Name:      expectedApprovalName,
+						Status:    domain.ApprovalStatusApproved,
+						Actor:     expectedUser,
+						Reason:    "nda signed",
+						CreatedAt: timestamppb.New(timeNow),
+						UpdatedAt: timestamppb.New(timeNow),
+					},
+				},
+				CreatedAt: timestamppb.New(timeNow),
+				UpdatedAt: timestamppb.New(timeNow),
+			},
+		}
+		expectedApprovalAction := domain.ApprovalAction{
+			AppealID:     expectedID,
+			ApprovalName: expectedApprovalName,
+			Actor:        expectedUser,
+			Action:       domain.AppealActionNameApprove,
+			Reason:       "nda signed",
+		}
+		s.appealService.EXPECT().UpdateExternalApproval(mock.AnythingOfType("*context.valueCtx"), expectedApprovalAction).Return(expectedAppeal, nil).Once()
+
+		req := &guardianv1beta1.UpdateExternalApprovalRequest{
+			Id:           expectedID,
+			ApprovalName: expectedApprovalName,
+			Decision:     domain.AppealActionNameApprove,
+			Reason:       "nda signed",
+		}
+		res, err := s.grpcServer.UpdateExternalApproval(s.ctx, req)
+
+		s.NoError(err)
+		s.Equal(expectedResponse, res)
+		s.appealService.AssertExpectations(s.T())
+	})
+
+	s.Run("should return unauthenticated error if request is not authenticated", func() {
+		s.setup()
+
+		req := &guardianv1beta1.UpdateExternalApprovalRequest{}
+		ctx := context.Background()
+		md := metadata.New(map[string]string{})
+		ctx = metadata.NewIncomingContext(ctx, md)
+		res, err := s.grpcServer.UpdateExternalApproval(ctx, req)
+
+		s.Equal(codes.Unauthenticated, status.Code(err))
+		s.Nil(res)
+	})
+
+	s.Run("should return error if appeal service returns an error", func() {
+		testCases := []struct {
+			name               string
+			expectedError      error
+			expectedStatusCode codes.Code
+		}{
+			{
+				"should return invalid error if appeal is no longer pending",
+				appeal.ErrAppealStatusApproved,
+				codes.InvalidArgument,
+			},
+			{
+				"should return invalid error if approval is already resolved",
+				appeal.ErrApprovalStatusRejected,
+				codes.InvalidArgument,
+			},
+			{
+				"should return invalid error if approval step is not external",
+				appeal.ErrApprovalNotExternal,
+				codes.InvalidArgument,
+			},
+			{
+				"should return invalid error if decision is invalid",
+				appeal.ErrActionInvalidValue,
+				codes.InvalidArgument,
+			},
+			{
+				"should return permission denied error if user is not a callback actor of the webhook",
+				appeal.ErrActionForbidden,
+				codes.PermissionDenied,
+			},
+			{
+				"should return not found error if appeal not found",
+				appeal.ErrAppealNotFound,
+				codes.NotFound,
+			},
+			{
+				"should return not found error if approval not found",
+				appeal.ErrApprovalNotFound,
+				codes.NotFound,
+			},
+			{
+				"should return internal error if appeal service returns a unexpected error",
+				errors.New("unexpected error"),
+				codes.Internal,
+			},
+		}
+
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				s.setup()
+
+				s.appealService.EXPECT().UpdateExternalApproval(mock.AnythingOfType("*context.valueCtx"), mock.Anything).
+					Return(nil, tc.expectedError).Once()
+
+				req := &guardianv1beta1.UpdateExternalApprovalRequest{}
+				res, err := s.grpcServer.UpdateExternalApproval(s.ctx, req)
+
+				s.Equal(tc.expectedStatusCode, status.Code(err))
+				s.Nil(res)
+				s.appealService.AssertExpectations(s.T())
+			})
+		}
+	})
+}
+
 func (s *GrpcHandlersSuite) TestAddApprover() {
 	s.Run("should return appeal details on success", func() {
 		s.setup()
@@ -0,0 +1,150 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/guardian/core/bundle"
+	"github.com/raystack/guardian/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) ListBundles(ctx context.Context, req *guardianv1beta1.ListBundlesRequest) (*guardianv1beta1.ListBundlesResponse, error) {
+	bundles, err := s.bundleService.Find(ctx, domain.ListBundlesFilter{
+		Names:     req.GetNames(),
+		CreatedBy: req.GetCreatedBy(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get bundle list: %v", err)
+	}
+
+	bundleProtos := []*guardianv1beta1.Bundle{}
+	for _, b := range bundles {
+		bundleProtos = append(bundleProtos, s.adapter.ToBundleProto(b))
+	}
+
+	return &guardianv1beta1.ListBundlesResponse{
+		Bundles: bundleProtos,
+	}, nil
+}
+
+func (s *GRPCServer) GetBundle(ctx context.Context, req *guardianv1beta1.GetBundleRequest) (*guardianv1beta1.GetBundleResponse, error) {
+	b, err := s.bundleService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, s.bundleErrorToStatus(err, "failed to get bundle details")
+	}
+
+	return &guardianv1beta1.GetBundleResponse{
+		Bundle: s.adapter.ToBundleProto(b),
+	}, nil
+}
+
+func (s *GRPCServer) CreateBundle(ctx context.Context, req *guardianv1beta1.CreateBundleRequest) (*guardianv1beta1.CreateBundleResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	b := s.adapter.FromBundleProto(req.GetBundle())
+	if b == nil {
+		return nil, status.Error(codes.InvalidArgument, "bundle payload is required")
+	}
+	b.CreatedBy = user
+
+	if err := s.bundleService.Create(ctx, b); err != nil {
+		return nil, s.bundleErrorToStatus(err, "failed to create bundle")
+	}
+
+	return &guardianv1beta1.CreateBundleResponse{
+		Bundle: s.adapter.ToBundleProto(b),
+	}, nil
+}
+
+func (s *GRPCServer) UpdateBundle(ctx context.Context, req *guardianv1beta1.UpdateBundleRequest) (*guardianv1beta1.UpdateBundleResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	b := s.adapter.FromBundleProto(req.GetBundle())
+	if b == nil {
+		return nil, status.Error(codes.InvalidArgument, "bundle payload is required")
+	}
+	b.ID = req.GetId()
+
+	if err := s.bundleService.Update(ctx, user, b); err != nil {
+		return nil, s.bundleErrorToStatus(err, "failed to update bundle")
+	}
+
+	return &guardianv1beta1.UpdateBundleResponse{
+		Bundle: s.adapter.ToBundleProto(b),
+	}, nil
+}
+
+func (s *GRPCServer) DeleteBundle(ctx context.Context, req *guardianv1beta1.DeleteBundleRequest) (*guardianv1beta1.DeleteBundleResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err := s.bundleService.Delete(ctx, user, req.GetId()); err != nil {
+		return nil, s.bundleErrorToStatus(err, "failed to delete bundle")
+	}
+
+	return &guardianv1beta1.DeleteBundleResponse{}, nil
+}
+
+func (s *GRPCServer) GetBundleStatus(ctx context.Context, req *guardianv1beta1.GetBundleStatusRequest) (*guardianv1beta1.GetBundleStatusResponse, error) {
+	accountID := req.GetAccountId()
+	if accountID == "" {
+		user, err := s.getUser(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		accountID = user
+	}
+
+	bundleStatus, appeals, err := s.bundleService.GetAppealStatus(ctx, req.GetId(), accountID)
+	if err != nil {
+		return nil, s.bundleErrorToStatus(err, "failed to get bundle status")
+	}
+
+	appealProtos := []*guardianv1beta1.Appeal{}
+	for _, a := range appeals {
+		appealProto, err := s.adapter.ToAppealProto(a)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to parse appeal: %v", err)
+		}
+		appealProtos = append(appealProtos, appealProto)
+	}
+
+	return &guardianv1beta1.GetBundleStatusResponse{
+		Status:  bundleStatus,
+		Appeals: appealProtos,
+	}, nil
+}
+
+func (s *GRPCServer) bundleErrorToStatus(err error, msg string) error {
+	var validationErrs validator.ValidationErrors
+	switch {
+	case errors.Is(err, bundle.ErrBundleNotFound),
+		errors.Is(err, bundle.ErrNoAppealsFoundOnBundle):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, bundle.ErrDuplicateBundleName):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, bundle.ErrInvalidBundleOwner):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, bundle.ErrEmptyIDParam),
+		errors.Is(err, bundle.ErrEmptyAccountIDParam),
+		errors.Is(err, bundle.ErrDuplicateBundleItem),
+		errors.Is(err, bundle.ErrInvalidBundleResource),
+		errors.Is(err, bundle.ErrInvalidBundlePolicy),
+		errors.As(err, &validationErrs):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
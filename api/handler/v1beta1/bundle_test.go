@@ -0,0 +1,200 @@
+package v1beta1_test
+
+import (
+	"context"
+
+	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/guardian/core/bundle"
+	"github.com/raystack/guardian/domain"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GrpcHandlersSuite) TestCreateBundle() {
+	s.Run("should set creator from authenticated user", func() {
+		s.setup()
+
+		expectedBundle := &domain.Bundle{
+			Name:           "new-joiner",
+			RequiredPolicy: &domain.PolicyConfig{ID: "onboarding", Version: 1},
+			Items: []*domain.BundleItem{
+				{Resource: &domain.ResourceIdentifier{ID: "resource-1"}, Role: "viewer", Duration: "720h"},
+			},
+			CreatedBy: "test@example.com",
+		}
+		s.bundleService.EXPECT().
+			Create(mock.Anything, expectedBundle).
+			Run(func(_ context.Context, b *domain.Bundle) {
+				b.ID = "bundle-id"
+			}).
+			Return(nil).Once()
+
+		req := &guardianv1beta1.CreateBundleRequest{
+			Bundle: &guardianv1beta1.Bundle{
+				Name:           "new-joiner",
+				RequiredPolicy: &guardianv1beta1.PolicyConfig{Id: "onboarding", Version: 1},
+				Items: []*guardianv1beta1.Bundle_Item{
+					{
+						Resource: &guardianv1beta1.Bundle_Item_ResourceIdentifier{Id: "resource-1"},
+						Role:     "viewer",
+						Duration: "720h",
+					},
+				},
+			},
+		}
+		res, err := s.grpcServer.CreateBundle(s.ctx, req)
+
+		s.NoError(err)
+		s.Equal("bundle-id", res.GetBundle().GetId())
+		s.Equal("test@example.com", res.GetBundle().GetCreatedBy())
+		s.bundleService.AssertExpectations(s.T())
+	})
+
+	s.Run("should return already exists error if bundle name is taken", func() {
+		s.setup()
+
+		s.bundleService.EXPECT().
+			Create(mock.Anything, mock.Anything).
+			Return(bundle.ErrDuplicateBundleName).Once()
+
+		res, err := s.grpcServer.CreateBundle(s.ctx, &guardianv1beta1.CreateBundleRequest{
+			Bundle: &guardianv1beta1.Bundle{Name: "new-joiner"},
+		})
+
+		s.Equal(codes.AlreadyExists, status.Code(err))
+		s.Nil(res)
+	})
+}
+
+func (s *GrpcHandlersSuite) TestUpdateBundle() {
+	s.Run("should return permission denied if the user is not the creator of the bundle", func() {
+		s.setup()
+
+		s.bundleService.EXPECT().
+			Update(mock.Anything, "test@example.com", mock.AnythingOfType("*domain.Bundle")).
+			Return(bundle.ErrInvalidBundleOwner).Once()
+
+		res, err := s.grpcServer.UpdateBundle(s.ctx, &guardianv1beta1.UpdateBundleRequest{
+			Id:     "bundle-id",
+			Bundle: &guardianv1beta1.Bundle{Name: "new-joiner"},
+		})
+
+		s.Equal(codes.PermissionDenied, status.Code(err))
+		s.Nil(res)
+		s.bundleService.AssertExpectations(s.T())
+	})
+
+	s.Run("should return unauthenticated error if the user is not found", func() {
+		s.setup()
+
+		res, err := s.grpcServer.UpdateBundle(context.Background(), &guardianv1beta1.UpdateBundleRequest{
+			Id:     "bundle-id",
+			Bundle: &guardianv1beta1.Bundle{Name: "new-joiner"},
+		})
+
+		s.Equal(codes.Unauthenticated, status.Code(err))
+		s.Nil(res)
+		s.bundleService.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func (s *GrpcHandlersSuite) TestDeleteBundle() {
+	s.Run("should delete the bundle as the authenticated user", func() {
+		s.setup()
+
+		s.bundleService.EXPECT().Delete(mock.Anything, "test@example.com", "bundle-id").Return(nil).Once()
+
+		res, err := s.grpcServer.DeleteBundle(s.ctx, &guardianv1beta1.DeleteBundleRequest{Id: "bundle-id"})
+
+		s.NoError(err)
+		s.NotNil(res)
+		s.bundleService.AssertExpectations(s.T())
+	})
+
+	s.Run("should return permission denied if the user is not the creator of the bundle", func() {
+		s.setup()
+
+		s.bundleService.EXPECT().Delete(mock.Anything, "test@example.com", "bundle-id").Return(bundle.ErrInvalidBundleOwner).Once()
+
+		res, err := s.grpcServer.DeleteBundle(s.ctx, &guardianv1beta1.DeleteBundleRequest{Id: "bundle-id"})
+
+		s.Equal(codes.PermissionDenied, status.Code(err))
+		s.Nil(res)
+	})
+}
+
+func (s *GrpcHandlersSuite) TestGetBundleStatus() {
+	s.Run("should default account id to the authenticated user", func() {
+		s.setup()
+
+		s.bundleService.EXPECT().
+			GetAppealStatus(mock.Anything, "bundle-id", "test@example.com").
+			Return(domain.BundleAppealStatusPartiallyApproved, []*domain.Appeal{
+				{ID: "appeal-1", BundleID: "bundle-id", Status: domain.AppealStatusApproved},
+				{ID: "appeal-2", BundleID: "bundle-id", Status: domain.AppealStatusRejected},
+			}, nil).Once()
+
+		res, err := s.grpcServer.GetBundleStatus(s.ctx, &guardianv1beta1.GetBundleStatusRequest{Id: "bundle-id"})
+
+		s.NoError(err)
+		s.Equal(domain.BundleAppealStatusPartiallyApproved, res.GetStatus())
+		s.Len(res.GetAppeals(), 2)
+		s.bundleService.AssertExpectations(s.T())
+	})
+
+	s.Run("should return not found error if bundle does not exist", func() {
+		s.setup()
+
+		s.bundleService.EXPECT().
+			GetAppealStatus(mock.Anything, mock.Anything, mock.Anything).
+			Return("", nil, bundle.ErrBundleNotFound).Once()
+
+		res, err := s.grpcServer.GetBundleStatus(s.ctx, &guardianv1beta1.GetBundleStatusRequest{Id: "bundle-id"})
+
+		s.Equal(codes.NotFound, status.Code(err))
+		s.Nil(res)
+	})
+}
+
+func (s *GrpcHandlersSuite) TestCreateAppeal__WithBundle() {
+	s.Run("should create appeals through the bundle service", func() {
+		s.setup()
+
+		s.bundleService.EXPECT().
+			CreateAppeals(mock.Anything, "bundle-id", &domain.Appeal{
+				AccountID:   "test@example.com",
+				AccountType: "user",
+				CreatedBy:   "test@example.com",
+				Description: "first day",
+			}).
+			Return([]*domain.Appeal{
+				{ID: "appeal-1", BundleID: "bundle-id"},
+				{ID: "appeal-2", BundleID: "bundle-id"},
+			}, nil).Once()
+
+		res, err := s.grpcServer.CreateAppeal(s.ctx, &guardianv1beta1.CreateAppealRequest{
+			AccountId:   "test@example.com",
+			AccountType: "user",
+			Description: "first day",
+			BundleId:    "bundle-id",
+		})
+
+		s.NoError(err)
+		s.Len(res.GetAppeals(), 2)
+		s.Equal("bundle-id", res.GetAppeals()[0].GetBundleId())
+		s.appealService.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should return invalid argument if resources are also specified", func() {
+		s.setup()
+
+		res, err := s.grpcServer.CreateAppeal(s.ctx, &guardianv1beta1.CreateAppealRequest{
+			BundleId:  "bundle-id",
+			Resources: []*guardianv1beta1.CreateAppealRequest_Resource{{Id: "resource-1", Role: "viewer"}},
+		})
+
+		s.Equal(codes.InvalidArgument, status.Code(err))
+		s.Nil(res)
+	})
+}
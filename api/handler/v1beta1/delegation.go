@@ -0,0 +1,127 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/guardian/core/delegation"
+	"github.com/raystack/guardian/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) ListDelegations(ctx context.Context, req *guardianv1beta1.ListDelegationsRequest) (*guardianv1beta1.ListDelegationsResponse, error) {
+	filter := domain.ListDelegationsFilter{
+		Delegators: req.GetDelegators(),
+		Delegates:  req.GetDelegates(),
+	}
+	if req.GetActiveOnly() {
+		now := time.Now()
+		filter.ActiveAt = &now
+	}
+
+	delegations, err := s.delegationService.Find(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get delegation list: %v", err)
+	}
+
+	delegationProtos := []*guardianv1beta1.Delegation{}
+	for _, d := range delegations {
+		delegationProtos = append(delegationProtos, s.adapter.ToDelegationProto(d))
+	}
+
+	return &guardianv1beta1.ListDelegationsResponse{
+		Delegations: delegationProtos,
+	}, nil
+}
+
+func (s *GRPCServer) GetDelegation(ctx context.Context, req *guardianv1beta1.GetDelegationRequest) (*guardianv1beta1.GetDelegationResponse, error) {
+	d, err := s.delegationService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, s.delegationErrorToStatus(err, "failed to get delegation details")
+	}
+
+	return &guardianv1beta1.GetDelegationResponse{
+		Delegation: s.adapter.ToDelegationProto(d),
+	}, nil
+}
+
+func (s *GRPCServer) CreateDelegation(ctx context.Context, req *guardianv1beta1.CreateDelegationRequest) (*guardianv1beta1.CreateDelegationResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	d := s.adapter.FromDelegationProto(req.GetDelegation())
+	if d == nil {
+		return nil, status.Error(codes.InvalidArgument, "delegation payload is required")
+	}
+	if d.Delegator == "" {
+		d.Delegator = user
+	}
+	if d.StartDate.IsZero() {
+		d.StartDate = time.Now()
+	}
+	d.CreatedBy = user
+
+	if err := s.delegationService.Create(ctx, d); err != nil {
+		return nil, s.delegationErrorToStatus(err, "failed to create delegation")
+	}
+
+	return &guardianv1beta1.CreateDelegationResponse{
+		Delegation: s.adapter.ToDelegationProto(d),
+	}, nil
+}
+
+func (s *GRPCServer) UpdateDelegation(ctx context.Context, req *guardianv1beta1.UpdateDelegationRequest) (*guardianv1beta1.UpdateDelegationResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	d := s.adapter.FromDelegationProto(req.GetDelegation())
+	if d == nil {
+		return nil, status.Error(codes.InvalidArgument, "delegation payload is required")
+	}
+	d.ID = req.GetId()
+
+	if err := s.delegationService.Update(ctx, user, d); err != nil {
+		return nil, s.delegationErrorToStatus(err, "failed to update delegation")
+	}
+
+	return &guardianv1beta1.UpdateDelegationResponse{
+		Delegation: s.adapter.ToDelegationProto(d),
+	}, nil
+}
+
+func (s *GRPCServer) DeleteDelegation(ctx context.Context, req *guardianv1beta1.DeleteDelegationRequest) (*guardianv1beta1.DeleteDelegationResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err := s.delegationService.Delete(ctx, user, req.GetId()); err != nil {
+		return nil, s.delegationErrorToStatus(err, "failed to delete delegation")
+	}
+
+	return &guardianv1beta1.DeleteDelegationResponse{}, nil
+}
+
+func (s *GRPCServer) delegationErrorToStatus(err error, msg string) error {
+	var validationErrs validator.ValidationErrors
+	switch {
+	case errors.Is(err, delegation.ErrDelegationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, delegation.ErrInvalidDelegator):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, delegation.ErrEmptyIDParam),
+		errors.Is(err, delegation.ErrDelegationWindowPast),
+		errors.As(err, &validationErrs):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
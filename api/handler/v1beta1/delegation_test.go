@@ -0,0 +1,127 @@
+package v1beta1_test
+
+import (
+	"context"
+	"time"
+
+	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/guardian/core/delegation"
+	"github.com/raystack/guardian/domain"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *GrpcHandlersSuite) TestCreateDelegation() {
+	s.Run("should set delegator and creator from authenticated user", func() {
+		s.setup()
+		startDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		endDate := startDate.Add(7 * 24 * time.Hour)
+
+		expectedDelegation := &domain.Delegation{
+			Delegator:    "test@example.com",
+			Delegate:     "substitute@example.com",
+			StartDate:    startDate,
+			EndDate:      endDate,
+			ProviderType: "bigquery",
+			CreatedBy:    "test@example.com",
+		}
+		s.delegationService.EXPECT().
+			Create(mock.Anything, expectedDelegation).
+			Run(func(_ context.Context, d *domain.Delegation) {
+				d.ID = "delegation-id"
+			}).
+			Return(nil).Once()
+
+		req := &guardianv1beta1.CreateDelegationRequest{
+			Delegation: &guardianv1beta1.Delegation{
+				Delegate:     "substitute@example.com",
+				StartDate:    timestamppb.New(startDate),
+				EndDate:      timestamppb.New(endDate),
+				ProviderType: "bigquery",
+			},
+		}
+		res, err := s.grpcServer.CreateDelegation(s.ctx, req)
+
+		s.NoError(err)
+		s.Equal(&guardianv1beta1.Delegation{
+			Id:           "delegation-id",
+			Delegator:    "test@example.com",
+			Delegate:     "substitute@example.com",
+			StartDate:    timestamppb.New(startDate),
+			EndDate:      timestamppb.New(endDate),
+			ProviderType: "bigquery",
+			CreatedBy:    "test@example.com",
+		}, res.GetDelegation())
+		s.delegationService.AssertExpectations(s.T())
+	})
+
+	s.Run("should return unauthenticated error if user is not in context", func() {
+		s.setup()
+
+		res, err := s.grpcServer.CreateDelegation(context.Background(), &guardianv1beta1.CreateDelegationRequest{})
+
+		s.Equal(codes.Unauthenticated, status.Code(err))
+		s.Nil(res)
+	})
+
+	s.Run("should return permission denied if delegating for someone else", func() {
+		s.setup()
+
+		s.delegationService.EXPECT().
+			Create(mock.Anything, mock.Anything).
+			Return(delegation.ErrInvalidDelegator).Once()
+
+		req := &guardianv1beta1.CreateDelegationRequest{
+			Delegation: &guardianv1beta1.Delegation{
+				Delegator: "someone.else@example.com",
+				Delegate:  "test@example.com",
+			},
+		}
+		res, err := s.grpcServer.CreateDelegation(s.ctx, req)
+
+		s.Equal(codes.PermissionDenied, status.Code(err))
+		s.Nil(res)
+	})
+}
+
+func (s *GrpcHandlersSuite) TestListDelegations() {
+	s.Run("should filter active delegations when requested", func() {
+		s.setup()
+
+		dummyDelegations := []*domain.Delegation{
+			{ID: "delegation-id", Delegator: "test@example.com", Delegate: "substitute@example.com"},
+		}
+		s.delegationService.EXPECT().
+			Find(mock.Anything, mock.MatchedBy(func(f domain.ListDelegationsFilter) bool {
+				return f.ActiveAt != nil && len(f.Delegators) == 1 && f.Delegators[0] == "test@example.com"
+			})).
+			Return(dummyDelegations, nil).Once()
+
+		res, err := s.grpcServer.ListDelegations(s.ctx, &guardianv1beta1.ListDelegationsRequest{
+			Delegators: []string{"test@example.com"},
+			ActiveOnly: true,
+		})
+
+		s.NoError(err)
+		s.Len(res.GetDelegations(), 1)
+		s.Equal("delegation-id", res.GetDelegations()[0].GetId())
+		s.delegationService.AssertExpectations(s.T())
+	})
+}
+
+func (s *GrpcHandlersSuite) TestDeleteDelegation() {
+	s.Run("should return not found if delegation doesn't exist", func() {
+		s.setup()
+
+		s.delegationService.EXPECT().
+			Delete(mock.Anything, "test@example.com", "delegation-id").
+			Return(delegation.ErrDelegationNotFound).Once()
+
+		res, err := s.grpcServer.DeleteDelegation(s.ctx, &guardianv1beta1.DeleteDelegationRequest{Id: "delegation-id"})
+
+		s.Equal(codes.NotFound, status.Code(err))
+		s.Nil(res)
+	})
+}
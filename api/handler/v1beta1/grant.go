@@ -3,8 +3,11 @@ package v1beta1
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/guardian/core/appeal"
 	"github.com/raystack/guardian/core/grant"
 	"github.com/raystack/guardian/core/provider"
 	"github.com/raystack/guardian/domain"
@@ -245,3 +248,100 @@ func (s *GRPCServer) ImportGrantsFromProvider(ctx context.Context, req *guardian
 		Grants: grantsProto,
 	}, nil
 }
+
+func (s *GRPCServer) ListGrantRecommendations(ctx context.Context, req *guardianv1beta1.ListGrantRecommendationsRequest) (*guardianv1beta1.ListGrantRecommendationsResponse, error) {
+	period, err := time.ParseDuration(req.GetPeriod())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid period: %v", err)
+	}
+
+	filter := domain.ListGrantRecommendationsFilter{
+		ProviderID:  req.GetProviderId(),
+		Period:      period,
+		AccountIDs:  req.GetAccountIds(),
+		ResourceIDs: req.GetResourceIds(),
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	recommendations, err := s.grantService.ListRecommendations(ctx, filter)
+	if err != nil {
+		if errors.Is(err, provider.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "provider with id %q not found: %v", req.GetProviderId(), err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list grant recommendations: %v", err)
+	}
+
+	recommendationsProto := []*guardianv1beta1.GrantRecommendation{}
+	for _, r := range recommendations {
+		recommendationProto, err := s.adapter.ToGrantRecommendationProto(r)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to parse grant recommendation %q: %v", r.Grant.ID, err)
+		}
+		recommendationsProto = append(recommendationsProto, recommendationProto)
+	}
+
+	return &guardianv1beta1.ListGrantRecommendationsResponse{
+		Recommendations: recommendationsProto,
+	}, nil
+}
+
+func (s *GRPCServer) DowngradeGrant(ctx context.Context, req *guardianv1beta1.DowngradeGrantRequest) (*guardianv1beta1.DowngradeGrantResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if req.GetRole() == "" {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+
+	g, err := s.grantService.GetByID(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, grant.ErrGrantNotFound) {
+			return nil, status.Errorf(codes.NotFound, "grant %q not found: %v", req.GetId(), err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get grant details: %v", err)
+	}
+	if g.Owner != user {
+		return nil, status.Error(codes.PermissionDenied, "grant can only be downgraded by its owner")
+	}
+
+	description := req.GetDescription()
+	if description == "" {
+		description = fmt.Sprintf("Downgrade of grant %s from role %s", g.ID, g.Role)
+	}
+	a := &domain.Appeal{
+		ResourceID:  g.ResourceID,
+		AccountID:   g.AccountID,
+		AccountType: g.AccountType,
+		CreatedBy:   user,
+		Role:        req.GetRole(),
+		Description: description,
+		Options: &domain.AppealOptions{
+			Duration:       req.GetDuration(),
+			ReplaceGrantID: g.ID,
+		},
+	}
+	if err := s.appealService.Create(ctx, []*domain.Appeal{a}); err != nil {
+		if errors.Is(err, appeal.ErrAppealDuplicate) {
+			return nil, status.Errorf(codes.AlreadyExists, "appeal already exists: %v", err)
+		}
+		if errors.Is(err, appeal.ErrInvalidGrantDowngrade) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to downgrade grant: %v", err)
+		}
+		if errors.Is(err, appeal.ErrSoDViolation) || errors.Is(err, domain.ErrNoApproversLeft) {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to downgrade grant: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to downgrade grant: %v", err)
+	}
+
+	appealProto, err := s.adapter.ToAppealProto(a)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse appeal: %v", err)
+	}
+
+	return &guardianv1beta1.DowngradeGrantResponse{
+		Appeal: appealProto,
+	}, nil
+}
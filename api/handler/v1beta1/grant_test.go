@@ -6,6 +6,7 @@ import (
 	"time"
 
 	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/guardian/core/appeal"
 	"github.com/raystack/guardian/core/grant"
 	"github.com/raystack/guardian/domain"
 	"github.com/stretchr/testify/mock"
@@ -411,3 +412,194 @@ func (s *GrpcHandlersSuite) TestImportFromProvider() {
 		s.Equal(expectedResponse, res)
 	})
 }
+
+func (s *GrpcHandlersSuite) TestListGrantRecommendations() {
+	s.Run("should return recommendations on success", func() {
+		s.setup()
+
+		dummyRecommendation := &domain.GrantRecommendation{
+			Grant: &domain.Grant{
+				ID:          "test-id",
+				AccountID:   "test-account-id",
+				Role:        "editor",
+				Permissions: []string{"editor"},
+			},
+			Type:                   domain.GrantRecommendationTypeDowngrade,
+			RecommendedRole:        "viewer",
+			RecommendedPermissions: []string{"viewer"},
+			UsedPermissions:        []string{"editor"},
+			ActivitiesCount:        2,
+			Reason:                 "test-reason",
+		}
+		expectedResponse := &guardianv1beta1.ListGrantRecommendationsResponse{
+			Recommendations: []*guardianv1beta1.GrantRecommendation{
+				{
+					Grant: &guardianv1beta1.Grant{
+						Id:          "test-id",
+						AccountId:   "test-account-id",
+						Role:        "editor",
+						Permissions: []string{"editor"},
+					},
+					Type:                   "downgrade",
+					RecommendedRole:        "viewer",
+					RecommendedPermissions: []string{"viewer"},
+					UsedPermissions:        []string{"editor"},
+					ActivitiesCount:        2,
+					Reason:                 "test-reason",
+				},
+			},
+		}
+		s.grantService.EXPECT().
+			ListRecommendations(mock.AnythingOfType("context.backgroundCtx"), domain.ListGrantRecommendationsFilter{
+				ProviderID: "test-provider-id",
+				Period:     720 * time.Hour,
+				AccountIDs: []string{"test-account-id"},
+			}).
+			Return([]*domain.GrantRecommendation{dummyRecommendation}, nil).Once()
+
+		req := &guardianv1beta1.ListGrantRecommendationsRequest{
+			ProviderId: "test-provider-id",
+			Period:     "720h",
+			AccountIds: []string{"test-account-id"},
+		}
+
+		res, err := s.grpcServer.ListGrantRecommendations(context.Background(), req)
+
+		s.NoError(err)
+		s.Equal(expectedResponse, res)
+	})
+
+	s.Run("should return invalid argument error if the period is invalid", func() {
+		s.setup()
+
+		req := &guardianv1beta1.ListGrantRecommendationsRequest{
+			ProviderId: "test-provider-id",
+			Period:     "invalid",
+		}
+
+		res, err := s.grpcServer.ListGrantRecommendations(context.Background(), req)
+
+		s.Equal(codes.InvalidArgument, status.Code(err))
+		s.Nil(res)
+	})
+
+	s.Run("should return invalid argument error if the provider id is empty", func() {
+		s.setup()
+
+		req := &guardianv1beta1.ListGrantRecommendationsRequest{
+			Period: "720h",
+		}
+
+		res, err := s.grpcServer.ListGrantRecommendations(context.Background(), req)
+
+		s.Equal(codes.InvalidArgument, status.Code(err))
+		s.Nil(res)
+	})
+
+	s.Run("should return internal error if grant service returns an error", func() {
+		s.setup()
+
+		s.grantService.EXPECT().
+			ListRecommendations(mock.AnythingOfType("context.backgroundCtx"), mock.Anything).
+			Return(nil, errors.New("unexpected error")).Once()
+
+		req := &guardianv1beta1.ListGrantRecommendationsRequest{
+			ProviderId: "test-provider-id",
+			Period:     "720h",
+		}
+
+		res, err := s.grpcServer.ListGrantRecommendations(context.Background(), req)
+
+		s.Equal(codes.Internal, status.Code(err))
+		s.Nil(res)
+	})
+}
+
+func (s *GrpcHandlersSuite) TestDowngradeGrant() {
+	dummyGrant := &domain.Grant{
+		ID:          "test-grant-id",
+		AccountID:   "test@example.com",
+		AccountType: "user",
+		ResourceID:  "test-resource-id",
+		Role:        "editor",
+		Owner:       "test@example.com",
+	}
+
+	s.Run("should create an appeal replacing the grant", func() {
+		s.setup()
+
+		s.grantService.EXPECT().GetByID(mock.Anything, "test-grant-id").Return(dummyGrant, nil).Once()
+		s.appealService.EXPECT().
+			Create(mock.Anything, mock.AnythingOfType("[]*domain.Appeal")).
+			Run(func(_a0 context.Context, appeals []*domain.Appeal, _a2 ...appeal.CreateAppealOption) {
+				s.Len(appeals, 1)
+				a := appeals[0]
+				s.Equal("test-resource-id", a.ResourceID)
+				s.Equal("test@example.com", a.AccountID)
+				s.Equal("test@example.com", a.CreatedBy)
+				s.Equal("viewer", a.Role)
+				s.Equal("720h", a.Options.Duration)
+				s.Equal("test-grant-id", a.Options.ReplaceGrantID)
+				a.ID = "test-appeal-id"
+			}).
+			Return(nil).Once()
+
+		res, err := s.grpcServer.DowngradeGrant(s.ctx, &guardianv1beta1.DowngradeGrantRequest{
+			Id:       "test-grant-id",
+			Role:     "viewer",
+			Duration: "720h",
+		})
+
+		s.NoError(err)
+		s.Equal("test-appeal-id", res.GetAppeal().GetId())
+		s.Equal("test-grant-id", res.GetAppeal().GetOptions().GetReplaceGrantId())
+	})
+
+	s.Run("should return permission denied if the user is not the owner of the grant", func() {
+		s.setup()
+
+		g := *dummyGrant
+		g.Owner = "other@example.com"
+		s.grantService.EXPECT().GetByID(mock.Anything, "test-grant-id").Return(&g, nil).Once()
+
+		res, err := s.grpcServer.DowngradeGrant(s.ctx, &guardianv1beta1.DowngradeGrantRequest{
+			Id:   "test-grant-id",
+			Role: "viewer",
+		})
+
+		s.Equal(codes.PermissionDenied, status.Code(err))
+		s.Nil(res)
+		s.appealService.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should return invalid argument if the role is not narrower than the grant", func() {
+		s.setup()
+
+		s.grantService.EXPECT().GetByID(mock.Anything, "test-grant-id").Return(dummyGrant, nil).Once()
+		s.appealService.EXPECT().
+			Create(mock.Anything, mock.AnythingOfType("[]*domain.Appeal")).
+			Return(appeal.ErrInvalidGrantDowngrade).Once()
+
+		res, err := s.grpcServer.DowngradeGrant(s.ctx, &guardianv1beta1.DowngradeGrantRequest{
+			Id:   "test-grant-id",
+			Role: "owner",
+		})
+
+		s.Equal(codes.InvalidArgument, status.Code(err))
+		s.Nil(res)
+	})
+
+	s.Run("should return not found if the grant doesn't exist", func() {
+		s.setup()
+
+		s.grantService.EXPECT().GetByID(mock.Anything, "test-grant-id").Return(nil, grant.ErrGrantNotFound).Once()
+
+		res, err := s.grpcServer.DowngradeGrant(s.ctx, &guardianv1beta1.DowngradeGrantRequest{
+			Id:   "test-grant-id",
+			Role: "viewer",
+		})
+
+		s.Equal(codes.NotFound, status.Code(err))
+		s.Nil(res)
+	})
+}
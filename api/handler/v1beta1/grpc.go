@@ -31,12 +31,32 @@ type ProtoAdapter interface {
 
 	ToAppealProto(*domain.Appeal) (*guardianv1beta1.Appeal, error)
 	FromCreateAppealProto(*guardianv1beta1.CreateAppealRequest, string) ([]*domain.Appeal, error)
+	FromSimulateAppealProto(*guardianv1beta1.SimulateAppealRequest, string) (*domain.Appeal, *domain.ResourceIdentifier)
+	ToAppealSimulationProto(*domain.AppealSimulation) (*guardianv1beta1.AppealSimulation, error)
 	ToApprovalProto(*domain.Approval) (*guardianv1beta1.Approval, error)
 
 	ToGrantProto(*domain.Grant) (*guardianv1beta1.Grant, error)
 	FromGrantProto(*guardianv1beta1.Grant) *domain.Grant
+	ToGrantRecommendationProto(*domain.GrantRecommendation) (*guardianv1beta1.GrantRecommendation, error)
 
 	ToActivityProto(*domain.Activity) (*guardianv1beta1.ProviderActivity, error)
+
+	FromDelegationProto(*guardianv1beta1.Delegation) *domain.Delegation
+	ToDelegationProto(*domain.Delegation) *guardianv1beta1.Delegation
+
+	FromBundleProto(*guardianv1beta1.Bundle) *domain.Bundle
+	ToBundleProto(*domain.Bundle) *guardianv1beta1.Bundle
+
+	FromReviewCampaignProto(*guardianv1beta1.ReviewCampaign) *domain.ReviewCampaign
+	ToReviewCampaignProto(*domain.ReviewCampaign) *guardianv1beta1.ReviewCampaign
+	FromReviewItemProto(*guardianv1beta1.ReviewItem) *domain.ReviewItem
+	ToReviewItemProto(*domain.ReviewItem) *guardianv1beta1.ReviewItem
+
+	FromSoDRuleProto(*guardianv1beta1.SoDRule) *domain.SoDRule
+	ToSoDRuleProto(*domain.SoDRule) (*guardianv1beta1.SoDRule, error)
+	ToSoDViolationProto(*domain.SoDViolation) (*guardianv1beta1.SoDViolation, error)
+
+	ToCommentProto(*domain.Comment) *guardianv1beta1.AppealComment
 }
 
 //go:generate mockery --name=resourceService --exported --with-expecter
@@ -89,9 +109,14 @@ type appealService interface {
 	Find(context.Context, *domain.ListAppealsFilter) ([]*domain.Appeal, error)
 	Create(context.Context, []*domain.Appeal, ...appeal.CreateAppealOption) error
 	Cancel(context.Context, string) (*domain.Appeal, error)
+	UpdateAppeal(context.Context, domain.AppealUpdate) (*domain.Appeal, error)
+	CreateComment(context.Context, *domain.Comment) error
+	ListComments(ctx context.Context, actor string, filter domain.ListCommentsFilter) ([]*domain.Comment, error)
 	AddApprover(ctx context.Context, appealID, approvalID, email string) (*domain.Appeal, error)
 	DeleteApprover(ctx context.Context, appealID, approvalID, email string) (*domain.Appeal, error)
 	UpdateApproval(ctx context.Context, approvalAction domain.ApprovalAction) (*domain.Appeal, error)
+	UpdateExternalApproval(ctx context.Context, approvalAction domain.ApprovalAction) (*domain.Appeal, error)
+	Simulate(ctx context.Context, a *domain.Appeal, ri *domain.ResourceIdentifier, policy *domain.Policy) (*domain.AppealSimulation, error)
 }
 
 //go:generate mockery --name=approvalService --exported --with-expecter
@@ -110,6 +135,7 @@ type grantService interface {
 	Revoke(ctx context.Context, id, actor, reason string, opts ...grant.Option) (*domain.Grant, error)
 	BulkRevoke(ctx context.Context, filter domain.RevokeGrantsFilter, actor, reason string) ([]*domain.Grant, error)
 	ImportFromProvider(ctx context.Context, criteria grant.ImportFromProviderCriteria) ([]*domain.Grant, error)
+	ListRecommendations(context.Context, domain.ListGrantRecommendationsFilter) ([]*domain.GrantRecommendation, error)
 }
 
 //go:generate mockery --name=namespaceService --exported --with-expecter
@@ -120,16 +146,61 @@ type namespaceService interface {
 	List(ctx context.Context, filter domain.NamespaceFilter) ([]*domain.Namespace, error)
 }
 
+//go:generate mockery --name=delegationService --exported --with-expecter
+type delegationService interface {
+	GetByID(ctx context.Context, id string) (*domain.Delegation, error)
+	Find(context.Context, domain.ListDelegationsFilter) ([]*domain.Delegation, error)
+	Create(context.Context, *domain.Delegation) error
+	Update(ctx context.Context, actor string, d *domain.Delegation) error
+	Delete(ctx context.Context, actor, id string) error
+}
+
+//go:generate mockery --name=bundleService --exported --with-expecter
+type bundleService interface {
+	GetByID(ctx context.Context, id string) (*domain.Bundle, error)
+	Find(context.Context, domain.ListBundlesFilter) ([]*domain.Bundle, error)
+	Create(context.Context, *domain.Bundle) error
+	Update(ctx context.Context, actor string, b *domain.Bundle) error
+	Delete(ctx context.Context, actor, id string) error
+	CreateAppeals(ctx context.Context, bundleID string, a *domain.Appeal) ([]*domain.Appeal, error)
+	GetAppealStatus(ctx context.Context, bundleID, accountID string) (string, []*domain.Appeal, error)
+}
+
+//go:generate mockery --name=reviewService --exported --with-expecter
+type reviewService interface {
+	GetCampaignByID(ctx context.Context, id string) (*domain.ReviewCampaign, error)
+	FindCampaigns(context.Context, domain.ListReviewCampaignsFilter) ([]*domain.ReviewCampaign, error)
+	FindItems(context.Context, domain.ListReviewItemsFilter) ([]*domain.ReviewItem, error)
+	CreateCampaign(context.Context, *domain.ReviewCampaign) ([]*domain.ReviewItem, error)
+	Decide(ctx context.Context, itemID, actor string, decision domain.ReviewDecision, reason string) (*domain.ReviewItem, error)
+	CloseCampaign(ctx context.Context, id, actor string) (*domain.ReviewCampaign, error)
+	GetSummary(ctx context.Context, id string) (*domain.ReviewCampaignSummary, error)
+}
+
+//go:generate mockery --name=sodService --exported --with-expecter
+type sodService interface {
+	GetByID(ctx context.Context, id string) (*domain.SoDRule, error)
+	Find(context.Context, domain.ListSoDRulesFilter) ([]*domain.SoDRule, error)
+	Create(context.Context, *domain.SoDRule) error
+	Update(context.Context, *domain.SoDRule) error
+	Delete(ctx context.Context, id string) error
+	ListViolations(context.Context, domain.ListSoDViolationsFilter) ([]*domain.SoDViolation, error)
+}
+
 type GRPCServer struct {
-	resourceService  resourceService
-	activityService  activityService
-	providerService  providerService
-	policyService    policyService
-	appealService    appealService
-	approvalService  approvalService
-	grantService     grantService
-	namespaceService namespaceService
-	adapter          ProtoAdapter
+	resourceService   resourceService
+	activityService   activityService
+	providerService   providerService
+	policyService     policyService
+	appealService     appealService
+	approvalService   approvalService
+	grantService      grantService
+	namespaceService  namespaceService
+	delegationService delegationService
+	bundleService     bundleService
+	reviewService     reviewService
+	sodService        sodService
+	adapter           ProtoAdapter
 
 	authenticatedUserContextKey interface{}
 
@@ -145,6 +216,10 @@ func NewGRPCServer(
 	approvalService approvalService,
 	grantService grantService,
 	namespaceService namespaceService,
+	delegationService delegationService,
+	bundleService bundleService,
+	reviewService reviewService,
+	sodService sodService,
 	adapter ProtoAdapter,
 	authenticatedUserContextKey interface{},
 ) *GRPCServer {
@@ -157,6 +232,10 @@ func NewGRPCServer(
 		approvalService:             approvalService,
 		grantService:                grantService,
 		namespaceService:            namespaceService,
+		delegationService:           delegationService,
+		bundleService:               bundleService,
+		reviewService:               reviewService,
+		sodService:                  sodService,
 		adapter:                     adapter,
 		authenticatedUserContextKey: authenticatedUserContextKey,
 	}
@@ -16,16 +16,20 @@ type authEmailTestContextKey struct{}
 type GrpcHandlersSuite struct {
 	suite.Suite
 
-	resourceService  *mocks.ResourceService
-	activityService  *mocks.ActivityService
-	providerService  *mocks.ProviderService
-	policyService    *mocks.PolicyService
-	appealService    *mocks.AppealService
-	approvalService  *mocks.ApprovalService
-	grantService     *mocks.GrantService
-	namespaceService *mocks.NamespaceService
-	grpcServer       *v1beta1.GRPCServer
-	ctx              context.Context
+	resourceService   *mocks.ResourceService
+	activityService   *mocks.ActivityService
+	providerService   *mocks.ProviderService
+	policyService     *mocks.PolicyService
+	appealService     *mocks.AppealService
+	approvalService   *mocks.ApprovalService
+	grantService      *mocks.GrantService
+	namespaceService  *mocks.NamespaceService
+	delegationService *mocks.DelegationService
+	bundleService     *mocks.BundleService
+	reviewService     *mocks.ReviewService
+	sodService        *mocks.SodService
+	grpcServer        *v1beta1.GRPCServer
+	ctx               context.Context
 }
 
 func TestGrpcHandler(t *testing.T) {
@@ -41,6 +45,10 @@ func (s *GrpcHandlersSuite) setup() {
 	s.approvalService = new(mocks.ApprovalService)
 	s.grantService = new(mocks.GrantService)
 	s.namespaceService = new(mocks.NamespaceService)
+	s.delegationService = new(mocks.DelegationService)
+	s.bundleService = new(mocks.BundleService)
+	s.reviewService = new(mocks.ReviewService)
+	s.sodService = new(mocks.SodService)
 	s.grpcServer = v1beta1.NewGRPCServer(
 		s.resourceService,
 		s.activityService,
@@ -50,6 +58,10 @@ func (s *GrpcHandlersSuite) setup() {
 		s.approvalService,
 		s.grantService,
 		s.namespaceService,
+		s.delegationService,
+		s.bundleService,
+		s.reviewService,
+		s.sodService,
 		v1beta1.NewAdapter(),
 		auth.AuthenticatedUserEmailContextKey{},
 	)
@@ -207,6 +207,53 @@ func (_c *AppealService_Create_Call) RunAndReturn(run func(context.Context, []*d
 	return _c
 }
 
+// CreateComment provides a mock function with given fields: _a0, _a1
+func (_m *AppealService) CreateComment(_a0 context.Context, _a1 *domain.Comment) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Comment) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AppealService_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type AppealService_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *domain.Comment
+func (_e *AppealService_Expecter) CreateComment(_a0 interface{}, _a1 interface{}) *AppealService_CreateComment_Call {
+	return &AppealService_CreateComment_Call{Call: _e.mock.On("CreateComment", _a0, _a1)}
+}
+
+func (_c *AppealService_CreateComment_Call) Run(run func(_a0 context.Context, _a1 *domain.Comment)) *AppealService_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Comment))
+	})
+	return _c
+}
+
+func (_c *AppealService_CreateComment_Call) Return(_a0 error) *AppealService_CreateComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AppealService_CreateComment_Call) RunAndReturn(run func(context.Context, *domain.Comment) error) *AppealService_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteApprover provides a mock function with given fields: ctx, appealID, approvalID, email
 func (_m *AppealService) DeleteApprover(ctx context.Context, appealID string, approvalID string, email string) (*domain.Appeal, error) {
 	ret := _m.Called(ctx, appealID, approvalID, email)
@@ -443,6 +490,186 @@ func (_c *AppealService_GetByID_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// ListComments provides a mock function with given fields: ctx, actor, filter
+func (_m *AppealService) ListComments(ctx context.Context, actor string, filter domain.ListCommentsFilter) ([]*domain.Comment, error) {
+	ret := _m.Called(ctx, actor, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListComments")
+	}
+
+	var r0 []*domain.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.ListCommentsFilter) ([]*domain.Comment, error)); ok {
+		return rf(ctx, actor, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.ListCommentsFilter) []*domain.Comment); ok {
+		r0 = rf(ctx, actor, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.ListCommentsFilter) error); ok {
+		r1 = rf(ctx, actor, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealService_ListComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListComments'
+type AppealService_ListComments_Call struct {
+	*mock.Call
+}
+
+// ListComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actor string
+//   - filter domain.ListCommentsFilter
+func (_e *AppealService_Expecter) ListComments(ctx interface{}, actor interface{}, filter interface{}) *AppealService_ListComments_Call {
+	return &AppealService_ListComments_Call{Call: _e.mock.On("ListComments", ctx, actor, filter)}
+}
+
+func (_c *AppealService_ListComments_Call) Run(run func(ctx context.Context, actor string, filter domain.ListCommentsFilter)) *AppealService_ListComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(domain.ListCommentsFilter))
+	})
+	return _c
+}
+
+func (_c *AppealService_ListComments_Call) Return(_a0 []*domain.Comment, _a1 error) *AppealService_ListComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealService_ListComments_Call) RunAndReturn(run func(context.Context, string, domain.ListCommentsFilter) ([]*domain.Comment, error)) *AppealService_ListComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Simulate provides a mock function with given fields: ctx, a, ri, policy
+func (_m *AppealService) Simulate(ctx context.Context, a *domain.Appeal, ri *domain.ResourceIdentifier, policy *domain.Policy) (*domain.AppealSimulation, error) {
+	ret := _m.Called(ctx, a, ri, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Simulate")
+	}
+
+	var r0 *domain.AppealSimulation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Appeal, *domain.ResourceIdentifier, *domain.Policy) (*domain.AppealSimulation, error)); ok {
+		return rf(ctx, a, ri, policy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Appeal, *domain.ResourceIdentifier, *domain.Policy) *domain.AppealSimulation); ok {
+		r0 = rf(ctx, a, ri, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.AppealSimulation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Appeal, *domain.ResourceIdentifier, *domain.Policy) error); ok {
+		r1 = rf(ctx, a, ri, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealService_Simulate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Simulate'
+type AppealService_Simulate_Call struct {
+	*mock.Call
+}
+
+// Simulate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - a *domain.Appeal
+//   - ri *domain.ResourceIdentifier
+//   - policy *domain.Policy
+func (_e *AppealService_Expecter) Simulate(ctx interface{}, a interface{}, ri interface{}, policy interface{}) *AppealService_Simulate_Call {
+	return &AppealService_Simulate_Call{Call: _e.mock.On("Simulate", ctx, a, ri, policy)}
+}
+
+func (_c *AppealService_Simulate_Call) Run(run func(ctx context.Context, a *domain.Appeal, ri *domain.ResourceIdentifier, policy *domain.Policy)) *AppealService_Simulate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Appeal), args[2].(*domain.ResourceIdentifier), args[3].(*domain.Policy))
+	})
+	return _c
+}
+
+func (_c *AppealService_Simulate_Call) Return(_a0 *domain.AppealSimulation, _a1 error) *AppealService_Simulate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealService_Simulate_Call) RunAndReturn(run func(context.Context, *domain.Appeal, *domain.ResourceIdentifier, *domain.Policy) (*domain.AppealSimulation, error)) *AppealService_Simulate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAppeal provides a mock function with given fields: _a0, _a1
+func (_m *AppealService) UpdateAppeal(_a0 context.Context, _a1 domain.AppealUpdate) (*domain.Appeal, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAppeal")
+	}
+
+	var r0 *domain.Appeal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AppealUpdate) (*domain.Appeal, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AppealUpdate) *domain.Appeal); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Appeal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.AppealUpdate) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealService_UpdateAppeal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAppeal'
+type AppealService_UpdateAppeal_Call struct {
+	*mock.Call
+}
+
+// UpdateAppeal is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.AppealUpdate
+func (_e *AppealService_Expecter) UpdateAppeal(_a0 interface{}, _a1 interface{}) *AppealService_UpdateAppeal_Call {
+	return &AppealService_UpdateAppeal_Call{Call: _e.mock.On("UpdateAppeal", _a0, _a1)}
+}
+
+func (_c *AppealService_UpdateAppeal_Call) Run(run func(_a0 context.Context, _a1 domain.AppealUpdate)) *AppealService_UpdateAppeal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AppealUpdate))
+	})
+	return _c
+}
+
+func (_c *AppealService_UpdateAppeal_Call) Return(_a0 *domain.Appeal, _a1 error) *AppealService_UpdateAppeal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealService_UpdateAppeal_Call) RunAndReturn(run func(context.Context, domain.AppealUpdate) (*domain.Appeal, error)) *AppealService_UpdateAppeal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateApproval provides a mock function with given fields: ctx, approvalAction
 func (_m *AppealService) UpdateApproval(ctx context.Context, approvalAction domain.ApprovalAction) (*domain.Appeal, error) {
 	ret := _m.Called(ctx, approvalAction)
@@ -502,6 +729,65 @@ func (_c *AppealService_UpdateApproval_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// UpdateExternalApproval provides a mock function with given fields: ctx, approvalAction
+func (_m *AppealService) UpdateExternalApproval(ctx context.Context, approvalAction domain.ApprovalAction) (*domain.Appeal, error) {
+	ret := _m.Called(ctx, approvalAction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateExternalApproval")
+	}
+
+	var r0 *domain.Appeal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ApprovalAction) (*domain.Appeal, error)); ok {
+		return rf(ctx, approvalAction)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ApprovalAction) *domain.Appeal); ok {
+		r0 = rf(ctx, approvalAction)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Appeal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ApprovalAction) error); ok {
+		r1 = rf(ctx, approvalAction)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealService_UpdateExternalApproval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateExternalApproval'
+type AppealService_UpdateExternalApproval_Call struct {
+	*mock.Call
+}
+
+// UpdateExternalApproval is a helper method to define mock.On call
+//   - ctx context.Context
+//   - approvalAction domain.ApprovalAction
+func (_e *AppealService_Expecter) UpdateExternalApproval(ctx interface{}, approvalAction interface{}) *AppealService_UpdateExternalApproval_Call {
+	return &AppealService_UpdateExternalApproval_Call{Call: _e.mock.On("UpdateExternalApproval", ctx, approvalAction)}
+}
+
+func (_c *AppealService_UpdateExternalApproval_Call) Run(run func(ctx context.Context, approvalAction domain.ApprovalAction)) *AppealService_UpdateExternalApproval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ApprovalAction))
+	})
+	return _c
+}
+
+func (_c *AppealService_UpdateExternalApproval_Call) Return(_a0 *domain.Appeal, _a1 error) *AppealService_UpdateExternalApproval_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealService_UpdateExternalApproval_Call) RunAndReturn(run func(context.Context, domain.ApprovalAction) (*domain.Appeal, error)) *AppealService_UpdateExternalApproval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewAppealService creates a new instance of AppealService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAppealService(t interface {
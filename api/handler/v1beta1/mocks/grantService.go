@@ -319,6 +319,65 @@ func (_c *GrantService_List_Call) RunAndReturn(run func(context.Context, domain.
 	return _c
 }
 
+// ListRecommendations provides a mock function with given fields: _a0, _a1
+func (_m *GrantService) ListRecommendations(_a0 context.Context, _a1 domain.ListGrantRecommendationsFilter) ([]*domain.GrantRecommendation, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRecommendations")
+	}
+
+	var r0 []*domain.GrantRecommendation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListGrantRecommendationsFilter) ([]*domain.GrantRecommendation, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListGrantRecommendationsFilter) []*domain.GrantRecommendation); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.GrantRecommendation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListGrantRecommendationsFilter) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GrantService_ListRecommendations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRecommendations'
+type GrantService_ListRecommendations_Call struct {
+	*mock.Call
+}
+
+// ListRecommendations is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.ListGrantRecommendationsFilter
+func (_e *GrantService_Expecter) ListRecommendations(_a0 interface{}, _a1 interface{}) *GrantService_ListRecommendations_Call {
+	return &GrantService_ListRecommendations_Call{Call: _e.mock.On("ListRecommendations", _a0, _a1)}
+}
+
+func (_c *GrantService_ListRecommendations_Call) Run(run func(_a0 context.Context, _a1 domain.ListGrantRecommendationsFilter)) *GrantService_ListRecommendations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListGrantRecommendationsFilter))
+	})
+	return _c
+}
+
+func (_c *GrantService_ListRecommendations_Call) Return(_a0 []*domain.GrantRecommendation, _a1 error) *GrantService_ListRecommendations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GrantService_ListRecommendations_Call) RunAndReturn(run func(context.Context, domain.ListGrantRecommendationsFilter) ([]*domain.GrantRecommendation, error)) *GrantService_ListRecommendations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Revoke provides a mock function with given fields: ctx, id, actor, reason, opts
 func (_m *GrantService) Revoke(ctx context.Context, id string, actor string, reason string, opts ...grant.Option) (*domain.Grant, error) {
 	_va := make([]interface{}, len(opts))
@@ -5,7 +5,10 @@ import (
 	"errors"
 
 	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/guardian/core/appeal"
 	"github.com/raystack/guardian/core/policy"
+	"github.com/raystack/guardian/core/resource"
+	"github.com/raystack/guardian/domain"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -125,3 +128,44 @@ func (s *GRPCServer) GetPolicyPreferences(ctx context.Context, req *guardianv1be
 		Appeal: appealConfigProto,
 	}, nil
 }
+
+func (s *GRPCServer) SimulateAppeal(ctx context.Context, req *guardianv1beta1.SimulateAppealRequest) (*guardianv1beta1.SimulateAppealResponse, error) {
+	authenticatedUser, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	a, ri := s.adapter.FromSimulateAppealProto(req, authenticatedUser)
+	if ri == nil {
+		return nil, status.Error(codes.InvalidArgument, "resource is required")
+	}
+	var p *domain.Policy
+	if req.GetPolicy() != nil {
+		p = s.adapter.FromPolicyProto(req.GetPolicy(), authenticatedUser)
+	}
+
+	simulation, err := s.appealService.Simulate(ctx, a, ri, p)
+	if err != nil {
+		switch {
+		case errors.Is(err, resource.ErrRecordNotFound),
+			errors.Is(err, appeal.ErrResourceNotFound),
+			errors.Is(err, policy.ErrPolicyNotFound),
+			errors.Is(err, appeal.ErrPolicyIDNotFound),
+			errors.Is(err, appeal.ErrPolicyVersionNotFound):
+			return nil, status.Errorf(codes.NotFound, "failed to simulate appeal: %v", err)
+		case errors.Is(err, appeal.ErrResourceIsDeleted):
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to simulate appeal: %v", err)
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to simulate appeal: %v", err)
+		}
+	}
+
+	simulationProto, err := s.adapter.ToAppealSimulationProto(simulation)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse appeal simulation: %v", err)
+	}
+
+	return &guardianv1beta1.SimulateAppealResponse{
+		Simulation: simulationProto,
+	}, nil
+}
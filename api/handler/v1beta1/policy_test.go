@@ -699,3 +699,82 @@ func (s *GrpcHandlersSuite) TestUpdatePolicy() {
 		s.policyService.AssertExpectations(s.T())
 	})
 }
+
+func (s *GrpcHandlersSuite) TestSimulateAppeal() {
+	s.Run("should return simulated approvals and matched requirements", func() {
+		s.setup()
+
+		expectedAppeal := &domain.Appeal{
+			PolicyID:      "test-policy",
+			PolicyVersion: 1,
+			AccountID:     "test@example.com",
+			CreatedBy:     "test@example.com",
+			Role:          "viewer",
+			Options:       &domain.AppealOptions{Duration: "24h"},
+			Creator:       map[string]interface{}{"role": "admin"},
+		}
+		expectedResourceIdentifier := &domain.ResourceIdentifier{ID: "test-resource-id"}
+		requirement := &domain.Requirement{
+			On: &domain.RequirementTrigger{Role: "viewer"},
+			Appeals: []*domain.AdditionalAppeal{
+				{Resource: &domain.ResourceIdentifier{ID: "other-resource-id"}, Role: "viewer"},
+			},
+		}
+		s.appealService.EXPECT().
+			Simulate(mock.Anything, expectedAppeal, expectedResourceIdentifier, (*domain.Policy)(nil)).
+			Return(&domain.AppealSimulation{
+				Appeal: &domain.Appeal{
+					Status: domain.AppealStatusPending,
+					Approvals: []*domain.Approval{
+						{Name: "auto", Status: domain.ApprovalStatusApproved},
+						{Name: "manual", Status: domain.ApprovalStatusPending, Approvers: []string{"approver@example.com"}},
+					},
+				},
+				MatchedRequirements: []*domain.Requirement{requirement},
+			}, nil).Once()
+
+		creator, err := structpb.NewValue(map[string]interface{}{"role": "admin"})
+		s.Require().NoError(err)
+		req := &guardianv1beta1.SimulateAppealRequest{
+			PolicyId:      "test-policy",
+			PolicyVersion: 1,
+			Resource:      &guardianv1beta1.SimulateAppealRequest_ResourceIdentifier{Id: "test-resource-id"},
+			Role:          "viewer",
+			Options:       &guardianv1beta1.AppealOptions{Duration: "24h"},
+			Creator:       creator,
+		}
+		res, err := s.grpcServer.SimulateAppeal(s.ctx, req)
+
+		s.NoError(err)
+		s.Equal(domain.AppealStatusPending, res.GetSimulation().GetAppeal().GetStatus())
+		s.Len(res.GetSimulation().GetAppeal().GetApprovals(), 2)
+		s.Equal([]string{"approver@example.com"}, res.GetSimulation().GetAppeal().GetApprovals()[1].GetApprovers())
+		s.Len(res.GetSimulation().GetMatchedRequirements(), 1)
+		s.appealService.AssertExpectations(s.T())
+	})
+
+	s.Run("should return invalid argument error if resource is empty", func() {
+		s.setup()
+
+		res, err := s.grpcServer.SimulateAppeal(s.ctx, &guardianv1beta1.SimulateAppealRequest{Role: "viewer"})
+
+		s.Equal(codes.InvalidArgument, status.Code(err))
+		s.Nil(res)
+	})
+
+	s.Run("should return not found error if policy is not found", func() {
+		s.setup()
+
+		s.appealService.EXPECT().
+			Simulate(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, policy.ErrPolicyNotFound).Once()
+
+		res, err := s.grpcServer.SimulateAppeal(s.ctx, &guardianv1beta1.SimulateAppealRequest{
+			PolicyId: "unknown",
+			Resource: &guardianv1beta1.SimulateAppealRequest_ResourceIdentifier{Id: "test-resource-id"},
+		})
+
+		s.Equal(codes.NotFound, status.Code(err))
+		s.Nil(res)
+	})
+}
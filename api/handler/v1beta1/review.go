@@ -0,0 +1,183 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/guardian/core/review"
+	"github.com/raystack/guardian/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) ListReviewCampaigns(ctx context.Context, req *guardianv1beta1.ListReviewCampaignsRequest) (*guardianv1beta1.ListReviewCampaignsResponse, error) {
+	campaigns, err := s.reviewService.FindCampaigns(ctx, domain.ListReviewCampaignsFilter{
+		Statuses:  req.GetStatuses(),
+		CreatedBy: req.GetCreatedBy(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get review campaign list: %v", err)
+	}
+
+	campaignProtos := []*guardianv1beta1.ReviewCampaign{}
+	for _, c := range campaigns {
+		campaignProtos = append(campaignProtos, s.adapter.ToReviewCampaignProto(c))
+	}
+
+	return &guardianv1beta1.ListReviewCampaignsResponse{
+		Campaigns: campaignProtos,
+	}, nil
+}
+
+func (s *GRPCServer) GetReviewCampaign(ctx context.Context, req *guardianv1beta1.GetReviewCampaignRequest) (*guardianv1beta1.GetReviewCampaignResponse, error) {
+	c, err := s.reviewService.GetCampaignByID(ctx, req.GetId())
+	if err != nil {
+		return nil, s.reviewErrorToStatus(err, "failed to get review campaign details")
+	}
+
+	return &guardianv1beta1.GetReviewCampaignResponse{
+		Campaign: s.adapter.ToReviewCampaignProto(c),
+	}, nil
+}
+
+func (s *GRPCServer) CreateReviewCampaign(ctx context.Context, req *guardianv1beta1.CreateReviewCampaignRequest) (*guardianv1beta1.CreateReviewCampaignResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	c := s.adapter.FromReviewCampaignProto(req.GetCampaign())
+	if c == nil {
+		return nil, status.Error(codes.InvalidArgument, "campaign payload is required")
+	}
+	c.CreatedBy = user
+
+	items, err := s.reviewService.CreateCampaign(ctx, c)
+	if err != nil {
+		return nil, s.reviewErrorToStatus(err, "failed to create review campaign")
+	}
+
+	return &guardianv1beta1.CreateReviewCampaignResponse{
+		Campaign:   s.adapter.ToReviewCampaignProto(c),
+		ItemsCount: int32(len(items)),
+	}, nil
+}
+
+func (s *GRPCServer) CloseReviewCampaign(ctx context.Context, req *guardianv1beta1.CloseReviewCampaignRequest) (*guardianv1beta1.CloseReviewCampaignResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	c, err := s.reviewService.CloseCampaign(ctx, req.GetId(), user)
+	if err != nil {
+		return nil, s.reviewErrorToStatus(err, "failed to close review campaign")
+	}
+
+	return &guardianv1beta1.CloseReviewCampaignResponse{
+		Campaign: s.adapter.ToReviewCampaignProto(c),
+	}, nil
+}
+
+func (s *GRPCServer) GetReviewCampaignSummary(ctx context.Context, req *guardianv1beta1.GetReviewCampaignSummaryRequest) (*guardianv1beta1.GetReviewCampaignSummaryResponse, error) {
+	summary, err := s.reviewService.GetSummary(ctx, req.GetId())
+	if err != nil {
+		return nil, s.reviewErrorToStatus(err, "failed to get review campaign summary")
+	}
+
+	return &guardianv1beta1.GetReviewCampaignSummaryResponse{
+		Campaign:  s.adapter.ToReviewCampaignProto(summary.Campaign),
+		Total:     int32(summary.Total),
+		Kept:      int32(summary.Kept),
+		Revoked:   int32(summary.Revoked),
+		Pending:   int32(summary.Pending),
+		Defaulted: int32(summary.Defaulted),
+		Items:     s.toReviewItemProtos(summary.Items),
+	}, nil
+}
+
+func (s *GRPCServer) ListReviewItems(ctx context.Context, req *guardianv1beta1.ListReviewItemsRequest) (*guardianv1beta1.ListReviewItemsResponse, error) {
+	if req.GetCampaignId() == "" {
+		return nil, status.Error(codes.InvalidArgument, review.ErrEmptyIDParam.Error())
+	}
+
+	items, err := s.reviewService.FindItems(ctx, domain.ListReviewItemsFilter{
+		CampaignID:  req.GetCampaignId(),
+		Reviewer:    req.GetReviewer(),
+		PendingOnly: req.GetPendingOnly(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get review item list: %v", err)
+	}
+
+	return &guardianv1beta1.ListReviewItemsResponse{
+		Items: s.toReviewItemProtos(items),
+	}, nil
+}
+
+func (s *GRPCServer) ListUserReviewItems(ctx context.Context, req *guardianv1beta1.ListUserReviewItemsRequest) (*guardianv1beta1.ListUserReviewItemsResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	items, err := s.reviewService.FindItems(ctx, domain.ListReviewItemsFilter{
+		CampaignStatuses: []string{domain.ReviewCampaignStatusActive},
+		Reviewer:         user,
+		PendingOnly:      req.GetPendingOnly(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get review item list: %v", err)
+	}
+
+	return &guardianv1beta1.ListUserReviewItemsResponse{
+		Items: s.toReviewItemProtos(items),
+	}, nil
+}
+
+func (s *GRPCServer) DecideReviewItem(ctx context.Context, req *guardianv1beta1.DecideReviewItemRequest) (*guardianv1beta1.DecideReviewItemResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	item, err := s.reviewService.Decide(ctx, req.GetId(), user, domain.ReviewDecision(req.GetDecision()), req.GetReason())
+	if err != nil {
+		return nil, s.reviewErrorToStatus(err, "failed to decide review item")
+	}
+
+	return &guardianv1beta1.DecideReviewItemResponse{
+		Item: s.adapter.ToReviewItemProto(item),
+	}, nil
+}
+
+func (s *GRPCServer) toReviewItemProtos(items []*domain.ReviewItem) []*guardianv1beta1.ReviewItem {
+	itemProtos := []*guardianv1beta1.ReviewItem{}
+	for _, i := range items {
+		itemProtos = append(itemProtos, s.adapter.ToReviewItemProto(i))
+	}
+	return itemProtos
+}
+
+func (s *GRPCServer) reviewErrorToStatus(err error, msg string) error {
+	var validationErrs validator.ValidationErrors
+	switch {
+	case errors.Is(err, review.ErrCampaignNotFound),
+		errors.Is(err, review.ErrItemNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, review.ErrNotReviewer),
+		errors.Is(err, review.ErrActionForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, review.ErrCampaignClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, review.ErrEmptyIDParam),
+		errors.Is(err, review.ErrNoGrantsInScope),
+		errors.Is(err, domain.ErrInvalidReviewDecision),
+		errors.As(err, &validationErrs):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
@@ -0,0 +1,110 @@
+package v1beta1_test
+
+import (
+	"context"
+
+	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/guardian/core/review"
+	"github.com/raystack/guardian/domain"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GrpcHandlersSuite) TestCreateReviewCampaign() {
+	s.Run("should set creator from authenticated user", func() {
+		s.setup()
+
+		expectedCampaign := &domain.ReviewCampaign{
+			Name:             "q1",
+			Scope:            &domain.ReviewCampaignScope{ProviderTypes: []string{"bigquery"}},
+			ReviewerStrategy: domain.ReviewerStrategyResourceOwner,
+			DefaultAction:    domain.ReviewDecisionRevoke,
+			CreatedBy:        "test@example.com",
+		}
+		s.reviewService.EXPECT().
+			CreateCampaign(mock.Anything, expectedCampaign).
+			Run(func(_ context.Context, c *domain.ReviewCampaign) {
+				c.ID = "campaign-id"
+				c.Status = domain.ReviewCampaignStatusActive
+			}).
+			Return([]*domain.ReviewItem{{ID: "item-1"}, {ID: "item-2"}}, nil).Once()
+
+		res, err := s.grpcServer.CreateReviewCampaign(s.ctx, &guardianv1beta1.CreateReviewCampaignRequest{
+			Campaign: &guardianv1beta1.ReviewCampaign{
+				Name:             "q1",
+				Scope:            &guardianv1beta1.ReviewCampaign_Scope{ProviderTypes: []string{"bigquery"}},
+				ReviewerStrategy: "resource_owner",
+				DefaultAction:    "revoke",
+			},
+		})
+
+		s.NoError(err)
+		s.Equal("campaign-id", res.GetCampaign().GetId())
+		s.Equal(int32(2), res.GetItemsCount())
+		s.reviewService.AssertExpectations(s.T())
+	})
+
+	s.Run("should return invalid argument if no grants are in scope", func() {
+		s.setup()
+
+		s.reviewService.EXPECT().CreateCampaign(mock.Anything, mock.Anything).Return(nil, review.ErrNoGrantsInScope).Once()
+
+		res, err := s.grpcServer.CreateReviewCampaign(s.ctx, &guardianv1beta1.CreateReviewCampaignRequest{
+			Campaign: &guardianv1beta1.ReviewCampaign{Name: "q1"},
+		})
+
+		s.Equal(codes.InvalidArgument, status.Code(err))
+		s.Nil(res)
+	})
+}
+
+func (s *GrpcHandlersSuite) TestDecideReviewItem() {
+	s.Run("should record decision as the authenticated user", func() {
+		s.setup()
+
+		s.reviewService.EXPECT().
+			Decide(mock.Anything, "item-id", "test@example.com", domain.ReviewDecisionRevoke, "no longer needed").
+			Return(&domain.ReviewItem{ID: "item-id", Decision: domain.ReviewDecisionRevoke}, nil).Once()
+
+		res, err := s.grpcServer.DecideReviewItem(s.ctx, &guardianv1beta1.DecideReviewItemRequest{
+			Id:       "item-id",
+			Decision: "revoke",
+			Reason:   "no longer needed",
+		})
+
+		s.NoError(err)
+		s.Equal("revoke", res.GetItem().GetDecision())
+	})
+
+	s.Run("should return permission denied if user is not a reviewer", func() {
+		s.setup()
+
+		s.reviewService.EXPECT().Decide(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, review.ErrNotReviewer).Once()
+
+		res, err := s.grpcServer.DecideReviewItem(s.ctx, &guardianv1beta1.DecideReviewItemRequest{Id: "item-id", Decision: "keep"})
+
+		s.Equal(codes.PermissionDenied, status.Code(err))
+		s.Nil(res)
+	})
+}
+
+func (s *GrpcHandlersSuite) TestListUserReviewItems() {
+	s.Run("should only list items of active campaigns assigned to the user", func() {
+		s.setup()
+
+		s.reviewService.EXPECT().
+			FindItems(mock.Anything, domain.ListReviewItemsFilter{
+				CampaignStatuses: []string{domain.ReviewCampaignStatusActive},
+				Reviewer:         "test@example.com",
+				PendingOnly:      true,
+			}).
+			Return([]*domain.ReviewItem{{ID: "item-1"}}, nil).Once()
+
+		res, err := s.grpcServer.ListUserReviewItems(s.ctx, &guardianv1beta1.ListUserReviewItemsRequest{PendingOnly: true})
+
+		s.NoError(err)
+		s.Len(res.GetItems(), 1)
+	})
+}
@@ -0,0 +1,145 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/guardian/core/sod"
+	"github.com/raystack/guardian/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) ListSoDRules(ctx context.Context, req *guardianv1beta1.ListSoDRulesRequest) (*guardianv1beta1.ListSoDRulesResponse, error) {
+	rules, err := s.sodService.Find(ctx, domain.ListSoDRulesFilter{
+		IDs:   req.GetIds(),
+		Names: req.GetNames(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get separation of duties rule list: %v", err)
+	}
+
+	ruleProtos := []*guardianv1beta1.SoDRule{}
+	for _, r := range rules {
+		ruleProto, err := s.adapter.ToSoDRuleProto(r)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to parse separation of duties rule: %v", err)
+		}
+		ruleProtos = append(ruleProtos, ruleProto)
+	}
+
+	return &guardianv1beta1.ListSoDRulesResponse{
+		Rules: ruleProtos,
+	}, nil
+}
+
+func (s *GRPCServer) GetSoDRule(ctx context.Context, req *guardianv1beta1.GetSoDRuleRequest) (*guardianv1beta1.GetSoDRuleResponse, error) {
+	r, err := s.sodService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, s.sodErrorToStatus(err, "failed to get separation of duties rule details")
+	}
+
+	ruleProto, err := s.adapter.ToSoDRuleProto(r)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse separation of duties rule: %v", err)
+	}
+
+	return &guardianv1beta1.GetSoDRuleResponse{
+		Rule: ruleProto,
+	}, nil
+}
+
+func (s *GRPCServer) CreateSoDRule(ctx context.Context, req *guardianv1beta1.CreateSoDRuleRequest) (*guardianv1beta1.CreateSoDRuleResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	r := s.adapter.FromSoDRuleProto(req.GetRule())
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "rule payload is required")
+	}
+	r.CreatedBy = user
+
+	if err := s.sodService.Create(ctx, r); err != nil {
+		return nil, s.sodErrorToStatus(err, "failed to create separation of duties rule")
+	}
+
+	ruleProto, err := s.adapter.ToSoDRuleProto(r)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse separation of duties rule: %v", err)
+	}
+
+	return &guardianv1beta1.CreateSoDRuleResponse{
+		Rule: ruleProto,
+	}, nil
+}
+
+func (s *GRPCServer) UpdateSoDRule(ctx context.Context, req *guardianv1beta1.UpdateSoDRuleRequest) (*guardianv1beta1.UpdateSoDRuleResponse, error) {
+	r := s.adapter.FromSoDRuleProto(req.GetRule())
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "rule payload is required")
+	}
+	r.ID = req.GetId()
+
+	if err := s.sodService.Update(ctx, r); err != nil {
+		return nil, s.sodErrorToStatus(err, "failed to update separation of duties rule")
+	}
+
+	ruleProto, err := s.adapter.ToSoDRuleProto(r)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse separation of duties rule: %v", err)
+	}
+
+	return &guardianv1beta1.UpdateSoDRuleResponse{
+		Rule: ruleProto,
+	}, nil
+}
+
+func (s *GRPCServer) DeleteSoDRule(ctx context.Context, req *guardianv1beta1.DeleteSoDRuleRequest) (*guardianv1beta1.DeleteSoDRuleResponse, error) {
+	if err := s.sodService.Delete(ctx, req.GetId()); err != nil {
+		return nil, s.sodErrorToStatus(err, "failed to delete separation of duties rule")
+	}
+
+	return &guardianv1beta1.DeleteSoDRuleResponse{}, nil
+}
+
+func (s *GRPCServer) ListSoDViolations(ctx context.Context, req *guardianv1beta1.ListSoDViolationsRequest) (*guardianv1beta1.ListSoDViolationsResponse, error) {
+	violations, err := s.sodService.ListViolations(ctx, domain.ListSoDViolationsFilter{
+		RuleIDs:    req.GetRuleIds(),
+		AccountIDs: req.GetAccountIds(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get separation of duties violations: %v", err)
+	}
+
+	violationProtos := []*guardianv1beta1.SoDViolation{}
+	for _, v := range violations {
+		violationProto, err := s.adapter.ToSoDViolationProto(v)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to parse separation of duties violation: %v", err)
+		}
+		violationProtos = append(violationProtos, violationProto)
+	}
+
+	return &guardianv1beta1.ListSoDViolationsResponse{
+		Violations: violationProtos,
+	}, nil
+}
+
+func (s *GRPCServer) sodErrorToStatus(err error, msg string) error {
+	var validationErrs validator.ValidationErrors
+	switch {
+	case errors.Is(err, sod.ErrRuleNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, sod.ErrDuplicateRuleName):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, sod.ErrEmptyIDParam),
+		errors.As(err, &validationErrs):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
@@ -0,0 +1,118 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+
+	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/guardian/core/sod"
+	"github.com/raystack/guardian/domain"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GrpcHandlersSuite) TestCreateSoDRule() {
+	s.Run("should set creator from authenticated user", func() {
+		s.setup()
+
+		expectedRule := &domain.SoDRule{
+			Name: "finance-writer-vs-reporting-admin",
+			Selectors: []*domain.RequirementTrigger{
+				{ResourceURN: "finance-raw", Role: "writer"},
+				{ResourceURN: "reporting", Role: "admin"},
+			},
+			Action:    domain.SoDRuleActionRequireApproval,
+			Approvers: []string{"compliance@example.com"},
+			CreatedBy: "test@example.com",
+		}
+		s.sodService.EXPECT().
+			Create(mock.Anything, expectedRule).
+			Run(func(_ context.Context, r *domain.SoDRule) {
+				r.ID = "rule-id"
+			}).
+			Return(nil).Once()
+
+		res, err := s.grpcServer.CreateSoDRule(s.ctx, &guardianv1beta1.CreateSoDRuleRequest{
+			Rule: &guardianv1beta1.SoDRule{
+				Name: "finance-writer-vs-reporting-admin",
+				Selectors: []*guardianv1beta1.Policy_Requirement_RequirementTrigger{
+					{ResourceUrn: "finance-raw", Role: "writer"},
+					{ResourceUrn: "reporting", Role: "admin"},
+				},
+				Action:    "require_approval",
+				Approvers: []string{"compliance@example.com"},
+			},
+		})
+
+		s.NoError(err)
+		s.Equal("rule-id", res.GetRule().GetId())
+		s.Equal("test@example.com", res.GetRule().GetCreatedBy())
+		s.Len(res.GetRule().GetSelectors(), 2)
+		s.sodService.AssertExpectations(s.T())
+	})
+
+	s.Run("should return already exists error if rule name is taken", func() {
+		s.setup()
+
+		s.sodService.EXPECT().
+			Create(mock.Anything, mock.Anything).
+			Return(sod.ErrDuplicateRuleName).Once()
+
+		res, err := s.grpcServer.CreateSoDRule(s.ctx, &guardianv1beta1.CreateSoDRuleRequest{
+			Rule: &guardianv1beta1.SoDRule{Name: "finance-writer-vs-reporting-admin"},
+		})
+
+		s.Equal(codes.AlreadyExists, status.Code(err))
+		s.Nil(res)
+	})
+}
+
+func (s *GrpcHandlersSuite) TestGetSoDRule() {
+	s.Run("should return not found error if rule doesn't exist", func() {
+		s.setup()
+
+		s.sodService.EXPECT().GetByID(mock.Anything, "rule-id").Return(nil, sod.ErrRuleNotFound).Once()
+
+		res, err := s.grpcServer.GetSoDRule(s.ctx, &guardianv1beta1.GetSoDRuleRequest{Id: "rule-id"})
+
+		s.Equal(codes.NotFound, status.Code(err))
+		s.Nil(res)
+	})
+}
+
+func (s *GrpcHandlersSuite) TestListSoDViolations() {
+	s.Run("should return violations", func() {
+		s.setup()
+
+		s.sodService.EXPECT().
+			ListViolations(mock.Anything, domain.ListSoDViolationsFilter{AccountIDs: []string{"user@example.com"}}).
+			Return([]*domain.SoDViolation{
+				{
+					Rule:      &domain.SoDRule{ID: "rule-id", Name: "finance-writer-vs-reporting-admin"},
+					AccountID: "user@example.com",
+					GrantIDs:  []string{"grant-1", "grant-2"},
+				},
+			}, nil).Once()
+
+		res, err := s.grpcServer.ListSoDViolations(s.ctx, &guardianv1beta1.ListSoDViolationsRequest{
+			AccountIds: []string{"user@example.com"},
+		})
+
+		s.NoError(err)
+		s.Require().Len(res.GetViolations(), 1)
+		s.Equal("rule-id", res.GetViolations()[0].GetRule().GetId())
+		s.Equal([]string{"grant-1", "grant-2"}, res.GetViolations()[0].GetGrantIds())
+	})
+
+	s.Run("should return internal error if service fails", func() {
+		s.setup()
+
+		s.sodService.EXPECT().ListViolations(mock.Anything, mock.Anything).Return(nil, errors.New("unexpected error")).Once()
+
+		res, err := s.grpcServer.ListSoDViolations(s.ctx, &guardianv1beta1.ListSoDViolationsRequest{})
+
+		s.Equal(codes.Internal, status.Code(err))
+		s.Nil(res)
+	})
+}
@@ -34,6 +34,7 @@ const (
 	GuardianService_GetPolicy_FullMethodName                = "/raystack.guardian.v1beta1.GuardianService/GetPolicy"
 	GuardianService_CreatePolicy_FullMethodName             = "/raystack.guardian.v1beta1.GuardianService/CreatePolicy"
 	GuardianService_UpdatePolicy_FullMethodName             = "/raystack.guardian.v1beta1.GuardianService/UpdatePolicy"
+	GuardianService_SimulateAppeal_FullMethodName           = "/raystack.guardian.v1beta1.GuardianService/SimulateAppeal"
 	GuardianService_GetPolicyPreferences_FullMethodName     = "/raystack.guardian.v1beta1.GuardianService/GetPolicyPreferences"
 	GuardianService_ListResources_FullMethodName            = "/raystack.guardian.v1beta1.GuardianService/ListResources"
 	GuardianService_GetResource_FullMethodName              = "/raystack.guardian.v1beta1.GuardianService/GetResource"
@@ -44,9 +45,13 @@ const (
 	GuardianService_GetAppeal_FullMethodName                = "/raystack.guardian.v1beta1.GuardianService/GetAppeal"
 	GuardianService_CancelAppeal_FullMethodName             = "/raystack.guardian.v1beta1.GuardianService/CancelAppeal"
 	GuardianService_CreateAppeal_FullMethodName             = "/raystack.guardian.v1beta1.GuardianService/CreateAppeal"
+	GuardianService_UpdateAppeal_FullMethodName             = "/raystack.guardian.v1beta1.GuardianService/UpdateAppeal"
+	GuardianService_ListAppealComments_FullMethodName       = "/raystack.guardian.v1beta1.GuardianService/ListAppealComments"
+	GuardianService_CreateAppealComment_FullMethodName      = "/raystack.guardian.v1beta1.GuardianService/CreateAppealComment"
 	GuardianService_ListUserApprovals_FullMethodName        = "/raystack.guardian.v1beta1.GuardianService/ListUserApprovals"
 	GuardianService_ListApprovals_FullMethodName            = "/raystack.guardian.v1beta1.GuardianService/ListApprovals"
 	GuardianService_UpdateApproval_FullMethodName           = "/raystack.guardian.v1beta1.GuardianService/UpdateApproval"
+	GuardianService_UpdateExternalApproval_FullMethodName   = "/raystack.guardian.v1beta1.GuardianService/UpdateExternalApproval"
 	GuardianService_AddApprover_FullMethodName              = "/raystack.guardian.v1beta1.GuardianService/AddApprover"
 	GuardianService_DeleteApprover_FullMethodName           = "/raystack.guardian.v1beta1.GuardianService/DeleteApprover"
 	GuardianService_ListGrants_FullMethodName               = "/raystack.guardian.v1beta1.GuardianService/ListGrants"
@@ -56,10 +61,37 @@ const (
 	GuardianService_RevokeGrant_FullMethodName              = "/raystack.guardian.v1beta1.GuardianService/RevokeGrant"
 	GuardianService_RevokeGrants_FullMethodName             = "/raystack.guardian.v1beta1.GuardianService/RevokeGrants"
 	GuardianService_ImportGrantsFromProvider_FullMethodName = "/raystack.guardian.v1beta1.GuardianService/ImportGrantsFromProvider"
+	GuardianService_ListGrantRecommendations_FullMethodName = "/raystack.guardian.v1beta1.GuardianService/ListGrantRecommendations"
+	GuardianService_DowngradeGrant_FullMethodName           = "/raystack.guardian.v1beta1.GuardianService/DowngradeGrant"
 	GuardianService_CreateNamespace_FullMethodName          = "/raystack.guardian.v1beta1.GuardianService/CreateNamespace"
 	GuardianService_GetNamespace_FullMethodName             = "/raystack.guardian.v1beta1.GuardianService/GetNamespace"
 	GuardianService_ListNamespaces_FullMethodName           = "/raystack.guardian.v1beta1.GuardianService/ListNamespaces"
 	GuardianService_UpdateNamespace_FullMethodName          = "/raystack.guardian.v1beta1.GuardianService/UpdateNamespace"
+	GuardianService_ListDelegations_FullMethodName          = "/raystack.guardian.v1beta1.GuardianService/ListDelegations"
+	GuardianService_GetDelegation_FullMethodName            = "/raystack.guardian.v1beta1.GuardianService/GetDelegation"
+	GuardianService_CreateDelegation_FullMethodName         = "/raystack.guardian.v1beta1.GuardianService/CreateDelegation"
+	GuardianService_UpdateDelegation_FullMethodName         = "/raystack.guardian.v1beta1.GuardianService/UpdateDelegation"
+	GuardianService_DeleteDelegation_FullMethodName         = "/raystack.guardian.v1beta1.GuardianService/DeleteDelegation"
+	GuardianService_ListBundles_FullMethodName              = "/raystack.guardian.v1beta1.GuardianService/ListBundles"
+	GuardianService_GetBundle_FullMethodName                = "/raystack.guardian.v1beta1.GuardianService/GetBundle"
+	GuardianService_CreateBundle_FullMethodName             = "/raystack.guardian.v1beta1.GuardianService/CreateBundle"
+	GuardianService_UpdateBundle_FullMethodName             = "/raystack.guardian.v1beta1.GuardianService/UpdateBundle"
+	GuardianService_DeleteBundle_FullMethodName             = "/raystack.guardian.v1beta1.GuardianService/DeleteBundle"
+	GuardianService_GetBundleStatus_FullMethodName          = "/raystack.guardian.v1beta1.GuardianService/GetBundleStatus"
+	GuardianService_ListReviewCampaigns_FullMethodName      = "/raystack.guardian.v1beta1.GuardianService/ListReviewCampaigns"
+	GuardianService_GetReviewCampaign_FullMethodName        = "/raystack.guardian.v1beta1.GuardianService/GetReviewCampaign"
+	GuardianService_CreateReviewCampaign_FullMethodName     = "/raystack.guardian.v1beta1.GuardianService/CreateReviewCampaign"
+	GuardianService_CloseReviewCampaign_FullMethodName      = "/raystack.guardian.v1beta1.GuardianService/CloseReviewCampaign"
+	GuardianService_GetReviewCampaignSummary_FullMethodName = "/raystack.guardian.v1beta1.GuardianService/GetReviewCampaignSummary"
+	GuardianService_ListReviewItems_FullMethodName          = "/raystack.guardian.v1beta1.GuardianService/ListReviewItems"
+	GuardianService_ListUserReviewItems_FullMethodName      = "/raystack.guardian.v1beta1.GuardianService/ListUserReviewItems"
+	GuardianService_DecideReviewItem_FullMethodName         = "/raystack.guardian.v1beta1.GuardianService/DecideReviewItem"
+	GuardianService_ListSoDRules_FullMethodName             = "/raystack.guardian.v1beta1.GuardianService/ListSoDRules"
+	GuardianService_GetSoDRule_FullMethodName               = "/raystack.guardian.v1beta1.GuardianService/GetSoDRule"
+	GuardianService_CreateSoDRule_FullMethodName            = "/raystack.guardian.v1beta1.GuardianService/CreateSoDRule"
+	GuardianService_UpdateSoDRule_FullMethodName            = "/raystack.guardian.v1beta1.GuardianService/UpdateSoDRule"
+	GuardianService_DeleteSoDRule_FullMethodName            = "/raystack.guardian.v1beta1.GuardianService/DeleteSoDRule"
+	GuardianService_ListSoDViolations_FullMethodName        = "/raystack.guardian.v1beta1.GuardianService/ListSoDViolations"
 )
 
 // GuardianServiceClient is the client API for GuardianService service.
@@ -81,6 +113,7 @@ type GuardianServiceClient interface {
 	GetPolicy(ctx context.Context, in *GetPolicyRequest, opts ...grpc.CallOption) (*GetPolicyResponse, error)
 	CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...grpc.CallOption) (*CreatePolicyResponse, error)
 	UpdatePolicy(ctx context.Context, in *UpdatePolicyRequest, opts ...grpc.CallOption) (*UpdatePolicyResponse, error)
+	SimulateAppeal(ctx context.Context, in *SimulateAppealRequest, opts ...grpc.CallOption) (*SimulateAppealResponse, error)
 	GetPolicyPreferences(ctx context.Context, in *GetPolicyPreferencesRequest, opts ...grpc.CallOption) (*GetPolicyPreferencesResponse, error)
 	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
 	GetResource(ctx context.Context, in *GetResourceRequest, opts ...grpc.CallOption) (*GetResourceResponse, error)
@@ -91,9 +124,13 @@ type GuardianServiceClient interface {
 	GetAppeal(ctx context.Context, in *GetAppealRequest, opts ...grpc.CallOption) (*GetAppealResponse, error)
 	CancelAppeal(ctx context.Context, in *CancelAppealRequest, opts ...grpc.CallOption) (*CancelAppealResponse, error)
 	CreateAppeal(ctx context.Context, in *CreateAppealRequest, opts ...grpc.CallOption) (*CreateAppealResponse, error)
+	UpdateAppeal(ctx context.Context, in *UpdateAppealRequest, opts ...grpc.CallOption) (*UpdateAppealResponse, error)
+	ListAppealComments(ctx context.Context, in *ListAppealCommentsRequest, opts ...grpc.CallOption) (*ListAppealCommentsResponse, error)
+	CreateAppealComment(ctx context.Context, in *CreateAppealCommentRequest, opts ...grpc.CallOption) (*CreateAppealCommentResponse, error)
 	ListUserApprovals(ctx context.Context, in *ListUserApprovalsRequest, opts ...grpc.CallOption) (*ListUserApprovalsResponse, error)
 	ListApprovals(ctx context.Context, in *ListApprovalsRequest, opts ...grpc.CallOption) (*ListApprovalsResponse, error)
 	UpdateApproval(ctx context.Context, in *UpdateApprovalRequest, opts ...grpc.CallOption) (*UpdateApprovalResponse, error)
+	UpdateExternalApproval(ctx context.Context, in *UpdateExternalApprovalRequest, opts ...grpc.CallOption) (*UpdateExternalApprovalResponse, error)
 	AddApprover(ctx context.Context, in *AddApproverRequest, opts ...grpc.CallOption) (*AddApproverResponse, error)
 	DeleteApprover(ctx context.Context, in *DeleteApproverRequest, opts ...grpc.CallOption) (*DeleteApproverResponse, error)
 	ListGrants(ctx context.Context, in *ListGrantsRequest, opts ...grpc.CallOption) (*ListGrantsResponse, error)
@@ -103,11 +140,38 @@ type GuardianServiceClient interface {
 	RevokeGrant(ctx context.Context, in *RevokeGrantRequest, opts ...grpc.CallOption) (*RevokeGrantResponse, error)
 	RevokeGrants(ctx context.Context, in *RevokeGrantsRequest, opts ...grpc.CallOption) (*RevokeGrantsResponse, error)
 	ImportGrantsFromProvider(ctx context.Context, in *ImportGrantsFromProviderRequest, opts ...grpc.CallOption) (*ImportGrantsFromProviderResponse, error)
+	ListGrantRecommendations(ctx context.Context, in *ListGrantRecommendationsRequest, opts ...grpc.CallOption) (*ListGrantRecommendationsResponse, error)
+	DowngradeGrant(ctx context.Context, in *DowngradeGrantRequest, opts ...grpc.CallOption) (*DowngradeGrantResponse, error)
 	// Namespace contains information about a tenant
 	CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*CreateNamespaceResponse, error)
 	GetNamespace(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*GetNamespaceResponse, error)
 	ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error)
 	UpdateNamespace(ctx context.Context, in *UpdateNamespaceRequest, opts ...grpc.CallOption) (*UpdateNamespaceResponse, error)
+	ListDelegations(ctx context.Context, in *ListDelegationsRequest, opts ...grpc.CallOption) (*ListDelegationsResponse, error)
+	GetDelegation(ctx context.Context, in *GetDelegationRequest, opts ...grpc.CallOption) (*GetDelegationResponse, error)
+	CreateDelegation(ctx context.Context, in *CreateDelegationRequest, opts ...grpc.CallOption) (*CreateDelegationResponse, error)
+	UpdateDelegation(ctx context.Context, in *UpdateDelegationRequest, opts ...grpc.CallOption) (*UpdateDelegationResponse, error)
+	DeleteDelegation(ctx context.Context, in *DeleteDelegationRequest, opts ...grpc.CallOption) (*DeleteDelegationResponse, error)
+	ListBundles(ctx context.Context, in *ListBundlesRequest, opts ...grpc.CallOption) (*ListBundlesResponse, error)
+	GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*GetBundleResponse, error)
+	CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (*CreateBundleResponse, error)
+	UpdateBundle(ctx context.Context, in *UpdateBundleRequest, opts ...grpc.CallOption) (*UpdateBundleResponse, error)
+	DeleteBundle(ctx context.Context, in *DeleteBundleRequest, opts ...grpc.CallOption) (*DeleteBundleResponse, error)
+	GetBundleStatus(ctx context.Context, in *GetBundleStatusRequest, opts ...grpc.CallOption) (*GetBundleStatusResponse, error)
+	ListReviewCampaigns(ctx context.Context, in *ListReviewCampaignsRequest, opts ...grpc.CallOption) (*ListReviewCampaignsResponse, error)
+	GetReviewCampaign(ctx context.Context, in *GetReviewCampaignRequest, opts ...grpc.CallOption) (*GetReviewCampaignResponse, error)
+	CreateReviewCampaign(ctx context.Context, in *CreateReviewCampaignRequest, opts ...grpc.CallOption) (*CreateReviewCampaignResponse, error)
+	CloseReviewCampaign(ctx context.Context, in *CloseReviewCampaignRequest, opts ...grpc.CallOption) (*CloseReviewCampaignResponse, error)
+	GetReviewCampaignSummary(ctx context.Context, in *GetReviewCampaignSummaryRequest, opts ...grpc.CallOption) (*GetReviewCampaignSummaryResponse, error)
+	ListReviewItems(ctx context.Context, in *ListReviewItemsRequest, opts ...grpc.CallOption) (*ListReviewItemsResponse, error)
+	ListUserReviewItems(ctx context.Context, in *ListUserReviewItemsRequest, opts ...grpc.CallOption) (*ListUserReviewItemsResponse, error)
+	DecideReviewItem(ctx context.Context, in *DecideReviewItemRequest, opts ...grpc.CallOption) (*DecideReviewItemResponse, error)
+	ListSoDRules(ctx context.Context, in *ListSoDRulesRequest, opts ...grpc.CallOption) (*ListSoDRulesResponse, error)
+	GetSoDRule(ctx context.Context, in *GetSoDRuleRequest, opts ...grpc.CallOption) (*GetSoDRuleResponse, error)
+	CreateSoDRule(ctx context.Context, in *CreateSoDRuleRequest, opts ...grpc.CallOption) (*CreateSoDRuleResponse, error)
+	UpdateSoDRule(ctx context.Context, in *UpdateSoDRuleRequest, opts ...grpc.CallOption) (*UpdateSoDRuleResponse, error)
+	DeleteSoDRule(ctx context.Context, in *DeleteSoDRuleRequest, opts ...grpc.CallOption) (*DeleteSoDRuleResponse, error)
+	ListSoDViolations(ctx context.Context, in *ListSoDViolationsRequest, opts ...grpc.CallOption) (*ListSoDViolationsResponse, error)
 }
 
 type guardianServiceClient struct {
@@ -253,6 +317,15 @@ func (c *guardianServiceClient) UpdatePolicy(ctx context.Context, in *UpdatePoli
 	return out, nil
 }
 
+func (c *guardianServiceClient) SimulateAppeal(ctx context.Context, in *SimulateAppealRequest, opts ...grpc.CallOption) (*SimulateAppealResponse, error) {
+	out := new(SimulateAppealResponse)
+	err := c.cc.Invoke(ctx, GuardianService_SimulateAppeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *guardianServiceClient) GetPolicyPreferences(ctx context.Context, in *GetPolicyPreferencesRequest, opts ...grpc.CallOption) (*GetPolicyPreferencesResponse, error) {
 	out := new(GetPolicyPreferencesResponse)
 	err := c.cc.Invoke(ctx, GuardianService_GetPolicyPreferences_FullMethodName, in, out, opts...)
@@ -343,6 +416,33 @@ func (c *guardianServiceClient) CreateAppeal(ctx context.Context, in *CreateAppe
 	return out, nil
 }
 
+func (c *guardianServiceClient) UpdateAppeal(ctx context.Context, in *UpdateAppealRequest, opts ...grpc.CallOption) (*UpdateAppealResponse, error) {
+	out := new(UpdateAppealResponse)
+	err := c.cc.Invoke(ctx, GuardianService_UpdateAppeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) ListAppealComments(ctx context.Context, in *ListAppealCommentsRequest, opts ...grpc.CallOption) (*ListAppealCommentsResponse, error) {
+	out := new(ListAppealCommentsResponse)
+	err := c.cc.Invoke(ctx, GuardianService_ListAppealComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) CreateAppealComment(ctx context.Context, in *CreateAppealCommentRequest, opts ...grpc.CallOption) (*CreateAppealCommentResponse, error) {
+	out := new(CreateAppealCommentResponse)
+	err := c.cc.Invoke(ctx, GuardianService_CreateAppealComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *guardianServiceClient) ListUserApprovals(ctx context.Context, in *ListUserApprovalsRequest, opts ...grpc.CallOption) (*ListUserApprovalsResponse, error) {
 	out := new(ListUserApprovalsResponse)
 	err := c.cc.Invoke(ctx, GuardianService_ListUserApprovals_FullMethodName, in, out, opts...)
@@ -370,6 +470,15 @@ func (c *guardianServiceClient) UpdateApproval(ctx context.Context, in *UpdateAp
 	return out, nil
 }
 
+func (c *guardianServiceClient) UpdateExternalApproval(ctx context.Context, in *UpdateExternalApprovalRequest, opts ...grpc.CallOption) (*UpdateExternalApprovalResponse, error) {
+	out := new(UpdateExternalApprovalResponse)
+	err := c.cc.Invoke(ctx, GuardianService_UpdateExternalApproval_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *guardianServiceClient) AddApprover(ctx context.Context, in *AddApproverRequest, opts ...grpc.CallOption) (*AddApproverResponse, error) {
 	out := new(AddApproverResponse)
 	err := c.cc.Invoke(ctx, GuardianService_AddApprover_FullMethodName, in, out, opts...)
@@ -451,6 +560,24 @@ func (c *guardianServiceClient) ImportGrantsFromProvider(ctx context.Context, in
 	return out, nil
 }
 
+func (c *guardianServiceClient) ListGrantRecommendations(ctx context.Context, in *ListGrantRecommendationsRequest, opts ...grpc.CallOption) (*ListGrantRecommendationsResponse, error) {
+	out := new(ListGrantRecommendationsResponse)
+	err := c.cc.Invoke(ctx, GuardianService_ListGrantRecommendations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) DowngradeGrant(ctx context.Context, in *DowngradeGrantRequest, opts ...grpc.CallOption) (*DowngradeGrantResponse, error) {
+	out := new(DowngradeGrantResponse)
+	err := c.cc.Invoke(ctx, GuardianService_DowngradeGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *guardianServiceClient) CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*CreateNamespaceResponse, error) {
 	out := new(CreateNamespaceResponse)
 	err := c.cc.Invoke(ctx, GuardianService_CreateNamespace_FullMethodName, in, out, opts...)
@@ -487,6 +614,231 @@ func (c *guardianServiceClient) UpdateNamespace(ctx context.Context, in *UpdateN
 	return out, nil
 }
 
+func (c *guardianServiceClient) ListDelegations(ctx context.Context, in *ListDelegationsRequest, opts ...grpc.CallOption) (*ListDelegationsResponse, error) {
+	out := new(ListDelegationsResponse)
+	err := c.cc.Invoke(ctx, GuardianService_ListDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) GetDelegation(ctx context.Context, in *GetDelegationRequest, opts ...grpc.CallOption) (*GetDelegationResponse, error) {
+	out := new(GetDelegationResponse)
+	err := c.cc.Invoke(ctx, GuardianService_GetDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) CreateDelegation(ctx context.Context, in *CreateDelegationRequest, opts ...grpc.CallOption) (*CreateDelegationResponse, error) {
+	out := new(CreateDelegationResponse)
+	err := c.cc.Invoke(ctx, GuardianService_CreateDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) UpdateDelegation(ctx context.Context, in *UpdateDelegationRequest, opts ...grpc.CallOption) (*UpdateDelegationResponse, error) {
+	out := new(UpdateDelegationResponse)
+	err := c.cc.Invoke(ctx, GuardianService_UpdateDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) DeleteDelegation(ctx context.Context, in *DeleteDelegationRequest, opts ...grpc.CallOption) (*DeleteDelegationResponse, error) {
+	out := new(DeleteDelegationResponse)
+	err := c.cc.Invoke(ctx, GuardianService_DeleteDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) ListBundles(ctx context.Context, in *ListBundlesRequest, opts ...grpc.CallOption) (*ListBundlesResponse, error) {
+	out := new(ListBundlesResponse)
+	err := c.cc.Invoke(ctx, GuardianService_ListBundles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*GetBundleResponse, error) {
+	out := new(GetBundleResponse)
+	err := c.cc.Invoke(ctx, GuardianService_GetBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (*CreateBundleResponse, error) {
+	out := new(CreateBundleResponse)
+	err := c.cc.Invoke(ctx, GuardianService_CreateBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) UpdateBundle(ctx context.Context, in *UpdateBundleRequest, opts ...grpc.CallOption) (*UpdateBundleResponse, error) {
+	out := new(UpdateBundleResponse)
+	err := c.cc.Invoke(ctx, GuardianService_UpdateBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) DeleteBundle(ctx context.Context, in *DeleteBundleRequest, opts ...grpc.CallOption) (*DeleteBundleResponse, error) {
+	out := new(DeleteBundleResponse)
+	err := c.cc.Invoke(ctx, GuardianService_DeleteBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) GetBundleStatus(ctx context.Context, in *GetBundleStatusRequest, opts ...grpc.CallOption) (*GetBundleStatusResponse, error) {
+	out := new(GetBundleStatusResponse)
+	err := c.cc.Invoke(ctx, GuardianService_GetBundleStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) ListReviewCampaigns(ctx context.Context, in *ListReviewCampaignsRequest, opts ...grpc.CallOption) (*ListReviewCampaignsResponse, error) {
+	out := new(ListReviewCampaignsResponse)
+	err := c.cc.Invoke(ctx, GuardianService_ListReviewCampaigns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) GetReviewCampaign(ctx context.Context, in *GetReviewCampaignRequest, opts ...grpc.CallOption) (*GetReviewCampaignResponse, error) {
+	out := new(GetReviewCampaignResponse)
+	err := c.cc.Invoke(ctx, GuardianService_GetReviewCampaign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) CreateReviewCampaign(ctx context.Context, in *CreateReviewCampaignRequest, opts ...grpc.CallOption) (*CreateReviewCampaignResponse, error) {
+	out := new(CreateReviewCampaignResponse)
+	err := c.cc.Invoke(ctx, GuardianService_CreateReviewCampaign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) CloseReviewCampaign(ctx context.Context, in *CloseReviewCampaignRequest, opts ...grpc.CallOption) (*CloseReviewCampaignResponse, error) {
+	out := new(CloseReviewCampaignResponse)
+	err := c.cc.Invoke(ctx, GuardianService_CloseReviewCampaign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) GetReviewCampaignSummary(ctx context.Context, in *GetReviewCampaignSummaryRequest, opts ...grpc.CallOption) (*GetReviewCampaignSummaryResponse, error) {
+	out := new(GetReviewCampaignSummaryResponse)
+	err := c.cc.Invoke(ctx, GuardianService_GetReviewCampaignSummary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) ListReviewItems(ctx context.Context, in *ListReviewItemsRequest, opts ...grpc.CallOption) (*ListReviewItemsResponse, error) {
+	out := new(ListReviewItemsResponse)
+	err := c.cc.Invoke(ctx, GuardianService_ListReviewItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) ListUserReviewItems(ctx context.Context, in *ListUserReviewItemsRequest, opts ...grpc.CallOption) (*ListUserReviewItemsResponse, error) {
+	out := new(ListUserReviewItemsResponse)
+	err := c.cc.Invoke(ctx, GuardianService_ListUserReviewItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) DecideReviewItem(ctx context.Context, in *DecideReviewItemRequest, opts ...grpc.CallOption) (*DecideReviewItemResponse, error) {
+	out := new(DecideReviewItemResponse)
+	err := c.cc.Invoke(ctx, GuardianService_DecideReviewItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) ListSoDRules(ctx context.Context, in *ListSoDRulesRequest, opts ...grpc.CallOption) (*ListSoDRulesResponse, error) {
+	out := new(ListSoDRulesResponse)
+	err := c.cc.Invoke(ctx, GuardianService_ListSoDRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) GetSoDRule(ctx context.Context, in *GetSoDRuleRequest, opts ...grpc.CallOption) (*GetSoDRuleResponse, error) {
+	out := new(GetSoDRuleResponse)
+	err := c.cc.Invoke(ctx, GuardianService_GetSoDRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) CreateSoDRule(ctx context.Context, in *CreateSoDRuleRequest, opts ...grpc.CallOption) (*CreateSoDRuleResponse, error) {
+	out := new(CreateSoDRuleResponse)
+	err := c.cc.Invoke(ctx, GuardianService_CreateSoDRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) UpdateSoDRule(ctx context.Context, in *UpdateSoDRuleRequest, opts ...grpc.CallOption) (*UpdateSoDRuleResponse, error) {
+	out := new(UpdateSoDRuleResponse)
+	err := c.cc.Invoke(ctx, GuardianService_UpdateSoDRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) DeleteSoDRule(ctx context.Context, in *DeleteSoDRuleRequest, opts ...grpc.CallOption) (*DeleteSoDRuleResponse, error) {
+	out := new(DeleteSoDRuleResponse)
+	err := c.cc.Invoke(ctx, GuardianService_DeleteSoDRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) ListSoDViolations(ctx context.Context, in *ListSoDViolationsRequest, opts ...grpc.CallOption) (*ListSoDViolationsResponse, error) {
+	out := new(ListSoDViolationsResponse)
+	err := c.cc.Invoke(ctx, GuardianService_ListSoDViolations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GuardianServiceServer is the server API for GuardianService service.
 // All implementations must embed UnimplementedGuardianServiceServer
 // for forward compatibility
@@ -506,6 +858,7 @@ type GuardianServiceServer interface {
 	GetPolicy(context.Context, *GetPolicyRequest) (*GetPolicyResponse, error)
 	CreatePolicy(context.Context, *CreatePolicyRequest) (*CreatePolicyResponse, error)
 	UpdatePolicy(context.Context, *UpdatePolicyRequest) (*UpdatePolicyResponse, error)
+	SimulateAppeal(context.Context, *SimulateAppealRequest) (*SimulateAppealResponse, error)
 	GetPolicyPreferences(context.Context, *GetPolicyPreferencesRequest) (*GetPolicyPreferencesResponse, error)
 	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
 	GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error)
@@ -516,9 +869,13 @@ type GuardianServiceServer interface {
 	GetAppeal(context.Context, *GetAppealRequest) (*GetAppealResponse, error)
 	CancelAppeal(context.Context, *CancelAppealRequest) (*CancelAppealResponse, error)
 	CreateAppeal(context.Context, *CreateAppealRequest) (*CreateAppealResponse, error)
+	UpdateAppeal(context.Context, *UpdateAppealRequest) (*UpdateAppealResponse, error)
+	ListAppealComments(context.Context, *ListAppealCommentsRequest) (*ListAppealCommentsResponse, error)
+	CreateAppealComment(context.Context, *CreateAppealCommentRequest) (*CreateAppealCommentResponse, error)
 	ListUserApprovals(context.Context, *ListUserApprovalsRequest) (*ListUserApprovalsResponse, error)
 	ListApprovals(context.Context, *ListApprovalsRequest) (*ListApprovalsResponse, error)
 	UpdateApproval(context.Context, *UpdateApprovalRequest) (*UpdateApprovalResponse, error)
+	UpdateExternalApproval(context.Context, *UpdateExternalApprovalRequest) (*UpdateExternalApprovalResponse, error)
 	AddApprover(context.Context, *AddApproverRequest) (*AddApproverResponse, error)
 	DeleteApprover(context.Context, *DeleteApproverRequest) (*DeleteApproverResponse, error)
 	ListGrants(context.Context, *ListGrantsRequest) (*ListGrantsResponse, error)
@@ -528,11 +885,38 @@ type GuardianServiceServer interface {
 	RevokeGrant(context.Context, *RevokeGrantRequest) (*RevokeGrantResponse, error)
 	RevokeGrants(context.Context, *RevokeGrantsRequest) (*RevokeGrantsResponse, error)
 	ImportGrantsFromProvider(context.Context, *ImportGrantsFromProviderRequest) (*ImportGrantsFromProviderResponse, error)
+	ListGrantRecommendations(context.Context, *ListGrantRecommendationsRequest) (*ListGrantRecommendationsResponse, error)
+	DowngradeGrant(context.Context, *DowngradeGrantRequest) (*DowngradeGrantResponse, error)
 	// Namespace contains information about a tenant
 	CreateNamespace(context.Context, *CreateNamespaceRequest) (*CreateNamespaceResponse, error)
 	GetNamespace(context.Context, *GetNamespaceRequest) (*GetNamespaceResponse, error)
 	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
 	UpdateNamespace(context.Context, *UpdateNamespaceRequest) (*UpdateNamespaceResponse, error)
+	ListDelegations(context.Context, *ListDelegationsRequest) (*ListDelegationsResponse, error)
+	GetDelegation(context.Context, *GetDelegationRequest) (*GetDelegationResponse, error)
+	CreateDelegation(context.Context, *CreateDelegationRequest) (*CreateDelegationResponse, error)
+	UpdateDelegation(context.Context, *UpdateDelegationRequest) (*UpdateDelegationResponse, error)
+	DeleteDelegation(context.Context, *DeleteDelegationRequest) (*DeleteDelegationResponse, error)
+	ListBundles(context.Context, *ListBundlesRequest) (*ListBundlesResponse, error)
+	GetBundle(context.Context, *GetBundleRequest) (*GetBundleResponse, error)
+	CreateBundle(context.Context, *CreateBundleRequest) (*CreateBundleResponse, error)
+	UpdateBundle(context.Context, *UpdateBundleRequest) (*UpdateBundleResponse, error)
+	DeleteBundle(context.Context, *DeleteBundleRequest) (*DeleteBundleResponse, error)
+	GetBundleStatus(context.Context, *GetBundleStatusRequest) (*GetBundleStatusResponse, error)
+	ListReviewCampaigns(context.Context, *ListReviewCampaignsRequest) (*ListReviewCampaignsResponse, error)
+	GetReviewCampaign(context.Context, *GetReviewCampaignRequest) (*GetReviewCampaignResponse, error)
+	CreateReviewCampaign(context.Context, *CreateReviewCampaignRequest) (*CreateReviewCampaignResponse, error)
+	CloseReviewCampaign(context.Context, *CloseReviewCampaignRequest) (*CloseReviewCampaignResponse, error)
+	GetReviewCampaignSummary(context.Context, *GetReviewCampaignSummaryRequest) (*GetReviewCampaignSummaryResponse, error)
+	ListReviewItems(context.Context, *ListReviewItemsRequest) (*ListReviewItemsResponse, error)
+	ListUserReviewItems(context.Context, *ListUserReviewItemsRequest) (*ListUserReviewItemsResponse, error)
+	DecideReviewItem(context.Context, *DecideReviewItemRequest) (*DecideReviewItemResponse, error)
+	ListSoDRules(context.Context, *ListSoDRulesRequest) (*ListSoDRulesResponse, error)
+	GetSoDRule(context.Context, *GetSoDRuleRequest) (*GetSoDRuleResponse, error)
+	CreateSoDRule(context.Context, *CreateSoDRuleRequest) (*CreateSoDRuleResponse, error)
+	UpdateSoDRule(context.Context, *UpdateSoDRuleRequest) (*UpdateSoDRuleResponse, error)
+	DeleteSoDRule(context.Context, *DeleteSoDRuleRequest) (*DeleteSoDRuleResponse, error)
+	ListSoDViolations(context.Context, *ListSoDViolationsRequest) (*ListSoDViolationsResponse, error)
 	mustEmbedUnimplementedGuardianServiceServer()
 }
 
@@ -585,6 +969,9 @@ func (UnimplementedGuardianServiceServer) CreatePolicy(context.Context, *CreateP
 func (UnimplementedGuardianServiceServer) UpdatePolicy(context.Context, *UpdatePolicyRequest) (*UpdatePolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePolicy not implemented")
 }
+func (UnimplementedGuardianServiceServer) SimulateAppeal(context.Context, *SimulateAppealRequest) (*SimulateAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateAppeal not implemented")
+}
 func (UnimplementedGuardianServiceServer) GetPolicyPreferences(context.Context, *GetPolicyPreferencesRequest) (*GetPolicyPreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPolicyPreferences not implemented")
 }
@@ -615,6 +1002,15 @@ func (UnimplementedGuardianServiceServer) CancelAppeal(context.Context, *CancelA
 func (UnimplementedGuardianServiceServer) CreateAppeal(context.Context, *CreateAppealRequest) (*CreateAppealResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAppeal not implemented")
 }
+func (UnimplementedGuardianServiceServer) UpdateAppeal(context.Context, *UpdateAppealRequest) (*UpdateAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppeal not implemented")
+}
+func (UnimplementedGuardianServiceServer) ListAppealComments(context.Context, *ListAppealCommentsRequest) (*ListAppealCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppealComments not implemented")
+}
+func (UnimplementedGuardianServiceServer) CreateAppealComment(context.Context, *CreateAppealCommentRequest) (*CreateAppealCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppealComment not implemented")
+}
 func (UnimplementedGuardianServiceServer) ListUserApprovals(context.Context, *ListUserApprovalsRequest) (*ListUserApprovalsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUserApprovals not implemented")
 }
@@ -624,6 +1020,9 @@ func (UnimplementedGuardianServiceServer) ListApprovals(context.Context, *ListAp
 func (UnimplementedGuardianServiceServer) UpdateApproval(context.Context, *UpdateApprovalRequest) (*UpdateApprovalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateApproval not implemented")
 }
+func (UnimplementedGuardianServiceServer) UpdateExternalApproval(context.Context, *UpdateExternalApprovalRequest) (*UpdateExternalApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExternalApproval not implemented")
+}
 func (UnimplementedGuardianServiceServer) AddApprover(context.Context, *AddApproverRequest) (*AddApproverResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddApprover not implemented")
 }
@@ -651,6 +1050,12 @@ func (UnimplementedGuardianServiceServer) RevokeGrants(context.Context, *RevokeG
 func (UnimplementedGuardianServiceServer) ImportGrantsFromProvider(context.Context, *ImportGrantsFromProviderRequest) (*ImportGrantsFromProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportGrantsFromProvider not implemented")
 }
+func (UnimplementedGuardianServiceServer) ListGrantRecommendations(context.Context, *ListGrantRecommendationsRequest) (*ListGrantRecommendationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGrantRecommendations not implemented")
+}
+func (UnimplementedGuardianServiceServer) DowngradeGrant(context.Context, *DowngradeGrantRequest) (*DowngradeGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowngradeGrant not implemented")
+}
 func (UnimplementedGuardianServiceServer) CreateNamespace(context.Context, *CreateNamespaceRequest) (*CreateNamespaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateNamespace not implemented")
 }
@@ -663,6 +1068,81 @@ func (UnimplementedGuardianServiceServer) ListNamespaces(context.Context, *ListN
 func (UnimplementedGuardianServiceServer) UpdateNamespace(context.Context, *UpdateNamespaceRequest) (*UpdateNamespaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespace not implemented")
 }
+func (UnimplementedGuardianServiceServer) ListDelegations(context.Context, *ListDelegationsRequest) (*ListDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDelegations not implemented")
+}
+func (UnimplementedGuardianServiceServer) GetDelegation(context.Context, *GetDelegationRequest) (*GetDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegation not implemented")
+}
+func (UnimplementedGuardianServiceServer) CreateDelegation(context.Context, *CreateDelegationRequest) (*CreateDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDelegation not implemented")
+}
+func (UnimplementedGuardianServiceServer) UpdateDelegation(context.Context, *UpdateDelegationRequest) (*UpdateDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDelegation not implemented")
+}
+func (UnimplementedGuardianServiceServer) DeleteDelegation(context.Context, *DeleteDelegationRequest) (*DeleteDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDelegation not implemented")
+}
+func (UnimplementedGuardianServiceServer) ListBundles(context.Context, *ListBundlesRequest) (*ListBundlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBundles not implemented")
+}
+func (UnimplementedGuardianServiceServer) GetBundle(context.Context, *GetBundleRequest) (*GetBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBundle not implemented")
+}
+func (UnimplementedGuardianServiceServer) CreateBundle(context.Context, *CreateBundleRequest) (*CreateBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBundle not implemented")
+}
+func (UnimplementedGuardianServiceServer) UpdateBundle(context.Context, *UpdateBundleRequest) (*UpdateBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBundle not implemented")
+}
+func (UnimplementedGuardianServiceServer) DeleteBundle(context.Context, *DeleteBundleRequest) (*DeleteBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBundle not implemented")
+}
+func (UnimplementedGuardianServiceServer) GetBundleStatus(context.Context, *GetBundleStatusRequest) (*GetBundleStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBundleStatus not implemented")
+}
+func (UnimplementedGuardianServiceServer) ListReviewCampaigns(context.Context, *ListReviewCampaignsRequest) (*ListReviewCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewCampaigns not implemented")
+}
+func (UnimplementedGuardianServiceServer) GetReviewCampaign(context.Context, *GetReviewCampaignRequest) (*GetReviewCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewCampaign not implemented")
+}
+func (UnimplementedGuardianServiceServer) CreateReviewCampaign(context.Context, *CreateReviewCampaignRequest) (*CreateReviewCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReviewCampaign not implemented")
+}
+func (UnimplementedGuardianServiceServer) CloseReviewCampaign(context.Context, *CloseReviewCampaignRequest) (*CloseReviewCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseReviewCampaign not implemented")
+}
+func (UnimplementedGuardianServiceServer) GetReviewCampaignSummary(context.Context, *GetReviewCampaignSummaryRequest) (*GetReviewCampaignSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewCampaignSummary not implemented")
+}
+func (UnimplementedGuardianServiceServer) ListReviewItems(context.Context, *ListReviewItemsRequest) (*ListReviewItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewItems not implemented")
+}
+func (UnimplementedGuardianServiceServer) ListUserReviewItems(context.Context, *ListUserReviewItemsRequest) (*ListUserReviewItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserReviewItems not implemented")
+}
+func (UnimplementedGuardianServiceServer) DecideReviewItem(context.Context, *DecideReviewItemRequest) (*DecideReviewItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecideReviewItem not implemented")
+}
+func (UnimplementedGuardianServiceServer) ListSoDRules(context.Context, *ListSoDRulesRequest) (*ListSoDRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSoDRules not implemented")
+}
+func (UnimplementedGuardianServiceServer) GetSoDRule(context.Context, *GetSoDRuleRequest) (*GetSoDRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSoDRule not implemented")
+}
+func (UnimplementedGuardianServiceServer) CreateSoDRule(context.Context, *CreateSoDRuleRequest) (*CreateSoDRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSoDRule not implemented")
+}
+func (UnimplementedGuardianServiceServer) UpdateSoDRule(context.Context, *UpdateSoDRuleRequest) (*UpdateSoDRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSoDRule not implemented")
+}
+func (UnimplementedGuardianServiceServer) DeleteSoDRule(context.Context, *DeleteSoDRuleRequest) (*DeleteSoDRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSoDRule not implemented")
+}
+func (UnimplementedGuardianServiceServer) ListSoDViolations(context.Context, *ListSoDViolationsRequest) (*ListSoDViolationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSoDViolations not implemented")
+}
 func (UnimplementedGuardianServiceServer) mustEmbedUnimplementedGuardianServiceServer() {}
 
 // UnsafeGuardianServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -946,6 +1426,24 @@ func _GuardianService_UpdatePolicy_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GuardianService_SimulateAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).SimulateAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_SimulateAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).SimulateAppeal(ctx, req.(*SimulateAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GuardianService_GetPolicyPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPolicyPreferencesRequest)
 	if err := dec(in); err != nil {
@@ -1126,62 +1624,134 @@ func _GuardianService_CreateAppeal_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GuardianService_ListUserApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUserApprovalsRequest)
+func _GuardianService_UpdateAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppealRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GuardianServiceServer).ListUserApprovals(ctx, in)
+		return srv.(GuardianServiceServer).UpdateAppeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GuardianService_ListUserApprovals_FullMethodName,
+		FullMethod: GuardianService_UpdateAppeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GuardianServiceServer).ListUserApprovals(ctx, req.(*ListUserApprovalsRequest))
+		return srv.(GuardianServiceServer).UpdateAppeal(ctx, req.(*UpdateAppealRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GuardianService_ListApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListApprovalsRequest)
+func _GuardianService_ListAppealComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppealCommentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GuardianServiceServer).ListApprovals(ctx, in)
+		return srv.(GuardianServiceServer).ListAppealComments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GuardianService_ListApprovals_FullMethodName,
+		FullMethod: GuardianService_ListAppealComments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GuardianServiceServer).ListApprovals(ctx, req.(*ListApprovalsRequest))
+		return srv.(GuardianServiceServer).ListAppealComments(ctx, req.(*ListAppealCommentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GuardianService_UpdateApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateApprovalRequest)
+func _GuardianService_CreateAppealComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppealCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GuardianServiceServer).UpdateApproval(ctx, in)
+		return srv.(GuardianServiceServer).CreateAppealComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GuardianService_UpdateApproval_FullMethodName,
+		FullMethod: GuardianService_CreateAppealComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GuardianServiceServer).UpdateApproval(ctx, req.(*UpdateApprovalRequest))
+		return srv.(GuardianServiceServer).CreateAppealComment(ctx, req.(*CreateAppealCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GuardianService_AddApprover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddApproverRequest)
+func _GuardianService_ListUserApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).ListUserApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_ListUserApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).ListUserApprovals(ctx, req.(*ListUserApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_ListApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).ListApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_ListApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).ListApprovals(ctx, req.(*ListApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_UpdateApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).UpdateApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_UpdateApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).UpdateApproval(ctx, req.(*UpdateApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_UpdateExternalApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExternalApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).UpdateExternalApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_UpdateExternalApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).UpdateExternalApproval(ctx, req.(*UpdateExternalApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_AddApprover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddApproverRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1342,6 +1912,42 @@ func _GuardianService_ImportGrantsFromProvider_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GuardianService_ListGrantRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGrantRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).ListGrantRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_ListGrantRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).ListGrantRecommendations(ctx, req.(*ListGrantRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_DowngradeGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DowngradeGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).DowngradeGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_DowngradeGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).DowngradeGrant(ctx, req.(*DowngradeGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GuardianService_CreateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateNamespaceRequest)
 	if err := dec(in); err != nil {
@@ -1414,6 +2020,456 @@ func _GuardianService_UpdateNamespace_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GuardianService_ListDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).ListDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_ListDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).ListDelegations(ctx, req.(*ListDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_GetDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).GetDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_GetDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).GetDelegation(ctx, req.(*GetDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_CreateDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).CreateDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_CreateDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).CreateDelegation(ctx, req.(*CreateDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_UpdateDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).UpdateDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_UpdateDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).UpdateDelegation(ctx, req.(*UpdateDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_DeleteDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).DeleteDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_DeleteDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).DeleteDelegation(ctx, req.(*DeleteDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_ListBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBundlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).ListBundles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_ListBundles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).ListBundles(ctx, req.(*ListBundlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_GetBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).GetBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_GetBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).GetBundle(ctx, req.(*GetBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_CreateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).CreateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_CreateBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).CreateBundle(ctx, req.(*CreateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_UpdateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).UpdateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_UpdateBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).UpdateBundle(ctx, req.(*UpdateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_DeleteBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).DeleteBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_DeleteBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).DeleteBundle(ctx, req.(*DeleteBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_GetBundleStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBundleStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).GetBundleStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_GetBundleStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).GetBundleStatus(ctx, req.(*GetBundleStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_ListReviewCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).ListReviewCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_ListReviewCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).ListReviewCampaigns(ctx, req.(*ListReviewCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_GetReviewCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).GetReviewCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_GetReviewCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).GetReviewCampaign(ctx, req.(*GetReviewCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_CreateReviewCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).CreateReviewCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_CreateReviewCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).CreateReviewCampaign(ctx, req.(*CreateReviewCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_CloseReviewCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseReviewCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).CloseReviewCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_CloseReviewCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).CloseReviewCampaign(ctx, req.(*CloseReviewCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_GetReviewCampaignSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewCampaignSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).GetReviewCampaignSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_GetReviewCampaignSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).GetReviewCampaignSummary(ctx, req.(*GetReviewCampaignSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_ListReviewItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).ListReviewItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_ListReviewItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).ListReviewItems(ctx, req.(*ListReviewItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_ListUserReviewItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserReviewItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).ListUserReviewItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_ListUserReviewItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).ListUserReviewItems(ctx, req.(*ListUserReviewItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_DecideReviewItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideReviewItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).DecideReviewItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_DecideReviewItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).DecideReviewItem(ctx, req.(*DecideReviewItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_ListSoDRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSoDRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).ListSoDRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_ListSoDRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).ListSoDRules(ctx, req.(*ListSoDRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_GetSoDRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSoDRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).GetSoDRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_GetSoDRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).GetSoDRule(ctx, req.(*GetSoDRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_CreateSoDRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSoDRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).CreateSoDRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_CreateSoDRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).CreateSoDRule(ctx, req.(*CreateSoDRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_UpdateSoDRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSoDRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).UpdateSoDRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_UpdateSoDRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).UpdateSoDRule(ctx, req.(*UpdateSoDRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_DeleteSoDRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSoDRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).DeleteSoDRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_DeleteSoDRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).DeleteSoDRule(ctx, req.(*DeleteSoDRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_ListSoDViolations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSoDViolationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).ListSoDViolations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_ListSoDViolations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).ListSoDViolations(ctx, req.(*ListSoDViolationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GuardianService_ServiceDesc is the grpc.ServiceDesc for GuardianService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1481,6 +2537,10 @@ var GuardianService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePolicy",
 			Handler:    _GuardianService_UpdatePolicy_Handler,
 		},
+		{
+			MethodName: "SimulateAppeal",
+			Handler:    _GuardianService_SimulateAppeal_Handler,
+		},
 		{
 			MethodName: "GetPolicyPreferences",
 			Handler:    _GuardianService_GetPolicyPreferences_Handler,
@@ -1521,6 +2581,18 @@ var GuardianService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateAppeal",
 			Handler:    _GuardianService_CreateAppeal_Handler,
 		},
+		{
+			MethodName: "UpdateAppeal",
+			Handler:    _GuardianService_UpdateAppeal_Handler,
+		},
+		{
+			MethodName: "ListAppealComments",
+			Handler:    _GuardianService_ListAppealComments_Handler,
+		},
+		{
+			MethodName: "CreateAppealComment",
+			Handler:    _GuardianService_CreateAppealComment_Handler,
+		},
 		{
 			MethodName: "ListUserApprovals",
 			Handler:    _GuardianService_ListUserApprovals_Handler,
@@ -1533,6 +2605,10 @@ var GuardianService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateApproval",
 			Handler:    _GuardianService_UpdateApproval_Handler,
 		},
+		{
+			MethodName: "UpdateExternalApproval",
+			Handler:    _GuardianService_UpdateExternalApproval_Handler,
+		},
 		{
 			MethodName: "AddApprover",
 			Handler:    _GuardianService_AddApprover_Handler,
@@ -1569,6 +2645,14 @@ var GuardianService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportGrantsFromProvider",
 			Handler:    _GuardianService_ImportGrantsFromProvider_Handler,
 		},
+		{
+			MethodName: "ListGrantRecommendations",
+			Handler:    _GuardianService_ListGrantRecommendations_Handler,
+		},
+		{
+			MethodName: "DowngradeGrant",
+			Handler:    _GuardianService_DowngradeGrant_Handler,
+		},
 		{
 			MethodName: "CreateNamespace",
 			Handler:    _GuardianService_CreateNamespace_Handler,
@@ -1585,6 +2669,106 @@ var GuardianService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateNamespace",
 			Handler:    _GuardianService_UpdateNamespace_Handler,
 		},
+		{
+			MethodName: "ListDelegations",
+			Handler:    _GuardianService_ListDelegations_Handler,
+		},
+		{
+			MethodName: "GetDelegation",
+			Handler:    _GuardianService_GetDelegation_Handler,
+		},
+		{
+			MethodName: "CreateDelegation",
+			Handler:    _GuardianService_CreateDelegation_Handler,
+		},
+		{
+			MethodName: "UpdateDelegation",
+			Handler:    _GuardianService_UpdateDelegation_Handler,
+		},
+		{
+			MethodName: "DeleteDelegation",
+			Handler:    _GuardianService_DeleteDelegation_Handler,
+		},
+		{
+			MethodName: "ListBundles",
+			Handler:    _GuardianService_ListBundles_Handler,
+		},
+		{
+			MethodName: "GetBundle",
+			Handler:    _GuardianService_GetBundle_Handler,
+		},
+		{
+			MethodName: "CreateBundle",
+			Handler:    _GuardianService_CreateBundle_Handler,
+		},
+		{
+			MethodName: "UpdateBundle",
+			Handler:    _GuardianService_UpdateBundle_Handler,
+		},
+		{
+			MethodName: "DeleteBundle",
+			Handler:    _GuardianService_DeleteBundle_Handler,
+		},
+		{
+			MethodName: "GetBundleStatus",
+			Handler:    _GuardianService_GetBundleStatus_Handler,
+		},
+		{
+			MethodName: "ListReviewCampaigns",
+			Handler:    _GuardianService_ListReviewCampaigns_Handler,
+		},
+		{
+			MethodName: "GetReviewCampaign",
+			Handler:    _GuardianService_GetReviewCampaign_Handler,
+		},
+		{
+			MethodName: "CreateReviewCampaign",
+			Handler:    _GuardianService_CreateReviewCampaign_Handler,
+		},
+		{
+			MethodName: "CloseReviewCampaign",
+			Handler:    _GuardianService_CloseReviewCampaign_Handler,
+		},
+		{
+			MethodName: "GetReviewCampaignSummary",
+			Handler:    _GuardianService_GetReviewCampaignSummary_Handler,
+		},
+		{
+			MethodName: "ListReviewItems",
+			Handler:    _GuardianService_ListReviewItems_Handler,
+		},
+		{
+			MethodName: "ListUserReviewItems",
+			Handler:    _GuardianService_ListUserReviewItems_Handler,
+		},
+		{
+			MethodName: "DecideReviewItem",
+			Handler:    _GuardianService_DecideReviewItem_Handler,
+		},
+		{
+			MethodName: "ListSoDRules",
+			Handler:    _GuardianService_ListSoDRules_Handler,
+		},
+		{
+			MethodName: "GetSoDRule",
+			Handler:    _GuardianService_GetSoDRule_Handler,
+		},
+		{
+			MethodName: "CreateSoDRule",
+			Handler:    _GuardianService_CreateSoDRule_Handler,
+		},
+		{
+			MethodName: "UpdateSoDRule",
+			Handler:    _GuardianService_UpdateSoDRule_Handler,
+		},
+		{
+			MethodName: "DeleteSoDRule",
+			Handler:    _GuardianService_DeleteSoDRule_Handler,
+		},
+		{
+			MethodName: "ListSoDViolations",
+			Handler:    _GuardianService_ListSoDViolations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "raystack/guardian/v1beta1/guardian.proto",
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/guardian/core/provider"
 	"github.com/raystack/guardian/domain"
 	"github.com/raystack/salt/printer"
 	"github.com/raystack/salt/term"
@@ -26,6 +28,9 @@ func appealsCommand() *cobra.Command {
 			$ guardian appeal create
 			$ guardian appeal approve
 			$ guardian appeal reject
+			$ guardian appeal request-info
+			$ guardian appeal update
+			$ guardian appeal comment
 			$ guardian appeal list
 			$ guardian appeal status
 			$ guardian appeal cancel
@@ -36,6 +41,9 @@ func appealsCommand() *cobra.Command {
 	cmd.AddCommand(createAppealCommand())
 	cmd.AddCommand(approveApprovalStepCommand())
 	cmd.AddCommand(rejectApprovalStepCommand())
+	cmd.AddCommand(requestInfoApprovalStepCommand())
+	cmd.AddCommand(updateAppealCommand())
+	cmd.AddCommand(commentAppealCommand())
 	cmd.AddCommand(statusAppealCommand())
 	cmd.AddCommand(cancelAppealCommand())
 	cmd.AddCommand(addApproverCommand())
@@ -108,6 +116,8 @@ func listAppealsCommand() *cobra.Command {
 
 func createAppealCommand() *cobra.Command {
 	var accountID, accountType, resourceID, role, optionsDuration, description string
+	var startDate string
+	var breakGlass bool
 
 	cmd := &cobra.Command{
 		Use:   "create",
@@ -115,6 +125,7 @@ func createAppealCommand() *cobra.Command {
 		Example: heredoc.Doc(`
 			$ guardian appeal create
 			$ guardian appeal create --account=<account-id> --type=<account-type> --resource=<resource-id> --role=<role> --description="<description>"
+			$ guardian appeal create --account=<account-id> --type=<account-type> --resource=<resource-id> --role=<role> --description="<justification>" --break-glass
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			spinner := printer.Spin("")
@@ -124,6 +135,12 @@ func createAppealCommand() *cobra.Command {
 			if optionsDuration != "" {
 				options["duration"] = optionsDuration
 			}
+			if startDate != "" {
+				options["start_date"] = startDate
+			}
+			if breakGlass {
+				options["break_glass"] = true
+			}
 			optionsProto, err := structpb.NewStruct(options)
 			if err != nil {
 				return err
@@ -176,17 +193,22 @@ func createAppealCommand() *cobra.Command {
 
 	cmd.Flags().StringVar(&description, "description", "", "The description of the appeal")
 
+	cmd.Flags().StringVar(&startDate, "start-date", "", "Start time of the access in RFC3339 format, e.g. 2023-01-01T00:00:00Z")
+
+	cmd.Flags().BoolVar(&breakGlass, "break-glass", false, "Request emergency access that is granted immediately and reviewed afterwards")
+
 	return cmd
 }
 
 func approveApprovalStepCommand() *cobra.Command {
-	var approvalName string
+	var approvalName, role, optionsDuration string
 
 	cmd := &cobra.Command{
 		Use:   "approve",
 		Short: "Approve an approval step",
 		Example: heredoc.Doc(`
 		$ guardian appeal approve <appeal-id> --step=<step-name>
+		$ guardian appeal approve <appeal-id> --step=<step-name> --role=<role> --duration=<duration>
 	`),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -204,7 +226,9 @@ func approveApprovalStepCommand() *cobra.Command {
 				Id:           id,
 				ApprovalName: approvalName,
 				Action: &guardianv1beta1.UpdateApprovalRequest_Action{
-					Action: "approve",
+					Action:   "approve",
+					Role:     role,
+					Duration: optionsDuration,
 				},
 			})
 			if err != nil {
@@ -221,6 +245,8 @@ func approveApprovalStepCommand() *cobra.Command {
 
 	cmd.Flags().StringVarP(&approvalName, "step", "s", "", "Name of approval step")
 	cmd.MarkFlagRequired("approval-name")
+	cmd.Flags().StringVarP(&role, "role", "r", "", "Approve a different role than requested")
+	cmd.Flags().StringVarP(&optionsDuration, "duration", "d", "", "Approve a shorter access than requested")
 
 	return cmd
 }
@@ -271,6 +297,186 @@ func rejectApprovalStepCommand() *cobra.Command {
 	return cmd
 }
 
+func requestInfoApprovalStepCommand() *cobra.Command {
+	var approvalName, question string
+
+	cmd := &cobra.Command{
+		Use:   "request-info",
+		Short: "Ask the appeal creator for more information before deciding on an approval step",
+		Example: heredoc.Doc(`
+		$ guardian appeal request-info <appeal-id> --step=<step-name> --question="<question>"
+	`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id := args[0]
+			_, err = client.UpdateApproval(createCtx(cmd), &guardianv1beta1.UpdateApprovalRequest{
+				Id:           id,
+				ApprovalName: approvalName,
+				Action: &guardianv1beta1.UpdateApprovalRequest_Action{
+					Action: "request_info",
+					Reason: question,
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+
+			fmt.Printf("appeal with id %v is waiting for more information from the creator", id)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&approvalName, "step", "s", "", "Name of approval step")
+	cmd.MarkFlagRequired("step")
+	cmd.Flags().StringVarP(&question, "question", "q", "", "Question to the appeal creator")
+	cmd.MarkFlagRequired("question")
+
+	return cmd
+}
+
+func updateAppealCommand() *cobra.Command {
+	var role, optionsDuration, description string
+	var answers map[string]string
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update a pending appeal, the approval steps are evaluated again",
+		Example: heredoc.Doc(`
+		$ guardian appeal update <appeal-id> --description="<description>"
+		$ guardian appeal update <appeal-id> --role=<role> --duration=<duration>
+		$ guardian appeal update <appeal-id> --answer=<question-key>=<answer>
+	`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			details := map[string]interface{}{}
+			if len(answers) > 0 {
+				questions := map[string]interface{}{}
+				for k, v := range answers {
+					questions[k] = v
+				}
+				details[provider.ReservedDetailsKeyPolicyQuestions] = questions
+			}
+			detailsProto, err := structpb.NewStruct(details)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id := args[0]
+			res, err := client.UpdateAppeal(createCtx(cmd), &guardianv1beta1.UpdateAppealRequest{
+				Id:          id,
+				Description: description,
+				Role:        role,
+				Duration:    optionsDuration,
+				Details:     detailsProto,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+
+			printer.Successf("appeal with id %v updated, status: %v", id, res.GetAppeal().GetStatus())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&role, "role", "r", "", "New role to be assigned")
+	cmd.Flags().StringVarP(&optionsDuration, "duration", "d", "", "New duration of the access")
+	cmd.Flags().StringVar(&description, "description", "", "New description of the appeal")
+	cmd.Flags().StringToStringVar(&answers, "answer", nil, "Answers to the policy questions, replacing the previous answers")
+
+	return cmd
+}
+
+func commentAppealCommand() *cobra.Command {
+	var message string
+
+	cmd := &cobra.Command{
+		Use:   "comment",
+		Short: "Comment on an appeal or show its comments",
+		Example: heredoc.Doc(`
+		$ guardian appeal comment <appeal-id>
+		$ guardian appeal comment <appeal-id> --message="<message>"
+	`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id := args[0]
+			if message != "" {
+				res, err := client.CreateAppealComment(createCtx(cmd), &guardianv1beta1.CreateAppealCommentRequest{
+					AppealId: id,
+					Body:     message,
+				})
+				if err != nil {
+					return err
+				}
+
+				spinner.Stop()
+
+				printer.Successf("comment posted on appeal with id %v, comment id: %v", id, res.GetComment().GetId())
+				return nil
+			}
+
+			res, err := client.ListAppealComments(createCtx(cmd), &guardianv1beta1.ListAppealCommentsRequest{
+				AppealId: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			comments := res.GetComments()
+			spinner.Stop()
+
+			fmt.Printf(" \nShowing %d comments\n \n", len(comments))
+
+			report := [][]string{}
+			report = append(report, []string{"CREATED AT", "CREATED BY", "COMMENT"})
+			for _, c := range comments {
+				report = append(report, []string{
+					c.GetCreatedAt().AsTime().Format(time.RFC822),
+					term.Greenf("%v", c.GetCreatedBy()),
+					c.GetBody(),
+				})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&message, "message", "m", "", "Comment to post, shows the comments of the appeal if empty")
+
+	return cmd
+}
+
 func statusAppealCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "status",
@@ -319,6 +525,17 @@ func statusAppealCommand() *cobra.Command {
 					} else if status == domain.ApprovalStatusRejected {
 						status = fmt.Sprintf("rejected by %s", actor)
 					}
+				} else if a.GetMinApprovals() > 1 {
+					var approvedBy []string
+					for _, d := range a.GetDecisions() {
+						if d.GetAction() == domain.AppealActionNameApprove {
+							approvedBy = append(approvedBy, d.GetActor())
+						}
+					}
+					status = fmt.Sprintf("%s (%d of %d approvals)", status, len(approvedBy), a.GetMinApprovals())
+					if len(approvedBy) > 0 {
+						status = fmt.Sprintf("%s approved by %s", status, strings.Join(approvedBy, " "))
+					}
 				}
 
 				report = append(report, []string{
@@ -0,0 +1,372 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	handlerv1beta1 "github.com/raystack/guardian/api/handler/v1beta1"
+	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/printer"
+	"github.com/raystack/salt/term"
+	"github.com/spf13/cobra"
+)
+
+func bundlesCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bundle",
+		Aliases: []string{"bundles"},
+		Short:   "Manage access request bundles",
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Example: heredoc.Doc(`
+			$ guardian bundle list
+			$ guardian bundle view
+			$ guardian bundle create
+			$ guardian bundle edit
+			$ guardian bundle delete
+			$ guardian bundle request
+			$ guardian bundle status
+		`),
+	}
+
+	cmd.AddCommand(listBundlesCommand())
+	cmd.AddCommand(viewBundleCommand(adapter))
+	cmd.AddCommand(createBundleCommand(adapter))
+	cmd.AddCommand(editBundleCommand(adapter))
+	cmd.AddCommand(deleteBundleCommand())
+	cmd.AddCommand(requestBundleCommand())
+	cmd.AddCommand(bundleStatusCommand())
+	bindFlagsFromConfig(cmd)
+
+	return cmd
+}
+
+func listBundlesCommand() *cobra.Command {
+	var names []string
+	var createdBy string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List and filter access request bundles",
+		Example: heredoc.Doc(`
+			$ guardian bundle list
+			$ guardian bundle list --names=new-joiner --created-by=john.doe@example.com
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ListBundles(createCtx(cmd), &guardianv1beta1.ListBundlesRequest{
+				Names:     names,
+				CreatedBy: createdBy,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			report := [][]string{}
+			report = append(report, []string{"ID", "NAME", "ITEMS", "REQUIRED POLICY", "CREATED BY"})
+			for _, b := range res.GetBundles() {
+				policy := "-"
+				if b.GetRequiredPolicy() != nil {
+					policy = fmt.Sprintf("%s@%d", b.GetRequiredPolicy().GetId(), b.GetRequiredPolicy().GetVersion())
+				}
+				report = append(report, []string{
+					term.Greenf("%v", b.GetId()),
+					b.GetName(),
+					fmt.Sprintf("%d", len(b.GetItems())),
+					policy,
+					b.GetCreatedBy(),
+				})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&names, "names", nil, "Filter by bundle name")
+	cmd.Flags().StringVar(&createdBy, "created-by", "", "Filter by creator")
+
+	return cmd
+}
+
+func viewBundleCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "Get access request bundle details",
+		Example: heredoc.Doc(`
+			$ guardian bundle view <bundle-id>
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.GetBundle(createCtx(cmd), &guardianv1beta1.GetBundleRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			b := adapter.FromBundleProto(res.GetBundle())
+			if err := printer.File(b, format); err != nil {
+				return fmt.Errorf("failed to format bundle result: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "output", "o", "yaml", "Print output with the selected format")
+
+	return cmd
+}
+
+func createBundleCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
+	var filePath string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new access request bundle",
+		Long: heredoc.Doc(`
+			Create a new access request bundle from a file.
+		`),
+		Example: heredoc.Doc(`
+			$ guardian bundle create --file=<file-path>
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			var bundle domain.Bundle
+			if err := parseFile(filePath, &bundle); err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.CreateBundle(createCtx(cmd), &guardianv1beta1.CreateBundleRequest{
+				Bundle: adapter.ToBundleProto(&bundle),
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("bundle created with id: %v", res.GetBundle().GetId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the bundle config")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func editBundleCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
+	var filePath string
+
+	cmd := &cobra.Command{
+		Use:   "edit",
+		Short: "Edit an access request bundle",
+		Example: heredoc.Doc(`
+			$ guardian bundle edit <bundle-id> --file=<file-path>
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			var bundle domain.Bundle
+			if err := parseFile(filePath, &bundle); err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id := args[0]
+			if _, err := client.UpdateBundle(createCtx(cmd), &guardianv1beta1.UpdateBundleRequest{
+				Id:     id,
+				Bundle: adapter.ToBundleProto(&bundle),
+			}); err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("bundle with id %q updated successfully", id)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the bundle config")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func deleteBundleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete an access request bundle",
+		Example: heredoc.Doc(`
+			$ guardian bundle delete <bundle-id>
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id := args[0]
+			if _, err := client.DeleteBundle(createCtx(cmd), &guardianv1beta1.DeleteBundleRequest{
+				Id: id,
+			}); err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("bundle with id %q deleted successfully", id)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func requestBundleCommand() *cobra.Command {
+	var accountID, accountType, description string
+
+	cmd := &cobra.Command{
+		Use:   "request",
+		Short: "Create an appeal for every item of a bundle",
+		Example: heredoc.Doc(`
+			$ guardian bundle request <bundle-id> --account=<account-id> --type=<account-type> --description="<description>"
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.CreateAppeal(createCtx(cmd), &guardianv1beta1.CreateAppealRequest{
+				AccountId:   accountID,
+				AccountType: accountType,
+				Description: description,
+				BundleId:    args[0],
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			report := [][]string{}
+			report = append(report, []string{"APPEAL ID", "RESOURCE ID", "ROLE", "STATUS"})
+			for _, a := range res.GetAppeals() {
+				report = append(report, []string{
+					term.Greenf("%v", a.GetId()),
+					a.GetResourceId(),
+					a.GetRole(),
+					a.GetStatus(),
+				})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Email of the account to appeal")
+	cmd.MarkFlagRequired("account")
+	cmd.Flags().StringVarP(&accountType, "type", "t", "", "Type of the account")
+	cmd.MarkFlagRequired("type")
+	cmd.Flags().StringVar(&description, "description", "", "The description of the appeals")
+
+	return cmd
+}
+
+func bundleStatusCommand() *cobra.Command {
+	var accountID string
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the status of the appeals created for a bundle",
+		Example: heredoc.Doc(`
+			$ guardian bundle status <bundle-id>
+			$ guardian bundle status <bundle-id> --account=<account-id>
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.GetBundleStatus(createCtx(cmd), &guardianv1beta1.GetBundleStatusRequest{
+				Id:        args[0],
+				AccountId: accountID,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("status: %s\n\n", res.GetStatus())
+			report := [][]string{}
+			report = append(report, []string{"APPEAL ID", "RESOURCE ID", "ROLE", "STATUS"})
+			for _, a := range res.GetAppeals() {
+				report = append(report, []string{
+					term.Greenf("%v", a.GetId()),
+					a.GetResourceId(),
+					a.GetRole(),
+					a.GetStatus(),
+				})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Account to show the bundle status for, defaults to the current user")
+
+	return cmd
+}
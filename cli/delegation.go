@@ -0,0 +1,324 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	handlerv1beta1 "github.com/raystack/guardian/api/handler/v1beta1"
+	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/salt/printer"
+	"github.com/raystack/salt/term"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func delegationsCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delegation",
+		Aliases: []string{"delegations"},
+		Short:   "Manage approval delegations",
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Example: heredoc.Doc(`
+			$ guardian delegation list
+			$ guardian delegation view
+			$ guardian delegation create
+			$ guardian delegation edit
+			$ guardian delegation delete
+		`),
+	}
+
+	cmd.AddCommand(listDelegationsCommand())
+	cmd.AddCommand(viewDelegationCommand(adapter))
+	cmd.AddCommand(createDelegationCommand())
+	cmd.AddCommand(editDelegationCommand())
+	cmd.AddCommand(deleteDelegationCommand())
+	bindFlagsFromConfig(cmd)
+
+	return cmd
+}
+
+func listDelegationsCommand() *cobra.Command {
+	var delegators, delegates []string
+	var activeOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List and filter approval delegations",
+		Example: heredoc.Doc(`
+			$ guardian delegation list
+			$ guardian delegation list --delegators=john.doe@example.com --active
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ListDelegations(createCtx(cmd), &guardianv1beta1.ListDelegationsRequest{
+				Delegators: delegators,
+				Delegates:  delegates,
+				ActiveOnly: activeOnly,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			report := [][]string{}
+			report = append(report, []string{"ID", "DELEGATOR", "DELEGATE", "START DATE", "END DATE", "SCOPE"})
+			for _, d := range res.GetDelegations() {
+				report = append(report, []string{
+					term.Greenf("%v", d.GetId()),
+					d.GetDelegator(),
+					d.GetDelegate(),
+					d.GetStartDate().AsTime().Format(time.RFC3339),
+					d.GetEndDate().AsTime().Format(time.RFC3339),
+					delegationScope(d),
+				})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&delegators, "delegators", nil, "Filter by delegator")
+	cmd.Flags().StringArrayVar(&delegates, "delegates", nil, "Filter by delegate")
+	cmd.Flags().BoolVar(&activeOnly, "active", false, "Only show delegations that are currently in effect")
+
+	return cmd
+}
+
+func viewDelegationCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "Get approval delegation details",
+		Example: heredoc.Doc(`
+			$ guardian delegation view <delegation-id>
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.GetDelegation(createCtx(cmd), &guardianv1beta1.GetDelegationRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			d := adapter.FromDelegationProto(res.GetDelegation())
+			if err := printer.File(d, format); err != nil {
+				return fmt.Errorf("failed to format delegation result: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "output", "o", "yaml", "Print output with the selected format")
+
+	return cmd
+}
+
+type delegationFlags struct {
+	delegate     string
+	startDate    string
+	endDate      string
+	policyID     string
+	providerType string
+	providerURN  string
+	reason       string
+}
+
+func (f *delegationFlags) toProto() (*guardianv1beta1.Delegation, error) {
+	d := &guardianv1beta1.Delegation{
+		Delegate:     f.delegate,
+		PolicyId:     f.policyID,
+		ProviderType: f.providerType,
+		ProviderUrn:  f.providerURN,
+		Reason:       f.reason,
+	}
+	if f.startDate != "" {
+		t, err := time.Parse(time.RFC3339, f.startDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start date: %w", err)
+		}
+		d.StartDate = timestamppb.New(t)
+	}
+	if f.endDate != "" {
+		t, err := time.Parse(time.RFC3339, f.endDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end date: %w", err)
+		}
+		d.EndDate = timestamppb.New(t)
+	}
+	return d, nil
+}
+
+func (f *delegationFlags) bind(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.startDate, "start", "", "Start of the delegation window in RFC3339 format, defaults to now")
+	cmd.Flags().StringVar(&f.endDate, "end", "", "End of the delegation window in RFC3339 format")
+	cmd.Flags().StringVar(&f.policyID, "policy-id", "", "Only delegate approvals of appeals under this policy")
+	cmd.Flags().StringVar(&f.providerType, "provider-type", "", "Only delegate approvals of appeals for this provider type")
+	cmd.Flags().StringVar(&f.providerURN, "provider-urn", "", "Only delegate approvals of appeals for this provider urn")
+	cmd.Flags().StringVarP(&f.reason, "reason", "r", "", "Reason of the delegation")
+}
+
+func createDelegationCommand() *cobra.Command {
+	var flags delegationFlags
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Delegate your approvals to another user",
+		Example: heredoc.Doc(`
+			$ guardian delegation create --delegate=jane.doe@example.com --end=2023-01-08T00:00:00Z
+			$ guardian delegation create --delegate=jane.doe@example.com --start=2023-01-01T00:00:00Z --end=2023-01-08T00:00:00Z --provider-type=bigquery --reason="On vacation"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			d, err := flags.toProto()
+			if err != nil {
+				return err
+			}
+
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.CreateDelegation(createCtx(cmd), &guardianv1beta1.CreateDelegationRequest{
+				Delegation: d,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("delegation created with id: %v", res.GetDelegation().GetId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&flags.delegate, "delegate", "d", "", "Email of the user receiving the approvals")
+	flags.bind(cmd)
+	cmd.MarkFlagRequired("delegate")
+	cmd.MarkFlagRequired("end")
+
+	return cmd
+}
+
+func editDelegationCommand() *cobra.Command {
+	var flags delegationFlags
+
+	cmd := &cobra.Command{
+		Use:   "edit",
+		Short: "Edit window, scope or reason of an approval delegation",
+		Example: heredoc.Doc(`
+			$ guardian delegation edit <delegation-id> --start=2023-01-01T00:00:00Z --end=2023-01-10T00:00:00Z
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			d, err := flags.toProto()
+			if err != nil {
+				return err
+			}
+
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id := args[0]
+			if _, err := client.UpdateDelegation(createCtx(cmd), &guardianv1beta1.UpdateDelegationRequest{
+				Id:         id,
+				Delegation: d,
+			}); err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("delegation with id %q updated successfully", id)
+			return nil
+		},
+	}
+
+	flags.bind(cmd)
+	cmd.MarkFlagRequired("start")
+	cmd.MarkFlagRequired("end")
+
+	return cmd
+}
+
+func deleteDelegationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete an approval delegation",
+		Example: heredoc.Doc(`
+			$ guardian delegation delete <delegation-id>
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id := args[0]
+			if _, err := client.DeleteDelegation(createCtx(cmd), &guardianv1beta1.DeleteDelegationRequest{
+				Id: id,
+			}); err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("delegation with id %q deleted successfully", id)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func delegationScope(d *guardianv1beta1.Delegation) string {
+	scope := ""
+	if d.GetPolicyId() != "" {
+		scope += fmt.Sprintf("policy:%s ", d.GetPolicyId())
+	}
+	if d.GetProviderType() != "" {
+		scope += fmt.Sprintf("provider_type:%s ", d.GetProviderType())
+	}
+	if d.GetProviderUrn() != "" {
+		scope += fmt.Sprintf("provider_urn:%s ", d.GetProviderUrn())
+	}
+	if scope == "" {
+		return "*"
+	}
+	return scope[:len(scope)-1]
+}
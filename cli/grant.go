@@ -25,6 +25,8 @@ func grantsCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
 			$ guardian grant view
 			$ guardian grant revoke
 			$ guardian grant bulk-revoke
+			$ guardian grant recommendations
+			$ guardian grant downgrade
 		`),
 	}
 
@@ -32,6 +34,8 @@ func grantsCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
 	cmd.AddCommand(viewGrantCommand(adapter))
 	cmd.AddCommand(revokeGrantCommand())
 	cmd.AddCommand(bulkRevokeGrantCommand())
+	cmd.AddCommand(listGrantRecommendationsCommand())
+	cmd.AddCommand(downgradeGrantCommand())
 	bindFlagsFromConfig(cmd)
 
 	return cmd
@@ -261,3 +265,116 @@ func bulkRevokeGrantCommand() *cobra.Command {
 	cmd.MarkFlagRequired("reason")
 	return cmd
 }
+
+func listGrantRecommendationsCommand() *cobra.Command {
+	var providerID, period string
+	var accountIDs, resourceIDs []string
+
+	cmd := &cobra.Command{
+		Use:   "recommendations",
+		Short: "List least-privilege recommendations for the active grants of a provider",
+		Example: heredoc.Doc(`
+			$ guardian grant recommendations --provider=<provider-id>
+			$ guardian grant recommendations --provider=<provider-id> --period=2160h --account-ids=<account-id>
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ListGrantRecommendations(createCtx(cmd), &guardianv1beta1.ListGrantRecommendationsRequest{
+				ProviderId:  providerID,
+				Period:      period,
+				AccountIds:  accountIDs,
+				ResourceIds: resourceIDs,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			report := [][]string{}
+			report = append(report, []string{"GRANT ID", "ACCOUNT ID", "RESOURCE ID", "ROLE", "RECOMMENDATION", "REASON"})
+			for _, r := range res.GetRecommendations() {
+				recommendation := r.GetType()
+				if r.GetRecommendedRole() != "" {
+					recommendation = fmt.Sprintf("%s to %s", r.GetType(), r.GetRecommendedRole())
+				}
+				report = append(report, []string{
+					term.Greenf("%v", r.GetGrant().GetId()),
+					r.GetGrant().GetAccountId(),
+					r.GetGrant().GetResourceId(),
+					r.GetGrant().GetRole(),
+					recommendation,
+					r.GetReason(),
+				})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&providerID, "provider", "p", "", "ID of the provider")
+	cmd.Flags().StringVar(&period, "period", "720h", "Period of the activities to look back on")
+	cmd.Flags().StringArrayVar(&accountIDs, "account-ids", nil, "Filter by account id")
+	cmd.Flags().StringArrayVar(&resourceIDs, "resource-ids", nil, "Filter by resource id")
+	cmd.MarkFlagRequired("provider")
+
+	return cmd
+}
+
+func downgradeGrantCommand() *cobra.Command {
+	var role, duration, description string
+
+	cmd := &cobra.Command{
+		Use:   "downgrade",
+		Short: "Request a narrower role in place of an active grant",
+		Long: heredoc.Doc(`
+			Request a narrower role on the resource of an active grant owned by the user.
+			The grant is revoked once the appeal for the narrower role is approved.
+		`),
+		Example: heredoc.Doc(`
+			$ guardian grant downgrade <grant-id> --role=<role>
+			$ guardian grant downgrade <grant-id> --role=<role> --duration=720h
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id := args[0]
+			res, err := client.DowngradeGrant(createCtx(cmd), &guardianv1beta1.DowngradeGrantRequest{
+				Id:          id,
+				Role:        role,
+				Duration:    duration,
+				Description: description,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+
+			fmt.Printf("appeal with id %q created to downgrade grant %q", res.GetAppeal().GetId(), id)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&role, "role", "R", "", "Narrower role to request")
+	cmd.Flags().StringVarP(&duration, "duration", "d", "", "Duration of the access, e.g. 720h. Empty requests a permanent access")
+	cmd.Flags().StringVar(&description, "description", "", "Description of the appeal")
+	cmd.MarkFlagRequired("role")
+
+	return cmd
+}
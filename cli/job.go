@@ -44,6 +44,13 @@ func runJobCmd() *cobra.Command {
 			$ guardian job run revoke_expired_grants
 			$ guardian job run revoke_grants_by_user_criteria
 			$ guardian job run grant_dormancy_check
+			$ guardian job run approval_step_timeout
+			$ guardian job run expire_pending_appeals
+			$ guardian job run break_glass_review_reminder
+			$ guardian job run activate_scheduled_grants
+			$ guardian job run request_external_approvals
+			$ guardian job run grant_drift_check
+			$ guardian job run grant_recommendation_notification
 		`),
 		Args: cobra.ExactValidArgs(1),
 		ValidArgs: []string{
@@ -52,6 +59,13 @@ func runJobCmd() *cobra.Command {
 			string(jobs.TypeRevokeExpiredGrants),
 			string(jobs.TypeRevokeGrantsByUserCriteria),
 			string(jobs.TypeGrantDormancyCheck),
+			string(jobs.TypeApprovalStepTimeout),
+			string(jobs.TypeExpirePendingAppeals),
+			string(jobs.TypeBreakGlassReviewReminder),
+			string(jobs.TypeActivateScheduledGrants),
+			string(jobs.TypeRequestExternalApprovals),
+			string(jobs.TypeGrantDriftCheck),
+			string(jobs.TypeGrantRecommendationNotification),
 
 			string(jobs.TypeRevokeExpiredAccess),
 			string(jobs.TypeExpiringAccessNotification),
@@ -89,6 +103,7 @@ func runJobCmd() *cobra.Command {
 				logger,
 				services.GrantService,
 				services.ProviderService,
+				services.AppealService,
 				notifier,
 				crypto,
 				validator,
@@ -118,6 +133,34 @@ func runJobCmd() *cobra.Command {
 					handler: handler.GrantDormancyCheck,
 					config:  config.Jobs.GrantDormancyCheck.Config,
 				},
+				jobs.TypeApprovalStepTimeout: {
+					handler: handler.ApprovalStepTimeout,
+					config:  config.Jobs.ApprovalStepTimeout.Config,
+				},
+				jobs.TypeExpirePendingAppeals: {
+					handler: handler.ExpirePendingAppeals,
+					config:  config.Jobs.ExpirePendingAppeals.Config,
+				},
+				jobs.TypeBreakGlassReviewReminder: {
+					handler: handler.BreakGlassReviewReminder,
+					config:  config.Jobs.BreakGlassReviewReminder.Config,
+				},
+				jobs.TypeActivateScheduledGrants: {
+					handler: handler.ActivateScheduledGrants,
+					config:  config.Jobs.ActivateScheduledGrants.Config,
+				},
+				jobs.TypeRequestExternalApprovals: {
+					handler: handler.RequestExternalApprovals,
+					config:  config.Jobs.RequestExternalApprovals.Config,
+				},
+				jobs.TypeGrantDriftCheck: {
+					handler: handler.GrantDriftCheck,
+					config:  config.Jobs.GrantDriftCheck.Config,
+				},
+				jobs.TypeGrantRecommendationNotification: {
+					handler: handler.GrantRecommendationNotification,
+					config:  config.Jobs.GrantRecommendationNotification.Config,
+				},
 
 				// deprecated job names
 				jobs.TypeExpiringAccessNotification: {
@@ -17,6 +17,7 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 	"gopkg.in/yaml.v3"
 )
 
@@ -36,6 +37,8 @@ func PolicyCmd(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
 			$ guardian policy list
 			$ guardian policy view
 			$ guardian policy init	
+			$ guardian policy simulate
+			$ guardian policy test
 		`),
 		Annotations: map[string]string{
 			"group": "core",
@@ -47,6 +50,8 @@ func PolicyCmd(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
 	cmd.AddCommand(createPolicyCmd(adapter))
 	cmd.AddCommand(updatePolicyCmd(adapter))
 	cmd.AddCommand(planPolicyCmd(adapter))
+	cmd.AddCommand(simulatePolicyCmd(adapter))
+	cmd.AddCommand(testPolicyCmd())
 	cmd.AddCommand(applyPolicyCmd(adapter))
 	cmd.AddCommand(initPolicyCmd())
 	bindFlagsFromConfig(cmd)
@@ -498,6 +503,159 @@ func planPolicyCmd(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
 	return cmd
 }
 
+func simulatePolicyCmd(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
+	var filePath, policyFlag, role, accountID, accountType, createdBy, duration string
+	var resource domain.ResourceIdentifier
+	var creator, details map[string]string
+
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate a policy against a hypothetical appeal",
+		Long: heredoc.Doc(`
+			Simulate a policy against a hypothetical appeal. Shows which approval steps would be skipped,
+			auto-approved, rejected or left pending, and who the approvers would be. Nothing is created.
+
+			The policy is taken from --file, or from --policy for an existing policy. If neither is given, the
+			policy configured for the resource in the provider is used.
+		`),
+		Example: heredoc.Doc(`
+			$ guardian policy simulate --file=<file-path> --resource-id=<resource-id> --role=viewer
+			$ guardian policy simulate --policy=<policy-id>@<version> --resource-id=<resource-id> --role=viewer --creator=department=finance
+			$ guardian policy simulate --provider-type=bigquery --provider-urn=my-project --resource-type=dataset --resource-urn=my-project:dataset --role=viewer
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			req := &guardianv1beta1.SimulateAppealRequest{
+				Resource: &guardianv1beta1.SimulateAppealRequest_ResourceIdentifier{
+					ProviderType: resource.ProviderType,
+					ProviderUrn:  resource.ProviderURN,
+					Type:         resource.Type,
+					Urn:          resource.URN,
+					Id:           resource.ID,
+				},
+				Role:        role,
+				AccountId:   accountID,
+				AccountType: accountType,
+				CreatedBy:   createdBy,
+			}
+
+			if filePath != "" {
+				var policy domain.Policy
+				if err := parseFile(filePath, &policy); err != nil {
+					return err
+				}
+				policyProto, err := adapter.ToPolicyProto(&policy)
+				if err != nil {
+					return err
+				}
+				req.Policy = policyProto
+			} else if policyFlag != "" {
+				policyID := strings.Split(policyFlag, "@")
+				version, err := getVersion("", policyID)
+				if err != nil {
+					return err
+				}
+				req.PolicyId = policyID[0]
+				req.PolicyVersion = uint32(version)
+			}
+
+			if duration != "" {
+				req.Options = &guardianv1beta1.AppealOptions{Duration: duration}
+			}
+			if len(creator) > 0 {
+				creatorValue, err := structpb.NewValue(toInterfaceMap(creator))
+				if err != nil {
+					return fmt.Errorf("invalid creator attributes: %w", err)
+				}
+				req.Creator = creatorValue
+			}
+			if len(details) > 0 {
+				detailsValue, err := structpb.NewStruct(toInterfaceMap(details))
+				if err != nil {
+					return fmt.Errorf("invalid appeal details: %w", err)
+				}
+				req.Details = detailsValue
+			}
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.SimulateAppeal(createCtx(cmd), req)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			appeal := res.GetSimulation().GetAppeal()
+			fmt.Printf("policy: %s@%d\nappeal status: %s\n\n", appeal.GetPolicyId(), appeal.GetPolicyVersion(), appeal.GetStatus())
+
+			report := [][]string{}
+			report = append(report, []string{"STEP", "STATUS", "APPROVERS", "REASON"})
+			for _, approval := range appeal.GetApprovals() {
+				report = append(report, []string{
+					approval.GetName(),
+					approval.GetStatus(),
+					strings.Join(approval.GetApprovers(), ", "),
+					approval.GetReason(),
+				})
+			}
+			printer.Table(os.Stdout, report)
+
+			if requirements := res.GetSimulation().GetMatchedRequirements(); len(requirements) > 0 {
+				fmt.Println("\nadditional appeals created once approved:")
+				report = [][]string{}
+				report = append(report, []string{"RESOURCE", "ROLE"})
+				for _, r := range requirements {
+					for _, aa := range r.GetAppeals() {
+						target := aa.GetResource().GetId()
+						if target == "" {
+							target = fmt.Sprintf("%s/%s/%s/%s", aa.GetResource().GetProviderType(), aa.GetResource().GetProviderUrn(), aa.GetResource().GetType(), aa.GetResource().GetUrn())
+						}
+						report = append(report, []string{target, aa.GetRole()})
+					}
+				}
+				printer.Table(os.Stdout, report)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the policy config to simulate")
+	cmd.Flags().StringVarP(&policyFlag, "policy", "p", "", "Existing policy to simulate, in <policy-id>@<version> format")
+	cmd.Flags().StringVar(&resource.ID, "resource-id", "", "ID of the resource to request")
+	cmd.Flags().StringVar(&resource.ProviderType, "provider-type", "", "Provider type of the resource to request")
+	cmd.Flags().StringVar(&resource.ProviderURN, "provider-urn", "", "Provider URN of the resource to request")
+	cmd.Flags().StringVar(&resource.Type, "resource-type", "", "Type of the resource to request")
+	cmd.Flags().StringVar(&resource.URN, "resource-urn", "", "URN of the resource to request")
+	cmd.Flags().StringVarP(&role, "role", "r", "", "Role to request")
+	cmd.MarkFlagRequired("role")
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Account to request the access for, defaults to the creator")
+	cmd.Flags().StringVarP(&accountType, "type", "t", "", "Type of the account")
+	cmd.Flags().StringVar(&createdBy, "created-by", "", "Email of the appeal creator, defaults to the current user")
+	cmd.Flags().StringVarP(&duration, "duration", "d", "", "Duration of the access")
+	cmd.Flags().StringToStringVar(&creator, "creator", nil, "Creator attributes used by the policy, e.g. --creator=department=finance. If empty, they are fetched using the policy IAM config")
+	cmd.Flags().StringToStringVar(&details, "details", nil, "Details of the appeal")
+	cmd.MarkFlagsMutuallyExclusive("file", "policy")
+
+	return cmd
+}
+
+func toInterfaceMap(m map[string]string) map[string]interface{} {
+	result := map[string]interface{}{}
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
 func getVersion(versionFlag string, policyId []string) (uint64, error) {
 	if versionFlag != "" {
 		ver, err := strconv.ParseUint(versionFlag, 10, 32)
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/raystack/guardian/core/policy"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/term"
+	"github.com/spf13/cobra"
+)
+
+func testPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test",
+		Short: "Run policy test suites locally",
+		Long: heredoc.Doc(`
+			Run policy test suites locally, without a Guardian server.
+
+			A test suite is a policy along with a list of appeal fixtures and their expected outcome: the final
+			appeal status, the status and approvers of each step, and the triggered requirements. The appeals are
+			evaluated with the same logic used by the server.
+		`),
+		Example: heredoc.Doc(`
+			$ guardian policy test ./policies/tests/*.yaml
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var passed, failed int
+			for _, filePath := range args {
+				suite, err := loadPolicyTestSuite(filePath)
+				if err != nil {
+					return fmt.Errorf("loading test suite %q: %w", filePath, err)
+				}
+
+				for _, result := range suite.Run() {
+					if result.Passed() {
+						passed++
+						fmt.Printf("%s %s: %s\n", term.Green("PASS"), filePath, result.Name)
+						continue
+					}
+
+					failed++
+					fmt.Printf("%s %s: %s\n", term.Red("FAIL"), filePath, result.Name)
+					if result.Error != nil {
+						fmt.Printf("    error: %v\n", result.Error)
+						continue
+					}
+					for _, f := range result.Failures {
+						fmt.Printf("    %s\n", f)
+					}
+					printPolicyTestOutcome(result.Simulation)
+				}
+			}
+
+			fmt.Printf("\n%d passed, %d failed\n", passed, failed)
+			if failed > 0 {
+				return fmt.Errorf("%d policy test(s) failed", failed)
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func loadPolicyTestSuite(filePath string) (*policy.TestSuite, error) {
+	var suite policy.TestSuite
+	if err := parseFile(filePath, &suite); err != nil {
+		return nil, err
+	}
+
+	if suite.Policy == nil && suite.PolicyFile != "" {
+		var p domain.Policy
+		if err := parseFile(filepath.Join(filepath.Dir(filePath), suite.PolicyFile), &p); err != nil {
+			return nil, fmt.Errorf("loading policy: %w", err)
+		}
+		suite.Policy = &p
+	}
+
+	return &suite, nil
+}
+
+func printPolicyTestOutcome(simulation *domain.AppealSimulation) {
+	fmt.Printf("    actual status: %s\n", simulation.Appeal.Status)
+	for _, approval := range simulation.Appeal.Approvals {
+		fmt.Printf("    actual steps.%s: %s %q\n", approval.Name, approval.Status, approval.Approvers)
+	}
+}
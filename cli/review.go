@@ -0,0 +1,417 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	handlerv1beta1 "github.com/raystack/guardian/api/handler/v1beta1"
+	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/printer"
+	"github.com/raystack/salt/term"
+	"github.com/spf13/cobra"
+)
+
+func reviewsCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "review",
+		Aliases: []string{"reviews"},
+		Short:   "Manage access review campaigns",
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Example: heredoc.Doc(`
+			$ guardian review list
+			$ guardian review view
+			$ guardian review create
+			$ guardian review close
+			$ guardian review items
+			$ guardian review decide
+			$ guardian review export
+		`),
+	}
+
+	cmd.AddCommand(listReviewCampaignsCommand())
+	cmd.AddCommand(viewReviewCampaignCommand(adapter))
+	cmd.AddCommand(createReviewCampaignCommand(adapter))
+	cmd.AddCommand(closeReviewCampaignCommand())
+	cmd.AddCommand(listReviewItemsCommand())
+	cmd.AddCommand(decideReviewItemCommand())
+	cmd.AddCommand(exportReviewCampaignCommand(adapter))
+	bindFlagsFromConfig(cmd)
+
+	return cmd
+}
+
+func listReviewCampaignsCommand() *cobra.Command {
+	var statuses []string
+	var createdBy string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List and filter access review campaigns",
+		Example: heredoc.Doc(`
+			$ guardian review list
+			$ guardian review list --status=active --created-by=john.doe@example.com
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ListReviewCampaigns(createCtx(cmd), &guardianv1beta1.ListReviewCampaignsRequest{
+				Statuses:  statuses,
+				CreatedBy: createdBy,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			report := [][]string{}
+			report = append(report, []string{"ID", "NAME", "REVIEWER STRATEGY", "DEFAULT ACTION", "STATUS", "CREATED BY"})
+			for _, c := range res.GetCampaigns() {
+				report = append(report, []string{
+					term.Greenf("%v", c.GetId()),
+					c.GetName(),
+					c.GetReviewerStrategy(),
+					c.GetDefaultAction(),
+					c.GetStatus(),
+					c.GetCreatedBy(),
+				})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&statuses, "status", "s", nil, "Filter by status(es)")
+	cmd.Flags().StringVar(&createdBy, "created-by", "", "Filter by creator")
+
+	return cmd
+}
+
+func viewReviewCampaignCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "Get access review campaign details",
+		Example: heredoc.Doc(`
+			$ guardian review view <campaign-id>
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.GetReviewCampaign(createCtx(cmd), &guardianv1beta1.GetReviewCampaignRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			c := adapter.FromReviewCampaignProto(res.GetCampaign())
+			if err := printer.File(c, format); err != nil {
+				return fmt.Errorf("failed to format review campaign result: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "output", "o", "yaml", "Print output with the selected format")
+
+	return cmd
+}
+
+func createReviewCampaignCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
+	var filePath string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Start a new access review campaign",
+		Long: heredoc.Doc(`
+			Start a new access review campaign from a file. All active grants matching the campaign scope are
+			assigned to reviewers based on the reviewer strategy.
+		`),
+		Example: heredoc.Doc(`
+			$ guardian review create --file=<file-path>
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			var campaign domain.ReviewCampaign
+			if err := parseFile(filePath, &campaign); err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.CreateReviewCampaign(createCtx(cmd), &guardianv1beta1.CreateReviewCampaignRequest{
+				Campaign: adapter.ToReviewCampaignProto(&campaign),
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("review campaign created with id: %v (%d items)", res.GetCampaign().GetId(), res.GetItemsCount())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the review campaign config")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func closeReviewCampaignCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close",
+		Short: "Close an access review campaign and revoke the grants decided to be revoked",
+		Example: heredoc.Doc(`
+			$ guardian review close <campaign-id>
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id := args[0]
+			if _, err := client.CloseReviewCampaign(createCtx(cmd), &guardianv1beta1.CloseReviewCampaignRequest{
+				Id: id,
+			}); err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("review campaign with id %q closed successfully", id)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func listReviewItemsCommand() *cobra.Command {
+	var reviewer string
+	var pendingOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "items",
+		Short: "List the grants to review",
+		Long: heredoc.Doc(`
+			List the grants to review in a campaign. Without a campaign id, lists the grants assigned to the
+			current user across all active campaigns.
+		`),
+		Example: heredoc.Doc(`
+			$ guardian review items
+			$ guardian review items <campaign-id> --reviewer=john.doe@example.com --pending
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			var items []*guardianv1beta1.ReviewItem
+			if len(args) == 0 {
+				res, err := client.ListUserReviewItems(createCtx(cmd), &guardianv1beta1.ListUserReviewItemsRequest{
+					PendingOnly: pendingOnly,
+				})
+				if err != nil {
+					return err
+				}
+				items = res.GetItems()
+			} else {
+				res, err := client.ListReviewItems(createCtx(cmd), &guardianv1beta1.ListReviewItemsRequest{
+					CampaignId:  args[0],
+					Reviewer:    reviewer,
+					PendingOnly: pendingOnly,
+				})
+				if err != nil {
+					return err
+				}
+				items = res.GetItems()
+			}
+			spinner.Stop()
+
+			report := [][]string{}
+			report = append(report, []string{"ID", "ACCOUNT", "RESOURCE", "ROLE", "DECISION"})
+			for _, i := range items {
+				decision := i.GetDecision()
+				if decision == "" {
+					decision = "-"
+				}
+				report = append(report, []string{
+					term.Greenf("%v", i.GetId()),
+					i.GetAccountId(),
+					i.GetResourceUrn(),
+					i.GetRole(),
+					decision,
+				})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&reviewer, "reviewer", "r", "", "Filter by reviewer")
+	cmd.Flags().BoolVar(&pendingOnly, "pending", false, "Only show grants that are not reviewed yet")
+
+	return cmd
+}
+
+func decideReviewItemCommand() *cobra.Command {
+	var decision, reason string
+
+	cmd := &cobra.Command{
+		Use:   "decide",
+		Short: "Decide whether a grant should be kept or revoked",
+		Example: heredoc.Doc(`
+			$ guardian review decide <item-id> --decision=keep
+			$ guardian review decide <item-id> --decision=revoke --reason="no longer in the team"
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id := args[0]
+			if _, err := client.DecideReviewItem(createCtx(cmd), &guardianv1beta1.DecideReviewItemRequest{
+				Id:       id,
+				Decision: decision,
+				Reason:   reason,
+			}); err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("review item with id %q decided to %s", id, decision)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&decision, "decision", "d", "", "Decision of the review, either keep or revoke")
+	cmd.MarkFlagRequired("decision")
+	cmd.Flags().StringVar(&reason, "reason", "", "Reason of the decision")
+
+	return cmd
+}
+
+func exportReviewCampaignCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the summary of an access review campaign",
+		Example: heredoc.Doc(`
+			$ guardian review export <campaign-id>
+			$ guardian review export <campaign-id> --output=csv > review.csv
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.GetReviewCampaignSummary(createCtx(cmd), &guardianv1beta1.GetReviewCampaignSummaryRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			items := []*domain.ReviewItem{}
+			for _, i := range res.GetItems() {
+				items = append(items, adapter.FromReviewItemProto(i))
+			}
+			summary := domain.NewReviewCampaignSummary(adapter.FromReviewCampaignProto(res.GetCampaign()), items)
+
+			if format == "csv" {
+				return writeReviewItemsCSV(summary.Items)
+			}
+			if err := printer.File(summary, format); err != nil {
+				return fmt.Errorf("failed to format review campaign summary: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "output", "o", "yaml", "Print output with the selected format: yaml, json or csv")
+
+	return cmd
+}
+
+func writeReviewItemsCSV(items []*domain.ReviewItem) error {
+	w := csv.NewWriter(os.Stdout)
+	w.Write([]string{"grant_id", "account_id", "account_type", "provider_type", "resource_urn", "role", "reviewers", "decision", "reason", "decided_by", "decided_at", "defaulted"})
+	for _, i := range items {
+		var decidedAt string
+		if i.DecidedAt != nil {
+			decidedAt = i.DecidedAt.Format(time.RFC3339)
+		}
+		w.Write([]string{
+			i.GrantID,
+			i.AccountID,
+			i.AccountType,
+			i.ProviderType,
+			i.ResourceURN,
+			i.Role,
+			strings.Join(i.Reviewers, ";"),
+			string(i.Decision),
+			i.Reason,
+			i.DecidedBy,
+			decidedAt,
+			strconv.FormatBool(i.Defaulted),
+		})
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -50,6 +50,10 @@ func New(cfg *Config) *cobra.Command {
 	cmd.AddCommand(PolicyCmd(protoAdapter))
 	cmd.AddCommand(appealsCommand())
 	cmd.AddCommand(grantsCommand(protoAdapter))
+	cmd.AddCommand(delegationsCommand(protoAdapter))
+	cmd.AddCommand(bundlesCommand(protoAdapter))
+	cmd.AddCommand(reviewsCommand(protoAdapter))
+	cmd.AddCommand(sodCommand(protoAdapter))
 	cmd.AddCommand(ServerCommand())
 	cmd.AddCommand(JobCmd())
 	cmd.AddCommand(configCommand())
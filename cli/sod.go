@@ -0,0 +1,318 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	handlerv1beta1 "github.com/raystack/guardian/api/handler/v1beta1"
+	guardianv1beta1 "github.com/raystack/guardian/api/proto/raystack/guardian/v1beta1"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/printer"
+	"github.com/raystack/salt/term"
+	"github.com/spf13/cobra"
+)
+
+func sodCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sod",
+		Aliases: []string{"sod-rules"},
+		Short:   "Manage separation-of-duties rules",
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Example: heredoc.Doc(`
+			$ guardian sod list
+			$ guardian sod view
+			$ guardian sod create
+			$ guardian sod edit
+			$ guardian sod delete
+			$ guardian sod violations
+		`),
+	}
+
+	cmd.AddCommand(listSoDRulesCommand())
+	cmd.AddCommand(viewSoDRuleCommand(adapter))
+	cmd.AddCommand(createSoDRuleCommand(adapter))
+	cmd.AddCommand(editSoDRuleCommand(adapter))
+	cmd.AddCommand(deleteSoDRuleCommand())
+	cmd.AddCommand(listSoDViolationsCommand())
+	bindFlagsFromConfig(cmd)
+
+	return cmd
+}
+
+func listSoDRulesCommand() *cobra.Command {
+	var names []string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List and filter separation-of-duties rules",
+		Example: heredoc.Doc(`
+			$ guardian sod list
+			$ guardian sod list --names=finance-writer-vs-reporting-admin
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ListSoDRules(createCtx(cmd), &guardianv1beta1.ListSoDRulesRequest{
+				Names: names,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			report := [][]string{}
+			report = append(report, []string{"ID", "NAME", "SELECTORS", "ACTION", "CREATED BY"})
+			for _, r := range res.GetRules() {
+				report = append(report, []string{
+					term.Greenf("%v", r.GetId()),
+					r.GetName(),
+					fmt.Sprintf("%d", len(r.GetSelectors())),
+					r.GetAction(),
+					r.GetCreatedBy(),
+				})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&names, "names", nil, "Filter by rule name")
+
+	return cmd
+}
+
+func viewSoDRuleCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "Get separation-of-duties rule details",
+		Example: heredoc.Doc(`
+			$ guardian sod view <rule-id>
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.GetSoDRule(createCtx(cmd), &guardianv1beta1.GetSoDRuleRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			r := adapter.FromSoDRuleProto(res.GetRule())
+			if err := printer.File(r, format); err != nil {
+				return fmt.Errorf("failed to format rule result: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "output", "o", "yaml", "Print output with the selected format")
+
+	return cmd
+}
+
+func createSoDRuleCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
+	var filePath string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new separation-of-duties rule",
+		Long: heredoc.Doc(`
+			Create a new separation-of-duties rule from a file.
+		`),
+		Example: heredoc.Doc(`
+			$ guardian sod create --file=<file-path>
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			var rule domain.SoDRule
+			if err := parseFile(filePath, &rule); err != nil {
+				return err
+			}
+
+			ruleProto, err := adapter.ToSoDRuleProto(&rule)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.CreateSoDRule(createCtx(cmd), &guardianv1beta1.CreateSoDRuleRequest{
+				Rule: ruleProto,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("rule created with id: %v", res.GetRule().GetId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the rule config")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func editSoDRuleCommand(adapter handlerv1beta1.ProtoAdapter) *cobra.Command {
+	var filePath string
+
+	cmd := &cobra.Command{
+		Use:   "edit",
+		Short: "Edit a separation-of-duties rule",
+		Example: heredoc.Doc(`
+			$ guardian sod edit <rule-id> --file=<file-path>
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			var rule domain.SoDRule
+			if err := parseFile(filePath, &rule); err != nil {
+				return err
+			}
+
+			ruleProto, err := adapter.ToSoDRuleProto(&rule)
+			if err != nil {
+				return err
+			}
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id := args[0]
+			if _, err := client.UpdateSoDRule(createCtx(cmd), &guardianv1beta1.UpdateSoDRuleRequest{
+				Id:   id,
+				Rule: ruleProto,
+			}); err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("rule with id %q updated successfully", id)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the rule config")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func deleteSoDRuleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a separation-of-duties rule",
+		Example: heredoc.Doc(`
+			$ guardian sod delete <rule-id>
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id := args[0]
+			if _, err := client.DeleteSoDRule(createCtx(cmd), &guardianv1beta1.DeleteSoDRuleRequest{
+				Id: id,
+			}); err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("rule with id %q deleted successfully", id)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func listSoDViolationsCommand() *cobra.Command {
+	var ruleIDs, accountIDs []string
+
+	cmd := &cobra.Command{
+		Use:   "violations",
+		Short: "List accounts whose active grants already violate separation-of-duties rules",
+		Example: heredoc.Doc(`
+			$ guardian sod violations
+			$ guardian sod violations --rule-ids=<rule-id> --account-ids=john.doe@example.com
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ListSoDViolations(createCtx(cmd), &guardianv1beta1.ListSoDViolationsRequest{
+				RuleIds:    ruleIDs,
+				AccountIds: accountIDs,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			report := [][]string{}
+			report = append(report, []string{"ACCOUNT ID", "RULE", "ACTION", "GRANT IDS"})
+			for _, v := range res.GetViolations() {
+				report = append(report, []string{
+					term.Greenf("%v", v.GetAccountId()),
+					v.GetRule().GetName(),
+					v.GetRule().GetAction(),
+					strings.Join(v.GetGrantIds(), ";"),
+				})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&ruleIDs, "rule-ids", nil, "Filter by rule ID")
+	cmd.Flags().StringArrayVar(&accountIDs, "account-ids", nil, "Filter by account ID")
+
+	return cmd
+}
@@ -10,15 +10,19 @@ var (
 	ErrApprovalIDEmptyParam = errors.New("approval id/name is required")
 
 	ErrAppealStatusCanceled           = errors.New("appeal already canceled")
+	ErrAppealStatusExpired            = errors.New("appeal already expired")
 	ErrAppealStatusApproved           = errors.New("appeal already approved")
 	ErrAppealStatusRejected           = errors.New("appeal already rejected")
 	ErrAppealStatusBlocked            = errors.New("approval is blocked")
+	ErrAppealStatusNeedsInfo          = errors.New("appeal is waiting for more information from the creator")
 	ErrAppealStatusUnrecognized       = errors.New("unrecognized appeal status")
 	ErrAppealDuplicate                = errors.New("appeal with the same resource and role already exists")
 	ErrAppealInvalidExtensionDuration = errors.New("invalid appeal extension duration")
 	ErrAppealFoundActiveGrant         = errors.New("user still have an active grant")
 	ErrGrantNotEligibleForExtension   = errors.New("existing grant is not eligible for extension")
 	ErrCannotCreateAppealForOtherUser = errors.New("creating appeal for other individual user (account_type=\"user\") is not allowed")
+	ErrAppealUpdateForbidden          = errors.New("only the appeal creator can update the appeal")
+	ErrCommentForbidden               = errors.New("only the appeal creator, the account and the approvers can access the appeal comments")
 
 	ErrApprovalDependencyIsBlocked = errors.New("found previous approval step that is still in blocked")
 	ErrApprovalDependencyIsPending = errors.New("found previous approval step that is still in pending")
@@ -33,6 +37,14 @@ var (
 	ErrActionForbidden    = errors.New("user is not allowed to make action on this approval step")
 	ErrActionInvalidValue = errors.New("invalid action value")
 
+	ErrInfoRequestQuestionRequired = errors.New("question for the appeal creator is required")
+
+	ErrApprovalNotExternal = errors.New("approval step is not resolved by an approval webhook")
+
+	ErrApprovalOverrideNotAllowed = errors.New("role and duration can only be changed when approving")
+	ErrDurationOverrideTooLong    = errors.New("duration can't be longer than the requested duration")
+	ErrRoleOverrideNotAllowed     = errors.New("role can only be changed to a role with a subset of the requested permissions")
+
 	ErrProviderTypeNotFound                = errors.New("provider is not registered")
 	ErrProviderURNNotFound                 = errors.New("provider with specified urn is not registered")
 	ErrResourceTypeNotFound                = errors.New("unable to find matching resource config for specified resource type")
@@ -46,6 +58,13 @@ var (
 	ErrResourceIsDeleted                   = errors.New("resource is deleted")
 	ErrOptionsDurationNotFound             = errors.New("duration option not found")
 	ErrDurationIsRequired                  = errors.New("having permanent access to this resource is not allowed, access duration is required")
+	ErrBreakGlassNotAllowed                = errors.New("break-glass access is not allowed by the policy")
+	ErrBreakGlassJustificationRequired     = errors.New("justification is required for break-glass access")
+	ErrBreakGlassScheduled                 = errors.New("break-glass access can't be scheduled")
+	ErrInvalidStartDate                    = errors.New("invalid start date")
+	ErrSoDViolation                        = errors.New("appeal violates separation of duties rule")
+	ErrBundlePolicyMismatch                = errors.New("bundle policy doesn't match the policy of the resource")
+	ErrInvalidGrantDowngrade               = errors.New("grant can only be downgraded to a role with a subset of its permissions on the same resource")
 
 	ErrApproverKeyNotRecognized = errors.New("unrecognized approvers key")
 	ErrApproverInvalidType      = errors.New("invalid approver type, expected an email string or array of email string")
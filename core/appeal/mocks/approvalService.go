@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	domain "github.com/raystack/guardian/domain"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -69,6 +70,53 @@ func (_c *ApprovalService_AddApprover_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// DeleteApproval provides a mock function with given fields: ctx, id
+func (_m *ApprovalService) DeleteApproval(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteApproval")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalService_DeleteApproval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteApproval'
+type ApprovalService_DeleteApproval_Call struct {
+	*mock.Call
+}
+
+// DeleteApproval is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ApprovalService_Expecter) DeleteApproval(ctx interface{}, id interface{}) *ApprovalService_DeleteApproval_Call {
+	return &ApprovalService_DeleteApproval_Call{Call: _e.mock.On("DeleteApproval", ctx, id)}
+}
+
+func (_c *ApprovalService_DeleteApproval_Call) Run(run func(ctx context.Context, id string)) *ApprovalService_DeleteApproval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ApprovalService_DeleteApproval_Call) Return(_a0 error) *ApprovalService_DeleteApproval_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalService_DeleteApproval_Call) RunAndReturn(run func(context.Context, string) error) *ApprovalService_DeleteApproval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteApprover provides a mock function with given fields: ctx, approvalID, email
 func (_m *ApprovalService) DeleteApprover(ctx context.Context, approvalID string, email string) error {
 	ret := _m.Called(ctx, approvalID, email)
@@ -117,6 +165,65 @@ func (_c *ApprovalService_DeleteApprover_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ListApprovals provides a mock function with given fields: _a0, _a1
+func (_m *ApprovalService) ListApprovals(_a0 context.Context, _a1 *domain.ListApprovalsFilter) ([]*domain.Approval, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListApprovals")
+	}
+
+	var r0 []*domain.Approval
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ListApprovalsFilter) ([]*domain.Approval, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ListApprovalsFilter) []*domain.Approval); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Approval)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.ListApprovalsFilter) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalService_ListApprovals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListApprovals'
+type ApprovalService_ListApprovals_Call struct {
+	*mock.Call
+}
+
+// ListApprovals is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *domain.ListApprovalsFilter
+func (_e *ApprovalService_Expecter) ListApprovals(_a0 interface{}, _a1 interface{}) *ApprovalService_ListApprovals_Call {
+	return &ApprovalService_ListApprovals_Call{Call: _e.mock.On("ListApprovals", _a0, _a1)}
+}
+
+func (_c *ApprovalService_ListApprovals_Call) Run(run func(_a0 context.Context, _a1 *domain.ListApprovalsFilter)) *ApprovalService_ListApprovals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ListApprovalsFilter))
+	})
+	return _c
+}
+
+func (_c *ApprovalService_ListApprovals_Call) Return(_a0 []*domain.Approval, _a1 error) *ApprovalService_ListApprovals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalService_ListApprovals_Call) RunAndReturn(run func(context.Context, *domain.ListApprovalsFilter) ([]*domain.Approval, error)) *ApprovalService_ListApprovals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewApprovalService creates a new instance of ApprovalService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewApprovalService(t interface {
@@ -24,6 +24,65 @@ func (_m *GrantService) EXPECT() *GrantService_Expecter {
 	return &GrantService_Expecter{mock: &_m.Mock}
 }
 
+// GetByID provides a mock function with given fields: _a0, _a1
+func (_m *GrantService) GetByID(_a0 context.Context, _a1 string) (*domain.Grant, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.Grant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Grant, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Grant); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Grant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GrantService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type GrantService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 string
+func (_e *GrantService_Expecter) GetByID(_a0 interface{}, _a1 interface{}) *GrantService_GetByID_Call {
+	return &GrantService_GetByID_Call{Call: _e.mock.On("GetByID", _a0, _a1)}
+}
+
+func (_c *GrantService_GetByID_Call) Run(run func(_a0 context.Context, _a1 string)) *GrantService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *GrantService_GetByID_Call) Return(_a0 *domain.Grant, _a1 error) *GrantService_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GrantService_GetByID_Call) RunAndReturn(run func(context.Context, string) (*domain.Grant, error)) *GrantService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function with given fields: _a0, _a1
 func (_m *GrantService) List(_a0 context.Context, _a1 domain.ListGrantsFilter) ([]domain.Grant, error) {
 	ret := _m.Called(_a0, _a1)
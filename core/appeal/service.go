@@ -26,8 +26,17 @@ const (
 	AuditKeyExtend         = "appeal.extend"
 	AuditKeyAddApprover    = "appeal.addApprover"
 	AuditKeyDeleteApprover = "appeal.deleteApprover"
-
-	RevokeReasonForExtension = "Automatically revoked for grant extension"
+	AuditKeyEscalate       = "appeal.escalate"
+	AuditKeyTimeout        = "appeal.timeout"
+	AuditKeyExpire         = "appeal.expire"
+	AuditKeyRequestInfo    = "appeal.requestInfo"
+	AuditKeyUpdate         = "appeal.update"
+	AuditKeyComment        = "appeal.comment"
+	AuditKeyExternal       = "appeal.external"
+
+	RevokeReasonForExtension             = "Automatically revoked for grant extension"
+	RevokeReasonBreakGlassReviewRejected = "Break-glass access rejected on review"
+	RevokeReasonForDowngrade             = "Automatically revoked for grant downgrade"
 )
 
 var TimeNow = time.Now
@@ -59,8 +68,10 @@ type policyService interface {
 
 //go:generate mockery --name=approvalService --exported --with-expecter
 type approvalService interface {
+	ListApprovals(context.Context, *domain.ListApprovalsFilter) ([]*domain.Approval, error)
 	AddApprover(ctx context.Context, approvalID, email string) error
 	DeleteApprover(ctx context.Context, approvalID, email string) error
+	DeleteApproval(ctx context.Context, id string) error
 }
 
 //go:generate mockery --name=providerService --exported --with-expecter
@@ -81,10 +92,33 @@ type resourceService interface {
 //go:generate mockery --name=grantService --exported --with-expecter
 type grantService interface {
 	List(context.Context, domain.ListGrantsFilter) ([]domain.Grant, error)
+	GetByID(context.Context, string) (*domain.Grant, error)
 	Prepare(context.Context, domain.Appeal) (*domain.Grant, error)
 	Revoke(ctx context.Context, id, actor, reason string, opts ...grant.Option) (*domain.Grant, error)
 }
 
+//go:generate mockery --name=delegationService --exported --with-expecter
+type delegationService interface {
+	FindActive(ctx context.Context, delegators []string) ([]*domain.Delegation, error)
+}
+
+//go:generate mockery --name=sodService --exported --with-expecter
+type sodService interface {
+	FindAppealViolations(ctx context.Context, a *domain.Appeal, otherAppeals []*domain.Appeal) ([]*domain.SoDViolation, error)
+}
+
+//go:generate mockery --name=commentRepository --exported --with-expecter
+type commentRepository interface {
+	Create(context.Context, *domain.Comment) error
+	List(context.Context, domain.ListCommentsFilter) ([]*domain.Comment, error)
+}
+
+//go:generate mockery --name=externalApprover --exported --with-expecter
+type externalApprover interface {
+	Evaluate(ctx context.Context, webhook string, req *domain.ExternalApprovalRequest) (*domain.ExternalApprovalResponse, error)
+	IsCallbackActor(webhook, user string) bool
+}
+
 //go:generate mockery --name=auditLogger --exported --with-expecter
 type auditLogger interface {
 	Log(ctx context.Context, action string, data interface{}) error
@@ -111,6 +145,12 @@ type ServiceDeps struct {
 	GrantService    grantService
 	IAMManager      iamManager
 
+	DelegationService delegationService
+	SoDService        sodService
+	CommentRepository commentRepository
+	ExternalApprover  externalApprover
+	RiskScoring       *domain.RiskScoringConfig
+
 	Notifier    notifier
 	Validator   *validator.Validate
 	Logger      log.Logger
@@ -127,6 +167,12 @@ type Service struct {
 	grantService    grantService
 	iam             domain.IAMManager
 
+	delegationService delegationService
+	sodService        sodService
+	commentRepo       commentRepository
+	externalApprover  externalApprover
+	riskScoring       *domain.RiskScoringConfig
+
 	notifier    notifier
 	validator   *validator.Validate
 	logger      log.Logger
@@ -146,6 +192,12 @@ func NewService(deps ServiceDeps) *Service {
 		deps.GrantService,
 		deps.IAMManager,
 
+		deps.DelegationService,
+		deps.SoDService,
+		deps.CommentRepository,
+		deps.ExternalApprover,
+		deps.RiskScoring,
+
 		deps.Notifier,
 		deps.Validator,
 		deps.Logger,
@@ -200,7 +252,7 @@ func (s *Service) Create(ctx context.Context, appeals []*domain.Appeal, opts ...
 	}
 
 	pendingAppeals, err := s.getAppealsMap(ctx, &domain.ListAppealsFilter{
-		Statuses:   []string{domain.AppealStatusPending},
+		Statuses:   []string{domain.AppealStatusPending, domain.AppealStatusNeedsInfo},
 		AccountIDs: accountIDs,
 	})
 	if err != nil {
@@ -209,7 +261,7 @@ func (s *Service) Create(ctx context.Context, appeals []*domain.Appeal, opts ...
 
 	notifications := []domain.Notification{}
 
-	for _, appeal := range appeals {
+	for i, appeal := range appeals {
 		appeal.SetDefaults()
 
 		if err := validateAppeal(appeal, pendingAppeals); err != nil {
@@ -226,6 +278,16 @@ func (s *Service) Create(ctx context.Context, appeals []*domain.Appeal, opts ...
 		var policy *domain.Policy
 		if isAdditionalAppealCreation && appeal.PolicyID != "" && appeal.PolicyVersion != 0 {
 			policy = policies[appeal.PolicyID][appeal.PolicyVersion]
+		} else if appeal.BundleID != "" && appeal.PolicyID != "" {
+			// the bundle policy can't replace the policy configured for the resource, otherwise a bundle pointing to
+			// a lenient policy would bypass the approval of the resource
+			policy, err = getPolicy(appeal, provider, policies)
+			if err != nil {
+				return fmt.Errorf("retrieving policy: %w", err)
+			}
+			if policy.ID != appeal.PolicyID || policy.Version != appeal.PolicyVersion {
+				return fmt.Errorf("%w: resource %q requires policy %q version %d", ErrBundlePolicyMismatch, appeal.Resource.URN, policy.ID, policy.Version)
+			}
 		} else {
 			policy, err = getPolicy(appeal, provider, policies)
 			if err != nil {
@@ -244,6 +306,12 @@ func (s *Service) Create(ctx context.Context, appeals []*domain.Appeal, opts ...
 			}
 		}
 
+		if appeal.IsBreakGlass() {
+			if err := applyBreakGlassConfig(appeal, policy); err != nil {
+				return fmt.Errorf("validating break-glass appeal: %w", err)
+			}
+		}
+
 		if err := s.providerService.ValidateAppeal(ctx, appeal, provider, policy); err != nil {
 			return fmt.Errorf("validating appeal based on provider: %w", err)
 		}
@@ -254,8 +322,14 @@ func (s *Service) Create(ctx context.Context, appeals []*domain.Appeal, opts ...
 		}
 		appeal.Permissions = strPermissions
 
-		if err := validateAppealDurationConfig(appeal, policy); err != nil {
-			return fmt.Errorf("validating appeal duration: %w", err)
+		if err := s.checkGrantDowngrade(ctx, appeal); err != nil {
+			return err
+		}
+
+		if !appeal.IsBreakGlass() {
+			if err := validateAppealDurationConfig(appeal, policy); err != nil {
+				return fmt.Errorf("validating appeal duration: %w", err)
+			}
 		}
 
 		if err := validateAppealOnBehalf(appeal, policy); err != nil {
@@ -266,12 +340,50 @@ func (s *Service) Create(ctx context.Context, appeals []*domain.Appeal, opts ...
 			return fmt.Errorf("retrieving creator details: %w", err)
 		}
 
-		if err := appeal.ApplyPolicy(policy); err != nil {
-			return fmt.Errorf("populating approvals: %w", err)
+		if err := s.addRiskScore(ctx, appeal); err != nil {
+			return fmt.Errorf("computing risk score: %w", err)
 		}
 
-		if err := appeal.AdvanceApproval(policy); err != nil {
-			return fmt.Errorf("initializing approval step statuses: %w", err)
+		// the pending appeals of the account and the previous appeals of the batch may complete a combination as well
+		otherAppeals := []*domain.Appeal{}
+		for _, resourceAppeals := range pendingAppeals[appeal.AccountID] {
+			for _, pendingAppeal := range resourceAppeals {
+				otherAppeals = append(otherAppeals, pendingAppeal)
+			}
+		}
+		otherAppeals = append(otherAppeals, appeals[:i]...)
+		sodApprovalViolations, err := s.checkSoDViolations(ctx, appeal, otherAppeals)
+		if err != nil {
+			return err
+		}
+
+		if appeal.IsBreakGlass() {
+			// break-glass appeals skip the approval steps, the access is granted right away and reviewed afterwards
+			review, err := policy.AppealConfig.ToBreakGlassReview(appeal, policy)
+			if err != nil {
+				return fmt.Errorf("populating break-glass review: %w", err)
+			}
+			appeal.Approvals = []*domain.Approval{review}
+			appeal.Init(policy)
+			if err := appeal.Approve(); err != nil {
+				return fmt.Errorf("approving break-glass appeal: %w", err)
+			}
+		} else {
+			if err := appeal.ApplyPolicy(policy); err != nil {
+				return fmt.Errorf("populating approvals: %w", err)
+			}
+
+			if len(sodApprovalViolations) > 0 {
+				sodApproval, err := domain.GetSoDApproval(sodApprovalViolations, appeal, policy)
+				if err != nil {
+					return fmt.Errorf("populating separation of duties approval: %w", err)
+				}
+				appeal.Approvals = append(appeal.Approvals, sodApproval)
+			}
+
+			if err := appeal.AdvanceApproval(policy); err != nil {
+				return fmt.Errorf("initializing approval step statuses: %w", err)
+			}
 		}
 		appeal.Policy = nil
 
@@ -295,6 +407,7 @@ func (s *Service) Create(ctx context.Context, appeals []*domain.Appeal, opts ...
 						return fmt.Errorf("granting access: %w", err)
 					}
 				}
+				s.revokeDowngradedGrant(ctx, appeal)
 
 				notifications = append(notifications, domain.Notification{
 					User: appeal.CreatedBy,
@@ -355,7 +468,11 @@ func (s *Service) Create(ctx context.Context, appeals []*domain.Appeal, opts ...
 			})
 		}
 
-		notifications = append(notifications, s.getApprovalNotifications(a)...)
+		if a.IsBreakGlass() {
+			notifications = append(notifications, getBreakGlassReviewNotifications(a)...)
+		} else {
+			notifications = append(notifications, s.getApprovalNotifications(ctx, a, a.GetPendingApprovals())...)
+		}
 	}
 
 	if len(notifications) > 0 {
@@ -369,6 +486,143 @@ func (s *Service) Create(ctx context.Context, appeals []*domain.Appeal, opts ...
 	return nil
 }
 
+// Simulate runs the policy against a hypothetical appeal without persisting anything. The policy is resolved from
+// the appeal's policy id and version if policy is nil, falling back to the one configured for the resource in the
+// provider. Creator details are fetched from the policy IAM unless given in the appeal.
+func (s *Service) Simulate(ctx context.Context, a *domain.Appeal, ri *domain.ResourceIdentifier, policy *domain.Policy) (*domain.AppealSimulation, error) {
+	if ri == nil {
+		return nil, ErrResourceNotFound
+	}
+	resource, err := s.resourceService.Get(ctx, ri)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving resource: %w", err)
+	}
+	if resource.IsDeleted {
+		return nil, ErrResourceIsDeleted
+	}
+	a.ResourceID = resource.ID
+	a.Resource = resource
+	a.SetDefaults()
+
+	if policy == nil {
+		if a.PolicyID != "" {
+			policy, err = s.policyService.GetOne(ctx, a.PolicyID, a.PolicyVersion)
+			if err != nil {
+				return nil, fmt.Errorf("retrieving policy: %w", err)
+			}
+		} else {
+			providers, err := s.getProvidersMap(ctx)
+			if err != nil {
+				return nil, err
+			}
+			provider, err := getProvider(a, providers)
+			if err != nil {
+				return nil, fmt.Errorf("retrieving provider: %w", err)
+			}
+			policies, err := s.getPoliciesMap(ctx)
+			if err != nil {
+				return nil, err
+			}
+			policy, err = getPolicy(a, provider, policies)
+			if err != nil {
+				return nil, fmt.Errorf("retrieving policy: %w", err)
+			}
+		}
+	}
+
+	if a.Creator == nil {
+		if err := s.addCreatorDetails(a, policy); err != nil {
+			return nil, fmt.Errorf("retrieving creator details: %w", err)
+		}
+	}
+
+	if err := s.addRiskScore(ctx, a); err != nil {
+		return nil, fmt.Errorf("computing risk score: %w", err)
+	}
+
+	return policy.Simulate(a)
+}
+
+// addRiskScore computes the risk of the appeal from the risk scoring rules, so that policy expressions can refer to
+// $appeal.risk. The risk is left empty if no rule is configured.
+func (s *Service) addRiskScore(ctx context.Context, a *domain.Appeal) error {
+	if !s.riskScoring.IsEnabled() {
+		return nil
+	}
+
+	rejectedAppeals, err := s.repo.GetAppealsTotalCount(ctx, &domain.ListAppealsFilter{
+		CreatedBy: a.CreatedBy,
+		Statuses:  []string{domain.AppealStatusRejected},
+	})
+	if err != nil {
+		return fmt.Errorf("counting rejected appeals: %w", err)
+	}
+
+	risk, err := s.riskScoring.Score(a, rejectedAppeals)
+	if err != nil {
+		return err
+	}
+	a.Risk = risk
+	return nil
+}
+
+// checkSoDViolations returns ErrSoDViolation if the appeal, along with the other appeals of the account that may
+// still be granted, violates a reject rule. Violations of require_approval rules are returned so that an extra
+// approval step can be added to the appeal. Break-glass appeals skip the approval steps, so they are rejected by
+// require_approval rules as well.
+func (s *Service) checkSoDViolations(ctx context.Context, a *domain.Appeal, otherAppeals []*domain.Appeal) ([]*domain.SoDViolation, error) {
+	violations, err := s.sodService.FindAppealViolations(ctx, a, otherAppeals)
+	if err != nil {
+		return nil, fmt.Errorf("checking separation of duties rules: %w", err)
+	}
+
+	var approvalViolations []*domain.SoDViolation
+	for _, v := range violations {
+		if v.Rule.Action == domain.SoDRuleActionReject {
+			return nil, fmt.Errorf("%w: %q", ErrSoDViolation, v.Rule.Name)
+		}
+		if a.IsBreakGlass() {
+			return nil, fmt.Errorf("%w: %q requires an approval, which break-glass appeals skip", ErrSoDViolation, v.Rule.Name)
+		}
+		approvalViolations = append(approvalViolations, v)
+	}
+	return approvalViolations, nil
+}
+
+// checkGrantDowngrade validates the grant replaced by the appeal. It has to be an active grant of the same account and
+// resource, and the requested role has to be narrower, i.e. have a strict subset of its permissions.
+func (s *Service) checkGrantDowngrade(ctx context.Context, a *domain.Appeal) error {
+	if a.Options == nil || a.Options.ReplaceGrantID == "" {
+		return nil
+	}
+
+	g, err := s.grantService.GetByID(ctx, a.Options.ReplaceGrantID)
+	if err != nil {
+		return fmt.Errorf("getting downgraded grant: %w", err)
+	}
+	if g.Status != domain.GrantStatusActive ||
+		g.AccountID != a.AccountID ||
+		g.AccountType != a.AccountType ||
+		g.ResourceID != a.ResourceID ||
+		!utils.IsStrictSubset(a.Permissions, g.Permissions) {
+		return fmt.Errorf("%w: grant %q with role %q", ErrInvalidGrantDowngrade, g.ID, g.Role)
+	}
+
+	return nil
+}
+
+// revokeDowngradedGrant revokes the grant replaced by the approved appeal. A failure is only logged as the narrower
+// access is already given, the broader grant is then recommended to be revoked on the next recommendations.
+func (s *Service) revokeDowngradedGrant(ctx context.Context, a *domain.Appeal) {
+	if a.Options == nil || a.Options.ReplaceGrantID == "" {
+		return
+	}
+
+	if _, err := s.grantService.Revoke(ctx, a.Options.ReplaceGrantID, domain.SystemActorName, RevokeReasonForDowngrade); err != nil {
+		s.logger.Error("failed to revoke downgraded grant", "appeal_id", a.ID, "grant_id", a.Options.ReplaceGrantID, "error", err)
+	}
+}
+
 func (s *Service) findActiveGrant(ctx context.Context, a *domain.Appeal) (*domain.Grant, error) {
 	grants, err := s.grantService.List(ctx, domain.ListGrantsFilter{
 		Statuses:    []string{string(domain.GrantStatusActive)},
@@ -412,6 +666,25 @@ func addOnBehalfApprovedNotification(appeal *domain.Appeal, notifications []doma
 }
 
 func validateAppealDurationConfig(appeal *domain.Appeal, policy *domain.Policy) error {
+	if appeal.Options != nil && appeal.Options.StartDate != nil {
+		now := TimeNow()
+		if appeal.Options.StartDate.Before(now) {
+			return fmt.Errorf("%w: start date should be in the future", ErrInvalidStartDate)
+		}
+		maxScheduleAhead, err := policy.AppealConfig.GetMaxScheduleAhead()
+		if err != nil {
+			return fmt.Errorf("parsing max schedule ahead: %w", err)
+		}
+		if appeal.Options.StartDate.After(now.Add(maxScheduleAhead)) {
+			return fmt.Errorf("%w: start date can't be more than %s ahead", ErrInvalidStartDate, maxScheduleAhead)
+		}
+	}
+
+	return validateAppealDurationOption(appeal, policy)
+}
+
+// validateAppealDurationOption checks the duration against the duration options of the policy, if any
+func validateAppealDurationOption(appeal *domain.Appeal, policy *domain.Policy) error {
 	// return nil if duration options are not configured for this policy
 	if policy.AppealConfig == nil || policy.AppealConfig.DurationOptions == nil {
 		return nil
@@ -425,6 +698,32 @@ func validateAppealDurationConfig(appeal *domain.Appeal, policy *domain.Policy)
 	return fmt.Errorf("%w: %s", ErrOptionsDurationNotFound, appeal.Options.Duration)
 }
 
+// applyBreakGlassConfig checks whether the policy allows break-glass access and caps the requested duration
+func applyBreakGlassConfig(appeal *domain.Appeal, policy *domain.Policy) error {
+	if policy.AppealConfig == nil || !policy.AppealConfig.AllowBreakGlass {
+		return ErrBreakGlassNotAllowed
+	}
+	if strings.TrimSpace(appeal.Description) == "" {
+		return ErrBreakGlassJustificationRequired
+	}
+	if appeal.Options.StartDate != nil {
+		return ErrBreakGlassScheduled
+	}
+
+	maxDuration, err := policy.AppealConfig.GetBreakGlassMaxDuration()
+	if err != nil {
+		return fmt.Errorf("parsing break-glass max duration: %w", err)
+	}
+	duration, err := appeal.GetDuration()
+	if err != nil {
+		return fmt.Errorf("parsing duration %q: %w", appeal.Options.Duration, err)
+	}
+	if duration == 0 || duration > maxDuration {
+		appeal.Options.Duration = maxDuration.String()
+	}
+	return nil
+}
+
 func validateAppealOnBehalf(a *domain.Appeal, policy *domain.Policy) error {
 	if a.AccountType == domain.DefaultAppealAccountType {
 		if policy.AppealConfig != nil && policy.AppealConfig.AllowOnBehalf {
@@ -442,18 +741,37 @@ func (s *Service) UpdateApproval(ctx context.Context, approvalAction domain.Appr
 	if err := utils.ValidateStruct(approvalAction); err != nil {
 		return nil, err
 	}
+	if approvalAction.Action == domain.AppealActionNameRequestInfo && strings.TrimSpace(approvalAction.Reason) == "" {
+		return nil, ErrInfoRequestQuestionRequired
+	}
+	if approvalAction.HasOverrides() && approvalAction.Action != domain.AppealActionNameApprove {
+		return nil, ErrApprovalOverrideNotAllowed
+	}
 
 	appeal, err := s.GetByID(ctx, approvalAction.AppealID)
 	if err != nil {
 		return nil, err
 	}
 
+	if appeal.IsBreakGlass() {
+		if approvalAction.HasOverrides() {
+			// the access is already granted by the time the break-glass appeal is reviewed
+			return nil, ErrApprovalOverrideNotAllowed
+		}
+		return s.reviewBreakGlassAppeal(ctx, appeal, approvalAction)
+	}
+
 	if err := checkIfAppealStatusStillPending(appeal.Status); err != nil {
 		return nil, err
 	}
 
+	target := appeal.GetApproval(approvalAction.ApprovalName)
 	for i, approval := range appeal.Approvals {
 		if approval.Name != approvalAction.ApprovalName {
+			if target != nil && approval.IsParallelWith(target) {
+				// steps of the same parallel group are decided independently
+				continue
+			}
 			if err := checkPreviousApprovalStatus(approval.Status); err != nil {
 				return nil, err
 			}
@@ -466,108 +784,77 @@ func (s *Service) UpdateApproval(ctx context.Context, approvalAction domain.Appr
 			}
 		}
 
-		if !utils.ContainsString(approval.Approvers, approvalAction.Actor) {
+		delegations, err := s.getDelegations(ctx, appeal, approval.Approvers)
+		if err != nil {
+			return nil, err
+		}
+		approvalAction.OnBehalfOf = getDelegator(delegations, approval.Approvers, approvalAction.Actor)
+		if !utils.ContainsString(approval.Approvers, approvalAction.Actor) && approvalAction.OnBehalfOf == "" {
 			return nil, ErrActionForbidden
 		}
 
-		approval.Actor = &approvalAction.Actor
-		approval.Reason = approvalAction.Reason
-		approval.UpdatedAt = TimeNow()
-
-		if approvalAction.Action == domain.AppealActionNameApprove {
-			approval.Approve()
-			if i+1 <= len(appeal.Approvals)-1 {
-				appeal.Approvals[i+1].Status = domain.ApprovalStatusPending
-			}
-			if appeal.Policy == nil {
-				appeal.Policy, err = s.policyService.GetOne(ctx, appeal.PolicyID, appeal.PolicyVersion)
-				if err != nil {
-					return nil, err
-				}
-			}
-			if err := appeal.AdvanceApproval(appeal.Policy); err != nil {
+		policy, err := s.getAppealPolicy(ctx, appeal)
+		if err != nil {
+			return nil, err
+		}
+		if err := policy.AppealConfig.ValidateApprover(appeal, approval, approvalAction); err != nil {
+			return nil, err
+		}
+		if approvalAction.HasOverrides() {
+			if err := s.applyApprovalOverrides(ctx, appeal, policy, approvalAction); err != nil {
 				return nil, err
 			}
-		} else if approvalAction.Action == domain.AppealActionNameReject {
-			approval.Reject()
-			appeal.Reject()
-
-			if i < len(appeal.Approvals)-1 {
-				for j := i + 1; j < len(appeal.Approvals); j++ {
-					appeal.Approvals[j].Skip()
-					appeal.Approvals[j].UpdatedAt = TimeNow()
-				}
-			}
-		} else {
+		}
+
+		if err := approval.AddDecision(&domain.ApprovalDecision{
+			Actor:      approvalAction.Actor,
+			OnBehalfOf: approvalAction.OnBehalfOf,
+			Action:     approvalAction.Action,
+			Reason:     approvalAction.Reason,
+			CreatedAt:  TimeNow(),
+		}); err != nil {
+			return nil, err
+		}
+		approval.UpdatedAt = TimeNow()
+
+		if approvalAction.Action == domain.AppealActionNameRequestInfo {
+			return s.requestAppealInfo(ctx, appeal, approval, approvalAction)
+		}
+		if approvalAction.Action != domain.AppealActionNameApprove && approvalAction.Action != domain.AppealActionNameReject {
 			return nil, ErrActionInvalidValue
 		}
 
-		if appeal.Status == domain.AppealStatusApproved {
-			newGrant, revokedGrant, err := s.prepareGrant(ctx, appeal)
-			if err != nil {
-				return nil, fmt.Errorf("preparing grant: %w", err)
-			}
-			newGrant.Resource = appeal.Resource
-			appeal.Grant = newGrant
-			if revokedGrant != nil {
-				if _, err := s.grantService.Revoke(ctx, revokedGrant.ID, domain.SystemActorName, RevokeReasonForExtension,
-					grant.SkipNotifications(),
-					grant.SkipRevokeAccessInProvider(),
-				); err != nil {
-					return nil, fmt.Errorf("revoking previous grant: %w", err)
-				}
-			} else {
-				if err := s.GrantAccessToProvider(ctx, appeal); err != nil {
-					return nil, fmt.Errorf("granting access: %w", err)
+		pendingApprovals := appeal.GetPendingApprovals()
+		isStepResolved, err := s.isApprovalStepResolved(ctx, appeal, approval, approvalAction.Action)
+		if err != nil {
+			return nil, err
+		}
+
+		// the step stays pending until its quorum is settled
+		if isStepResolved {
+			approval.Actor = &approvalAction.Actor
+			approval.Reason = approvalAction.Reason
+			switch approvalAction.Action {
+			case domain.AppealActionNameApprove:
+				approval.Approve()
+				appeal.UnblockNextApprovals(i)
+				if err := appeal.AdvanceApproval(policy); err != nil {
+					return nil, err
 				}
+			case domain.AppealActionNameReject:
+				approval.Reject()
+				appeal.Reject()
+				skipUnresolvedApprovals(appeal)
 			}
 		}
 
-		if err := s.Update(ctx, appeal); err != nil {
-			if err := s.providerService.RevokeAccess(ctx, *appeal.Grant); err != nil {
-				return nil, fmt.Errorf("revoking access: %w", err)
-			}
-			return nil, fmt.Errorf("updating appeal: %w", err)
+		if err := s.updateResolvedAppeal(ctx, appeal); err != nil {
+			return nil, err
 		}
 
-		notifications := []domain.Notification{}
-		if appeal.Status == domain.AppealStatusApproved {
-			notifications = append(notifications, domain.Notification{
-				User: appeal.CreatedBy,
-				Labels: map[string]string{
-					"appeal_id": appeal.ID,
-				},
-				Message: domain.NotificationMessage{
-					Type: domain.NotificationTypeAppealApproved,
-					Variables: map[string]interface{}{
-						"resource_name": fmt.Sprintf("%s (%s: %s)", appeal.Resource.Name, appeal.Resource.ProviderType, appeal.Resource.URN),
-						"role":          appeal.Role,
-						"account_id":    appeal.AccountID,
-						"appeal_id":     appeal.ID,
-						"requestor":     appeal.CreatedBy,
-					},
-				},
-			})
-			notifications = addOnBehalfApprovedNotification(appeal, notifications)
-		} else if appeal.Status == domain.AppealStatusRejected {
-			notifications = append(notifications, domain.Notification{
-				User: appeal.CreatedBy,
-				Labels: map[string]string{
-					"appeal_id": appeal.ID,
-				},
-				Message: domain.NotificationMessage{
-					Type: domain.NotificationTypeAppealRejected,
-					Variables: map[string]interface{}{
-						"resource_name": fmt.Sprintf("%s (%s: %s)", appeal.Resource.Name, appeal.Resource.ProviderType, appeal.Resource.URN),
-						"role":          appeal.Role,
-						"account_id":    appeal.AccountID,
-						"appeal_id":     appeal.ID,
-						"requestor":     appeal.CreatedBy,
-					},
-				},
-			})
-		} else {
-			notifications = append(notifications, s.getApprovalNotifications(appeal)...)
+		notifications := getAppealResolutionNotifications(appeal)
+		if appeal.Status == domain.AppealStatusPending && isStepResolved {
+			notifications = append(notifications, s.getApprovalNotifications(ctx, appeal, getUnblockedApprovals(appeal, pendingApprovals))...)
 		}
 		if len(notifications) > 0 {
 			if errs := s.notifier.Notify(notifications); errs != nil {
@@ -595,44 +882,529 @@ func (s *Service) UpdateApproval(ctx context.Context, approvalAction domain.Appr
 	return nil, ErrApprovalNotFound
 }
 
-func (s *Service) Update(ctx context.Context, appeal *domain.Appeal) error {
-	return s.repo.Update(ctx, appeal)
-}
-
-func (s *Service) Cancel(ctx context.Context, id string) (*domain.Appeal, error) {
-	if id == "" {
-		return nil, ErrAppealIDEmptyParam
+// applyApprovalOverrides changes the role and/or the duration of the appeal as decided by the approver. The duration
+// can only be shortened and the role can only be one of the roles of the resource whose permissions are a subset of
+// the requested ones. The requested values are kept in the appeal revisions.
+func (s *Service) applyApprovalOverrides(ctx context.Context, appeal *domain.Appeal, policy *domain.Policy, approvalAction domain.ApprovalAction) error {
+	requestedRole := appeal.Role
+	requestedPermissions := appeal.Permissions
+	requestedDuration, err := appeal.GetDuration()
+	if err != nil {
+		return fmt.Errorf("parsing requested duration: %w", err)
+	}
+	var requestedDurationOption string
+	if requestedDuration > 0 {
+		requestedDurationOption = appeal.Options.Duration
 	}
 
-	if !utils.IsValidUUID(id) {
-		return nil, InvalidError{AppealID: id}
+	appeal.Update(domain.AppealUpdate{
+		AppealID: appeal.ID,
+		Actor:    approvalAction.Actor,
+		Role:     approvalAction.Role,
+		Duration: approvalAction.Duration,
+	})
+
+	if approvalAction.Duration != "" {
+		duration, err := appeal.GetDuration()
+		if err != nil {
+			return fmt.Errorf("parsing duration %q: %w", approvalAction.Duration, err)
+		}
+		if requestedDuration > 0 && (duration == 0 || duration > requestedDuration) {
+			return fmt.Errorf("%w: %q is longer than %q", ErrDurationOverrideTooLong, approvalAction.Duration, requestedDurationOption)
+		}
+		if err := validateAppealDurationOption(appeal, policy); err != nil {
+			return err
+		}
 	}
 
-	appeal, err := s.GetByID(ctx, id)
+	providers, err := s.getProvidersMap(ctx)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	provider, err := getProvider(appeal, providers)
+	if err != nil {
+		return fmt.Errorf("retrieving provider: %w", err)
+	}
+	if err := s.providerService.ValidateAppeal(ctx, appeal, provider, policy); err != nil {
+		return fmt.Errorf("validating appeal based on provider: %w", err)
+	}
+	permissions, err := s.getPermissions(ctx, provider.Config, appeal.Resource.Type, appeal.Role)
+	if err != nil {
+		return fmt.Errorf("getting permissions list: %w", err)
+	}
+	if appeal.Role != requestedRole {
+		if len(requestedPermissions) == 0 {
+			requestedPermissions, err = s.getPermissions(ctx, provider.Config, appeal.Resource.Type, requestedRole)
+			if err != nil {
+				return fmt.Errorf("getting permissions list of the requested role: %w", err)
+			}
+		}
+		for _, p := range permissions {
+			if !utils.ContainsString(requestedPermissions, p) {
+				return fmt.Errorf("%w: %q of role %q is not requested", ErrRoleOverrideNotAllowed, p, appeal.Role)
+			}
+		}
 	}
+	appeal.Permissions = permissions
 
-	// TODO: check only appeal creator who is allowed to cancel the appeal
+	return nil
+}
 
-	if err := checkIfAppealStatusStillPending(appeal.Status); err != nil {
+// reviewBreakGlassAppeal records the post-hoc review of an already granted break-glass appeal. Rejecting the review
+// revokes the access if it is still active.
+func (s *Service) reviewBreakGlassAppeal(ctx context.Context, appeal *domain.Appeal, approvalAction domain.ApprovalAction) (*domain.Appeal, error) {
+	review := appeal.GetBreakGlassReview()
+	if review == nil || review.Name != approvalAction.ApprovalName {
+		return nil, ErrApprovalNotFound
+	}
+	if review.Status != domain.ApprovalStatusPending {
+		if err := checkApprovalStatus(review.Status); err != nil {
+			return nil, err
+		}
+	}
+
+	delegations, err := s.getDelegations(ctx, appeal, review.Approvers)
+	if err != nil {
 		return nil, err
 	}
+	approvalAction.OnBehalfOf = getDelegator(delegations, review.Approvers, approvalAction.Actor)
+	if !utils.ContainsString(review.Approvers, approvalAction.Actor) && approvalAction.OnBehalfOf == "" {
+		return nil, ErrActionForbidden
+	}
 
-	appeal.Cancel()
-	if err := s.repo.Update(ctx, appeal); err != nil {
+	policy, err := s.getAppealPolicy(ctx, appeal)
+	if err != nil {
+		return nil, err
+	}
+	if err := policy.AppealConfig.ValidateApprover(appeal, review, approvalAction); err != nil {
 		return nil, err
 	}
 
-	if err := s.auditLogger.Log(ctx, AuditKeyCancel, map[string]interface{}{
-		"appeal_id": id,
+	if err := review.AddDecision(&domain.ApprovalDecision{
+		Actor:      approvalAction.Actor,
+		OnBehalfOf: approvalAction.OnBehalfOf,
+		Action:     approvalAction.Action,
+		Reason:     approvalAction.Reason,
+		CreatedAt:  TimeNow(),
 	}); err != nil {
+		return nil, err
+	}
+	review.Actor = &approvalAction.Actor
+	review.Reason = approvalAction.Reason
+	review.UpdatedAt = TimeNow()
+
+	var auditKey string
+	switch approvalAction.Action {
+	case domain.AppealActionNameApprove:
+		review.Approve()
+		auditKey = AuditKeyApprove
+	case domain.AppealActionNameReject:
+		review.Reject()
+		auditKey = AuditKeyReject
+		if appeal.Grant != nil && appeal.Grant.Status == domain.GrantStatusActive {
+			revokedGrant, err := s.grantService.Revoke(ctx, appeal.Grant.ID, approvalAction.Actor, RevokeReasonBreakGlassReviewRejected)
+			if err != nil {
+				return nil, fmt.Errorf("revoking break-glass grant: %w", err)
+			}
+			appeal.Grant = revokedGrant
+		}
+	default:
+		return nil, ErrActionInvalidValue
+	}
+
+	if err := s.Update(ctx, appeal); err != nil {
+		return nil, fmt.Errorf("updating appeal: %w", err)
+	}
+
+	if err := s.auditLogger.Log(ctx, auditKey, approvalAction); err != nil {
 		s.logger.Error("failed to record audit log", "error", err)
 	}
 
 	return appeal, nil
 }
 
+// requestAppealInfo puts the appeal on hold and sends the question of the approver to the creator. The approval
+// chain is evaluated again once the creator updates the appeal.
+func (s *Service) requestAppealInfo(ctx context.Context, appeal *domain.Appeal, approval *domain.Approval, approvalAction domain.ApprovalAction) (*domain.Appeal, error) {
+	appeal.RequestInfo()
+	if err := s.Update(ctx, appeal); err != nil {
+		return nil, fmt.Errorf("updating appeal: %w", err)
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyRequestInfo, approvalAction); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	if errs := s.notifier.Notify([]domain.Notification{
+		{
+			User: appeal.CreatedBy,
+			Labels: map[string]string{
+				"appeal_id": appeal.ID,
+			},
+			Message: domain.NotificationMessage{
+				Type: domain.NotificationTypeAppealNeedsInfo,
+				Variables: map[string]interface{}{
+					"resource_name": fmt.Sprintf("%s (%s: %s)", appeal.Resource.Name, appeal.Resource.ProviderType, appeal.Resource.URN),
+					"role":          appeal.Role,
+					"account_id":    appeal.AccountID,
+					"appeal_id":     appeal.ID,
+					"requestor":     appeal.CreatedBy,
+					"approval_step": approval.Name,
+					"approver":      approvalAction.Actor,
+					"question":      approvalAction.Reason,
+				},
+			},
+		},
+	}); errs != nil {
+		for _, err1 := range errs {
+			s.logger.Error("failed to send notifications", "error", err1.Error())
+		}
+	}
+
+	return appeal, nil
+}
+
+// updateResolvedAppeal grants the access if the appeal has been approved, then persists the appeal
+func (s *Service) updateResolvedAppeal(ctx context.Context, appeal *domain.Appeal) error {
+	if appeal.Status == domain.AppealStatusApproved {
+		newGrant, revokedGrant, err := s.prepareGrant(ctx, appeal)
+		if err != nil {
+			return fmt.Errorf("preparing grant: %w", err)
+		}
+		newGrant.Resource = appeal.Resource
+		appeal.Grant = newGrant
+		if revokedGrant != nil {
+			if _, err := s.grantService.Revoke(ctx, revokedGrant.ID, domain.SystemActorName, RevokeReasonForExtension,
+				grant.SkipNotifications(),
+				grant.SkipRevokeAccessInProvider(),
+			); err != nil {
+				return fmt.Errorf("revoking previous grant: %w", err)
+			}
+		} else {
+			if err := s.GrantAccessToProvider(ctx, appeal); err != nil {
+				return fmt.Errorf("granting access: %w", err)
+			}
+		}
+		s.revokeDowngradedGrant(ctx, appeal)
+	}
+
+	if err := s.Update(ctx, appeal); err != nil {
+		if appeal.Grant != nil {
+			if err := s.providerService.RevokeAccess(ctx, *appeal.Grant); err != nil {
+				return fmt.Errorf("revoking access: %w", err)
+			}
+		}
+		return fmt.Errorf("updating appeal: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) Update(ctx context.Context, appeal *domain.Appeal) error {
+	return s.repo.Update(ctx, appeal)
+}
+
+func (s *Service) Cancel(ctx context.Context, id string) (*domain.Appeal, error) {
+	if id == "" {
+		return nil, ErrAppealIDEmptyParam
+	}
+
+	if !utils.IsValidUUID(id) {
+		return nil, InvalidError{AppealID: id}
+	}
+
+	appeal, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: check only appeal creator who is allowed to cancel the appeal
+
+	if !appeal.IsUpdatable() {
+		return nil, checkIfAppealStatusStillPending(appeal.Status)
+	}
+
+	appeal.Cancel()
+	if err := s.repo.Update(ctx, appeal); err != nil {
+		return nil, err
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyCancel, map[string]interface{}{
+		"appeal_id": id,
+	}); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	return appeal, nil
+}
+
+// UpdateAppeal applies the changes of the creator to an appeal that is pending or waiting for more information. The
+// approval chain is evaluated again for the updated appeal, decisions made on the previous version are kept in the
+// appeal revisions.
+func (s *Service) UpdateAppeal(ctx context.Context, u domain.AppealUpdate) (*domain.Appeal, error) {
+	if err := utils.ValidateStruct(u); err != nil {
+		return nil, err
+	}
+
+	appeal, err := s.GetByID(ctx, u.AppealID)
+	if err != nil {
+		return nil, err
+	}
+	if appeal.CreatedBy != u.Actor {
+		return nil, ErrAppealUpdateForbidden
+	}
+	if !appeal.IsUpdatable() {
+		return nil, checkIfAppealStatusStillPending(appeal.Status)
+	}
+
+	isRoleChanged := u.Role != "" && u.Role != appeal.Role
+	appeal.Update(u)
+
+	// the other pending appeals of the account are also used to check the separation of duties rules
+	pendingAppeals, err := s.repo.Find(ctx, &domain.ListAppealsFilter{
+		Statuses:   []string{domain.AppealStatusPending, domain.AppealStatusNeedsInfo},
+		AccountIDs: []string{appeal.AccountID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing pending appeals: %w", err)
+	}
+	if isRoleChanged {
+		for _, existing := range pendingAppeals {
+			if existing.ID != appeal.ID && existing.ResourceID == appeal.ResourceID && existing.Role == appeal.Role {
+				return nil, ErrAppealDuplicate
+			}
+		}
+	}
+
+	providers, err := s.getProvidersMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := getProvider(appeal, providers)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving provider: %w", err)
+	}
+	policy, err := s.getAppealPolicy(ctx, appeal)
+	if err != nil {
+		return nil, err
+	}
+
+	activeGrant, err := s.findActiveGrant(ctx, appeal)
+	if err != nil && err != ErrGrantNotFound {
+		return nil, err
+	}
+	if activeGrant != nil {
+		if err := s.checkExtensionEligibility(appeal, provider, policy, activeGrant); err != nil {
+			return nil, fmt.Errorf("checking grant extension eligibility: %w", err)
+		}
+	}
+
+	if err := s.providerService.ValidateAppeal(ctx, appeal, provider, policy); err != nil {
+		return nil, fmt.Errorf("validating appeal based on provider: %w", err)
+	}
+	permissions, err := s.getPermissions(ctx, provider.Config, appeal.Resource.Type, appeal.Role)
+	if err != nil {
+		return nil, fmt.Errorf("getting permissions list: %w", err)
+	}
+	appeal.Permissions = permissions
+	if err := validateAppealDurationConfig(appeal, policy); err != nil {
+		return nil, fmt.Errorf("validating appeal duration: %w", err)
+	}
+
+	if err := s.addRiskScore(ctx, appeal); err != nil {
+		return nil, fmt.Errorf("computing risk score: %w", err)
+	}
+
+	sodApprovalViolations, err := s.checkSoDViolations(ctx, appeal, pendingAppeals)
+	if err != nil {
+		return nil, err
+	}
+
+	previousApprovals := appeal.Approvals
+	if err := appeal.ApplyPolicy(policy); err != nil {
+		return nil, fmt.Errorf("populating approvals: %w", err)
+	}
+	if len(sodApprovalViolations) > 0 {
+		sodApproval, err := domain.GetSoDApproval(sodApprovalViolations, appeal, policy)
+		if err != nil {
+			return nil, fmt.Errorf("populating separation of duties approval: %w", err)
+		}
+		appeal.Approvals = append(appeal.Approvals, sodApproval)
+	}
+	appeal.Approvals = reuseApprovals(previousApprovals, appeal.Approvals)
+	if err := appeal.AdvanceApproval(policy); err != nil {
+		return nil, fmt.Errorf("initializing approval step statuses: %w", err)
+	}
+	appeal.Policy = nil
+
+	if err := s.updateResolvedAppeal(ctx, appeal); err != nil {
+		return nil, err
+	}
+	if err := s.updateApprovers(ctx, previousApprovals, appeal.Approvals); err != nil {
+		return nil, err
+	}
+	if err := s.deleteDroppedApprovals(ctx, previousApprovals, appeal.Approvals); err != nil {
+		return nil, err
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyUpdate, u); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	notifications := getAppealResolutionNotifications(appeal)
+	if appeal.Status == domain.AppealStatusPending {
+		notifications = append(notifications, s.getApprovalNotifications(ctx, appeal, appeal.GetPendingApprovals())...)
+	}
+	if len(notifications) > 0 {
+		if errs := s.notifier.Notify(notifications); errs != nil {
+			for _, err1 := range errs {
+				s.logger.Error("failed to send notifications", "error", err1.Error())
+			}
+		}
+	}
+
+	return appeal, nil
+}
+
+// reuseApprovals keeps the records of the previous approvals for the re-evaluated approvals of the same step. The
+// previous approvals are left untouched.
+func reuseApprovals(previous, approvals []*domain.Approval) []*domain.Approval {
+	for _, approval := range approvals {
+		for _, p := range previous {
+			if approval.Name == p.Name {
+				approval.ID = p.ID
+				approval.CreatedAt = p.CreatedAt
+				approval.UpdatedAt = TimeNow()
+				break
+			}
+		}
+	}
+	return approvals
+}
+
+// deleteDroppedApprovals removes the previous approvals that the re-evaluation no longer produces, e.g. a separation
+// of duties approval whose conflict was removed
+func (s *Service) deleteDroppedApprovals(ctx context.Context, previous, approvals []*domain.Approval) error {
+	for _, p := range previous {
+		dropped := true
+		for _, a := range approvals {
+			if a.ID == p.ID {
+				dropped = false
+				break
+			}
+		}
+		if dropped {
+			if err := s.approvalService.DeleteApproval(ctx, p.ID); err != nil {
+				return fmt.Errorf("removing approval %q: %w", p.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// updateApprovers syncs the approvers of the re-evaluated approvals, which are not saved along with the appeal
+func (s *Service) updateApprovers(ctx context.Context, previous, approvals []*domain.Approval) error {
+	previousApprovers := map[string][]string{}
+	for _, p := range previous {
+		previousApprovers[p.ID] = p.Approvers
+	}
+
+	for _, approval := range approvals {
+		for _, approver := range approval.Approvers {
+			if !utils.ContainsString(previousApprovers[approval.ID], approver) {
+				if err := s.approvalService.AddApprover(ctx, approval.ID, approver); err != nil {
+					return fmt.Errorf("adding approver %q: %w", approver, err)
+				}
+			}
+		}
+		for _, approver := range previousApprovers[approval.ID] {
+			if !utils.ContainsString(approval.Approvers, approver) {
+				if err := s.approvalService.DeleteApprover(ctx, approval.ID, approver); err != nil {
+					return fmt.Errorf("removing approver %q: %w", approver, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// CreateComment posts a comment on the appeal and notifies the other participants of the discussion: the appeal
+// creator, the approvers of the current approval step and their delegates
+func (s *Service) CreateComment(ctx context.Context, c *domain.Comment) error {
+	c.Body = strings.TrimSpace(c.Body)
+	if err := s.validator.Struct(c); err != nil {
+		return err
+	}
+
+	appeal, err := s.GetByID(ctx, c.AppealID)
+	if err != nil {
+		return fmt.Errorf("getting appeal details: %w", err)
+	}
+	if err := s.checkCommentAccess(ctx, appeal, c.CreatedBy); err != nil {
+		return err
+	}
+
+	if err := s.commentRepo.Create(ctx, c); err != nil {
+		return fmt.Errorf("inserting comment into db: %w", err)
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyComment, c); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	if notifications := s.getCommentNotifications(ctx, appeal, c); len(notifications) > 0 {
+		if errs := s.notifier.Notify(notifications); errs != nil {
+			for _, err1 := range errs {
+				s.logger.Error("failed to send notifications", "error", err1.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListComments returns the comments of an appeal, sorted from the oldest unless specified otherwise. Only the
+// participants of the appeal are allowed to read them.
+func (s *Service) ListComments(ctx context.Context, actor string, filter domain.ListCommentsFilter) ([]*domain.Comment, error) {
+	appeal, err := s.GetByID(ctx, filter.AppealID)
+	if err != nil {
+		return nil, fmt.Errorf("getting appeal details: %w", err)
+	}
+	if err := s.checkCommentAccess(ctx, appeal, actor); err != nil {
+		return nil, err
+	}
+
+	return s.commentRepo.List(ctx, filter)
+}
+
+// checkCommentAccess allows the appeal creator, the account, the approvers of any step and their delegates to take
+// part in the discussion of the appeal
+func (s *Service) checkCommentAccess(ctx context.Context, appeal *domain.Appeal, actor string) error {
+	if actor == appeal.CreatedBy || actor == appeal.AccountID {
+		return nil
+	}
+
+	var approvers []string
+	for _, approval := range appeal.Approvals {
+		if utils.ContainsString(approval.Approvers, actor) {
+			return nil
+		}
+		approvers = append(approvers, approval.Approvers...)
+	}
+
+	delegations, err := s.getDelegations(ctx, appeal, approvers)
+	if err != nil {
+		return err
+	}
+	for _, d := range delegations {
+		if d.Delegate == actor {
+			return nil
+		}
+	}
+
+	return ErrCommentForbidden
+}
+
 func (s *Service) AddApprover(ctx context.Context, appealID, approvalID, email string) (*domain.Appeal, error) {
 	if err := s.validator.Var(email, "email"); err != nil {
 		return nil, fmt.Errorf("%w: %s", ErrApproverEmail, err)
@@ -656,110 +1428,669 @@ func (s *Service) AddApprover(ctx context.Context, appealID, approvalID, email s
 			// approval is automatic (strategy: auto) that is still on blocked
 			return nil, fmt.Errorf("%w: can't modify approvers for approval with strategy auto", ErrUnableToAddApprover)
 		}
-	default:
-		return nil, fmt.Errorf("%w: can't add approver to approval with %q status", ErrUnableToAddApprover, approval.Status)
+	default:
+		return nil, fmt.Errorf("%w: can't add approver to approval with %q status", ErrUnableToAddApprover, approval.Status)
+	}
+
+	if err := s.approvalService.AddApprover(ctx, approval.ID, email); err != nil {
+		return nil, fmt.Errorf("adding new approver: %w", err)
+	}
+	approval.Approvers = append(approval.Approvers, email)
+
+	if err := s.auditLogger.Log(ctx, AuditKeyAddApprover, approval); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	duration := domain.PermanentDurationLabel
+	if !appeal.IsDurationEmpty() {
+		duration, err = utils.GetReadableDuration(appeal.Options.Duration)
+		if err != nil {
+			s.logger.Error("failed to get readable duration", "error", err, "appeal_id", appeal.ID)
+		}
+	}
+
+	if errs := s.notifier.Notify([]domain.Notification{
+		{
+			User: email,
+			Labels: map[string]string{
+				"appeal_id": appeal.ID,
+			},
+			Message: domain.NotificationMessage{
+				Type: domain.NotificationTypeApproverNotification,
+				Variables: map[string]interface{}{
+					"resource_name": fmt.Sprintf("%s (%s: %s)", appeal.Resource.Name, appeal.Resource.ProviderType, appeal.Resource.URN),
+					"role":          appeal.Role,
+					"requestor":     appeal.CreatedBy,
+					"appeal_id":     appeal.ID,
+					"account_id":    appeal.AccountID,
+					"account_type":  appeal.AccountType,
+					"provider_type": appeal.Resource.ProviderType,
+					"resource_type": appeal.Resource.Type,
+					"created_at":    appeal.CreatedAt,
+					"approval_step": approval.Name,
+					"actor":         email,
+					"details":       appeal.Details,
+					"duration":      duration,
+					"creator":       appeal.Creator,
+				},
+			},
+		},
+	}); errs != nil {
+		for _, err1 := range errs {
+			s.logger.Error("failed to send notifications", "error", err1.Error())
+		}
+	}
+
+	return appeal, nil
+}
+
+func (s *Service) DeleteApprover(ctx context.Context, appealID, approvalID, email string) (*domain.Appeal, error) {
+	if err := s.validator.Var(email, "email"); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrApproverEmail, err)
+	}
+
+	appeal, approval, err := s.getApproval(ctx, appealID, approvalID)
+	if err != nil {
+		return nil, err
+	}
+	if appeal.Status != domain.AppealStatusPending {
+		return nil, fmt.Errorf("%w: can't delete approver to appeal with %q status", ErrUnableToDeleteApprover, appeal.Status)
+	}
+
+	switch approval.Status {
+	case domain.ApprovalStatusPending:
+		break
+	case domain.ApprovalStatusBlocked:
+		// check if approval type is auto
+		// this approach is the quickest way to assume that approval is auto, otherwise need to fetch the policy details and lookup the approval type which takes more time
+		if approval.Approvers == nil || len(approval.Approvers) == 0 {
+			// approval is automatic (strategy: auto) that is still on blocked
+			return nil, fmt.Errorf("%w: can't modify approvers for approval with strategy auto", ErrUnableToDeleteApprover)
+		}
+	default:
+		return nil, fmt.Errorf("%w: can't delete approver to approval with %q status", ErrUnableToDeleteApprover, approval.Status)
+	}
+
+	if len(approval.Approvers) == 1 {
+		return nil, fmt.Errorf("%w: can't delete if there's only one approver", ErrUnableToDeleteApprover)
+	}
+
+	if err := s.approvalService.DeleteApprover(ctx, approvalID, email); err != nil {
+		return nil, err
+	}
+
+	var newApprovers []string
+	for _, a := range approval.Approvers {
+		if a != email {
+			newApprovers = append(newApprovers, a)
+		}
+	}
+	approval.Approvers = newApprovers
+
+	if err := s.auditLogger.Log(ctx, AuditKeyDeleteApprover, approval); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	return appeal, nil
+}
+
+// ExpirePendingAppeals marks pending appeals that exceed their policy's pending TTL as expired and notifies the
+// creators. It returns the expired appeals.
+func (s *Service) ExpirePendingAppeals(ctx context.Context) ([]*domain.Appeal, error) {
+	policiesMap, err := s.getPoliciesMap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing policies: %w", err)
+	}
+
+	// the shortest TTL narrows down the candidates, each appeal is then checked against its own policy
+	var minTTL time.Duration
+	for _, versions := range policiesMap {
+		for _, p := range versions {
+			ttl, err := p.AppealConfig.GetPendingTTL()
+			if err != nil {
+				s.logger.Error("invalid pending ttl", "policy_id", p.ID, "policy_version", p.Version, "error", err)
+				continue
+			}
+			if ttl > 0 && (minTTL == 0 || ttl < minTTL) {
+				minTTL = ttl
+			}
+		}
+	}
+	if minTTL == 0 {
+		return nil, nil
+	}
+
+	now := TimeNow()
+	candidates, err := s.repo.Find(ctx, &domain.ListAppealsFilter{
+		Statuses:          []string{domain.AppealStatusPending, domain.AppealStatusNeedsInfo},
+		CreatedAtLessThan: now.Add(-minTTL),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing pending appeals: %w", err)
+	}
+
+	var expiredAppeals []*domain.Appeal
+	var notifications []domain.Notification
+	for _, appeal := range candidates {
+		policy := policiesMap[appeal.PolicyID][appeal.PolicyVersion]
+		if policy == nil {
+			continue
+		}
+		ttl, err := policy.AppealConfig.GetPendingTTL()
+		if err != nil || ttl == 0 || now.Before(appeal.CreatedAt.Add(ttl)) {
+			continue
+		}
+
+		appeal.Expire()
+		if err := s.repo.Update(ctx, appeal); err != nil {
+			s.logger.Error("failed to expire appeal", "appeal_id", appeal.ID, "error", err)
+			continue
+		}
+
+		if err := s.auditLogger.Log(ctx, AuditKeyExpire, map[string]interface{}{
+			"appeal_id":   appeal.ID,
+			"pending_ttl": policy.AppealConfig.PendingTTL,
+		}); err != nil {
+			s.logger.Error("failed to record audit log", "error", err)
+		}
+
+		var resourceName string
+		if appeal.Resource != nil {
+			resourceName = fmt.Sprintf("%s (%s: %s)", appeal.Resource.Name, appeal.Resource.ProviderType, appeal.Resource.URN)
+		}
+		notifications = append(notifications, domain.Notification{
+			User: appeal.CreatedBy,
+			Labels: map[string]string{
+				"appeal_id": appeal.ID,
+			},
+			Message: domain.NotificationMessage{
+				Type: domain.NotificationTypeAppealExpired,
+				Variables: map[string]interface{}{
+					"resource_name": resourceName,
+					"role":          appeal.Role,
+					"account_id":    appeal.AccountID,
+					"appeal_id":     appeal.ID,
+					"requestor":     appeal.CreatedBy,
+					"pending_ttl":   policy.AppealConfig.PendingTTL,
+				},
+			},
+		})
+
+		expiredAppeals = append(expiredAppeals, appeal)
+	}
+
+	if len(notifications) > 0 {
+		if errs := s.notifier.Notify(notifications); errs != nil {
+			for _, err1 := range errs {
+				s.logger.Error("failed to send notifications", "error", err1.Error())
+			}
+		}
+	}
+
+	return expiredAppeals, nil
+}
+
+// RemindBreakGlassReviews notifies the reviewers of break-glass appeals which haven't been reviewed yet
+func (s *Service) RemindBreakGlassReviews(ctx context.Context) ([]*domain.Appeal, error) {
+	approvals, err := s.approvalService.ListApprovals(ctx, &domain.ListApprovalsFilter{
+		Statuses:       []string{domain.ApprovalStatusPending},
+		AppealStatuses: []string{domain.AppealStatusApproved},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing pending break-glass reviews: %w", err)
+	}
+
+	var appeals []*domain.Appeal
+	var notifications []domain.Notification
+	visited := map[string]bool{}
+	for _, approval := range approvals {
+		if approval.Name != domain.BreakGlassReviewApprovalName || visited[approval.AppealID] {
+			continue
+		}
+		visited[approval.AppealID] = true
+
+		appeal, err := s.GetByID(ctx, approval.AppealID)
+		if err != nil {
+			s.logger.Error("failed to get appeal details", "appeal_id", approval.AppealID, "error", err)
+			continue
+		}
+		review := appeal.GetBreakGlassReview()
+		if review == nil || review.Status != domain.ApprovalStatusPending {
+			continue
+		}
+
+		appeals = append(appeals, appeal)
+		notifications = append(notifications, getBreakGlassReviewNotifications(appeal)...)
+	}
+
+	if len(notifications) > 0 {
+		if errs := s.notifier.Notify(notifications); errs != nil {
+			for _, err1 := range errs {
+				s.logger.Error("failed to send notifications", "error", err1.Error())
+			}
+		}
+	}
+
+	return appeals, nil
+}
+
+// HandleOverdueApprovals escalates or resolves pending approvals that passed their step timeout.
+// It returns the appeals that have been updated.
+func (s *Service) HandleOverdueApprovals(ctx context.Context) ([]*domain.Appeal, error) {
+	pendingAppeals, err := s.repo.Find(ctx, &domain.ListAppealsFilter{
+		Statuses: []string{domain.AppealStatusPending},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing pending appeals: %w", err)
+	}
+
+	policiesCache := map[string]map[uint]*domain.Policy{}
+	var updatedAppeals []*domain.Appeal
+	for _, a := range pendingAppeals {
+		policy, err := s.getCachedPolicy(ctx, policiesCache, a.PolicyID, a.PolicyVersion)
+		if err != nil {
+			s.logger.Error("failed to get policy", "policy_id", a.PolicyID, "policy_version", a.PolicyVersion, "error", err)
+			continue
+		}
+		if policy == nil || !hasStepTimeout(policy) {
+			continue
+		}
+
+		appeal, err := s.GetByID(ctx, a.ID)
+		if err != nil {
+			s.logger.Error("failed to get appeal", "appeal_id", a.ID, "error", err)
+			continue
+		}
+		appeal.Policy = policy
+
+		isUpdated := false
+		for _, approval := range appeal.GetPendingApprovals() {
+			// the appeal might get rejected by another overdue step of the same parallel group
+			if appeal.Status != domain.AppealStatusPending || approval.Status != domain.ApprovalStatusPending {
+				continue
+			}
+			updated, err := s.handleOverdueApproval(ctx, appeal, approval)
+			if err != nil {
+				s.logger.Error("failed to handle overdue approval", "appeal_id", appeal.ID, "approval", approval.Name, "error", err)
+				continue
+			}
+			isUpdated = isUpdated || updated
+		}
+		if !isUpdated {
+			continue
+		}
+
+		updatedAppeals = append(updatedAppeals, appeal)
+	}
+
+	return updatedAppeals, nil
+}
+
+// handleOverdueApproval escalates or resolves the approval once it stays pending longer than the step timeout.
+// Returns false if the approval is not overdue or the step has nothing configured for it.
+func (s *Service) handleOverdueApproval(ctx context.Context, appeal *domain.Appeal, approval *domain.Approval) (bool, error) {
+	if approval.Index >= len(appeal.Policy.Steps) {
+		return false, nil
+	}
+	step := appeal.Policy.Steps[approval.Index]
+	if step.Timeout == "" {
+		return false, nil
+	}
+	timeout, err := time.ParseDuration(step.Timeout)
+	if err != nil {
+		return false, fmt.Errorf("invalid step timeout: %w", err)
+	}
+	if TimeNow().Before(appeal.GetApprovalPendingSince(approval).Add(timeout)) {
+		return false, nil
+	}
+
+	if len(step.EscalateTo) > 0 && approval.EscalatedAt == nil {
+		return true, s.escalateApproval(ctx, appeal, approval, step)
+	} else if step.OnTimeout != "" {
+		return true, s.resolveOverdueApproval(ctx, appeal, approval, step)
+	}
+	return false, nil
+}
+
+// escalateApproval adds the escalation approvers to the overdue approval and notifies them
+func (s *Service) escalateApproval(ctx context.Context, appeal *domain.Appeal, approval *domain.Approval, step *domain.Step) error {
+	escalationApprovers, err := step.ResolveEscalationApprovers(appeal, appeal.Policy)
+	if err != nil {
+		return fmt.Errorf("resolving escalation approvers: %w", err)
+	}
+
+	var newApprovers []string
+	for _, approver := range escalationApprovers {
+		if utils.ContainsString(approval.Approvers, approver) {
+			continue
+		}
+		if err := s.approvalService.AddApprover(ctx, approval.ID, approver); err != nil {
+			return fmt.Errorf("adding escalation approver %q: %w", approver, err)
+		}
+		approval.Approvers = append(approval.Approvers, approver)
+		newApprovers = append(newApprovers, approver)
+	}
+
+	now := TimeNow()
+	approval.EscalatedAt = &now
+	approval.UpdatedAt = now
+	if err := s.Update(ctx, appeal); err != nil {
+		return fmt.Errorf("updating appeal: %w", err)
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyEscalate, map[string]interface{}{
+		"appeal_id":    appeal.ID,
+		"approval":     approval.Name,
+		"timeout":      step.Timeout,
+		"escalated_to": newApprovers,
+	}); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	var notifications []domain.Notification
+	for _, approver := range newApprovers {
+		notifications = append(notifications, domain.Notification{
+			User: approver,
+			Labels: map[string]string{
+				"appeal_id": appeal.ID,
+			},
+			Message: domain.NotificationMessage{
+				Type: domain.NotificationTypeApprovalEscalated,
+				Variables: map[string]interface{}{
+					"resource_name": fmt.Sprintf("%s (%s: %s)", appeal.Resource.Name, appeal.Resource.ProviderType, appeal.Resource.URN),
+					"role":          appeal.Role,
+					"requestor":     appeal.CreatedBy,
+					"appeal_id":     appeal.ID,
+					"account_id":    appeal.AccountID,
+					"approval_step": approval.Name,
+					"timeout":       step.Timeout,
+					"approvers":     approval.Approvers,
+					"actor":         approver,
+				},
+			},
+		})
+	}
+	if len(notifications) > 0 {
+		if errs := s.notifier.Notify(notifications); errs != nil {
+			for _, err1 := range errs {
+				s.logger.Error("failed to send notifications", "error", err1.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveOverdueApproval rejects or skips the overdue approval according to the step configuration
+func (s *Service) resolveOverdueApproval(ctx context.Context, appeal *domain.Appeal, approval *domain.Approval, step *domain.Step) error {
+	pendingApprovals := appeal.GetPendingApprovals()
+	actor := domain.SystemActorName
+	approval.Actor = &actor
+	approval.Reason = fmt.Sprintf("approval step timed out after %s", step.Timeout)
+	approval.UpdatedAt = TimeNow()
+
+	switch step.OnTimeout {
+	case domain.StepTimeoutActionReject:
+		approval.Reject()
+		appeal.Reject()
+		skipUnresolvedApprovals(appeal)
+	case domain.StepTimeoutActionSkip:
+		approval.Skip()
+		for i, a := range appeal.Approvals {
+			if a == approval {
+				appeal.UnblockNextApprovals(i)
+			}
+		}
+		if err := appeal.AdvanceApproval(appeal.Policy); err != nil {
+			return fmt.Errorf("advancing approval: %w", err)
+		}
+	default:
+		return fmt.Errorf("invalid on_timeout value: %q", step.OnTimeout)
+	}
+
+	if err := s.updateResolvedAppeal(ctx, appeal); err != nil {
+		return err
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyTimeout, map[string]interface{}{
+		"appeal_id":  appeal.ID,
+		"approval":   approval.Name,
+		"timeout":    step.Timeout,
+		"on_timeout": step.OnTimeout,
+	}); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	notifications := getAppealResolutionNotifications(appeal)
+	if appeal.Status == domain.AppealStatusPending {
+		notifications = append(notifications, s.getApprovalNotifications(ctx, appeal, getUnblockedApprovals(appeal, pendingApprovals))...)
+	}
+	if len(notifications) > 0 {
+		if errs := s.notifier.Notify(notifications); errs != nil {
+			for _, err1 := range errs {
+				s.logger.Error("failed to send notifications", "error", err1.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateExternalApproval applies the decision of an approval webhook that replied with a pending decision. Only the
+// callback actors of the webhook are allowed to submit it.
+func (s *Service) UpdateExternalApproval(ctx context.Context, approvalAction domain.ApprovalAction) (*domain.Appeal, error) {
+	if err := utils.ValidateStruct(approvalAction); err != nil {
+		return nil, err
+	}
+	if approvalAction.Action != domain.AppealActionNameApprove && approvalAction.Action != domain.AppealActionNameReject {
+		return nil, ErrActionInvalidValue
+	}
+
+	appeal, err := s.GetByID(ctx, approvalAction.AppealID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkIfAppealStatusStillPending(appeal.Status); err != nil {
+		return nil, err
+	}
+
+	approval := appeal.GetApproval(approvalAction.ApprovalName)
+	if approval == nil {
+		return nil, ErrApprovalNotFound
+	}
+	policy, err := s.getAppealPolicy(ctx, appeal)
+	if err != nil {
+		return nil, err
+	}
+	step := getExternalStep(policy, approval)
+	if step == nil {
+		return nil, ErrApprovalNotExternal
+	}
+	if approval.Status != domain.ApprovalStatusPending {
+		return nil, checkApprovalStatus(approval.Status)
+	}
+	if !s.externalApprover.IsCallbackActor(step.Webhook, approvalAction.Actor) {
+		return nil, ErrActionForbidden
+	}
+
+	if err := s.resolveExternalApproval(ctx, appeal, policy, approval, approvalAction); err != nil {
+		return nil, err
+	}
+
+	return appeal, nil
+}
+
+// RequestExternalApprovals calls the approval webhooks of the pending external steps. This is the only caller of the
+// webhooks: a step becoming pending through a request, including UpdateExternalApproval, or through the approval step
+// timeout job is picked up here on the next run, and again if the webhook failed or replied with a pending decision
+func (s *Service) RequestExternalApprovals(ctx context.Context) ([]*domain.Appeal, error) {
+	pendingAppeals, err := s.repo.Find(ctx, &domain.ListAppealsFilter{
+		Statuses: []string{domain.AppealStatusPending},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing pending appeals: %w", err)
+	}
+	policiesMap, err := s.getPoliciesMap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing policies: %w", err)
+	}
+
+	var requestedAppeals []*domain.Appeal
+	for _, a := range pendingAppeals {
+		policy := policiesMap[a.PolicyID][a.PolicyVersion]
+		if policy == nil || !hasExternalStep(policy) {
+			continue
+		}
+
+		appeal, err := s.GetByID(ctx, a.ID)
+		if err != nil {
+			s.logger.Error("failed to get appeal", "appeal_id", a.ID, "error", err)
+			continue
+		}
+		if getPendingExternalApproval(appeal, policy, nil) == nil {
+			continue
+		}
+		s.requestExternalApprovals(ctx, appeal, policy)
+		requestedAppeals = append(requestedAppeals, appeal)
 	}
 
-	if err := s.approvalService.AddApprover(ctx, approval.ID, email); err != nil {
-		return nil, fmt.Errorf("adding new approver: %w", err)
-	}
-	approval.Approvers = append(approval.Approvers, email)
+	return requestedAppeals, nil
+}
 
-	if err := s.auditLogger.Log(ctx, AuditKeyAddApprover, approval); err != nil {
-		s.logger.Error("failed to record audit log", "error", err)
-	}
+// requestExternalApprovals calls the approval webhooks of the pending external steps, and applies the decisions
+// returned right away. Failed calls are logged and the steps stay pending until the next request.
+func (s *Service) requestExternalApprovals(ctx context.Context, appeal *domain.Appeal, policy *domain.Policy) {
+	requested := map[*domain.Approval]bool{}
+	for appeal.Status == domain.AppealStatusPending {
+		approval := getPendingExternalApproval(appeal, policy, requested)
+		if approval == nil {
+			return
+		}
+		requested[approval] = true
+		step := policy.Steps[approval.Index]
 
-	duration := domain.PermanentDurationLabel
-	if !appeal.IsDurationEmpty() {
-		duration, err = utils.GetReadableDuration(appeal.Options.Duration)
+		res, err := s.externalApprover.Evaluate(ctx, step.Webhook, &domain.ExternalApprovalRequest{
+			AppealID:     appeal.ID,
+			ApprovalName: approval.Name,
+			Appeal:       appeal,
+		})
+		auditData := map[string]interface{}{
+			"appeal_id": appeal.ID,
+			"approval":  approval.Name,
+			"webhook":   step.Webhook,
+		}
 		if err != nil {
-			s.logger.Error("failed to get readable duration", "error", err, "appeal_id", appeal.ID)
+			auditData["error"] = err.Error()
+		} else {
+			auditData["decision"] = res.Decision
+			auditData["reason"] = res.Reason
+		}
+		if err := s.auditLogger.Log(ctx, AuditKeyExternal, auditData); err != nil {
+			s.logger.Error("failed to record audit log", "error", err)
+		}
+		if err != nil {
+			s.logger.Error("failed to request external approval", "appeal_id", appeal.ID, "approval", approval.Name, "webhook", step.Webhook, "error", err)
+			continue
+		}
+		if res.Decision == domain.ExternalApprovalDecisionPending {
+			continue
 		}
-	}
 
-	if errs := s.notifier.Notify([]domain.Notification{
-		{
-			User: email,
-			Labels: map[string]string{
-				"appeal_id": appeal.ID,
-			},
-			Message: domain.NotificationMessage{
-				Type: domain.NotificationTypeApproverNotification,
-				Variables: map[string]interface{}{
-					"resource_name": fmt.Sprintf("%s (%s: %s)", appeal.Resource.Name, appeal.Resource.ProviderType, appeal.Resource.URN),
-					"role":          appeal.Role,
-					"requestor":     appeal.CreatedBy,
-					"appeal_id":     appeal.ID,
-					"account_id":    appeal.AccountID,
-					"account_type":  appeal.AccountType,
-					"provider_type": appeal.Resource.ProviderType,
-					"resource_type": appeal.Resource.Type,
-					"created_at":    appeal.CreatedAt,
-					"approval_step": approval.Name,
-					"actor":         email,
-					"details":       appeal.Details,
-					"duration":      duration,
-					"creator":       appeal.Creator,
-				},
-			},
-		},
-	}); errs != nil {
-		for _, err1 := range errs {
-			s.logger.Error("failed to send notifications", "error", err1.Error())
+		if err := s.resolveExternalApproval(ctx, appeal, policy, approval, domain.ApprovalAction{
+			AppealID:     appeal.ID,
+			ApprovalName: approval.Name,
+			Actor:        domain.SystemActorName,
+			Action:       res.Decision,
+			Reason:       res.Reason,
+		}); err != nil {
+			s.logger.Error("failed to resolve external approval", "appeal_id", appeal.ID, "approval", approval.Name, "error", err)
+			return
 		}
 	}
-
-	return appeal, nil
 }
 
-func (s *Service) DeleteApprover(ctx context.Context, appealID, approvalID, email string) (*domain.Appeal, error) {
-	if err := s.validator.Var(email, "email"); err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrApproverEmail, err)
-	}
+// resolveExternalApproval approves or rejects the external step, then moves the appeal forward the same way as
+// manual steps
+func (s *Service) resolveExternalApproval(ctx context.Context, appeal *domain.Appeal, policy *domain.Policy, approval *domain.Approval, approvalAction domain.ApprovalAction) error {
+	pendingApprovals := appeal.GetPendingApprovals()
 
-	appeal, approval, err := s.getApproval(ctx, appealID, approvalID)
-	if err != nil {
-		return nil, err
-	}
-	if appeal.Status != domain.AppealStatusPending {
-		return nil, fmt.Errorf("%w: can't delete approver to appeal with %q status", ErrUnableToDeleteApprover, appeal.Status)
+	if err := approval.AddDecision(&domain.ApprovalDecision{
+		Actor:     approvalAction.Actor,
+		Action:    approvalAction.Action,
+		Reason:    approvalAction.Reason,
+		CreatedAt: TimeNow(),
+	}); err != nil {
+		return err
 	}
-
-	switch approval.Status {
-	case domain.ApprovalStatusPending:
-		break
-	case domain.ApprovalStatusBlocked:
-		// check if approval type is auto
-		// this approach is the quickest way to assume that approval is auto, otherwise need to fetch the policy details and lookup the approval type which takes more time
-		if approval.Approvers == nil || len(approval.Approvers) == 0 {
-			// approval is automatic (strategy: auto) that is still on blocked
-			return nil, fmt.Errorf("%w: can't modify approvers for approval with strategy auto", ErrUnableToDeleteApprover)
+	approval.Actor = &approvalAction.Actor
+	approval.Reason = approvalAction.Reason
+	approval.UpdatedAt = TimeNow()
+
+	var auditKey string
+	switch approvalAction.Action {
+	case domain.AppealActionNameApprove:
+		approval.Approve()
+		for i, a := range appeal.Approvals {
+			if a == approval {
+				appeal.UnblockNextApprovals(i)
+			}
 		}
+		if err := appeal.AdvanceApproval(policy); err != nil {
+			return err
+		}
+		auditKey = AuditKeyApprove
+	case domain.AppealActionNameReject:
+		approval.Reject()
+		appeal.Reject()
+		skipUnresolvedApprovals(appeal)
+		auditKey = AuditKeyReject
 	default:
-		return nil, fmt.Errorf("%w: can't delete approver to approval with %q status", ErrUnableToDeleteApprover, approval.Status)
+		return ErrActionInvalidValue
 	}
 
-	if len(approval.Approvers) == 1 {
-		return nil, fmt.Errorf("%w: can't delete if there's only one approver", ErrUnableToDeleteApprover)
+	if err := s.updateResolvedAppeal(ctx, appeal); err != nil {
+		return err
 	}
 
-	if err := s.approvalService.DeleteApprover(ctx, approvalID, email); err != nil {
-		return nil, err
+	notifications := getAppealResolutionNotifications(appeal)
+	if appeal.Status == domain.AppealStatusPending {
+		notifications = append(notifications, s.getApprovalNotifications(ctx, appeal, getUnblockedApprovals(appeal, pendingApprovals))...)
 	}
-
-	var newApprovers []string
-	for _, a := range approval.Approvers {
-		if a != email {
-			newApprovers = append(newApprovers, a)
+	if len(notifications) > 0 {
+		if errs := s.notifier.Notify(notifications); errs != nil {
+			for _, err1 := range errs {
+				s.logger.Error("failed to send notifications", "error", err1.Error())
+			}
 		}
 	}
-	approval.Approvers = newApprovers
 
-	if err := s.auditLogger.Log(ctx, AuditKeyDeleteApprover, approval); err != nil {
+	if err := s.auditLogger.Log(ctx, auditKey, approvalAction); err != nil {
 		s.logger.Error("failed to record audit log", "error", err)
 	}
 
-	return appeal, nil
+	return nil
+}
+
+// getExternalStep returns the policy step of the approval if it is resolved by an approval webhook
+func getExternalStep(policy *domain.Policy, approval *domain.Approval) *domain.Step {
+	if policy == nil || approval.Index >= len(policy.Steps) {
+		return nil
+	}
+	step := policy.Steps[approval.Index]
+	if step.Strategy != domain.ApprovalStepStrategyExternal {
+		return nil
+	}
+	return step
+}
+
+// getPendingExternalApproval returns the first pending external step of the appeal, leaving out the excluded ones
+func getPendingExternalApproval(appeal *domain.Appeal, policy *domain.Policy, excluded map[*domain.Approval]bool) *domain.Approval {
+	for _, approval := range appeal.Approvals {
+		if approval.Status == domain.ApprovalStatusPending && !excluded[approval] && getExternalStep(policy, approval) != nil {
+			return approval
+		}
+	}
+	return nil
 }
 
 func (s *Service) getApproval(ctx context.Context, appealID, approvalID string) (*domain.Appeal, *domain.Approval, error) {
@@ -858,12 +2189,38 @@ func (s *Service) getPoliciesMap(ctx context.Context) (map[string]map[uint]*doma
 	return policiesMap, nil
 }
 
-func (s *Service) getApprovalNotifications(appeal *domain.Appeal) []domain.Notification {
+// getCachedPolicy returns the policy of the given id and version from the cache, fetching each policy version only
+// once. A policy that failed to be fetched is cached as nil.
+func (s *Service) getCachedPolicy(ctx context.Context, cache map[string]map[uint]*domain.Policy, id string, version uint) (*domain.Policy, error) {
+	if p, ok := cache[id][version]; ok {
+		return p, nil
+	}
+	if cache[id] == nil {
+		cache[id] = map[uint]*domain.Policy{}
+	}
+	p, err := s.policyService.GetOne(ctx, id, version)
+	cache[id][version] = p
+	return p, err
+}
+
+// getApprovalNotifications asks the approvers of the given approvals, along with their delegates, to review the appeal
+func (s *Service) getApprovalNotifications(ctx context.Context, appeal *domain.Appeal, approvals []*domain.Approval) []domain.Notification {
 	notifications := []domain.Notification{}
-	approval := appeal.GetNextPendingApproval()
+	if len(approvals) == 0 {
+		return notifications
+	}
+
+	var approvers []string
+	for _, approval := range approvals {
+		approvers = append(approvers, approval.Approvers...)
+	}
+	delegations, err := s.getDelegations(ctx, appeal, approvers)
+	if err != nil {
+		// the approvers are still notified if their delegates can't be resolved
+		s.logger.Error("failed to resolve delegations", "error", err, "appeal_id", appeal.ID)
+	}
 
 	duration := domain.PermanentDurationLabel
-	var err error
 	if !appeal.IsDurationEmpty() {
 		duration, err = utils.GetReadableDuration(appeal.Options.Duration)
 		if err != nil {
@@ -871,8 +2228,15 @@ func (s *Service) getApprovalNotifications(appeal *domain.Appeal) []domain.Notif
 		}
 	}
 
-	if approval != nil {
-		for _, approver := range approval.Approvers {
+	for _, approval := range approvals {
+		recipients := append([]string{}, approval.Approvers...)
+		for _, d := range delegations {
+			if utils.ContainsString(approval.Approvers, d.Delegator) && !utils.ContainsString(recipients, d.Delegate) {
+				recipients = append(recipients, d.Delegate)
+			}
+		}
+
+		for _, approver := range recipients {
 			notifications = append(notifications, domain.Notification{
 				User: approver,
 				Labels: map[string]string{
@@ -903,6 +2267,239 @@ func (s *Service) getApprovalNotifications(appeal *domain.Appeal) []domain.Notif
 	return notifications
 }
 
+// getUnblockedApprovals returns the pending approvals that were not pending yet before the appeal got updated
+func getUnblockedApprovals(appeal *domain.Appeal, previouslyPending []*domain.Approval) []*domain.Approval {
+	var approvals []*domain.Approval
+	for _, approval := range appeal.GetPendingApprovals() {
+		isPreviouslyPending := false
+		for _, prev := range previouslyPending {
+			if prev == approval {
+				isPreviouslyPending = true
+				break
+			}
+		}
+		if !isPreviouslyPending {
+			approvals = append(approvals, approval)
+		}
+	}
+	return approvals
+}
+
+func (s *Service) getCommentNotifications(ctx context.Context, appeal *domain.Appeal, c *domain.Comment) []domain.Notification {
+	recipients := []string{appeal.CreatedBy}
+	for _, approval := range appeal.GetPendingApprovals() {
+		recipients = append(recipients, approval.Approvers...)
+	}
+	delegations, err := s.getDelegations(ctx, appeal, recipients[1:])
+	if err != nil {
+		// the approvers are still notified if their delegates can't be resolved
+		s.logger.Error("failed to resolve delegations", "error", err, "appeal_id", appeal.ID)
+	}
+	for _, d := range delegations {
+		recipients = append(recipients, d.Delegate)
+	}
+
+	notifications := []domain.Notification{}
+	notified := map[string]bool{c.CreatedBy: true}
+	for _, recipient := range recipients {
+		if notified[recipient] {
+			continue
+		}
+		notified[recipient] = true
+
+		variables := map[string]interface{}{
+			"appeal_id":     appeal.ID,
+			"role":          appeal.Role,
+			"account_id":    appeal.AccountID,
+			"requestor":     appeal.CreatedBy,
+			"commenter":     c.CreatedBy,
+			"comment":       c.Body,
+			"resource_name": "",
+		}
+		if appeal.Resource != nil {
+			variables["resource_name"] = fmt.Sprintf("%s (%s: %s)", appeal.Resource.Name, appeal.Resource.ProviderType, appeal.Resource.URN)
+		}
+		notifications = append(notifications, domain.Notification{
+			User: recipient,
+			Labels: map[string]string{
+				"appeal_id": appeal.ID,
+			},
+			Message: domain.NotificationMessage{
+				Type:      domain.NotificationTypeNewComment,
+				Variables: variables,
+			},
+		})
+	}
+	return notifications
+}
+
+// getBreakGlassReviewNotifications asks the reviewers to review a break-glass access that has been granted
+func getBreakGlassReviewNotifications(appeal *domain.Appeal) []domain.Notification {
+	notifications := []domain.Notification{}
+	review := appeal.GetBreakGlassReview()
+	if review == nil || review.Status != domain.ApprovalStatusPending {
+		return notifications
+	}
+
+	for _, reviewer := range review.Approvers {
+		notifications = append(notifications, domain.Notification{
+			User: reviewer,
+			Labels: map[string]string{
+				"appeal_id": appeal.ID,
+			},
+			Message: domain.NotificationMessage{
+				Type: domain.NotificationTypeBreakGlassReview,
+				Variables: map[string]interface{}{
+					"resource_name": fmt.Sprintf("%s (%s: %s)", appeal.Resource.Name, appeal.Resource.ProviderType, appeal.Resource.URN),
+					"role":          appeal.Role,
+					"requestor":     appeal.CreatedBy,
+					"appeal_id":     appeal.ID,
+					"account_id":    appeal.AccountID,
+					"approval_step": review.Name,
+					"justification": appeal.Description,
+					"created_at":    appeal.CreatedAt,
+				},
+			},
+		})
+	}
+	return notifications
+}
+
+// getAppealResolutionNotifications returns the notifications for the requestor once the appeal is approved or rejected
+func getAppealResolutionNotifications(appeal *domain.Appeal) []domain.Notification {
+	notifications := []domain.Notification{}
+	variables := map[string]interface{}{
+		"resource_name": fmt.Sprintf("%s (%s: %s)", appeal.Resource.Name, appeal.Resource.ProviderType, appeal.Resource.URN),
+		"role":          appeal.Role,
+		"account_id":    appeal.AccountID,
+		"appeal_id":     appeal.ID,
+		"requestor":     appeal.CreatedBy,
+	}
+
+	switch appeal.Status {
+	case domain.AppealStatusApproved:
+		notifications = append(notifications, domain.Notification{
+			User: appeal.CreatedBy,
+			Labels: map[string]string{
+				"appeal_id": appeal.ID,
+			},
+			Message: domain.NotificationMessage{
+				Type:      domain.NotificationTypeAppealApproved,
+				Variables: variables,
+			},
+		})
+		notifications = addOnBehalfApprovedNotification(appeal, notifications)
+	case domain.AppealStatusRejected:
+		notifications = append(notifications, domain.Notification{
+			User: appeal.CreatedBy,
+			Labels: map[string]string{
+				"appeal_id": appeal.ID,
+			},
+			Message: domain.NotificationMessage{
+				Type:      domain.NotificationTypeAppealRejected,
+				Variables: variables,
+			},
+		})
+	}
+
+	return notifications
+}
+
+// isApprovalStepResolved checks whether the latest decision settles the approval step based on its quorum
+func (s *Service) isApprovalStepResolved(ctx context.Context, appeal *domain.Appeal, approval *domain.Approval, action string) (bool, error) {
+	switch action {
+	case domain.AppealActionNameApprove:
+		return approval.IsQuorumReached(), nil
+	case domain.AppealActionNameReject:
+		if approval.GetRequiredApprovals() <= 1 || !approval.IsQuorumReachable() {
+			return true, nil
+		}
+		policy, err := s.getAppealPolicy(ctx, appeal)
+		if err != nil {
+			return false, err
+		}
+		if approval.Index < len(policy.Steps) {
+			return policy.Steps[approval.Index].FailOnAnyRejection, nil
+		}
+		return true, nil
+	default:
+		return false, ErrActionInvalidValue
+	}
+}
+
+// getAppealPolicy returns the policy of the appeal, fetching it if it's not loaded yet
+func (s *Service) getAppealPolicy(ctx context.Context, appeal *domain.Appeal) (*domain.Policy, error) {
+	if appeal.Policy == nil {
+		p, err := s.policyService.GetOne(ctx, appeal.PolicyID, appeal.PolicyVersion)
+		if err != nil {
+			return nil, err
+		}
+		appeal.Policy = p
+	}
+	return appeal.Policy, nil
+}
+
+func hasStepTimeout(p *domain.Policy) bool {
+	for _, step := range p.Steps {
+		if step.Timeout != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func hasExternalStep(p *domain.Policy) bool {
+	for _, step := range p.Steps {
+		if step.Strategy == domain.ApprovalStepStrategyExternal {
+			return true
+		}
+	}
+	return false
+}
+
+// getDelegations returns the active delegations of the given approvers whose scope covers the appeal
+func (s *Service) getDelegations(ctx context.Context, appeal *domain.Appeal, approvers []string) ([]*domain.Delegation, error) {
+	if len(approvers) == 0 {
+		return nil, nil
+	}
+	delegations, err := s.delegationService.FindActive(ctx, approvers)
+	if err != nil {
+		return nil, fmt.Errorf("listing active delegations: %w", err)
+	}
+	return getApplicableDelegations(delegations, appeal.PolicyID, appeal.Resource), nil
+}
+
+// getApplicableDelegations filters delegations whose scope covers the given policy and resource
+func getApplicableDelegations(delegations []*domain.Delegation, policyID string, r *domain.Resource) []*domain.Delegation {
+	var providerType, providerURN string
+	if r != nil {
+		providerType = r.ProviderType
+		providerURN = r.ProviderURN
+	}
+
+	var applicable []*domain.Delegation
+	for _, d := range delegations {
+		if d.Covers(policyID, providerType, providerURN) {
+			applicable = append(applicable, d)
+		}
+	}
+	return applicable
+}
+
+// getDelegator returns the approver on whose behalf the actor is acting, if any. Approvers always act on their own
+// behalf, even if another approver delegated to them.
+func getDelegator(delegations []*domain.Delegation, approvers []string, actor string) string {
+	if utils.ContainsString(approvers, actor) {
+		return ""
+	}
+	for _, d := range delegations {
+		if d.Delegate == actor && d.Delegator != actor && utils.ContainsString(approvers, d.Delegator) {
+			return d.Delegator
+		}
+	}
+	return ""
+}
+
 func checkIfAppealStatusStillPending(status string) error {
 	if status == domain.AppealStatusPending {
 		return nil
@@ -910,8 +2507,12 @@ func checkIfAppealStatusStillPending(status string) error {
 
 	var err error
 	switch status {
+	case domain.AppealStatusNeedsInfo:
+		err = ErrAppealStatusNeedsInfo
 	case domain.AppealStatusCanceled:
 		err = ErrAppealStatusCanceled
+	case domain.AppealStatusExpired:
+		err = ErrAppealStatusExpired
 	case domain.AppealStatusApproved:
 		err = ErrAppealStatusApproved
 	case domain.AppealStatusRejected:
@@ -922,6 +2523,17 @@ func checkIfAppealStatusStillPending(status string) error {
 	return err
 }
 
+// skipUnresolvedApprovals skips the steps of a rejected appeal that are still waiting, including the other steps of
+// the parallel group of the rejected step
+func skipUnresolvedApprovals(appeal *domain.Appeal) {
+	for _, approval := range appeal.Approvals {
+		if approval.Status == domain.ApprovalStatusPending || approval.Status == domain.ApprovalStatusBlocked {
+			approval.Skip()
+			approval.UpdatedAt = TimeNow()
+		}
+	}
+}
+
 func checkPreviousApprovalStatus(status string) error {
 	var err error
 	switch status {
@@ -962,7 +2574,7 @@ func (s *Service) handleAppealRequirements(ctx context.Context, a *domain.Appeal
 		g, ctx := errgroup.WithContext(ctx)
 
 		for reqIndex, r := range p.Requirements {
-			isAppealMatchesRequirement, err := r.On.IsMatch(a)
+			isAppealMatchesRequirement, err := r.On.IsMatchWithLanguage(a, p.GetExpressionLanguage())
 			if err != nil {
 				return fmt.Errorf("evaluating requirements[%v]: %v", reqIndex, err)
 			}
@@ -1032,6 +2644,11 @@ func (s *Service) GrantAccessToProvider(ctx context.Context, a *domain.Appeal, o
 		}
 	}
 
+	if a.Grant.Status == domain.GrantStatusScheduled {
+		// the access is given by the scheduled grant activation job once the start date is reached
+		return nil
+	}
+
 	if err := s.providerService.GrantAccess(ctx, *a.Grant); err != nil {
 		return fmt.Errorf("granting access: %w", err)
 	}
@@ -1194,14 +2811,18 @@ func (s *Service) getPermissions(ctx context.Context, pc *domain.ProviderConfig,
 }
 
 func (s *Service) prepareGrant(ctx context.Context, appeal *domain.Appeal) (newGrant *domain.Grant, deactivatedGrant *domain.Grant, err error) {
-	activeGrants, err := s.grantService.List(ctx, domain.ListGrantsFilter{
-		AccountIDs:  []string{appeal.AccountID},
-		ResourceIDs: []string{appeal.ResourceID},
-		Statuses:    []string{string(domain.GrantStatusActive)},
-		Permissions: appeal.Permissions,
-	})
-	if err != nil {
-		return nil, nil, fmt.Errorf("unable to retrieve existing active grants: %w", err)
+	// an existing active grant is kept until the scheduled grant gets activated
+	var activeGrants []domain.Grant
+	if !appeal.IsScheduled() {
+		activeGrants, err = s.grantService.List(ctx, domain.ListGrantsFilter{
+			AccountIDs:  []string{appeal.AccountID},
+			ResourceIDs: []string{appeal.ResourceID},
+			Statuses:    []string{string(domain.GrantStatusActive)},
+			Permissions: appeal.Permissions,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to retrieve existing active grants: %w", err)
+		}
 	}
 
 	if len(activeGrants) > 0 {
@@ -21,16 +21,20 @@ import (
 
 type ServiceTestSuite struct {
 	suite.Suite
-	mockRepository      *appealmocks.Repository
-	mockApprovalService *appealmocks.ApprovalService
-	mockResourceService *appealmocks.ResourceService
-	mockProviderService *appealmocks.ProviderService
-	mockPolicyService   *appealmocks.PolicyService
-	mockGrantService    *appealmocks.GrantService
-	mockIAMManager      *appealmocks.IamManager
-	mockIAMClient       *mocks.IAMClient
-	mockNotifier        *appealmocks.Notifier
-	mockAuditLogger     *appealmocks.AuditLogger
+	mockRepository       *appealmocks.Repository
+	mockApprovalService  *appealmocks.ApprovalService
+	mockResourceService  *appealmocks.ResourceService
+	mockProviderService  *appealmocks.ProviderService
+	mockPolicyService    *appealmocks.PolicyService
+	mockGrantService     *appealmocks.GrantService
+	mockIAMManager       *appealmocks.IamManager
+	mockIAMClient        *mocks.IAMClient
+	mockDelegation       *appealmocks.DelegationService
+	mockSoDService       *appealmocks.SodService
+	mockCommentRepo      *appealmocks.CommentRepository
+	mockExternalApprover *appealmocks.ExternalApprover
+	mockNotifier         *appealmocks.Notifier
+	mockAuditLogger      *appealmocks.AuditLogger
 
 	service *appeal.Service
 	now     time.Time
@@ -45,6 +49,10 @@ func (s *ServiceTestSuite) setup() {
 	s.mockGrantService = new(appealmocks.GrantService)
 	s.mockIAMManager = new(appealmocks.IamManager)
 	s.mockIAMClient = new(mocks.IAMClient)
+	s.mockDelegation = new(appealmocks.DelegationService)
+	s.mockSoDService = new(appealmocks.SodService)
+	s.mockCommentRepo = new(appealmocks.CommentRepository)
+	s.mockExternalApprover = new(appealmocks.ExternalApprover)
 	s.mockNotifier = new(appealmocks.Notifier)
 	s.mockAuditLogger = new(appealmocks.AuditLogger)
 	s.now = time.Now()
@@ -57,6 +65,11 @@ func (s *ServiceTestSuite) setup() {
 		s.mockPolicyService,
 		s.mockGrantService,
 		s.mockIAMManager,
+		s.mockDelegation,
+		s.mockSoDService,
+		s.mockCommentRepo,
+		s.mockExternalApprover,
+		nil,
 		s.mockNotifier,
 		validator.New(),
 		log.NewNoop(),
@@ -65,6 +78,8 @@ func (s *ServiceTestSuite) setup() {
 	service.TimeNow = func() time.Time {
 		return s.now
 	}
+	s.mockDelegation.EXPECT().FindActive(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	s.mockSoDService.EXPECT().FindAppealViolations(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 
 	s.service = service
 }
@@ -547,6 +562,60 @@ func (s *ServiceTestSuite) TestCreate() {
 				}},
 				expectedError: appeal.ErrOptionsDurationNotFound,
 			},
+			{
+				name: "start date in the past",
+				resources: []*domain.Resource{{
+					ID:           "1",
+					ProviderType: "provider_type",
+					ProviderURN:  "provider_urn",
+					Type:         "resource_type",
+				}},
+				providers:              []*domain.Provider{testProvider},
+				policies:               []*domain.Policy{{ID: "policy_id", Version: uint(1)}},
+				callMockValidateAppeal: true,
+				callMockGetPermissions: true,
+				appeals: []*domain.Appeal{{
+					ResourceID:    "1",
+					Role:          "role_1",
+					PolicyID:      "policy_id",
+					PolicyVersion: uint(1),
+					Options: &domain.AppealOptions{
+						Duration:  "24h",
+						StartDate: func() *time.Time { t := timeNow.Add(-time.Hour); return &t }(),
+					},
+				}},
+				expectedError: appeal.ErrInvalidStartDate,
+			},
+			{
+				name: "start date further than the policy max schedule ahead",
+				resources: []*domain.Resource{{
+					ID:           "1",
+					ProviderType: "provider_type",
+					ProviderURN:  "provider_urn",
+					Type:         "resource_type",
+				}},
+				providers: []*domain.Provider{testProvider},
+				policies: []*domain.Policy{{
+					ID:      "policy_id",
+					Version: uint(1),
+					AppealConfig: &domain.PolicyAppealConfig{
+						MaxScheduleAhead: "168h",
+					},
+				}},
+				callMockValidateAppeal: true,
+				callMockGetPermissions: true,
+				appeals: []*domain.Appeal{{
+					ResourceID:    "1",
+					Role:          "role_1",
+					PolicyID:      "policy_id",
+					PolicyVersion: uint(1),
+					Options: &domain.AppealOptions{
+						Duration:  "24h",
+						StartDate: func() *time.Time { t := timeNow.Add(8 * 24 * time.Hour); return &t }(),
+					},
+				}},
+				expectedError: appeal.ErrInvalidStartDate,
+			},
 		}
 
 		for _, tc := range testCases {
@@ -905,7 +974,7 @@ func (s *ServiceTestSuite) TestCreate() {
 		s.mockProviderService.EXPECT().Find(mock.Anything, domain.ProviderFilter{}).Return(providers, nil).Once()
 		s.mockPolicyService.On("Find", mock.Anything).Return(policies, nil).Once()
 		expectedExistingAppealsFilters := &domain.ListAppealsFilter{
-			Statuses:   []string{domain.AppealStatusPending},
+			Statuses:   []string{domain.AppealStatusPending, domain.AppealStatusNeedsInfo},
 			AccountIDs: []string{"test@email.com", "addOnBehalfApprovedNotification-user"},
 		}
 		s.mockRepository.EXPECT().
@@ -1278,7 +1347,7 @@ func (s *ServiceTestSuite) TestCreateAppeal__WithExistingAppealAndWithAutoApprov
 	s.mockProviderService.EXPECT().Find(mock.Anything, domain.ProviderFilter{}).Return(providers, nil).Once()
 	s.mockPolicyService.On("Find", mock.Anything).Return(policies, nil).Once()
 	expectedExistingAppealsFilters := &domain.ListAppealsFilter{
-		Statuses:   []string{domain.AppealStatusPending},
+		Statuses:   []string{domain.AppealStatusPending, domain.AppealStatusNeedsInfo},
 		AccountIDs: []string{accountID},
 	}
 	s.mockRepository.EXPECT().
@@ -1545,178 +1614,1633 @@ func (s *ServiceTestSuite) TestCreateAppeal__WithAdditionalAppeals() {
 	s.NoError(err)
 }
 
-func (s *ServiceTestSuite) TestUpdateApproval() {
-	timeNow := time.Now()
-	appealID := uuid.New().String()
-	appeal.TimeNow = func() time.Time {
-		return timeNow
+func (s *ServiceTestSuite) TestCreate__WithBreakGlass() {
+	providerType := "test-provider-type"
+	providerURN := "test-provider-urn"
+	resourceType := "test-resource-type"
+	resource := &domain.Resource{
+		ID:           "test-resource-id",
+		URN:          "test-resource-urn",
+		Name:         "test-resource-name",
+		Type:         resourceType,
+		ProviderType: providerType,
+		ProviderURN:  providerURN,
 	}
-	s.Run("should return error if approval action parameter is invalid", func() {
-		invalidApprovalActionParameters := []domain.ApprovalAction{
-			{
-				ApprovalName: "approval_1",
-				Actor:        "user@email.com",
-				Action:       "name",
-			},
-			{
-				AppealID: appealID,
-				Actor:    "user@email.com",
-				Action:   "name",
-			},
+	policy := &domain.Policy{
+		ID:      "test-policy-id",
+		Version: 1,
+		Steps: []*domain.Step{
 			{
-				AppealID:     appealID,
-				ApprovalName: "approval_1",
-				Actor:        "invalidemail",
-				Action:       "name",
+				Name:      "step-1",
+				Strategy:  domain.ApprovalStepStrategyManual,
+				Approvers: []string{"approver@example.com"},
 			},
-			{
-				AppealID:     appealID,
-				ApprovalName: "approval_1",
-				Action:       "name",
+		},
+		AppealConfig: &domain.PolicyAppealConfig{
+			AllowBreakGlass:       true,
+			BreakGlassMaxDuration: "2h",
+			BreakGlassReviewers:   []string{"security@example.com"},
+		},
+	}
+	provider := &domain.Provider{
+		ID:   "test-provider-id",
+		Type: providerType,
+		URN:  providerURN,
+		Config: &domain.ProviderConfig{
+			Resources: []*domain.ResourceConfig{
+				{
+					Type: resourceType,
+					Policy: &domain.PolicyConfig{
+						ID:      policy.ID,
+						Version: int(policy.Version),
+					},
+					Roles: []*domain.Role{
+						{
+							ID:          "test-role",
+							Permissions: []interface{}{"test-permission"},
+						},
+					},
+				},
 			},
-			{
-				AppealID:     appealID,
-				ApprovalName: "approval_1",
-				Actor:        "user@email.com",
+		},
+	}
+	newAppeal := func(description string) *domain.Appeal {
+		return &domain.Appeal{
+			CreatedBy:   "user@example.com",
+			AccountID:   "user@example.com",
+			ResourceID:  resource.ID,
+			Role:        "test-role",
+			Description: description,
+			Options: &domain.AppealOptions{
+				Duration:   "24h",
+				BreakGlass: true,
 			},
 		}
+	}
+	expectLookups := func(p *domain.Policy) {
+		s.mockResourceService.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Resource{resource}, nil).Once()
+		s.mockProviderService.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Provider{provider}, nil).Once()
+		s.mockPolicyService.EXPECT().Find(mock.Anything).Return([]*domain.Policy{p}, nil).Once()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{}, nil).Once()
+		s.mockGrantService.EXPECT().List(mock.Anything, mock.Anything).Return([]domain.Grant{}, nil).Once()
+	}
 
-		for _, param := range invalidApprovalActionParameters {
-			actualResult, actualError := s.service.UpdateApproval(context.Background(), param)
-
-			s.Nil(actualResult)
-			s.Error(actualError)
+	s.Run("should return error if policy doesn't allow break-glass", func() {
+		s.setup()
+		policyWithoutBreakGlass := &domain.Policy{
+			ID:      policy.ID,
+			Version: policy.Version,
+			Steps:   policy.Steps,
 		}
+		expectLookups(policyWithoutBreakGlass)
+
+		actualError := s.service.Create(context.Background(), []*domain.Appeal{newAppeal("incident")})
+
+		s.ErrorIs(actualError, appeal.ErrBreakGlassNotAllowed)
 	})
 
-	validApprovalActionParam := domain.ApprovalAction{
-		AppealID:     appealID,
-		ApprovalName: "approval_1",
-		Actor:        "user@email.com",
-		Action:       "approve",
-	}
+	s.Run("should return error if the appeal violates a require_approval rule", func() {
+		s.setup()
+		s.mockSoDService.ExpectedCalls = nil
+		expectLookups(policy)
+		breakGlassAppeal := newAppeal("incident #123")
+		s.mockProviderService.EXPECT().ValidateAppeal(mock.Anything, breakGlassAppeal, provider, policy).Return(nil).Once()
+		s.mockProviderService.EXPECT().GetPermissions(mock.Anything, provider.Config, resourceType, "test-role").Return([]interface{}{"test-permission"}, nil).Once()
+		s.mockSoDService.EXPECT().FindAppealViolations(mock.Anything, breakGlassAppeal, mock.Anything).
+			Return([]*domain.SoDViolation{{Rule: &domain.SoDRule{Name: "test-rule", Action: domain.SoDRuleActionRequireApproval}}}, nil).Once()
+
+		actualError := s.service.Create(context.Background(), []*domain.Appeal{breakGlassAppeal})
+
+		s.ErrorIs(actualError, appeal.ErrSoDViolation)
+		s.mockRepository.AssertNotCalled(s.T(), "BulkUpsert", mock.Anything, mock.Anything)
+		s.mockProviderService.AssertNotCalled(s.T(), "GrantAccess", mock.Anything, mock.Anything)
+	})
 
-	s.Run("should return error if got any from repository while getting appeal details", func() {
-		expectedError := errors.New("repository error")
-		s.mockRepository.EXPECT().GetByID(mock.AnythingOfType("context.backgroundCtx"), mock.Anything).Return(nil, expectedError).Once()
+	s.Run("should return error if justification is empty", func() {
+		s.setup()
+		expectLookups(policy)
 
-		actualResult, actualError := s.service.UpdateApproval(context.Background(), validApprovalActionParam)
+		actualError := s.service.Create(context.Background(), []*domain.Appeal{newAppeal(" ")})
 
-		s.mockRepository.AssertExpectations(s.T())
-		s.Nil(actualResult)
-		s.EqualError(actualError, expectedError.Error())
+		s.ErrorIs(actualError, appeal.ErrBreakGlassJustificationRequired)
 	})
 
-	s.Run("should return error if appeal not found", func() {
-		expectedError := appeal.ErrAppealNotFound
-		s.mockRepository.EXPECT().GetByID(mock.AnythingOfType("context.backgroundCtx"), mock.Anything).Return(nil, expectedError).Once()
+	s.Run("should grant access immediately with capped duration and notify reviewers", func() {
+		s.setup()
+		expectLookups(policy)
+		breakGlassAppeal := newAppeal("incident #123")
+
+		s.mockProviderService.EXPECT().ValidateAppeal(mock.Anything, breakGlassAppeal, provider, policy).Return(nil).Once()
+		s.mockProviderService.EXPECT().GetPermissions(mock.Anything, provider.Config, resourceType, "test-role").Return([]interface{}{"test-permission"}, nil).Once()
+		s.mockGrantService.EXPECT().List(mock.Anything, mock.Anything).Return([]domain.Grant{}, nil).Once()
+		s.mockGrantService.EXPECT().Prepare(mock.Anything, mock.AnythingOfType("domain.Appeal")).
+			RunAndReturn(func(_ context.Context, a domain.Appeal) (*domain.Grant, error) {
+				return a.ToGrant()
+			}).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+		s.mockProviderService.EXPECT().GrantAccess(mock.Anything, mock.AnythingOfType("domain.Grant")).Return(nil).Once()
+		s.mockRepository.EXPECT().BulkUpsert(mock.Anything, []*domain.Appeal{breakGlassAppeal}).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyBulkInsert, mock.Anything).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.MatchedBy(func(notifications []domain.Notification) bool {
+			var hasReview bool
+			for _, n := range notifications {
+				if n.Message.Type == domain.NotificationTypeBreakGlassReview && n.User == "security@example.com" {
+					hasReview = true
+				}
+				if n.Message.Type == domain.NotificationTypeApproverNotification {
+					return false
+				}
+			}
+			return hasReview
+		})).Return(nil).Once()
 
-		actualResult, actualError := s.service.UpdateApproval(context.Background(), validApprovalActionParam)
+		actualError := s.service.Create(context.Background(), []*domain.Appeal{breakGlassAppeal})
 
-		s.mockRepository.AssertExpectations(s.T())
-		s.Nil(actualResult)
-		s.EqualError(actualError, appeal.ErrAppealNotFound.Error())
+		s.NoError(actualError)
+		s.Equal(domain.AppealStatusApproved, breakGlassAppeal.Status)
+		s.Equal("2h0m0s", breakGlassAppeal.Options.Duration)
+		s.Require().Len(breakGlassAppeal.Approvals, 1)
+		s.Equal(domain.BreakGlassReviewApprovalName, breakGlassAppeal.Approvals[0].Name)
+		s.Equal(domain.ApprovalStatusPending, breakGlassAppeal.Approvals[0].Status)
+		s.Equal([]string{"security@example.com"}, breakGlassAppeal.Approvals[0].Approvers)
+		s.Require().NotNil(breakGlassAppeal.Grant)
+		s.Equal(domain.GrantSourceBreakGlass, breakGlassAppeal.Grant.Source)
+		s.mockNotifier.AssertExpectations(s.T())
 	})
+}
 
-	s.Run("should return error based on statuses conditions", func() {
-		testCases := []struct {
-			name          string
-			appealStatus  string
-			approvals     []*domain.Approval
-			expectedError error
-		}{
-			{
-				name:          "appeal not eligible, status: approved",
-				appealStatus:  domain.AppealStatusApproved,
-				expectedError: appeal.ErrAppealStatusApproved,
-			},
+func (s *ServiceTestSuite) TestCreate__WithBundlePolicy() {
+	resource := &domain.Resource{
+		ID:           "test-resource-id",
+		URN:          "test-resource-urn",
+		Type:         "test-resource-type",
+		ProviderType: "test-provider-type",
+		ProviderURN:  "test-provider-urn",
+	}
+	resourcePolicy := &domain.Policy{
+		ID:      "resource-policy",
+		Version: 1,
+		Steps: []*domain.Step{
 			{
-				name:          "appeal not eligible, status: rejected",
-				appealStatus:  domain.AppealStatusRejected,
-				expectedError: appeal.ErrAppealStatusRejected,
+				Name:      "step-1",
+				Strategy:  domain.ApprovalStepStrategyManual,
+				Approvers: []string{"approver@example.com"},
 			},
+		},
+	}
+	lenientPolicy := &domain.Policy{
+		ID:      "lenient-policy",
+		Version: 1,
+		Steps: []*domain.Step{
 			{
-				name:          "invalid appeal status",
-				appealStatus:  "invalidstatus",
-				expectedError: appeal.ErrAppealStatusUnrecognized,
+				Name:        "step-1",
+				Strategy:    domain.ApprovalStepStrategyAuto,
+				ApproveIf:   "true",
+				AllowFailed: true,
 			},
-			{
-				name:         "previous approval step still on pending",
-				appealStatus: domain.AppealStatusPending,
-				approvals: []*domain.Approval{
-					{
-						Name:   "approval_0",
-						Status: domain.ApprovalStatusPending,
+		},
+	}
+	provider := &domain.Provider{
+		ID:   "test-provider-id",
+		Type: resource.ProviderType,
+		URN:  resource.ProviderURN,
+		Config: &domain.ProviderConfig{
+			Resources: []*domain.ResourceConfig{
+				{
+					Type: resource.Type,
+					Policy: &domain.PolicyConfig{
+						ID:      resourcePolicy.ID,
+						Version: int(resourcePolicy.Version),
 					},
-					{
-						Name:   "approval_1",
-						Status: domain.ApprovalStatusPending,
+					Roles: []*domain.Role{
+						{
+							ID:          "test-role",
+							Permissions: []interface{}{"test-permission"},
+						},
 					},
 				},
-				expectedError: appeal.ErrApprovalDependencyIsPending,
 			},
+		},
+	}
+
+	s.Run("should return error if the bundle policy is not the policy of the resource", func() {
+		s.setup()
+		s.mockResourceService.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Resource{resource}, nil).Once()
+		s.mockProviderService.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Provider{provider}, nil).Once()
+		s.mockPolicyService.EXPECT().Find(mock.Anything).Return([]*domain.Policy{resourcePolicy, lenientPolicy}, nil).Once()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{}, nil).Once()
+		s.mockGrantService.EXPECT().List(mock.Anything, mock.Anything).Return([]domain.Grant{}, nil).Maybe()
+
+		actualError := s.service.Create(context.Background(), []*domain.Appeal{
 			{
-				name:         "found one previous approval is reject",
-				appealStatus: domain.AppealStatusPending,
-				approvals: []*domain.Approval{
-					{
-						Name:   "approval_0",
-						Status: domain.ApprovalStatusRejected,
-					},
-					{
-						Name:   "approval_1",
-						Status: domain.ApprovalStatusPending,
-					},
-				},
-				expectedError: appeal.ErrAppealStatusRejected,
+				CreatedBy:     "user@example.com",
+				AccountID:     "user@example.com",
+				ResourceID:    resource.ID,
+				Role:          "test-role",
+				BundleID:      "test-bundle-id",
+				PolicyID:      lenientPolicy.ID,
+				PolicyVersion: lenientPolicy.Version,
 			},
+		})
+
+		s.ErrorIs(actualError, appeal.ErrBundlePolicyMismatch)
+		s.mockRepository.AssertNotCalled(s.T(), "BulkUpsert", mock.Anything, mock.Anything)
+	})
+}
+
+func (s *ServiceTestSuite) TestCreate__WithGrantDowngrade() {
+	resource := &domain.Resource{
+		ID:           "test-resource-id",
+		URN:          "test-resource-urn",
+		Type:         "dataset",
+		ProviderType: "bigquery",
+		ProviderURN:  "test-provider-urn",
+	}
+	policy := &domain.Policy{
+		ID:      "test-policy-id",
+		Version: 1,
+		Steps: []*domain.Step{
 			{
-				name:         "invalid approval status",
-				appealStatus: domain.AppealStatusPending,
-				approvals: []*domain.Approval{
-					{
-						Name:   "approval_0",
-						Status: "invalidstatus",
-					},
-					{
-						Name:   "approval_1",
-						Status: domain.ApprovalStatusPending,
-					},
-				},
-				expectedError: appeal.ErrApprovalStatusUnrecognized,
+				Name:      "step-1",
+				Strategy:  domain.ApprovalStepStrategyAuto,
+				ApproveIf: "true",
 			},
-			{
-				name:         "approval step already approved",
-				appealStatus: domain.AppealStatusPending,
-				approvals: []*domain.Approval{
-					{
-						Name:   "approval_0",
-						Status: domain.ApprovalStatusApproved,
-					},
-					{
-						Name:   "approval_1",
-						Status: domain.ApprovalStatusApproved,
+		},
+	}
+	provider := &domain.Provider{
+		Type: resource.ProviderType,
+		URN:  resource.ProviderURN,
+		Config: &domain.ProviderConfig{
+			Resources: []*domain.ResourceConfig{
+				{
+					Type:   resource.Type,
+					Policy: &domain.PolicyConfig{ID: policy.ID, Version: int(policy.Version)},
+					Roles: []*domain.Role{
+						{ID: "viewer", Permissions: []interface{}{"read"}},
+						{ID: "editor", Permissions: []interface{}{"read", "write"}},
 					},
 				},
-				expectedError: appeal.ErrApprovalStatusApproved,
 			},
+		},
+	}
+	editorGrant := &domain.Grant{
+		ID:          "editor-grant-id",
+		Status:      domain.GrantStatusActive,
+		AccountID:   "user@example.com",
+		AccountType: domain.DefaultAppealAccountType,
+		ResourceID:  resource.ID,
+		Role:        "editor",
+		Permissions: []string{"read", "write"},
+	}
+	newAppeal := func(role string) *domain.Appeal {
+		return &domain.Appeal{
+			CreatedBy:  "user@example.com",
+			AccountID:  "user@example.com",
+			ResourceID: resource.ID,
+			Role:       role,
+			Options:    &domain.AppealOptions{ReplaceGrantID: editorGrant.ID},
+		}
+	}
+	expectLookups := func(role string, permissions []interface{}) {
+		s.mockResourceService.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Resource{resource}, nil).Once()
+		s.mockProviderService.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Provider{provider}, nil).Once()
+		s.mockPolicyService.EXPECT().Find(mock.Anything).Return([]*domain.Policy{policy}, nil).Once()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{}, nil).Once()
+		s.mockProviderService.EXPECT().ValidateAppeal(mock.Anything, mock.Anything, provider, policy).Return(nil).Once()
+		s.mockProviderService.EXPECT().GetPermissions(mock.Anything, provider.Config, resource.Type, role).Return(permissions, nil).Once()
+		s.mockGrantService.EXPECT().GetByID(mock.Anything, editorGrant.ID).Return(editorGrant, nil).Once()
+	}
+
+	s.Run("should return error if the role is not narrower than the replaced grant", func() {
+		s.setup()
+		expectLookups("editor", []interface{}{"read", "write"})
+		s.mockGrantService.EXPECT().List(mock.Anything, mock.Anything).Return([]domain.Grant{}, nil).Once()
+
+		actualError := s.service.Create(context.Background(), []*domain.Appeal{newAppeal("editor")})
+
+		s.ErrorIs(actualError, appeal.ErrInvalidGrantDowngrade)
+		s.mockRepository.AssertNotCalled(s.T(), "BulkUpsert", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should revoke the replaced grant once the appeal is approved", func() {
+		s.setup()
+		expectLookups("viewer", []interface{}{"read"})
+		a := newAppeal("viewer")
+		s.mockGrantService.EXPECT().List(mock.Anything, mock.Anything).Return([]domain.Grant{}, nil).Twice()
+		s.mockGrantService.EXPECT().Prepare(mock.Anything, mock.AnythingOfType("domain.Appeal")).
+			RunAndReturn(func(_ context.Context, a domain.Appeal) (*domain.Grant, error) {
+				return a.ToGrant()
+			}).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+		s.mockProviderService.EXPECT().GrantAccess(mock.Anything, mock.AnythingOfType("domain.Grant")).Return(nil).Once()
+		s.mockGrantService.EXPECT().Revoke(mock.Anything, editorGrant.ID, domain.SystemActorName, appeal.RevokeReasonForDowngrade).
+			Return(editorGrant, nil).Once()
+		s.mockRepository.EXPECT().BulkUpsert(mock.Anything, []*domain.Appeal{a}).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyBulkInsert, mock.Anything).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Return(nil).Once()
+
+		actualError := s.service.Create(context.Background(), []*domain.Appeal{a})
+
+		s.NoError(actualError)
+		s.Equal(domain.AppealStatusApproved, a.Status)
+		s.mockGrantService.AssertExpectations(s.T())
+	})
+}
+
+func (s *ServiceTestSuite) TestCreate__WithSoDRules() {
+	resource := &domain.Resource{
+		ID:           "test-resource-id",
+		URN:          "finance-raw",
+		Type:         "dataset",
+		ProviderType: "bigquery",
+		ProviderURN:  "test-provider-urn",
+	}
+	policy := &domain.Policy{
+		ID:      "test-policy-id",
+		Version: 1,
+		Steps: []*domain.Step{
 			{
-				name:         "approval step already rejected",
-				appealStatus: domain.AppealStatusPending,
-				approvals: []*domain.Approval{
-					{
-						Name:   "approval_0",
-						Status: domain.ApprovalStatusApproved,
-					},
-					{
-						Name:   "approval_1",
-						Status: domain.ApprovalStatusRejected,
-					},
+				Name:      "step-1",
+				Strategy:  domain.ApprovalStepStrategyManual,
+				Approvers: []string{"approver@example.com"},
+			},
+		},
+	}
+	provider := &domain.Provider{
+		Type: resource.ProviderType,
+		URN:  resource.ProviderURN,
+		Config: &domain.ProviderConfig{
+			Resources: []*domain.ResourceConfig{
+				{
+					Type:   resource.Type,
+					Policy: &domain.PolicyConfig{ID: policy.ID, Version: int(policy.Version)},
+					Roles:  []*domain.Role{{ID: "writer", Permissions: []interface{}{"write"}}},
 				},
-				expectedError: appeal.ErrApprovalStatusRejected,
 			},
-			{
-				name:         "approval step already skipped",
+		},
+	}
+	newAppeal := func() *domain.Appeal {
+		return &domain.Appeal{
+			CreatedBy:  "user@example.com",
+			AccountID:  "user@example.com",
+			ResourceID: resource.ID,
+			Role:       "writer",
+		}
+	}
+	newViolation := func(action domain.SoDRuleAction) *domain.SoDViolation {
+		return &domain.SoDViolation{
+			Rule: &domain.SoDRule{
+				Name:      "finance-writer-vs-reporting-admin",
+				Action:    action,
+				Approvers: []string{"compliance@example.com"},
+			},
+			AccountID: "user@example.com",
+			GrantIDs:  []string{"reporting-admin-grant-id"},
+		}
+	}
+	expectLookups := func() {
+		s.mockResourceService.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Resource{resource}, nil).Once()
+		s.mockProviderService.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Provider{provider}, nil).Once()
+		s.mockPolicyService.EXPECT().Find(mock.Anything).Return([]*domain.Policy{policy}, nil).Once()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{}, nil).Once()
+		s.mockGrantService.EXPECT().List(mock.Anything, mock.Anything).Return([]domain.Grant{}, nil).Once()
+		s.mockProviderService.EXPECT().ValidateAppeal(mock.Anything, mock.Anything, provider, policy).Return(nil).Once()
+		s.mockProviderService.EXPECT().GetPermissions(mock.Anything, provider.Config, resource.Type, "writer").Return([]interface{}{"write"}, nil).Once()
+	}
+
+	s.Run("should reject appeal violating a reject rule", func() {
+		s.setup()
+		s.mockSoDService.ExpectedCalls = nil
+		expectLookups()
+		a := newAppeal()
+		s.mockSoDService.EXPECT().FindAppealViolations(mock.Anything, a, mock.Anything).
+			Return([]*domain.SoDViolation{newViolation(domain.SoDRuleActionReject)}, nil).Once()
+
+		actualError := s.service.Create(context.Background(), []*domain.Appeal{a})
+
+		s.ErrorIs(actualError, appeal.ErrSoDViolation)
+		s.mockRepository.AssertNotCalled(s.T(), "BulkUpsert", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should add an extra approval step for require_approval rules", func() {
+		s.setup()
+		s.mockSoDService.ExpectedCalls = nil
+		expectLookups()
+		a := newAppeal()
+		s.mockSoDService.EXPECT().FindAppealViolations(mock.Anything, a, mock.Anything).
+			Return([]*domain.SoDViolation{newViolation(domain.SoDRuleActionRequireApproval)}, nil).Once()
+		s.mockRepository.EXPECT().BulkUpsert(mock.Anything, []*domain.Appeal{a}).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyBulkInsert, mock.Anything).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Return(nil).Once()
+
+		actualError := s.service.Create(context.Background(), []*domain.Appeal{a})
+
+		s.NoError(actualError)
+		s.Equal(domain.AppealStatusPending, a.Status)
+		s.Require().Len(a.Approvals, 2)
+		s.Equal(domain.ApprovalStatusPending, a.Approvals[0].Status)
+		s.Equal(domain.SoDApprovalName, a.Approvals[1].Name)
+		s.Equal(domain.ApprovalStatusBlocked, a.Approvals[1].Status)
+		s.Equal(1, a.Approvals[1].Index)
+		s.Equal([]string{"compliance@example.com"}, a.Approvals[1].Approvers)
+	})
+
+	s.Run("should check the appeal along with the pending appeals of the account", func() {
+		s.setup()
+		s.mockSoDService.ExpectedCalls = nil
+		pendingAppeal := &domain.Appeal{
+			ID:         "pending-appeal-id",
+			AccountID:  "user@example.com",
+			ResourceID: "reporting-resource-id",
+			Role:       "admin",
+			Status:     domain.AppealStatusPending,
+		}
+		s.mockResourceService.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Resource{resource}, nil).Once()
+		s.mockProviderService.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Provider{provider}, nil).Once()
+		s.mockPolicyService.EXPECT().Find(mock.Anything).Return([]*domain.Policy{policy}, nil).Once()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{pendingAppeal}, nil).Once()
+		s.mockGrantService.EXPECT().List(mock.Anything, mock.Anything).Return([]domain.Grant{}, nil).Once()
+		s.mockProviderService.EXPECT().ValidateAppeal(mock.Anything, mock.Anything, provider, policy).Return(nil).Once()
+		s.mockProviderService.EXPECT().GetPermissions(mock.Anything, provider.Config, resource.Type, "writer").Return([]interface{}{"write"}, nil).Once()
+		a := newAppeal()
+		s.mockSoDService.EXPECT().FindAppealViolations(mock.Anything, a, []*domain.Appeal{pendingAppeal}).
+			Return([]*domain.SoDViolation{newViolation(domain.SoDRuleActionReject)}, nil).Once()
+
+		actualError := s.service.Create(context.Background(), []*domain.Appeal{a})
+
+		s.ErrorIs(actualError, appeal.ErrSoDViolation)
+		s.mockSoDService.AssertExpectations(s.T())
+	})
+}
+
+func (s *ServiceTestSuite) TestUpdateApproval__BreakGlassReview() {
+	newAppeal := func() *domain.Appeal {
+		return &domain.Appeal{
+			ID:            uuid.New().String(),
+			PolicyID:      "test-policy-id",
+			PolicyVersion: 1,
+			Status:        domain.AppealStatusApproved,
+			CreatedBy:     "user@example.com",
+			Options:       &domain.AppealOptions{Duration: "1h", BreakGlass: true},
+			Resource:      &domain.Resource{ID: "test-resource-id"},
+			Approvals: []*domain.Approval{
+				{
+					ID:        "review-id",
+					Name:      domain.BreakGlassReviewApprovalName,
+					Status:    domain.ApprovalStatusPending,
+					Approvers: []string{"security@example.com"},
+				},
+			},
+			Grant: &domain.Grant{ID: "grant-id", Status: domain.GrantStatusActive},
+		}
+	}
+
+	s.Run("should return error if actor is not a reviewer", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+
+		_, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: domain.BreakGlassReviewApprovalName,
+			Actor:        "user@example.com",
+			Action:       domain.AppealActionNameApprove,
+		})
+
+		s.ErrorIs(actualError, appeal.ErrActionForbidden)
+	})
+
+	s.Run("should acknowledge the review without changing the grant", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, a.PolicyID, a.PolicyVersion).Return(&domain.Policy{}, nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyApprove, mock.Anything).Return(nil).Once()
+
+		actualAppeal, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: domain.BreakGlassReviewApprovalName,
+			Actor:        "security@example.com",
+			Action:       domain.AppealActionNameApprove,
+		})
+
+		s.NoError(actualError)
+		s.Equal(domain.ApprovalStatusApproved, actualAppeal.Approvals[0].Status)
+		s.Equal(domain.AppealStatusApproved, actualAppeal.Status)
+		s.mockGrantService.AssertNotCalled(s.T(), "Revoke", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("should revoke the grant if the review is rejected", func() {
+		s.setup()
+		a := newAppeal()
+		revokedGrant := &domain.Grant{ID: "grant-id", Status: domain.GrantStatusInactive}
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, a.PolicyID, a.PolicyVersion).Return(&domain.Policy{}, nil).Once()
+		s.mockGrantService.EXPECT().Revoke(mock.Anything, "grant-id", "security@example.com", appeal.RevokeReasonBreakGlassReviewRejected).Return(revokedGrant, nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyReject, mock.Anything).Return(nil).Once()
+
+		actualAppeal, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: domain.BreakGlassReviewApprovalName,
+			Actor:        "security@example.com",
+			Action:       domain.AppealActionNameReject,
+			Reason:       "not an incident",
+		})
+
+		s.NoError(actualError)
+		s.Equal(domain.ApprovalStatusRejected, actualAppeal.Approvals[0].Status)
+		s.Equal(revokedGrant, actualAppeal.Grant)
+	})
+}
+
+func (s *ServiceTestSuite) TestUpdateApproval__SelfApproval() {
+	approver := "approver@example.com"
+	newAppeal := func() *domain.Appeal {
+		return &domain.Appeal{
+			ID:            uuid.New().String(),
+			PolicyID:      "test-policy-id",
+			PolicyVersion: 1,
+			Status:        domain.AppealStatusPending,
+			AccountID:     "user@example.com",
+			CreatedBy:     "user@example.com",
+			Resource:      &domain.Resource{ID: "test-resource-id"},
+			Approvals: []*domain.Approval{
+				{
+					Name:      "step-1",
+					Index:     0,
+					Status:    domain.ApprovalStatusApproved,
+					Actor:     &approver,
+					Approvers: []string{approver},
+				},
+				{
+					Name:      "step-2",
+					Index:     1,
+					Status:    domain.ApprovalStatusPending,
+					Approvers: []string{approver, "user@example.com"},
+				},
+			},
+		}
+	}
+	newPolicy := func(config *domain.PolicyAppealConfig) *domain.Policy {
+		return &domain.Policy{
+			ID:           "test-policy-id",
+			Version:      1,
+			AppealConfig: config,
+			Steps: []*domain.Step{
+				{Name: "step-1", Strategy: domain.ApprovalStepStrategyManual, Approvers: []string{approver}},
+				{Name: "step-2", Strategy: domain.ApprovalStepStrategyManual, Approvers: []string{approver}},
+			},
+		}
+	}
+
+	s.Run("should return error if the creator approves their own appeal", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, a.PolicyID, a.PolicyVersion).
+			Return(newPolicy(&domain.PolicyAppealConfig{DisallowSelfApproval: true}), nil).Once()
+
+		_, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "step-2",
+			Actor:        "user@example.com",
+			Action:       domain.AppealActionNameApprove,
+		})
+
+		s.ErrorIs(actualError, domain.ErrSelfApprovalNotAllowed)
+		s.mockRepository.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should return error if the approver approved a previous step", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, a.PolicyID, a.PolicyVersion).
+			Return(newPolicy(&domain.PolicyAppealConfig{DisallowRepeatedApprover: true}), nil).Once()
+
+		_, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "step-2",
+			Actor:        approver,
+			Action:       domain.AppealActionNameApprove,
+		})
+
+		s.ErrorIs(actualError, domain.ErrRepeatedApproverNotAllowed)
+		s.mockRepository.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func (s *ServiceTestSuite) TestUpdateApproval__RequestInfo() {
+	approver := "approver@example.com"
+	newAppeal := func() *domain.Appeal {
+		return &domain.Appeal{
+			ID:            uuid.New().String(),
+			PolicyID:      "test-policy-id",
+			PolicyVersion: 1,
+			Status:        domain.AppealStatusPending,
+			AccountID:     "user@example.com",
+			CreatedBy:     "user@example.com",
+			Role:          "viewer",
+			Resource:      &domain.Resource{ID: "test-resource-id"},
+			Approvals: []*domain.Approval{
+				{
+					Name:      "step-1",
+					Index:     0,
+					Status:    domain.ApprovalStatusPending,
+					Approvers: []string{approver},
+				},
+			},
+		}
+	}
+
+	s.Run("should return error if question is empty", func() {
+		s.setup()
+
+		_, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     uuid.New().String(),
+			ApprovalName: "step-1",
+			Actor:        approver,
+			Action:       domain.AppealActionNameRequestInfo,
+			Reason:       " ",
+		})
+
+		s.ErrorIs(actualError, appeal.ErrInfoRequestQuestionRequired)
+	})
+
+	s.Run("should put the appeal on hold and notify the creator", func() {
+		s.setup()
+		a := newAppeal()
+		question := "which tables do you need?"
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, a.PolicyID, a.PolicyVersion).Return(&domain.Policy{}, nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyRequestInfo, mock.Anything).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Run(func(notifications []domain.Notification) {
+			s.Len(notifications, 1)
+			s.Equal(a.CreatedBy, notifications[0].User)
+			s.Equal(domain.NotificationTypeAppealNeedsInfo, notifications[0].Message.Type)
+			s.Equal(question, notifications[0].Message.Variables["question"])
+		}).Return(nil).Once()
+
+		actualAppeal, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "step-1",
+			Actor:        approver,
+			Action:       domain.AppealActionNameRequestInfo,
+			Reason:       question,
+		})
+
+		s.NoError(actualError)
+		s.Equal(domain.AppealStatusNeedsInfo, actualAppeal.Status)
+		approval := actualAppeal.GetApproval("step-1")
+		s.Equal(domain.ApprovalStatusPending, approval.Status)
+		s.Len(approval.Decisions, 1)
+		s.Equal(domain.AppealActionNameRequestInfo, approval.Decisions[0].Action)
+		s.Equal(question, approval.Decisions[0].Reason)
+		s.mockRepository.AssertExpectations(s.T())
+		s.mockNotifier.AssertExpectations(s.T())
+	})
+
+	s.Run("should return error when approving an appeal waiting for more information", func() {
+		s.setup()
+		a := newAppeal()
+		a.Status = domain.AppealStatusNeedsInfo
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+
+		_, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "step-1",
+			Actor:        approver,
+			Action:       domain.AppealActionNameApprove,
+		})
+
+		s.ErrorIs(actualError, appeal.ErrAppealStatusNeedsInfo)
+	})
+}
+
+func (s *ServiceTestSuite) TestUpdateAppeal() {
+	approver := "approver@example.com"
+	question := "which tables do you need?"
+	provider := &domain.Provider{
+		Type: "test-provider-type",
+		URN:  "test-provider-urn",
+		Config: &domain.ProviderConfig{
+			Type: "test-provider-type",
+			URN:  "test-provider-urn",
+		},
+	}
+	policy := &domain.Policy{
+		ID:      "test-policy-id",
+		Version: 1,
+		Steps: []*domain.Step{
+			{Name: "auto", Strategy: domain.ApprovalStepStrategyAuto, ApproveIf: `$appeal.role == "viewer"`, AllowFailed: true},
+			{Name: "owner", Strategy: domain.ApprovalStepStrategyManual, Approvers: []string{approver}},
+		},
+	}
+	newAppeal := func() *domain.Appeal {
+		return &domain.Appeal{
+			ID:            uuid.New().String(),
+			ResourceID:    "test-resource-id",
+			PolicyID:      policy.ID,
+			PolicyVersion: policy.Version,
+			Status:        domain.AppealStatusNeedsInfo,
+			AccountID:     "user@example.com",
+			AccountType:   domain.DefaultAppealAccountType,
+			CreatedBy:     "user@example.com",
+			Role:          "viewer",
+			Options:       &domain.AppealOptions{Duration: "24h"},
+			Description:   "need access",
+			Resource: &domain.Resource{
+				ID:           "test-resource-id",
+				Type:         "test-resource-type",
+				ProviderType: provider.Type,
+				ProviderURN:  provider.URN,
+			},
+			Approvals: []*domain.Approval{
+				{
+					ID:     "approval-auto",
+					Name:   "auto",
+					Index:  0,
+					Status: domain.ApprovalStatusApproved,
+				},
+				{
+					ID:        "approval-owner",
+					Name:      "owner",
+					Index:     1,
+					Status:    domain.ApprovalStatusPending,
+					Approvers: []string{approver, "escalation@example.com"},
+					Decisions: []*domain.ApprovalDecision{
+						{Actor: approver, Action: domain.AppealActionNameRequestInfo, Reason: question},
+					},
+				},
+			},
+		}
+	}
+
+	s.Run("should return error if actor is not the creator", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+
+		_, actualError := s.service.UpdateAppeal(context.Background(), domain.AppealUpdate{
+			AppealID: a.ID,
+			Actor:    approver,
+		})
+
+		s.ErrorIs(actualError, appeal.ErrAppealUpdateForbidden)
+	})
+
+	s.Run("should return error if appeal is no longer pending", func() {
+		s.setup()
+		a := newAppeal()
+		a.Status = domain.AppealStatusApproved
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+
+		_, actualError := s.service.UpdateAppeal(context.Background(), domain.AppealUpdate{
+			AppealID: a.ID,
+			Actor:    a.CreatedBy,
+		})
+
+		s.ErrorIs(actualError, appeal.ErrAppealStatusApproved)
+	})
+
+	s.Run("should return error if another pending appeal has the same role", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{
+			{AccountID: a.AccountID, ResourceID: a.ResourceID, Role: "editor"},
+		}, nil).Once()
+
+		_, actualError := s.service.UpdateAppeal(context.Background(), domain.AppealUpdate{
+			AppealID: a.ID,
+			Actor:    a.CreatedBy,
+			Role:     "editor",
+		})
+
+		s.ErrorIs(actualError, appeal.ErrAppealDuplicate)
+	})
+
+	s.Run("should re-evaluate the approvals and keep the previous version", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{
+			{ID: a.ID, AccountID: a.AccountID, ResourceID: a.ResourceID, Role: "viewer"},
+		}, nil).Once()
+		s.mockProviderService.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Provider{provider}, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+		s.mockGrantService.EXPECT().List(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockProviderService.EXPECT().ValidateAppeal(mock.Anything, a, provider, policy).Return(nil).Once()
+		s.mockProviderService.EXPECT().GetPermissions(mock.Anything, provider.Config, "test-resource-type", "editor").
+			Return([]interface{}{"roles/editor"}, nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockApprovalService.EXPECT().DeleteApprover(mock.Anything, "approval-owner", "escalation@example.com").Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyUpdate, mock.Anything).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Return(nil).Once()
+
+		actualAppeal, actualError := s.service.UpdateAppeal(context.Background(), domain.AppealUpdate{
+			AppealID:    a.ID,
+			Actor:       a.CreatedBy,
+			Role:        "editor",
+			Description: "need to update the customers table",
+		})
+
+		s.NoError(actualError)
+		s.Equal(domain.AppealStatusPending, actualAppeal.Status)
+		s.Equal("editor", actualAppeal.Role)
+		s.Equal([]string{"roles/editor"}, actualAppeal.Permissions)
+		s.Equal("need to update the customers table", actualAppeal.Description)
+		s.Equal("24h", actualAppeal.Options.Duration)
+
+		s.Len(actualAppeal.Approvals, 2)
+		s.Equal("approval-auto", actualAppeal.Approvals[0].ID)
+		s.Equal(domain.ApprovalStatusSkipped, actualAppeal.Approvals[0].Status)
+		s.Equal("approval-owner", actualAppeal.Approvals[1].ID)
+		s.Equal(domain.ApprovalStatusPending, actualAppeal.Approvals[1].Status)
+		s.Equal([]string{approver}, actualAppeal.Approvals[1].Approvers)
+		s.Empty(actualAppeal.Approvals[1].Decisions)
+
+		s.Len(actualAppeal.Revisions, 1)
+		revision := actualAppeal.Revisions[0]
+		s.Equal(domain.AppealStatusNeedsInfo, revision.Status)
+		s.Equal("viewer", revision.Role)
+		s.Equal("need access", revision.Description)
+		s.Equal(a.CreatedBy, revision.UpdatedBy)
+		s.Equal(question, revision.Approvals[1].Decisions[0].Reason)
+
+		s.mockRepository.AssertExpectations(s.T())
+		s.mockApprovalService.AssertExpectations(s.T())
+	})
+	s.Run("should drop the previous approvals no longer produced by the re-evaluation", func() {
+		s.setup()
+		a := newAppeal()
+		a.Approvals = append(a.Approvals, &domain.Approval{
+			ID:        "approval-sod",
+			Name:      domain.SoDApprovalName,
+			Index:     2,
+			Status:    domain.ApprovalStatusBlocked,
+			Approvers: []string{"security@example.com"},
+		})
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockProviderService.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Provider{provider}, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+		s.mockGrantService.EXPECT().List(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockProviderService.EXPECT().ValidateAppeal(mock.Anything, a, provider, policy).Return(nil).Once()
+		s.mockProviderService.EXPECT().GetPermissions(mock.Anything, provider.Config, "test-resource-type", "viewer").
+			Return([]interface{}{"roles/viewer"}, nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockApprovalService.EXPECT().DeleteApprover(mock.Anything, "approval-owner", "escalation@example.com").Return(nil).Once()
+		s.mockApprovalService.EXPECT().DeleteApproval(mock.Anything, "approval-sod").Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyUpdate, mock.Anything).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Return(nil).Once()
+
+		actualAppeal, actualError := s.service.UpdateAppeal(context.Background(), domain.AppealUpdate{
+			AppealID: a.ID,
+			Actor:    a.CreatedBy,
+		})
+
+		s.NoError(actualError)
+		s.Len(actualAppeal.Approvals, 2)
+		s.Equal("approval-auto", actualAppeal.Approvals[0].ID)
+		s.Equal("approval-owner", actualAppeal.Approvals[1].ID)
+
+		s.Require().Len(actualAppeal.Revisions, 1)
+		s.Len(actualAppeal.Revisions[0].Approvals, 3, "previous approvals should be left untouched")
+		s.Equal(domain.ApprovalStatusPending, actualAppeal.Revisions[0].Approvals[1].Status)
+		s.Len(actualAppeal.Revisions[0].Approvals[1].Decisions, 1)
+
+		s.mockRepository.AssertExpectations(s.T())
+		s.mockApprovalService.AssertExpectations(s.T())
+	})
+}
+
+func (s *ServiceTestSuite) TestUpdateApproval__Overrides() {
+	approver := "approver@example.com"
+	provider := &domain.Provider{
+		Type: "test-provider-type",
+		URN:  "test-provider-urn",
+		Config: &domain.ProviderConfig{
+			Type: "test-provider-type",
+			URN:  "test-provider-urn",
+		},
+	}
+	policy := &domain.Policy{
+		ID:      "test-policy-id",
+		Version: 1,
+		Steps: []*domain.Step{
+			{Name: "owner", Strategy: domain.ApprovalStepStrategyManual, Approvers: []string{approver}},
+			{Name: "admin", Strategy: domain.ApprovalStepStrategyManual, Approvers: []string{"admin@example.com"}},
+		},
+		AppealConfig: &domain.PolicyAppealConfig{
+			DurationOptions: []domain.AppealDurationOption{
+				{Name: "1 hour", Value: "1h"},
+				{Name: "1 day", Value: "24h"},
+				{Name: "1 week", Value: "168h"},
+			},
+		},
+	}
+	newAppeal := func() *domain.Appeal {
+		return &domain.Appeal{
+			ID:            uuid.New().String(),
+			ResourceID:    "test-resource-id",
+			PolicyID:      policy.ID,
+			PolicyVersion: policy.Version,
+			Status:        domain.AppealStatusPending,
+			AccountID:     "user@example.com",
+			AccountType:   domain.DefaultAppealAccountType,
+			CreatedBy:     "user@example.com",
+			Role:          "editor",
+			Permissions:   []string{"read", "write"},
+			Options:       &domain.AppealOptions{Duration: "24h"},
+			Resource: &domain.Resource{
+				ID:           "test-resource-id",
+				Type:         "test-resource-type",
+				ProviderType: provider.Type,
+				ProviderURN:  provider.URN,
+			},
+			Approvals: []*domain.Approval{
+				{Name: "owner", Index: 0, Status: domain.ApprovalStatusPending, Approvers: []string{approver}},
+				{Name: "admin", Index: 1, Status: domain.ApprovalStatusBlocked, Approvers: []string{"admin@example.com"}},
+			},
+		}
+	}
+
+	s.Run("should return error if overrides are set for other actions than approve", func() {
+		s.setup()
+
+		_, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     uuid.New().String(),
+			ApprovalName: "owner",
+			Actor:        approver,
+			Action:       domain.AppealActionNameReject,
+			Role:         "viewer",
+		})
+
+		s.ErrorIs(actualError, appeal.ErrApprovalOverrideNotAllowed)
+	})
+
+	s.Run("should return error if duration is longer than requested", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+
+		_, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "owner",
+			Actor:        approver,
+			Action:       domain.AppealActionNameApprove,
+			Duration:     "168h",
+		})
+
+		s.ErrorIs(actualError, appeal.ErrDurationOverrideTooLong)
+	})
+
+	s.Run("should return error if duration is not one of the policy duration options", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+
+		_, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "owner",
+			Actor:        approver,
+			Action:       domain.AppealActionNameApprove,
+			Duration:     "2h",
+		})
+
+		s.ErrorIs(actualError, appeal.ErrOptionsDurationNotFound)
+	})
+
+	s.Run("should approve with the new role and duration and keep the requested ones", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+		s.mockProviderService.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Provider{provider}, nil).Once()
+		s.mockProviderService.EXPECT().ValidateAppeal(mock.Anything, a, provider, policy).Return(nil).Once()
+		s.mockProviderService.EXPECT().GetPermissions(mock.Anything, provider.Config, "test-resource-type", "viewer").
+			Return([]interface{}{"read"}, nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyApprove, mock.Anything).Return(nil).Once()
+
+		actualAppeal, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "owner",
+			Actor:        approver,
+			Action:       domain.AppealActionNameApprove,
+			Role:         "viewer",
+			Duration:     "1h",
+		})
+
+		s.NoError(actualError)
+		s.Equal("viewer", actualAppeal.Role)
+		s.Equal([]string{"read"}, actualAppeal.Permissions)
+		s.Equal("1h", actualAppeal.Options.Duration)
+		s.Equal(domain.ApprovalStatusApproved, actualAppeal.Approvals[0].Status)
+		s.Equal(domain.ApprovalStatusPending, actualAppeal.Approvals[1].Status)
+
+		s.Require().Len(actualAppeal.Revisions, 1)
+		revision := actualAppeal.Revisions[0]
+		s.Equal("editor", revision.Role)
+		s.Equal([]string{"read", "write"}, revision.Permissions)
+		s.Equal("24h", revision.Options.Duration)
+		s.Equal(approver, revision.UpdatedBy)
+		s.mockProviderService.AssertExpectations(s.T())
+	})
+
+	s.Run("should return error if the role has permissions that are not requested", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+		s.mockProviderService.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Provider{provider}, nil).Once()
+		s.mockProviderService.EXPECT().ValidateAppeal(mock.Anything, a, provider, policy).Return(nil).Once()
+		s.mockProviderService.EXPECT().GetPermissions(mock.Anything, provider.Config, "test-resource-type", "owner").
+			Return([]interface{}{"read", "write", "admin"}, nil).Once()
+
+		_, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "owner",
+			Actor:        approver,
+			Action:       domain.AppealActionNameApprove,
+			Role:         "owner",
+		})
+
+		s.ErrorIs(actualError, appeal.ErrRoleOverrideNotAllowed)
+		s.mockRepository.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func (s *ServiceTestSuite) TestUpdateApproval__ParallelGroup() {
+	policy := &domain.Policy{
+		ID:      "test-policy-id",
+		Version: 1,
+		Steps: []*domain.Step{
+			{Name: "security", Strategy: domain.ApprovalStepStrategyManual, Approvers: []string{"security@example.com"}, ParallelGroup: "review"},
+			{Name: "owner", Strategy: domain.ApprovalStepStrategyManual, Approvers: []string{"owner@example.com"}, ParallelGroup: "review"},
+			{Name: "admin", Strategy: domain.ApprovalStepStrategyManual, Approvers: []string{"admin@example.com"}},
+		},
+	}
+	newAppeal := func(securityStatus string) *domain.Appeal {
+		return &domain.Appeal{
+			ID:            uuid.New().String(),
+			ResourceID:    "test-resource-id",
+			PolicyID:      policy.ID,
+			PolicyVersion: policy.Version,
+			Status:        domain.AppealStatusPending,
+			AccountID:     "user@example.com",
+			AccountType:   domain.DefaultAppealAccountType,
+			CreatedBy:     "user@example.com",
+			Role:          "editor",
+			Resource:      &domain.Resource{ID: "test-resource-id"},
+			Approvals: []*domain.Approval{
+				{Name: "security", Index: 0, Status: securityStatus, Approvers: []string{"security@example.com"}, ParallelGroup: "review"},
+				{Name: "owner", Index: 1, Status: domain.ApprovalStatusPending, Approvers: []string{"owner@example.com"}, ParallelGroup: "review"},
+				{Name: "admin", Index: 2, Status: domain.ApprovalStatusBlocked, Approvers: []string{"admin@example.com"}},
+			},
+		}
+	}
+
+	s.Run("should approve a step while the other steps of the group are still pending", func() {
+		s.setup()
+		a := newAppeal(domain.ApprovalStatusPending)
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyApprove, mock.Anything).Return(nil).Once()
+
+		actualAppeal, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "owner",
+			Actor:        "owner@example.com",
+			Action:       domain.AppealActionNameApprove,
+		})
+
+		s.NoError(actualError)
+		s.Equal(domain.AppealStatusPending, actualAppeal.Status)
+		s.Equal(domain.ApprovalStatusPending, actualAppeal.Approvals[0].Status)
+		s.Equal(domain.ApprovalStatusApproved, actualAppeal.Approvals[1].Status)
+		s.Equal(domain.ApprovalStatusBlocked, actualAppeal.Approvals[2].Status)
+		s.mockNotifier.AssertNotCalled(s.T(), "Notify", mock.Anything)
+	})
+
+	s.Run("should unblock the next step and notify its approvers once the whole group is approved", func() {
+		s.setup()
+		a := newAppeal(domain.ApprovalStatusApproved)
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.MatchedBy(func(notifications []domain.Notification) bool {
+			return len(notifications) == 1 &&
+				notifications[0].User == "admin@example.com" &&
+				notifications[0].Message.Type == domain.NotificationTypeApproverNotification
+		})).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyApprove, mock.Anything).Return(nil).Once()
+
+		actualAppeal, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "owner",
+			Actor:        "owner@example.com",
+			Action:       domain.AppealActionNameApprove,
+		})
+
+		s.NoError(actualError)
+		s.Equal(domain.AppealStatusPending, actualAppeal.Status)
+		s.Equal(domain.ApprovalStatusApproved, actualAppeal.Approvals[1].Status)
+		s.Equal(domain.ApprovalStatusPending, actualAppeal.Approvals[2].Status)
+		s.mockNotifier.AssertExpectations(s.T())
+	})
+
+	s.Run("should skip the other steps of the group if a step is rejected", func() {
+		s.setup()
+		a := newAppeal(domain.ApprovalStatusPending)
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyReject, mock.Anything).Return(nil).Once()
+
+		actualAppeal, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "owner",
+			Actor:        "owner@example.com",
+			Action:       domain.AppealActionNameReject,
+		})
+
+		s.NoError(actualError)
+		s.Equal(domain.AppealStatusRejected, actualAppeal.Status)
+		s.Equal(domain.ApprovalStatusSkipped, actualAppeal.Approvals[0].Status)
+		s.Equal(domain.ApprovalStatusRejected, actualAppeal.Approvals[1].Status)
+		s.Equal(domain.ApprovalStatusSkipped, actualAppeal.Approvals[2].Status)
+	})
+}
+
+func (s *ServiceTestSuite) TestUpdateExternalApproval() {
+	policy := &domain.Policy{
+		ID:      "test-policy-id",
+		Version: 1,
+		Steps: []*domain.Step{
+			{Name: "nda_check", Strategy: domain.ApprovalStepStrategyExternal, Webhook: "nda"},
+			{Name: "admin", Strategy: domain.ApprovalStepStrategyManual, Approvers: []string{"admin@example.com"}},
+		},
+	}
+	newAppeal := func() *domain.Appeal {
+		return &domain.Appeal{
+			ID:            uuid.New().String(),
+			ResourceID:    "test-resource-id",
+			PolicyID:      policy.ID,
+			PolicyVersion: policy.Version,
+			Status:        domain.AppealStatusPending,
+			AccountID:     "user@example.com",
+			AccountType:   domain.DefaultAppealAccountType,
+			CreatedBy:     "user@example.com",
+			Role:          "editor",
+			Resource:      &domain.Resource{ID: "test-resource-id"},
+			Approvals: []*domain.Approval{
+				{Name: "nda_check", Index: 0, Status: domain.ApprovalStatusPending},
+				{Name: "admin", Index: 1, Status: domain.ApprovalStatusBlocked, Approvers: []string{"admin@example.com"}},
+			},
+		}
+	}
+
+	s.Run("should approve the external step and unblock the next step", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+		s.mockExternalApprover.EXPECT().IsCallbackActor("nda", "nda-service@example.com").Return(true).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.MatchedBy(func(notifications []domain.Notification) bool {
+			return len(notifications) == 1 && notifications[0].User == "admin@example.com"
+		})).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyApprove, mock.Anything).Return(nil).Once()
+
+		actualAppeal, actualError := s.service.UpdateExternalApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "nda_check",
+			Actor:        "nda-service@example.com",
+			Action:       domain.AppealActionNameApprove,
+			Reason:       "nda signed",
+		})
+
+		s.NoError(actualError)
+		s.Equal(domain.AppealStatusPending, actualAppeal.Status)
+		s.Equal(domain.ApprovalStatusApproved, actualAppeal.Approvals[0].Status)
+		s.Equal("nda-service@example.com", *actualAppeal.Approvals[0].Actor)
+		s.Equal("nda signed", actualAppeal.Approvals[0].Reason)
+		s.Equal(domain.ApprovalStatusPending, actualAppeal.Approvals[1].Status)
+		s.mockNotifier.AssertExpectations(s.T())
+	})
+
+	s.Run("should leave the webhook of the next external step to the job", func() {
+		s.setup()
+		externalPolicy := &domain.Policy{
+			ID:      policy.ID,
+			Version: policy.Version,
+			Steps: []*domain.Step{
+				{Name: "nda_check", Strategy: domain.ApprovalStepStrategyExternal, Webhook: "nda"},
+				{Name: "compliance_check", Strategy: domain.ApprovalStepStrategyExternal, Webhook: "compliance"},
+			},
+		}
+		a := newAppeal()
+		a.Approvals[1] = &domain.Approval{Name: "compliance_check", Index: 1, Status: domain.ApprovalStatusBlocked}
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(externalPolicy, nil).Once()
+		s.mockExternalApprover.EXPECT().IsCallbackActor("nda", "nda-service@example.com").Return(true).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyApprove, mock.Anything).Return(nil).Once()
+
+		actualAppeal, actualError := s.service.UpdateExternalApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "nda_check",
+			Actor:        "nda-service@example.com",
+			Action:       domain.AppealActionNameApprove,
+		})
+
+		s.NoError(actualError)
+		s.Equal(domain.AppealStatusPending, actualAppeal.Status)
+		s.Equal(domain.ApprovalStatusPending, actualAppeal.Approvals[1].Status)
+		s.mockExternalApprover.AssertNotCalled(s.T(), "Evaluate", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("should reject the appeal if the external step is rejected", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+		s.mockExternalApprover.EXPECT().IsCallbackActor("nda", "nda-service@example.com").Return(true).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyReject, mock.Anything).Return(nil).Once()
+
+		actualAppeal, actualError := s.service.UpdateExternalApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "nda_check",
+			Actor:        "nda-service@example.com",
+			Action:       domain.AppealActionNameReject,
+		})
+
+		s.NoError(actualError)
+		s.Equal(domain.AppealStatusRejected, actualAppeal.Status)
+		s.Equal(domain.ApprovalStatusRejected, actualAppeal.Approvals[0].Status)
+		s.Equal(domain.ApprovalStatusSkipped, actualAppeal.Approvals[1].Status)
+	})
+
+	s.Run("should return error if actor is not a callback actor of the webhook", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+		s.mockExternalApprover.EXPECT().IsCallbackActor("nda", "user@example.com").Return(false).Once()
+
+		actualAppeal, actualError := s.service.UpdateExternalApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "nda_check",
+			Actor:        "user@example.com",
+			Action:       domain.AppealActionNameApprove,
+		})
+
+		s.ErrorIs(actualError, appeal.ErrActionForbidden)
+		s.Nil(actualAppeal)
+		s.mockRepository.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should return error if the step is not external", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+
+		actualAppeal, actualError := s.service.UpdateExternalApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     a.ID,
+			ApprovalName: "admin",
+			Actor:        "nda-service@example.com",
+			Action:       domain.AppealActionNameApprove,
+		})
+
+		s.ErrorIs(actualError, appeal.ErrApprovalNotExternal)
+		s.Nil(actualAppeal)
+	})
+
+	s.Run("should return error if decision is neither approve nor reject", func() {
+		s.setup()
+
+		actualAppeal, actualError := s.service.UpdateExternalApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     uuid.New().String(),
+			ApprovalName: "nda_check",
+			Actor:        "nda-service@example.com",
+			Action:       domain.AppealActionNameRequestInfo,
+		})
+
+		s.ErrorIs(actualError, appeal.ErrActionInvalidValue)
+		s.Nil(actualAppeal)
+	})
+}
+
+func (s *ServiceTestSuite) TestRequestExternalApprovals() {
+	policy := &domain.Policy{
+		ID:      "test-policy-id",
+		Version: 1,
+		Steps: []*domain.Step{
+			{Name: "nda_check", Strategy: domain.ApprovalStepStrategyExternal, Webhook: "nda"},
+			{Name: "admin", Strategy: domain.ApprovalStepStrategyManual, Approvers: []string{"admin@example.com"}},
+		},
+	}
+	newAppeal := func() *domain.Appeal {
+		return &domain.Appeal{
+			ID:            uuid.New().String(),
+			ResourceID:    "test-resource-id",
+			PolicyID:      policy.ID,
+			PolicyVersion: policy.Version,
+			Status:        domain.AppealStatusPending,
+			AccountID:     "user@example.com",
+			AccountType:   domain.DefaultAppealAccountType,
+			CreatedBy:     "user@example.com",
+			Role:          "editor",
+			Resource:      &domain.Resource{ID: "test-resource-id"},
+			Approvals: []*domain.Approval{
+				{Name: "nda_check", Index: 0, Status: domain.ApprovalStatusPending},
+				{Name: "admin", Index: 1, Status: domain.ApprovalStatusBlocked, Approvers: []string{"admin@example.com"}},
+			},
+		}
+	}
+
+	s.Run("should apply the decision returned by the webhook", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{a}, nil).Once()
+		s.mockPolicyService.EXPECT().Find(mock.Anything).Return([]*domain.Policy{policy}, nil).Once()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockExternalApprover.EXPECT().Evaluate(mock.Anything, "nda", mock.MatchedBy(func(req *domain.ExternalApprovalRequest) bool {
+			return req.AppealID == a.ID && req.ApprovalName == "nda_check"
+		})).Return(&domain.ExternalApprovalResponse{Decision: domain.AppealActionNameApprove, Reason: "nda signed"}, nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyExternal, mock.Anything).Return(nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyApprove, mock.Anything).Return(nil).Once()
+
+		actualAppeals, actualError := s.service.RequestExternalApprovals(context.Background())
+
+		s.NoError(actualError)
+		s.Len(actualAppeals, 1)
+		s.Equal(domain.ApprovalStatusApproved, a.Approvals[0].Status)
+		s.Equal(domain.SystemActorName, *a.Approvals[0].Actor)
+		s.Equal(domain.ApprovalStatusPending, a.Approvals[1].Status)
+	})
+
+	s.Run("should keep the step pending if the webhook fails", func() {
+		s.setup()
+		a := newAppeal()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{a}, nil).Once()
+		s.mockPolicyService.EXPECT().Find(mock.Anything).Return([]*domain.Policy{policy}, nil).Once()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockExternalApprover.EXPECT().Evaluate(mock.Anything, "nda", mock.Anything).Return(nil, errors.New("connection refused")).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyExternal, mock.MatchedBy(func(data map[string]interface{}) bool {
+			return data["error"] == "connection refused"
+		})).Return(nil).Once()
+
+		actualAppeals, actualError := s.service.RequestExternalApprovals(context.Background())
+
+		s.NoError(actualError)
+		s.Len(actualAppeals, 1)
+		s.Equal(domain.ApprovalStatusPending, a.Approvals[0].Status)
+		s.mockRepository.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should ignore appeals without external steps", func() {
+		s.setup()
+		a := newAppeal()
+		a.PolicyID = "other-policy-id"
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{a}, nil).Once()
+		s.mockPolicyService.EXPECT().Find(mock.Anything).Return([]*domain.Policy{policy, {ID: "other-policy-id", Version: 1}}, nil).Once()
+
+		actualAppeals, actualError := s.service.RequestExternalApprovals(context.Background())
+
+		s.NoError(actualError)
+		s.Empty(actualAppeals)
+		s.mockExternalApprover.AssertNotCalled(s.T(), "Evaluate", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func (s *ServiceTestSuite) TestRemindBreakGlassReviews() {
+	s.Run("should notify reviewers of pending break-glass reviews", func() {
+		s.setup()
+		appealID := uuid.New().String()
+		breakGlassAppeal := &domain.Appeal{
+			ID:       appealID,
+			Status:   domain.AppealStatusApproved,
+			Options:  &domain.AppealOptions{BreakGlass: true},
+			Resource: &domain.Resource{ID: "test-resource-id"},
+			Approvals: []*domain.Approval{
+				{
+					Name:      domain.BreakGlassReviewApprovalName,
+					Status:    domain.ApprovalStatusPending,
+					Approvers: []string{"a@example.com", "b@example.com"},
+				},
+			},
+		}
+		s.mockApprovalService.EXPECT().ListApprovals(mock.Anything, &domain.ListApprovalsFilter{
+			Statuses:       []string{domain.ApprovalStatusPending},
+			AppealStatuses: []string{domain.AppealStatusApproved},
+		}).Return([]*domain.Approval{
+			{AppealID: appealID, Name: domain.BreakGlassReviewApprovalName},
+			{AppealID: appealID, Name: domain.BreakGlassReviewApprovalName},
+			{AppealID: "other-appeal", Name: "step-1"},
+		}, nil).Once()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(breakGlassAppeal, nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.MatchedBy(func(notifications []domain.Notification) bool {
+			return len(notifications) == 2 &&
+				notifications[0].Message.Type == domain.NotificationTypeBreakGlassReview &&
+				notifications[1].User == "b@example.com"
+		})).Return(nil).Once()
+
+		actualAppeals, actualError := s.service.RemindBreakGlassReviews(context.Background())
+
+		s.NoError(actualError)
+		s.Equal([]*domain.Appeal{breakGlassAppeal}, actualAppeals)
+		s.mockNotifier.AssertExpectations(s.T())
+	})
+}
+
+func (s *ServiceTestSuite) TestUpdateApproval() {
+	timeNow := time.Now()
+	appealID := uuid.New().String()
+	appeal.TimeNow = func() time.Time {
+		return timeNow
+	}
+	s.Run("should return error if approval action parameter is invalid", func() {
+		invalidApprovalActionParameters := []domain.ApprovalAction{
+			{
+				ApprovalName: "approval_1",
+				Actor:        "user@email.com",
+				Action:       "name",
+			},
+			{
+				AppealID: appealID,
+				Actor:    "user@email.com",
+				Action:   "name",
+			},
+			{
+				AppealID:     appealID,
+				ApprovalName: "approval_1",
+				Actor:        "invalidemail",
+				Action:       "name",
+			},
+			{
+				AppealID:     appealID,
+				ApprovalName: "approval_1",
+				Action:       "name",
+			},
+			{
+				AppealID:     appealID,
+				ApprovalName: "approval_1",
+				Actor:        "user@email.com",
+			},
+		}
+
+		for _, param := range invalidApprovalActionParameters {
+			actualResult, actualError := s.service.UpdateApproval(context.Background(), param)
+
+			s.Nil(actualResult)
+			s.Error(actualError)
+		}
+	})
+
+	validApprovalActionParam := domain.ApprovalAction{
+		AppealID:     appealID,
+		ApprovalName: "approval_1",
+		Actor:        "user@email.com",
+		Action:       "approve",
+	}
+
+	s.Run("should return error if got any from repository while getting appeal details", func() {
+		expectedError := errors.New("repository error")
+		s.mockRepository.EXPECT().GetByID(mock.AnythingOfType("context.backgroundCtx"), mock.Anything).Return(nil, expectedError).Once()
+
+		actualResult, actualError := s.service.UpdateApproval(context.Background(), validApprovalActionParam)
+
+		s.mockRepository.AssertExpectations(s.T())
+		s.Nil(actualResult)
+		s.EqualError(actualError, expectedError.Error())
+	})
+
+	s.Run("should return error if appeal not found", func() {
+		expectedError := appeal.ErrAppealNotFound
+		s.mockRepository.EXPECT().GetByID(mock.AnythingOfType("context.backgroundCtx"), mock.Anything).Return(nil, expectedError).Once()
+
+		actualResult, actualError := s.service.UpdateApproval(context.Background(), validApprovalActionParam)
+
+		s.mockRepository.AssertExpectations(s.T())
+		s.Nil(actualResult)
+		s.EqualError(actualError, appeal.ErrAppealNotFound.Error())
+	})
+
+	s.Run("should return error based on statuses conditions", func() {
+		testCases := []struct {
+			name          string
+			appealStatus  string
+			approvals     []*domain.Approval
+			expectedError error
+		}{
+			{
+				name:          "appeal not eligible, status: approved",
+				appealStatus:  domain.AppealStatusApproved,
+				expectedError: appeal.ErrAppealStatusApproved,
+			},
+			{
+				name:          "appeal not eligible, status: rejected",
+				appealStatus:  domain.AppealStatusRejected,
+				expectedError: appeal.ErrAppealStatusRejected,
+			},
+			{
+				name:          "invalid appeal status",
+				appealStatus:  "invalidstatus",
+				expectedError: appeal.ErrAppealStatusUnrecognized,
+			},
+			{
+				name:         "previous approval step still on pending",
+				appealStatus: domain.AppealStatusPending,
+				approvals: []*domain.Approval{
+					{
+						Name:   "approval_0",
+						Status: domain.ApprovalStatusPending,
+					},
+					{
+						Name:   "approval_1",
+						Status: domain.ApprovalStatusPending,
+					},
+				},
+				expectedError: appeal.ErrApprovalDependencyIsPending,
+			},
+			{
+				name:         "found one previous approval is reject",
+				appealStatus: domain.AppealStatusPending,
+				approvals: []*domain.Approval{
+					{
+						Name:   "approval_0",
+						Status: domain.ApprovalStatusRejected,
+					},
+					{
+						Name:   "approval_1",
+						Status: domain.ApprovalStatusPending,
+					},
+				},
+				expectedError: appeal.ErrAppealStatusRejected,
+			},
+			{
+				name:         "invalid approval status",
+				appealStatus: domain.AppealStatusPending,
+				approvals: []*domain.Approval{
+					{
+						Name:   "approval_0",
+						Status: "invalidstatus",
+					},
+					{
+						Name:   "approval_1",
+						Status: domain.ApprovalStatusPending,
+					},
+				},
+				expectedError: appeal.ErrApprovalStatusUnrecognized,
+			},
+			{
+				name:         "approval step already approved",
+				appealStatus: domain.AppealStatusPending,
+				approvals: []*domain.Approval{
+					{
+						Name:   "approval_0",
+						Status: domain.ApprovalStatusApproved,
+					},
+					{
+						Name:   "approval_1",
+						Status: domain.ApprovalStatusApproved,
+					},
+				},
+				expectedError: appeal.ErrApprovalStatusApproved,
+			},
+			{
+				name:         "approval step already rejected",
+				appealStatus: domain.AppealStatusPending,
+				approvals: []*domain.Approval{
+					{
+						Name:   "approval_0",
+						Status: domain.ApprovalStatusApproved,
+					},
+					{
+						Name:   "approval_1",
+						Status: domain.ApprovalStatusRejected,
+					},
+				},
+				expectedError: appeal.ErrApprovalStatusRejected,
+			},
+			{
+				name:         "approval step already skipped",
 				appealStatus: domain.AppealStatusPending,
 				approvals: []*domain.Approval{
 					{
@@ -2263,54 +3787,644 @@ func (s *ServiceTestSuite) TestUpdateApproval() {
 				},
 			},
 		}
-		for _, tc := range testCases {
-			s.Run(tc.name, func() {
-				s.setup()
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				s.setup()
+
+				s.mockRepository.EXPECT().
+					GetByID(mock.AnythingOfType("context.backgroundCtx"), validApprovalActionParam.AppealID).
+					Return(tc.expectedAppealDetails, nil).Once()
+
+				mockPolicy := &domain.Policy{
+					Steps: []*domain.Step{
+						{
+							Name: "step-1",
+						},
+						{
+							Name: "step-2",
+						},
+					},
+				}
+				s.mockPolicyService.EXPECT().
+					GetOne(mock.Anything, tc.expectedAppealDetails.PolicyID, tc.expectedAppealDetails.PolicyVersion).
+					Return(mockPolicy, nil).Once()
+				tc.expectedResult.Policy = mockPolicy
+
+				if tc.expectedApprovalAction.Action == "approve" {
+					s.mockGrantService.EXPECT().
+						List(mock.Anything, domain.ListGrantsFilter{
+							AccountIDs:  []string{tc.expectedAppealDetails.AccountID},
+							ResourceIDs: []string{tc.expectedAppealDetails.ResourceID},
+							Statuses:    []string{string(domain.GrantStatusActive)},
+							Permissions: tc.expectedAppealDetails.Permissions,
+						}).Return([]domain.Grant{}, nil).Once()
+					s.mockGrantService.EXPECT().
+						Prepare(mock.Anything, mock.Anything).Return(tc.expectedGrant, nil).Once()
+					s.mockProviderService.EXPECT().GrantAccess(mock.Anything, *tc.expectedGrant).Return(nil).Once()
+				}
+
+				// every action is recorded as a decision of the acting approver
+				for _, approval := range tc.expectedResult.Approvals {
+					if approval.Name == tc.expectedApprovalAction.ApprovalName {
+						approval.Decisions = []*domain.ApprovalDecision{{
+							Actor:     tc.expectedApprovalAction.Actor,
+							Action:    tc.expectedApprovalAction.Action,
+							Reason:    tc.expectedApprovalAction.Reason,
+							CreatedAt: timeNow,
+						}}
+					}
+				}
+
+				s.mockRepository.EXPECT().Update(mock.AnythingOfType("context.backgroundCtx"), tc.expectedResult).Return(nil).Once()
+				s.mockNotifier.EXPECT().Notify(mock.Anything).Return(nil).Once()
+				s.mockAuditLogger.EXPECT().Log(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil).Once()
+
+				actualResult, actualError := s.service.UpdateApproval(context.Background(), tc.expectedApprovalAction)
+
+				s.NoError(actualError)
+				tc.expectedResult.Policy = actualResult.Policy
+				s.Equal(tc.expectedResult, actualResult)
+			})
+		}
+	})
+}
+
+func (s *ServiceTestSuite) TestExpirePendingAppeals() {
+	timeNow := time.Now()
+	appeal.TimeNow = func() time.Time {
+		return timeNow
+	}
+	policies := []*domain.Policy{
+		{ID: "policy_1", Version: 1, AppealConfig: &domain.PolicyAppealConfig{PendingTTL: "24h"}},
+		{ID: "policy_2", Version: 1, AppealConfig: &domain.PolicyAppealConfig{PendingTTL: "72h"}},
+		{ID: "policy_3", Version: 1},
+	}
+
+	s.Run("should do nothing if no policy has pending ttl", func() {
+		s.setup()
+
+		s.mockPolicyService.EXPECT().Find(mock.Anything).Return([]*domain.Policy{{ID: "policy_3", Version: 1}}, nil).Once()
+
+		actualAppeals, actualError := s.service.ExpirePendingAppeals(context.Background())
+
+		s.NoError(actualError)
+		s.Empty(actualAppeals)
+		s.mockRepository.AssertNotCalled(s.T(), "Find", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should expire appeals older than their policy pending ttl", func() {
+		s.setup()
+
+		expiredAppeal := &domain.Appeal{
+			ID:            "appeal-1",
+			PolicyID:      "policy_1",
+			PolicyVersion: 1,
+			Status:        domain.AppealStatusPending,
+			CreatedBy:     "user@example.com",
+			CreatedAt:     timeNow.Add(-48 * time.Hour),
+		}
+		withinTTLAppeal := &domain.Appeal{
+			ID:            "appeal-2",
+			PolicyID:      "policy_2",
+			PolicyVersion: 1,
+			Status:        domain.AppealStatusPending,
+			CreatedBy:     "user@example.com",
+			CreatedAt:     timeNow.Add(-48 * time.Hour),
+		}
+		s.mockPolicyService.EXPECT().Find(mock.Anything).Return(policies, nil).Once()
+		s.mockRepository.EXPECT().Find(mock.Anything, &domain.ListAppealsFilter{
+			Statuses:          []string{domain.AppealStatusPending, domain.AppealStatusNeedsInfo},
+			CreatedAtLessThan: timeNow.Add(-24 * time.Hour),
+		}).Return([]*domain.Appeal{expiredAppeal, withinTTLAppeal}, nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, expiredAppeal).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyExpire, mock.Anything).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.MatchedBy(func(notifications []domain.Notification) bool {
+			return len(notifications) == 1 &&
+				notifications[0].User == "user@example.com" &&
+				notifications[0].Message.Type == domain.NotificationTypeAppealExpired
+		})).Return(nil).Once()
+
+		actualAppeals, actualError := s.service.ExpirePendingAppeals(context.Background())
+
+		s.NoError(actualError)
+		s.Equal([]*domain.Appeal{expiredAppeal}, actualAppeals)
+		s.Equal(domain.AppealStatusExpired, expiredAppeal.Status)
+		s.Equal(domain.AppealStatusPending, withinTTLAppeal.Status)
+		s.mockRepository.AssertExpectations(s.T())
+		s.mockNotifier.AssertExpectations(s.T())
+	})
+}
+
+func (s *ServiceTestSuite) TestHandleOverdueApprovals() {
+	timeNow := time.Now()
+	appeal.TimeNow = func() time.Time {
+		return timeNow
+	}
+	newPolicy := func(step *domain.Step) *domain.Policy {
+		step.Name = "step_1"
+		step.Strategy = domain.ApprovalStepStrategyManual
+		step.Approvers = []string{"approver@example.com"}
+		return &domain.Policy{
+			ID:      "policy_1",
+			Version: 1,
+			Steps:   []*domain.Step{step},
+		}
+	}
+	appealID := uuid.New().String()
+	newAppeal := func(createdAt time.Time) *domain.Appeal {
+		return &domain.Appeal{
+			ID:            appealID,
+			PolicyID:      "policy_1",
+			PolicyVersion: 1,
+			Status:        domain.AppealStatusPending,
+			CreatedBy:     "user@example.com",
+			Resource:      &domain.Resource{},
+			Approvals: []*domain.Approval{
+				{
+					ID:        "approval-1",
+					Name:      "step_1",
+					Index:     0,
+					Status:    domain.ApprovalStatusPending,
+					Approvers: []string{"approver@example.com"},
+				},
+			},
+			CreatedAt: createdAt,
+		}
+	}
+
+	s.Run("should skip approvals that are not overdue yet", func() {
+		s.setup()
+
+		a := newAppeal(timeNow.Add(-time.Hour))
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{a}, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "policy_1", uint(1)).Return(newPolicy(&domain.Step{Timeout: "24h", OnTimeout: domain.StepTimeoutActionReject}), nil).Once()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+
+		actualAppeals, actualError := s.service.HandleOverdueApprovals(context.Background())
+
+		s.NoError(actualError)
+		s.Empty(actualAppeals)
+		s.mockRepository.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should add escalation approvers to overdue approval", func() {
+		s.setup()
+
+		a := newAppeal(timeNow.Add(-25 * time.Hour))
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{a}, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "policy_1", uint(1)).Return(newPolicy(&domain.Step{
+			Timeout:    "24h",
+			EscalateTo: []string{"manager@example.com"},
+			OnTimeout:  domain.StepTimeoutActionReject,
+		}), nil).Once()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockApprovalService.EXPECT().AddApprover(mock.Anything, "approval-1", "manager@example.com").Return(nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyEscalate, mock.Anything).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.MatchedBy(func(notifications []domain.Notification) bool {
+			return len(notifications) == 1 &&
+				notifications[0].User == "manager@example.com" &&
+				notifications[0].Message.Type == domain.NotificationTypeApprovalEscalated
+		})).Return(nil).Once()
+
+		actualAppeals, actualError := s.service.HandleOverdueApprovals(context.Background())
+
+		s.NoError(actualError)
+		s.Len(actualAppeals, 1)
+		s.Equal(domain.AppealStatusPending, a.Status)
+		s.Equal([]string{"approver@example.com", "manager@example.com"}, a.Approvals[0].Approvers)
+		s.Equal(timeNow, *a.Approvals[0].EscalatedAt)
+		s.mockApprovalService.AssertExpectations(s.T())
+		s.mockNotifier.AssertExpectations(s.T())
+	})
+
+	s.Run("should reject appeal if escalated approval is overdue again", func() {
+		s.setup()
+
+		a := newAppeal(timeNow.Add(-72 * time.Hour))
+		escalatedAt := timeNow.Add(-25 * time.Hour)
+		a.Approvals[0].EscalatedAt = &escalatedAt
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{a}, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "policy_1", uint(1)).Return(newPolicy(&domain.Step{
+			Timeout:    "24h",
+			EscalateTo: []string{"manager@example.com"},
+			OnTimeout:  domain.StepTimeoutActionReject,
+		}), nil).Once()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyTimeout, mock.Anything).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.MatchedBy(func(notifications []domain.Notification) bool {
+			return len(notifications) == 1 && notifications[0].Message.Type == domain.NotificationTypeAppealRejected
+		})).Return(nil).Once()
+
+		actualAppeals, actualError := s.service.HandleOverdueApprovals(context.Background())
+
+		s.NoError(actualError)
+		s.Len(actualAppeals, 1)
+		s.Equal(domain.AppealStatusRejected, a.Status)
+		s.Equal(domain.ApprovalStatusRejected, a.Approvals[0].Status)
+		s.Equal(domain.SystemActorName, *a.Approvals[0].Actor)
+	})
+
+	s.Run("should skip overdue approval and move on to the next step", func() {
+		s.setup()
+
+		a := newAppeal(timeNow.Add(-25 * time.Hour))
+		a.Approvals = append(a.Approvals, &domain.Approval{
+			ID:        "approval-2",
+			Name:      "step_2",
+			Index:     1,
+			Status:    domain.ApprovalStatusBlocked,
+			Approvers: []string{"next@example.com"},
+		})
+		p := newPolicy(&domain.Step{Timeout: "24h", OnTimeout: domain.StepTimeoutActionSkip})
+		p.Steps = append(p.Steps, &domain.Step{
+			Name:      "step_2",
+			Strategy:  domain.ApprovalStepStrategyManual,
+			Approvers: []string{"next@example.com"},
+		})
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{a}, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "policy_1", uint(1)).Return(p, nil).Once()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyTimeout, mock.Anything).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.MatchedBy(func(notifications []domain.Notification) bool {
+			return len(notifications) == 1 && notifications[0].User == "next@example.com"
+		})).Return(nil).Once()
+
+		actualAppeals, actualError := s.service.HandleOverdueApprovals(context.Background())
+
+		s.NoError(actualError)
+		s.Len(actualAppeals, 1)
+		s.Equal(domain.AppealStatusPending, a.Status)
+		s.Equal(domain.ApprovalStatusSkipped, a.Approvals[0].Status)
+		s.Equal(domain.ApprovalStatusPending, a.Approvals[1].Status)
+	})
+
+	s.Run("should get each policy version once", func() {
+		s.setup()
+
+		a1 := newAppeal(timeNow.Add(-time.Hour))
+		a2 := newAppeal(timeNow.Add(-time.Hour))
+		a2.ID = uuid.New().String()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{a1, a2}, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "policy_1", uint(1)).Return(newPolicy(&domain.Step{Timeout: "24h", OnTimeout: domain.StepTimeoutActionReject}), nil).Once()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a1.ID).Return(a1, nil).Once()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a2.ID).Return(a2, nil).Once()
+
+		actualAppeals, actualError := s.service.HandleOverdueApprovals(context.Background())
+
+		s.NoError(actualError)
+		s.Empty(actualAppeals)
+		s.mockPolicyService.AssertNumberOfCalls(s.T(), "GetOne", 1)
+	})
+
+	s.Run("should count the timeout from the resolution of the previous skipped step", func() {
+		s.setup()
+
+		a := newAppeal(timeNow.Add(-72 * time.Hour))
+		a.Approvals[0].Status = domain.ApprovalStatusSkipped
+		a.Approvals[0].UpdatedAt = timeNow.Add(-time.Hour)
+		a.Approvals = append(a.Approvals, &domain.Approval{
+			ID:        "approval-2",
+			Name:      "step_2",
+			Index:     1,
+			Status:    domain.ApprovalStatusPending,
+			Approvers: []string{"next@example.com"},
+		})
+		p := newPolicy(&domain.Step{Timeout: "24h", OnTimeout: domain.StepTimeoutActionSkip})
+		p.Steps = append(p.Steps, &domain.Step{
+			Name:      "step_2",
+			Strategy:  domain.ApprovalStepStrategyManual,
+			Approvers: []string{"next@example.com"},
+			Timeout:   "24h",
+			OnTimeout: domain.StepTimeoutActionReject,
+		})
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Appeal{a}, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "policy_1", uint(1)).Return(p, nil).Once()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, a.ID).Return(a, nil).Once()
+
+		actualAppeals, actualError := s.service.HandleOverdueApprovals(context.Background())
+
+		s.NoError(actualError)
+		s.Empty(actualAppeals)
+		s.Equal(domain.ApprovalStatusPending, a.Approvals[1].Status)
+		s.mockRepository.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func (s *ServiceTestSuite) TestUpdateApproval__WithQuorum() {
+	appealID := uuid.New().String()
+	newAppeal := func(decisions ...*domain.ApprovalDecision) *domain.Appeal {
+		return &domain.Appeal{
+			ID:       appealID,
+			PolicyID: "policy-test",
+			Status:   domain.AppealStatusPending,
+			Resource: &domain.Resource{},
+			Approvals: []*domain.Approval{
+				{
+					Name:         "approval_0",
+					Index:        0,
+					Status:       domain.ApprovalStatusPending,
+					Approvers:    []string{"a@example.com", "b@example.com", "c@example.com"},
+					MinApprovals: 2,
+					Decisions:    decisions,
+				},
+				{
+					Name:      "approval_1",
+					Index:     1,
+					Status:    domain.ApprovalStatusBlocked,
+					Approvers: []string{"next@example.com"},
+				},
+			},
+		}
+	}
+	newPolicy := func(failOnAnyRejection bool) *domain.Policy {
+		return &domain.Policy{
+			ID: "policy-test",
+			Steps: []*domain.Step{
+				{Name: "approval_0", Strategy: domain.ApprovalStepStrategyManual, MinApprovals: 2, FailOnAnyRejection: failOnAnyRejection},
+				{Name: "approval_1", Strategy: domain.ApprovalStepStrategyManual},
+			},
+		}
+	}
+	approvedBy := func(actor string) *domain.ApprovalDecision {
+		return &domain.ApprovalDecision{Actor: actor, Action: domain.AppealActionNameApprove}
+	}
 
-				s.mockRepository.EXPECT().
-					GetByID(mock.AnythingOfType("context.backgroundCtx"), validApprovalActionParam.AppealID).
-					Return(tc.expectedAppealDetails, nil).Once()
+	s.Run("should keep the step pending until quorum is reached", func() {
+		s.setup()
 
-				if tc.expectedApprovalAction.Action == "approve" {
-					s.mockGrantService.EXPECT().
-						List(mock.Anything, domain.ListGrantsFilter{
-							AccountIDs:  []string{tc.expectedAppealDetails.AccountID},
-							ResourceIDs: []string{tc.expectedAppealDetails.ResourceID},
-							Statuses:    []string{string(domain.GrantStatusActive)},
-							Permissions: tc.expectedAppealDetails.Permissions,
-						}).Return([]domain.Grant{}, nil).Once()
-					s.mockGrantService.EXPECT().
-						Prepare(mock.Anything, mock.Anything).Return(tc.expectedGrant, nil).Once()
-					mockPolicy := &domain.Policy{
-						Steps: []*domain.Step{
-							{
-								Name: "step-1",
-							},
-							{
-								Name: "step-2",
-							},
-						},
-					}
-					s.mockPolicyService.EXPECT().
-						GetOne(mock.Anything, tc.expectedAppealDetails.PolicyID, tc.expectedAppealDetails.PolicyVersion).
-						Return(mockPolicy, nil).Once()
-					s.mockProviderService.EXPECT().GrantAccess(mock.Anything, *tc.expectedGrant).Return(nil).Once()
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "policy-test", mock.Anything).Return(newPolicy(false), nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyApprove, mock.Anything).Return(nil).Once()
 
-					tc.expectedResult.Policy = mockPolicy
-				}
+		actualResult, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     appealID,
+			ApprovalName: "approval_0",
+			Actor:        "a@example.com",
+			Action:       domain.AppealActionNameApprove,
+		})
 
-				s.mockRepository.EXPECT().Update(mock.AnythingOfType("context.backgroundCtx"), tc.expectedResult).Return(nil).Once()
-				s.mockNotifier.EXPECT().Notify(mock.Anything).Return(nil).Once()
-				s.mockAuditLogger.EXPECT().Log(mock.Anything, mock.Anything, mock.Anything).
-					Return(nil).Once()
+		s.NoError(actualError)
+		s.Equal(domain.AppealStatusPending, actualResult.Status)
+		s.Equal(domain.ApprovalStatusPending, actualResult.Approvals[0].Status)
+		s.Nil(actualResult.Approvals[0].Actor)
+		s.Len(actualResult.Approvals[0].Decisions, 1)
+		s.Equal(domain.ApprovalStatusBlocked, actualResult.Approvals[1].Status)
+		s.mockNotifier.AssertNotCalled(s.T(), "Notify", mock.Anything)
+	})
 
-				actualResult, actualError := s.service.UpdateApproval(context.Background(), tc.expectedApprovalAction)
+	s.Run("should return error if approver already submitted a decision", func() {
+		s.setup()
 
-				s.NoError(actualError)
-				tc.expectedResult.Policy = actualResult.Policy
-				s.Equal(tc.expectedResult, actualResult)
-			})
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(newAppeal(approvedBy("a@example.com")), nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "policy-test", mock.Anything).Return(newPolicy(false), nil).Once()
+
+		actualResult, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     appealID,
+			ApprovalName: "approval_0",
+			Actor:        "a@example.com",
+			Action:       domain.AppealActionNameApprove,
+		})
+
+		s.Nil(actualResult)
+		s.ErrorIs(actualError, domain.ErrApproverAlreadyDecided)
+	})
+
+	s.Run("should approve the step and notify next approvers once quorum is reached", func() {
+		s.setup()
+
+		a := newAppeal(approvedBy("a@example.com"))
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "policy-test", mock.Anything).Return(newPolicy(false), nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.MatchedBy(func(notifications []domain.Notification) bool {
+			return len(notifications) == 1 && notifications[0].User == "next@example.com"
+		})).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyApprove, mock.Anything).Return(nil).Once()
+
+		actualResult, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     appealID,
+			ApprovalName: "approval_0",
+			Actor:        "b@example.com",
+			Action:       domain.AppealActionNameApprove,
+		})
+
+		s.NoError(actualError)
+		s.Equal(domain.ApprovalStatusApproved, actualResult.Approvals[0].Status)
+		s.Equal("b@example.com", *actualResult.Approvals[0].Actor)
+		s.Equal(domain.ApprovalStatusPending, actualResult.Approvals[1].Status)
+		s.mockNotifier.AssertExpectations(s.T())
+	})
+
+	s.Run("should keep the step pending on rejection if quorum is still reachable", func() {
+		s.setup()
+
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "policy-test", mock.Anything).Return(newPolicy(false), nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyReject, mock.Anything).Return(nil).Once()
+
+		actualResult, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     appealID,
+			ApprovalName: "approval_0",
+			Actor:        "a@example.com",
+			Action:       domain.AppealActionNameReject,
+		})
+
+		s.NoError(actualError)
+		s.Equal(domain.AppealStatusPending, actualResult.Status)
+		s.Equal(domain.ApprovalStatusPending, actualResult.Approvals[0].Status)
+	})
+
+	s.Run("should reject the step on rejection if quorum is no longer reachable", func() {
+		s.setup()
+
+		a := newAppeal(&domain.ApprovalDecision{Actor: "a@example.com", Action: domain.AppealActionNameReject})
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "policy-test", mock.Anything).Return(newPolicy(false), nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyReject, mock.Anything).Return(nil).Once()
+
+		actualResult, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     appealID,
+			ApprovalName: "approval_0",
+			Actor:        "b@example.com",
+			Action:       domain.AppealActionNameReject,
+		})
+
+		s.NoError(actualError)
+		s.Equal(domain.AppealStatusRejected, actualResult.Status)
+		s.Equal(domain.ApprovalStatusRejected, actualResult.Approvals[0].Status)
+		s.Equal(domain.ApprovalStatusSkipped, actualResult.Approvals[1].Status)
+	})
+
+	s.Run("should reject the step on any rejection if configured", func() {
+		s.setup()
+
+		a := newAppeal(approvedBy("a@example.com"))
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(a, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "policy-test", mock.Anything).Return(newPolicy(true), nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyReject, mock.Anything).Return(nil).Once()
+
+		actualResult, actualError := s.service.UpdateApproval(context.Background(), domain.ApprovalAction{
+			AppealID:     appealID,
+			ApprovalName: "approval_0",
+			Actor:        "b@example.com",
+			Action:       domain.AppealActionNameReject,
+		})
+
+		s.NoError(actualError)
+		s.Equal(domain.AppealStatusRejected, actualResult.Status)
+		s.Equal(domain.ApprovalStatusRejected, actualResult.Approvals[0].Status)
+	})
+}
+
+func (s *ServiceTestSuite) TestUpdateApproval__ByDelegate() {
+	appealID := uuid.New().String()
+	newAppeal := func() *domain.Appeal {
+		return &domain.Appeal{
+			ID:       appealID,
+			PolicyID: "policy-test",
+			Status:   domain.AppealStatusPending,
+			Resource: &domain.Resource{
+				ProviderType: "bigquery",
+				ProviderURN:  "bq-prod",
+			},
+			Approvals: []*domain.Approval{
+				{
+					Name:      "approval_0",
+					Status:    domain.ApprovalStatusPending,
+					Approvers: []string{"approver@example.com"},
+				},
+				{
+					Name:      "approval_1",
+					Status:    domain.ApprovalStatusBlocked,
+					Approvers: []string{"approver@example.com"},
+				},
+			},
 		}
+	}
+	action := domain.ApprovalAction{
+		AppealID:     appealID,
+		ApprovalName: "approval_0",
+		Actor:        "delegate@example.com",
+		Action:       domain.AppealActionNameApprove,
+	}
+
+	s.Run("should return error if delegation scope doesn't cover the appeal", func() {
+		s.setup()
+		mockDelegation := new(appealmocks.DelegationService)
+		service := appeal.NewService(appeal.ServiceDeps{
+			Repository:        s.mockRepository,
+			DelegationService: mockDelegation,
+			Logger:            log.NewNoop(),
+		})
+
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(newAppeal(), nil).Once()
+		mockDelegation.EXPECT().FindActive(mock.Anything, mock.Anything).Return([]*domain.Delegation{
+			{Delegator: "approver@example.com", Delegate: "delegate@example.com", ProviderType: "gcs"},
+		}, nil).Once()
+
+		actualResult, actualError := service.UpdateApproval(context.Background(), action)
+
+		s.Nil(actualResult)
+		s.ErrorIs(actualError, appeal.ErrActionForbidden)
+	})
+
+	s.Run("should allow delegate to approve and record the delegator", func() {
+		s.setup()
+		mockDelegation := new(appealmocks.DelegationService)
+		service := appeal.NewService(appeal.ServiceDeps{
+			Repository:        s.mockRepository,
+			PolicyService:     s.mockPolicyService,
+			DelegationService: mockDelegation,
+			Notifier:          s.mockNotifier,
+			Logger:            log.NewNoop(),
+			AuditLogger:       s.mockAuditLogger,
+		})
+
+		a := newAppeal()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(a, nil).Once()
+		// delegations are resolved for the acting approval and again for the notified approvals
+		mockDelegation.EXPECT().FindActive(mock.Anything, []string{"approver@example.com"}).Return([]*domain.Delegation{
+			{Delegator: "approver@example.com", Delegate: "delegate@example.com", ProviderType: "bigquery"},
+		}, nil).Twice()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "policy-test", mock.Anything).Return(&domain.Policy{
+			ID: "policy-test",
+			Steps: []*domain.Step{
+				{Name: "approval_0", Strategy: domain.ApprovalStepStrategyManual},
+				{Name: "approval_1", Strategy: domain.ApprovalStepStrategyManual},
+			},
+		}, nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.MatchedBy(func(notifications []domain.Notification) bool {
+			// next step approvers and their delegates should be notified
+			var users []string
+			for _, n := range notifications {
+				users = append(users, n.User)
+			}
+			return s.ElementsMatch([]string{"approver@example.com", "delegate@example.com"}, users)
+		})).Return(nil).Once()
+		expectedAction := action
+		expectedAction.OnBehalfOf = "approver@example.com"
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyApprove, expectedAction).Return(nil).Once()
+
+		actualResult, actualError := service.UpdateApproval(context.Background(), action)
+
+		s.NoError(actualError)
+		s.Equal(domain.ApprovalStatusApproved, actualResult.Approvals[0].Status)
+		s.Equal("delegate@example.com", *actualResult.Approvals[0].Actor)
+		s.Equal([]string{"approver@example.com"}, actualResult.Approvals[1].Approvers, "delegates should not be persisted as approvers")
+		s.mockNotifier.AssertExpectations(s.T())
+		mockDelegation.AssertExpectations(s.T())
+		s.mockAuditLogger.AssertExpectations(s.T())
+	})
+
+	s.Run("should record a delegate who is also an approver of the step as acting on their own behalf", func() {
+		s.setup()
+		mockDelegation := new(appealmocks.DelegationService)
+		service := appeal.NewService(appeal.ServiceDeps{
+			Repository:        s.mockRepository,
+			PolicyService:     s.mockPolicyService,
+			DelegationService: mockDelegation,
+			Notifier:          s.mockNotifier,
+			Logger:            log.NewNoop(),
+			AuditLogger:       s.mockAuditLogger,
+		})
+
+		a := newAppeal()
+		a.Approvals[0].Approvers = []string{"approver@example.com", "delegate@example.com"}
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(a, nil).Once()
+		mockDelegation.EXPECT().FindActive(mock.Anything, mock.Anything).Return([]*domain.Delegation{
+			{Delegator: "approver@example.com", Delegate: "delegate@example.com"},
+		}, nil)
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "policy-test", mock.Anything).Return(&domain.Policy{
+			ID: "policy-test",
+			Steps: []*domain.Step{
+				{Name: "approval_0", Strategy: domain.ApprovalStepStrategyManual},
+				{Name: "approval_1", Strategy: domain.ApprovalStepStrategyManual},
+			},
+		}, nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, a).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyApprove, action).Return(nil).Once()
+
+		actualResult, actualError := service.UpdateApproval(context.Background(), action)
+
+		s.NoError(actualError)
+		s.Equal(domain.ApprovalStatusApproved, actualResult.Approvals[0].Status)
+		s.Require().Len(actualResult.Approvals[0].Decisions, 1)
+		s.Empty(actualResult.Approvals[0].Decisions[0].OnBehalfOf)
+		s.mockAuditLogger.AssertExpectations(s.T())
 	})
 }
 
@@ -2407,6 +4521,191 @@ func (s *ServiceTestSuite) TestGrantAccessToProvider() {
 	})
 }
 
+func (s *ServiceTestSuite) TestSimulate() {
+	resource := &domain.Resource{
+		ID:           "test-resource-id",
+		URN:          "test-resource-urn",
+		Type:         "test-resource-type",
+		ProviderType: "test-provider-type",
+		ProviderURN:  "test-provider-urn",
+	}
+	ri := &domain.ResourceIdentifier{ID: resource.ID}
+	policy := &domain.Policy{
+		ID:      "test-policy-id",
+		Version: 1,
+		Steps: []*domain.Step{
+			{
+				Name:      "auto_approve_admin",
+				Strategy:  domain.ApprovalStepStrategyAuto,
+				ApproveIf: `$appeal.creator.role == "admin"`,
+			},
+			{
+				Name:      "skip_non_sensitive",
+				When:      `$appeal.resource.urn == "sensitive-resource-urn"`,
+				Strategy:  domain.ApprovalStepStrategyManual,
+				Approvers: []string{"security@example.com"},
+			},
+			{
+				Name:      "manager_approval",
+				Strategy:  domain.ApprovalStepStrategyManual,
+				Approvers: []string{"$appeal.creator.manager"},
+			},
+		},
+		Requirements: []*domain.Requirement{
+			{
+				On: &domain.RequirementTrigger{Role: "^viewer$"},
+				Appeals: []*domain.AdditionalAppeal{
+					{Resource: &domain.ResourceIdentifier{ID: "other-resource-id"}, Role: "viewer"},
+				},
+			},
+			{
+				On: &domain.RequirementTrigger{Role: "^editor$"},
+				Appeals: []*domain.AdditionalAppeal{
+					{Resource: &domain.ResourceIdentifier{ID: "other-resource-id"}, Role: "editor"},
+				},
+			},
+		},
+	}
+	newAppeal := func(creator map[string]interface{}) *domain.Appeal {
+		return &domain.Appeal{
+			AccountID: "user@example.com",
+			CreatedBy: "user@example.com",
+			Role:      "viewer",
+			Creator:   creator,
+		}
+	}
+
+	s.Run("should return error if resource is not found", func() {
+		s.setup()
+		expectedError := errors.New("resource not found")
+		s.mockResourceService.EXPECT().Get(mock.Anything, ri).Return(nil, expectedError).Once()
+
+		actual, err := s.service.Simulate(context.Background(), newAppeal(nil), ri, policy)
+
+		s.ErrorIs(err, expectedError)
+		s.Nil(actual)
+	})
+
+	s.Run("should run inline policy against the appeal", func() {
+		s.setup()
+		s.mockResourceService.EXPECT().Get(mock.Anything, ri).Return(resource, nil).Once()
+
+		actual, err := s.service.Simulate(context.Background(), newAppeal(map[string]interface{}{
+			"role":    "admin",
+			"manager": "manager@example.com",
+		}), ri, policy)
+
+		s.Require().NoError(err)
+		s.Equal(domain.AppealStatusPending, actual.Appeal.Status)
+		s.Equal(policy.ID, actual.Appeal.PolicyID)
+		s.Require().Len(actual.Appeal.Approvals, 3)
+		s.Equal(domain.ApprovalStatusApproved, actual.Appeal.Approvals[0].Status)
+		s.Equal(domain.ApprovalStatusSkipped, actual.Appeal.Approvals[1].Status)
+		s.Equal(domain.ApprovalStatusPending, actual.Appeal.Approvals[2].Status)
+		s.Equal([]string{"manager@example.com"}, actual.Appeal.Approvals[2].Approvers)
+		s.Equal([]*domain.Requirement{policy.Requirements[0]}, actual.MatchedRequirements)
+	})
+
+	s.Run("should mark the appeal as rejected if an auto step fails", func() {
+		s.setup()
+		s.mockResourceService.EXPECT().Get(mock.Anything, ri).Return(resource, nil).Once()
+
+		actual, err := s.service.Simulate(context.Background(), newAppeal(map[string]interface{}{
+			"role":    "member",
+			"manager": "manager@example.com",
+		}), ri, policy)
+
+		s.Require().NoError(err)
+		s.Equal(domain.AppealStatusRejected, actual.Appeal.Status)
+		s.Equal(domain.ApprovalStatusRejected, actual.Appeal.Approvals[0].Status)
+	})
+
+	s.Run("should use stored policy if policy id is given", func() {
+		s.setup()
+		s.mockResourceService.EXPECT().Get(mock.Anything, ri).Return(resource, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, policy.ID, policy.Version).Return(policy, nil).Once()
+		a := newAppeal(map[string]interface{}{"role": "admin", "manager": "manager@example.com"})
+		a.PolicyID = policy.ID
+		a.PolicyVersion = policy.Version
+
+		actual, err := s.service.Simulate(context.Background(), a, ri, nil)
+
+		s.Require().NoError(err)
+		s.Len(actual.Appeal.Approvals, 3)
+	})
+
+	s.Run("should use policy configured in the provider if no policy is given", func() {
+		s.setup()
+		provider := &domain.Provider{
+			Type: resource.ProviderType,
+			URN:  resource.ProviderURN,
+			Config: &domain.ProviderConfig{
+				Resources: []*domain.ResourceConfig{
+					{
+						Type:   resource.Type,
+						Policy: &domain.PolicyConfig{ID: policy.ID, Version: int(policy.Version)},
+					},
+				},
+			},
+		}
+		s.mockResourceService.EXPECT().Get(mock.Anything, ri).Return(resource, nil).Once()
+		s.mockProviderService.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.Provider{provider}, nil).Once()
+		s.mockPolicyService.EXPECT().Find(mock.Anything).Return([]*domain.Policy{policy}, nil).Once()
+
+		actual, err := s.service.Simulate(context.Background(), newAppeal(map[string]interface{}{
+			"role":    "admin",
+			"manager": "manager@example.com",
+		}), ri, nil)
+
+		s.Require().NoError(err)
+		s.Equal(policy.ID, actual.Appeal.PolicyID)
+		s.Equal(policy.Version, actual.Appeal.PolicyVersion)
+	})
+
+	s.Run("should compute the appeal risk before running the policy", func() {
+		s.setup()
+		service := appeal.NewService(appeal.ServiceDeps{
+			Repository:        s.mockRepository,
+			ResourceService:   s.mockResourceService,
+			DelegationService: s.mockDelegation,
+			RiskScoring: &domain.RiskScoringConfig{
+				Rules: []*domain.RiskRule{
+					{Name: "permanent_access", When: "$permanent", Score: 40},
+					{Name: "previously_rejected", When: "$rejected_appeals > 2", Score: 40},
+				},
+			},
+			Logger: log.NewNoop(),
+		})
+		riskPolicy := &domain.Policy{
+			ID:      "test-policy-id",
+			Version: 1,
+			Steps: []*domain.Step{
+				{
+					Name:      "auto_approve_low_risk",
+					Strategy:  domain.ApprovalStepStrategyAuto,
+					ApproveIf: `$appeal.risk.level == "low"`,
+				},
+			},
+		}
+		s.mockResourceService.EXPECT().Get(mock.Anything, ri).Return(resource, nil).Once()
+		s.mockRepository.EXPECT().GetAppealsTotalCount(mock.Anything, &domain.ListAppealsFilter{
+			CreatedBy: "user@example.com",
+			Statuses:  []string{domain.AppealStatusRejected},
+		}).Return(int64(3), nil).Once()
+
+		actual, err := service.Simulate(context.Background(), newAppeal(map[string]interface{}{}), ri, riskPolicy)
+
+		s.Require().NoError(err)
+		s.Equal(&domain.AppealRisk{
+			Score:   80,
+			Level:   domain.RiskLevelHigh,
+			Factors: []string{"permanent_access", "previously_rejected"},
+		}, actual.Appeal.Risk)
+		s.Equal(domain.AppealStatusRejected, actual.Appeal.Status)
+		s.mockRepository.AssertExpectations(s.T())
+	})
+}
+
 func (s *ServiceTestSuite) TestCancel() {
 	s.Run("should return error if appeal id is empty", func() {
 		id := ""
@@ -2427,6 +4726,181 @@ func (s *ServiceTestSuite) TestCancel() {
 	})
 }
 
+func (s *ServiceTestSuite) TestCreateComment() {
+	appealID := uuid.New().String()
+	newAppeal := func() *domain.Appeal {
+		return &domain.Appeal{
+			ID:        appealID,
+			Role:      "viewer",
+			AccountID: "user@example.com",
+			CreatedBy: "user@example.com",
+			Status:    domain.AppealStatusPending,
+			Resource:  &domain.Resource{Name: "dataset", ProviderType: "bigquery", URN: "project:dataset"},
+			Approvals: []*domain.Approval{
+				{Name: "step_1", Status: domain.ApprovalStatusApproved, Approvers: []string{"first@example.com"}},
+				{Name: "step_2", Status: domain.ApprovalStatusPending, Approvers: []string{"approver@example.com", "user@example.com"}},
+			},
+		}
+	}
+
+	s.Run("should return error if body is empty", func() {
+		s.setup()
+
+		err := s.service.CreateComment(context.Background(), &domain.Comment{
+			AppealID:  appealID,
+			CreatedBy: "user@example.com",
+			Body:      "  ",
+		})
+
+		s.Error(err)
+	})
+
+	s.Run("should return error if appeal is not found", func() {
+		s.setup()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(nil, appeal.ErrAppealNotFound).Once()
+
+		err := s.service.CreateComment(context.Background(), &domain.Comment{
+			AppealID:  appealID,
+			CreatedBy: "user@example.com",
+			Body:      "hello",
+		})
+
+		s.ErrorIs(err, appeal.ErrAppealNotFound)
+	})
+
+	s.Run("should store the comment and notify the other participants", func() {
+		s.setup()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(newAppeal(), nil).Once()
+		s.mockCommentRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Comment")).
+			Run(func(_ context.Context, c *domain.Comment) {
+				c.ID = "comment-id"
+			}).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyComment, mock.Anything).Return(nil).Once()
+		var notifications []domain.Notification
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Run(func(n []domain.Notification) {
+			notifications = n
+		}).Return(nil).Once()
+
+		c := &domain.Comment{
+			AppealID:  appealID,
+			CreatedBy: "approver@example.com",
+			Body:      " is read access enough? ",
+		}
+		err := s.service.CreateComment(context.Background(), c)
+
+		s.NoError(err)
+		s.Equal("comment-id", c.ID)
+		s.Equal("is read access enough?", c.Body)
+		s.Require().Len(notifications, 1)
+		s.Equal("user@example.com", notifications[0].User)
+		s.Equal(domain.NotificationTypeNewComment, notifications[0].Message.Type)
+		s.Equal("is read access enough?", notifications[0].Message.Variables["comment"])
+		s.Equal("approver@example.com", notifications[0].Message.Variables["commenter"])
+	})
+
+	s.Run("should notify the delegates of the pending approvers", func() {
+		s.setup()
+		s.mockDelegation.ExpectedCalls = nil
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(newAppeal(), nil).Once()
+		s.mockCommentRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Comment")).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyComment, mock.Anything).Return(nil).Once()
+		s.mockDelegation.EXPECT().FindActive(mock.Anything, []string{"approver@example.com", "user@example.com"}).
+			Return([]*domain.Delegation{{Delegator: "approver@example.com", Delegate: "delegate@example.com"}}, nil).Once()
+		var notifications []domain.Notification
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Run(func(n []domain.Notification) {
+			notifications = n
+		}).Return(nil).Once()
+
+		err := s.service.CreateComment(context.Background(), &domain.Comment{
+			AppealID:  appealID,
+			CreatedBy: "user@example.com",
+			Body:      "ping",
+		})
+
+		s.NoError(err)
+		var recipients []string
+		for _, n := range notifications {
+			recipients = append(recipients, n.User)
+		}
+		s.Equal([]string{"approver@example.com", "delegate@example.com"}, recipients)
+	})
+
+	s.Run("should allow the delegate of an approver to comment", func() {
+		s.setup()
+		s.mockDelegation.ExpectedCalls = nil
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(newAppeal(), nil).Once()
+		s.mockDelegation.EXPECT().FindActive(mock.Anything, mock.Anything).
+			Return([]*domain.Delegation{{Delegator: "first@example.com", Delegate: "delegate@example.com"}}, nil)
+		s.mockCommentRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Comment")).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, appeal.AuditKeyComment, mock.Anything).Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Return(nil).Once()
+
+		err := s.service.CreateComment(context.Background(), &domain.Comment{
+			AppealID:  appealID,
+			CreatedBy: "delegate@example.com",
+			Body:      "looks good",
+		})
+
+		s.NoError(err)
+	})
+
+	s.Run("should return error if the user is not a participant of the appeal", func() {
+		s.setup()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(newAppeal(), nil).Once()
+
+		err := s.service.CreateComment(context.Background(), &domain.Comment{
+			AppealID:  appealID,
+			CreatedBy: "other@example.com",
+			Body:      "hello",
+		})
+
+		s.ErrorIs(err, appeal.ErrCommentForbidden)
+		s.mockCommentRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func (s *ServiceTestSuite) TestListComments() {
+	appealID := uuid.New().String()
+
+	s.Run("should return error if appeal id is empty", func() {
+		s.setup()
+
+		actual, err := s.service.ListComments(context.Background(), "user@example.com", domain.ListCommentsFilter{})
+
+		s.Nil(actual)
+		s.ErrorIs(err, appeal.ErrAppealIDEmptyParam)
+	})
+
+	s.Run("should return comments of the appeal", func() {
+		s.setup()
+		filter := domain.ListCommentsFilter{AppealID: appealID}
+		expected := []*domain.Comment{{ID: "comment-id", AppealID: appealID, Body: "hello"}}
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(&domain.Appeal{ID: appealID, CreatedBy: "user@example.com"}, nil).Once()
+		s.mockCommentRepo.EXPECT().List(mock.Anything, filter).Return(expected, nil).Once()
+
+		actual, err := s.service.ListComments(context.Background(), "user@example.com", filter)
+
+		s.NoError(err)
+		s.Equal(expected, actual)
+	})
+
+	s.Run("should return error if the user is not a participant of the appeal", func() {
+		s.setup()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, appealID).Return(&domain.Appeal{
+			ID:        appealID,
+			AccountID: "user@example.com",
+			CreatedBy: "user@example.com",
+			Approvals: []*domain.Approval{{Name: "step_1", Approvers: []string{"approver@example.com"}}},
+		}, nil).Once()
+
+		actual, err := s.service.ListComments(context.Background(), "other@example.com", domain.ListCommentsFilter{AppealID: appealID})
+
+		s.Nil(actual)
+		s.ErrorIs(err, appeal.ErrCommentForbidden)
+		s.mockCommentRepo.AssertNotCalled(s.T(), "List", mock.Anything, mock.Anything)
+	})
+}
+
 func (s *ServiceTestSuite) TestAddApprover() {
 	s.Run("should return appeal on success", func() {
 		appealID := uuid.New().String()
@@ -116,6 +116,53 @@ func (_c *Repository_BulkInsert_Call) RunAndReturn(run func(context.Context, []*
 	return _c
 }
 
+// DeleteApproval provides a mock function with given fields: ctx, id
+func (_m *Repository) DeleteApproval(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteApproval")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repository_DeleteApproval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteApproval'
+type Repository_DeleteApproval_Call struct {
+	*mock.Call
+}
+
+// DeleteApproval is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) DeleteApproval(ctx interface{}, id interface{}) *Repository_DeleteApproval_Call {
+	return &Repository_DeleteApproval_Call{Call: _e.mock.On("DeleteApproval", ctx, id)}
+}
+
+func (_c *Repository_DeleteApproval_Call) Run(run func(ctx context.Context, id string)) *Repository_DeleteApproval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Repository_DeleteApproval_Call) Return(_a0 error) *Repository_DeleteApproval_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repository_DeleteApproval_Call) RunAndReturn(run func(context.Context, string) error) *Repository_DeleteApproval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteApprover provides a mock function with given fields: ctx, approvalID, email
 func (_m *Repository) DeleteApprover(ctx context.Context, approvalID string, email string) error {
 	ret := _m.Called(ctx, approvalID, email)
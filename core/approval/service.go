@@ -13,6 +13,7 @@ type repository interface {
 	ListApprovals(context.Context, *domain.ListApprovalsFilter) ([]*domain.Approval, error)
 	AddApprover(context.Context, *domain.Approver) error
 	DeleteApprover(ctx context.Context, approvalID, email string) error
+	DeleteApproval(ctx context.Context, id string) error
 }
 
 //go:generate mockery --name=policyService --exported --with-expecter
@@ -58,3 +59,7 @@ func (s *Service) AddApprover(ctx context.Context, approvalID, email string) err
 func (s *Service) DeleteApprover(ctx context.Context, approvalID, email string) error {
 	return s.repo.DeleteApprover(ctx, approvalID, email)
 }
+
+func (s *Service) DeleteApproval(ctx context.Context, id string) error {
+	return s.repo.DeleteApproval(ctx, id)
+}
@@ -152,3 +152,26 @@ func (s *ServiceTestSuite) TestDeleteApprover() {
 		s.mockRepository.AssertExpectations(s.T())
 	})
 }
+
+func (s *ServiceTestSuite) TestDeleteApproval() {
+	s.Run("should return nil error on success", func() {
+		approvalID := uuid.New().String()
+
+		s.mockRepository.EXPECT().DeleteApproval(mock.AnythingOfType("context.backgroundCtx"), approvalID).Return(nil).Once()
+
+		err := s.service.DeleteApproval(context.Background(), approvalID)
+
+		s.NoError(err)
+		s.mockRepository.AssertExpectations(s.T())
+	})
+
+	s.Run("should return error if repository returns an error", func() {
+		expectedError := errors.New("unexpected error")
+		s.mockRepository.EXPECT().DeleteApproval(mock.AnythingOfType("context.backgroundCtx"), mock.Anything).Return(expectedError).Once()
+
+		err := s.service.DeleteApproval(context.Background(), "")
+
+		s.ErrorIs(err, expectedError)
+		s.mockRepository.AssertExpectations(s.T())
+	})
+}
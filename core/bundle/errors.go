@@ -0,0 +1,15 @@
+package bundle
+
+import "errors"
+
+var (
+	ErrEmptyIDParam           = errors.New("bundle id can't be empty")
+	ErrBundleNotFound         = errors.New("bundle not found")
+	ErrDuplicateBundleName    = errors.New("bundle with the same name already exists")
+	ErrDuplicateBundleItem    = errors.New("bundle contains duplicate resource and role")
+	ErrInvalidBundleResource  = errors.New("unable to find resource of the bundle item")
+	ErrInvalidBundlePolicy    = errors.New("unable to find policy of the bundle")
+	ErrEmptyAccountIDParam    = errors.New("account id can't be empty")
+	ErrNoAppealsFoundOnBundle = errors.New("no appeals found for the bundle")
+	ErrInvalidBundleOwner     = errors.New("bundle can only be managed by its creator")
+)
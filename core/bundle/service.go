@@ -0,0 +1,279 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/raystack/guardian/core/appeal"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/log"
+)
+
+const (
+	AuditKeyCreate = "bundle.create"
+	AuditKeyUpdate = "bundle.update"
+	AuditKeyDelete = "bundle.delete"
+)
+
+//go:generate mockery --name=repository --exported --with-expecter
+type repository interface {
+	Create(context.Context, *domain.Bundle) error
+	Update(context.Context, *domain.Bundle) error
+	GetByID(ctx context.Context, id string) (*domain.Bundle, error)
+	Find(context.Context, domain.ListBundlesFilter) ([]*domain.Bundle, error)
+	Delete(ctx context.Context, id string) error
+}
+
+//go:generate mockery --name=resourceService --exported --with-expecter
+type resourceService interface {
+	Get(context.Context, *domain.ResourceIdentifier) (*domain.Resource, error)
+}
+
+//go:generate mockery --name=policyService --exported --with-expecter
+type policyService interface {
+	GetOne(ctx context.Context, id string, version uint) (*domain.Policy, error)
+}
+
+//go:generate mockery --name=appealService --exported --with-expecter
+type appealService interface {
+	Create(context.Context, []*domain.Appeal, ...appeal.CreateAppealOption) error
+	Find(context.Context, *domain.ListAppealsFilter) ([]*domain.Appeal, error)
+}
+
+//go:generate mockery --name=auditLogger --exported --with-expecter
+type auditLogger interface {
+	Log(ctx context.Context, action string, data interface{}) error
+}
+
+type ServiceDeps struct {
+	Repository      repository
+	ResourceService resourceService
+	PolicyService   policyService
+	AppealService   appealService
+
+	Validator   *validator.Validate
+	Logger      log.Logger
+	AuditLogger auditLogger
+}
+
+type Service struct {
+	repo            repository
+	resourceService resourceService
+	policyService   policyService
+	appealService   appealService
+
+	validator   *validator.Validate
+	logger      log.Logger
+	auditLogger auditLogger
+}
+
+func NewService(deps ServiceDeps) *Service {
+	return &Service{
+		repo:            deps.Repository,
+		resourceService: deps.ResourceService,
+		policyService:   deps.PolicyService,
+		appealService:   deps.AppealService,
+		validator:       deps.Validator,
+		logger:          deps.Logger,
+		auditLogger:     deps.AuditLogger,
+	}
+}
+
+func (s *Service) GetByID(ctx context.Context, id string) (*domain.Bundle, error) {
+	if id == "" {
+		return nil, ErrEmptyIDParam
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *Service) Find(ctx context.Context, filter domain.ListBundlesFilter) ([]*domain.Bundle, error) {
+	return s.repo.Find(ctx, filter)
+}
+
+func (s *Service) Create(ctx context.Context, b *domain.Bundle) error {
+	if err := s.validate(ctx, b); err != nil {
+		return err
+	}
+
+	if err := s.repo.Create(ctx, b); err != nil {
+		return fmt.Errorf("inserting bundle into db: %w", err)
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyCreate, b); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	return nil
+}
+
+// Update replaces the name, description, policy, items and labels of the bundle. Appeals created from the previous
+// version of the bundle are left untouched. Only the creator of the bundle can update it.
+func (s *Service) Update(ctx context.Context, actor string, payload *domain.Bundle) error {
+	existing, err := s.GetByID(ctx, payload.ID)
+	if err != nil {
+		return fmt.Errorf("getting bundle details: %w", err)
+	}
+	if existing.CreatedBy != actor {
+		return ErrInvalidBundleOwner
+	}
+
+	updated := *existing
+	updated.Name = payload.Name
+	updated.Description = payload.Description
+	updated.RequiredPolicy = payload.RequiredPolicy
+	updated.Items = payload.Items
+	updated.Labels = payload.Labels
+	if err := s.validate(ctx, &updated); err != nil {
+		return err
+	}
+
+	if err := s.repo.Update(ctx, &updated); err != nil {
+		return err
+	}
+	*payload = updated
+
+	if err := s.auditLogger.Log(ctx, AuditKeyUpdate, map[string]interface{}{
+		"bundle_id":      existing.ID,
+		"previous":       existing,
+		"updated_bundle": payload,
+	}); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	return nil
+}
+
+func (s *Service) Delete(ctx context.Context, actor, id string) error {
+	existing, err := s.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("getting bundle details: %w", err)
+	}
+	if existing.CreatedBy != actor {
+		return ErrInvalidBundleOwner
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyDelete, existing); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	return nil
+}
+
+// CreateAppeals creates one appeal for each item of the bundle using the account, description and details of the
+// given appeal. Appeals are created in a single batch, so none of them is created if any fails the validation.
+func (s *Service) CreateAppeals(ctx context.Context, bundleID string, a *domain.Appeal) ([]*domain.Appeal, error) {
+	b, err := s.GetByID(ctx, bundleID)
+	if err != nil {
+		return nil, fmt.Errorf("getting bundle details: %w", err)
+	}
+
+	var policy *domain.Policy
+	if b.RequiredPolicy != nil {
+		policy, err = s.policyService.GetOne(ctx, b.RequiredPolicy.ID, uint(b.RequiredPolicy.Version))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidBundlePolicy, err)
+		}
+	}
+
+	appeals := make([]*domain.Appeal, 0, len(b.Items))
+	for _, item := range b.Items {
+		r, err := s.resourceService.Get(ctx, item.Resource)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidBundleResource, err)
+		}
+
+		bundleAppeal := item.ToAppeal(b, r, a)
+		if policy != nil {
+			bundleAppeal.PolicyID = policy.ID
+			bundleAppeal.PolicyVersion = policy.Version
+		}
+		appeals = append(appeals, bundleAppeal)
+	}
+
+	if err := s.appealService.Create(ctx, appeals); err != nil {
+		return nil, err
+	}
+
+	return appeals, nil
+}
+
+// GetAppealStatus returns the rolled up status of the latest appeal of each bundle item requested for the account
+func (s *Service) GetAppealStatus(ctx context.Context, bundleID, accountID string) (string, []*domain.Appeal, error) {
+	if accountID == "" {
+		return "", nil, ErrEmptyAccountIDParam
+	}
+	if _, err := s.GetByID(ctx, bundleID); err != nil {
+		return "", nil, fmt.Errorf("getting bundle details: %w", err)
+	}
+
+	appeals, err := s.appealService.Find(ctx, &domain.ListAppealsFilter{
+		BundleID:  bundleID,
+		AccountID: accountID,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("listing bundle appeals: %w", err)
+	}
+
+	sort.Slice(appeals, func(i, j int) bool {
+		return appeals[i].CreatedAt.After(appeals[j].CreatedAt)
+	})
+
+	latestAppeals := []*domain.Appeal{}
+	itemKeys := map[string]bool{}
+	for _, a := range appeals {
+		key := domain.GetBundleItemKey(a.ResourceID, a.Role)
+		if itemKeys[key] {
+			continue
+		}
+		itemKeys[key] = true
+		latestAppeals = append(latestAppeals, a)
+	}
+	if len(latestAppeals) == 0 {
+		return "", nil, ErrNoAppealsFoundOnBundle
+	}
+
+	return domain.GetBundleAppealStatus(latestAppeals), latestAppeals, nil
+}
+
+func (s *Service) validate(ctx context.Context, b *domain.Bundle) error {
+	if err := s.validator.Struct(b); err != nil {
+		return err
+	}
+
+	existingBundles, err := s.repo.Find(ctx, domain.ListBundlesFilter{Names: []string{b.Name}})
+	if err != nil {
+		return fmt.Errorf("listing bundles: %w", err)
+	}
+	for _, existing := range existingBundles {
+		if existing.ID != b.ID {
+			return ErrDuplicateBundleName
+		}
+	}
+
+	if b.RequiredPolicy != nil {
+		if _, err := s.policyService.GetOne(ctx, b.RequiredPolicy.ID, uint(b.RequiredPolicy.Version)); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidBundlePolicy, err)
+		}
+	}
+
+	itemKeys := map[string]bool{}
+	for _, item := range b.Items {
+		r, err := s.resourceService.Get(ctx, item.Resource)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidBundleResource, err)
+		}
+		key := domain.GetBundleItemKey(r.ID, item.Role)
+		if itemKeys[key] {
+			return fmt.Errorf("%w: %q", ErrDuplicateBundleItem, key)
+		}
+		itemKeys[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,277 @@
+package bundle_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/raystack/guardian/core/bundle"
+	"github.com/raystack/guardian/core/bundle/mocks"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	mockRepository      *mocks.Repository
+	mockResourceService *mocks.ResourceService
+	mockPolicyService   *mocks.PolicyService
+	mockAppealService   *mocks.AppealService
+	mockAuditLogger     *mocks.AuditLogger
+	service             *bundle.Service
+}
+
+func TestService(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) setup() {
+	s.mockRepository = new(mocks.Repository)
+	s.mockResourceService = new(mocks.ResourceService)
+	s.mockPolicyService = new(mocks.PolicyService)
+	s.mockAppealService = new(mocks.AppealService)
+	s.mockAuditLogger = new(mocks.AuditLogger)
+	s.service = bundle.NewService(bundle.ServiceDeps{
+		Repository:      s.mockRepository,
+		ResourceService: s.mockResourceService,
+		PolicyService:   s.mockPolicyService,
+		AppealService:   s.mockAppealService,
+		Validator:       validator.New(),
+		Logger:          log.NewNoop(),
+		AuditLogger:     s.mockAuditLogger,
+	})
+}
+
+func (s *ServiceTestSuite) validBundle() *domain.Bundle {
+	return &domain.Bundle{
+		Name:           "new-joiner",
+		RequiredPolicy: &domain.PolicyConfig{ID: "onboarding", Version: 1},
+		Items: []*domain.BundleItem{
+			{Resource: &domain.ResourceIdentifier{ID: "resource-1"}, Role: "viewer"},
+			{Resource: &domain.ResourceIdentifier{ID: "resource-2"}, Role: "editor", Duration: "720h"},
+		},
+		CreatedBy: "admin@example.com",
+	}
+}
+
+func (s *ServiceTestSuite) TestCreate() {
+	s.Run("should return error if payload is invalid", func() {
+		s.setup()
+
+		b := s.validBundle()
+		b.Items = nil
+
+		err := s.service.Create(context.Background(), b)
+
+		s.Error(err)
+		s.mockRepository.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should return error if bundle name is already used", func() {
+		s.setup()
+
+		b := s.validBundle()
+		s.mockRepository.EXPECT().
+			Find(mock.Anything, domain.ListBundlesFilter{Names: []string{b.Name}}).
+			Return([]*domain.Bundle{{ID: "existing-bundle", Name: b.Name}}, nil).Once()
+
+		err := s.service.Create(context.Background(), b)
+
+		s.ErrorIs(err, bundle.ErrDuplicateBundleName)
+	})
+
+	s.Run("should return error if policy does not exist", func() {
+		s.setup()
+
+		b := s.validBundle()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "onboarding", uint(1)).Return(nil, errors.New("not found")).Once()
+
+		err := s.service.Create(context.Background(), b)
+
+		s.ErrorIs(err, bundle.ErrInvalidBundlePolicy)
+	})
+
+	s.Run("should return error if bundle contains the same resource and role twice", func() {
+		s.setup()
+
+		b := s.validBundle()
+		b.Items = append(b.Items, &domain.BundleItem{Resource: &domain.ResourceIdentifier{ID: "resource-1"}, Role: "viewer"})
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, mock.Anything, mock.Anything).Return(&domain.Policy{}, nil).Once()
+		s.mockResourceService.EXPECT().
+			Get(mock.Anything, mock.AnythingOfType("*domain.ResourceIdentifier")).
+			RunAndReturn(func(_ context.Context, ri *domain.ResourceIdentifier) (*domain.Resource, error) {
+				return &domain.Resource{ID: ri.ID}, nil
+			})
+
+		err := s.service.Create(context.Background(), b)
+
+		s.ErrorIs(err, bundle.ErrDuplicateBundleItem)
+	})
+
+	s.Run("should persist bundle and record audit log", func() {
+		s.setup()
+
+		b := s.validBundle()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, mock.Anything, mock.Anything).Return(&domain.Policy{}, nil).Once()
+		s.mockResourceService.EXPECT().
+			Get(mock.Anything, mock.AnythingOfType("*domain.ResourceIdentifier")).
+			RunAndReturn(func(_ context.Context, ri *domain.ResourceIdentifier) (*domain.Resource, error) {
+				return &domain.Resource{ID: ri.ID}, nil
+			})
+		s.mockRepository.EXPECT().Create(mock.Anything, b).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, bundle.AuditKeyCreate, b).Return(nil).Once()
+
+		err := s.service.Create(context.Background(), b)
+
+		s.NoError(err)
+		s.mockRepository.AssertExpectations(s.T())
+		s.mockAuditLogger.AssertExpectations(s.T())
+	})
+}
+
+func (s *ServiceTestSuite) TestUpdate() {
+	s.Run("should return error if the actor is not the creator of the bundle", func() {
+		s.setup()
+
+		existing := s.validBundle()
+		existing.ID = "bundle-1"
+		s.mockRepository.EXPECT().GetByID(mock.Anything, existing.ID).Return(existing, nil).Once()
+
+		payload := s.validBundle()
+		payload.ID = existing.ID
+		err := s.service.Update(context.Background(), "user@example.com", payload)
+
+		s.ErrorIs(err, bundle.ErrInvalidBundleOwner)
+		s.mockRepository.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func (s *ServiceTestSuite) TestDelete() {
+	s.Run("should return error if the actor is not the creator of the bundle", func() {
+		s.setup()
+
+		existing := s.validBundle()
+		existing.ID = "bundle-1"
+		s.mockRepository.EXPECT().GetByID(mock.Anything, existing.ID).Return(existing, nil).Once()
+
+		err := s.service.Delete(context.Background(), "user@example.com", existing.ID)
+
+		s.ErrorIs(err, bundle.ErrInvalidBundleOwner)
+		s.mockRepository.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should delete the bundle if the actor is its creator", func() {
+		s.setup()
+
+		existing := s.validBundle()
+		existing.ID = "bundle-1"
+		s.mockRepository.EXPECT().GetByID(mock.Anything, existing.ID).Return(existing, nil).Once()
+		s.mockRepository.EXPECT().Delete(mock.Anything, existing.ID).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, bundle.AuditKeyDelete, existing).Return(nil).Once()
+
+		err := s.service.Delete(context.Background(), existing.CreatedBy, existing.ID)
+
+		s.NoError(err)
+		s.mockRepository.AssertExpectations(s.T())
+	})
+}
+
+func (s *ServiceTestSuite) TestCreateAppeals() {
+	s.Run("should create an appeal for each bundle item using the bundle policy", func() {
+		s.setup()
+
+		b := s.validBundle()
+		b.ID = "bundle-1"
+		policy := &domain.Policy{ID: "onboarding", Version: 1}
+		s.mockRepository.EXPECT().GetByID(mock.Anything, b.ID).Return(b, nil).Once()
+		s.mockPolicyService.EXPECT().GetOne(mock.Anything, "onboarding", uint(1)).Return(policy, nil).Once()
+		s.mockResourceService.EXPECT().
+			Get(mock.Anything, mock.AnythingOfType("*domain.ResourceIdentifier")).
+			RunAndReturn(func(_ context.Context, ri *domain.ResourceIdentifier) (*domain.Resource, error) {
+				return &domain.Resource{ID: ri.ID}, nil
+			})
+		s.mockAppealService.EXPECT().
+			Create(mock.Anything, mock.AnythingOfType("[]*domain.Appeal")).
+			Return(nil).Once()
+
+		appeals, err := s.service.CreateAppeals(context.Background(), b.ID, &domain.Appeal{
+			AccountID:   "user@example.com",
+			AccountType: domain.DefaultAppealAccountType,
+			CreatedBy:   "user@example.com",
+			Description: "first day",
+		})
+
+		s.NoError(err)
+		s.Require().Len(appeals, 2)
+		for i, a := range appeals {
+			s.Equal(b.ID, a.BundleID)
+			s.Equal(b.Items[i].Resource.ID, a.ResourceID)
+			s.Equal(b.Items[i].Role, a.Role)
+			s.Equal("user@example.com", a.AccountID)
+			s.Equal("first day", a.Description)
+			s.Equal(policy.ID, a.PolicyID)
+			s.Equal(policy.Version, a.PolicyVersion)
+		}
+		s.Nil(appeals[0].Options)
+		s.Equal("720h", appeals[1].Options.Duration)
+	})
+
+	s.Run("should not create any appeal if a resource can't be found", func() {
+		s.setup()
+
+		b := s.validBundle()
+		b.ID = "bundle-1"
+		b.RequiredPolicy = nil
+		s.mockRepository.EXPECT().GetByID(mock.Anything, b.ID).Return(b, nil).Once()
+		s.mockResourceService.EXPECT().Get(mock.Anything, mock.Anything).Return(nil, errors.New("not found")).Once()
+
+		_, err := s.service.CreateAppeals(context.Background(), b.ID, &domain.Appeal{AccountID: "user@example.com"})
+
+		s.ErrorIs(err, bundle.ErrInvalidBundleResource)
+		s.mockAppealService.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func (s *ServiceTestSuite) TestGetAppealStatus() {
+	now := time.Now()
+	appeal := func(resourceID, status string, createdAt time.Time) *domain.Appeal {
+		return &domain.Appeal{ResourceID: resourceID, Role: "viewer", Status: status, CreatedAt: createdAt}
+	}
+
+	s.Run("should roll up the latest appeal of each bundle item", func() {
+		s.setup()
+
+		s.mockRepository.EXPECT().GetByID(mock.Anything, "bundle-1").Return(&domain.Bundle{ID: "bundle-1"}, nil).Once()
+		s.mockAppealService.EXPECT().
+			Find(mock.Anything, &domain.ListAppealsFilter{BundleID: "bundle-1", AccountID: "user@example.com"}).
+			Return([]*domain.Appeal{
+				appeal("resource-1", domain.AppealStatusRejected, now.Add(-time.Hour)),
+				appeal("resource-1", domain.AppealStatusApproved, now),
+				appeal("resource-2", domain.AppealStatusRejected, now),
+			}, nil).Once()
+
+		status, appeals, err := s.service.GetAppealStatus(context.Background(), "bundle-1", "user@example.com")
+
+		s.NoError(err)
+		s.Equal(domain.BundleAppealStatusPartiallyApproved, status)
+		s.Len(appeals, 2)
+	})
+
+	s.Run("should return error if the account has no appeals on the bundle", func() {
+		s.setup()
+
+		s.mockRepository.EXPECT().GetByID(mock.Anything, "bundle-1").Return(&domain.Bundle{ID: "bundle-1"}, nil).Once()
+		s.mockAppealService.EXPECT().Find(mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+		_, _, err := s.service.GetAppealStatus(context.Background(), "bundle-1", "user@example.com")
+
+		s.ErrorIs(err, bundle.ErrNoAppealsFoundOnBundle)
+	})
+}
@@ -0,0 +1,10 @@
+package delegation
+
+import "errors"
+
+var (
+	ErrEmptyIDParam         = errors.New("delegation id can't be empty")
+	ErrDelegationNotFound   = errors.New("delegation not found")
+	ErrInvalidDelegator     = errors.New("delegation can only be managed by the delegator")
+	ErrDelegationWindowPast = errors.New("delegation end date should be in the future")
+)
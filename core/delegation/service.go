@@ -0,0 +1,164 @@
+package delegation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/log"
+)
+
+const (
+	AuditKeyCreate = "delegation.create"
+	AuditKeyUpdate = "delegation.update"
+	AuditKeyDelete = "delegation.delete"
+)
+
+//go:generate mockery --name=repository --exported --with-expecter
+type repository interface {
+	Create(context.Context, *domain.Delegation) error
+	Update(context.Context, *domain.Delegation) error
+	GetByID(ctx context.Context, id string) (*domain.Delegation, error)
+	Find(context.Context, domain.ListDelegationsFilter) ([]*domain.Delegation, error)
+	Delete(ctx context.Context, id string) error
+}
+
+//go:generate mockery --name=auditLogger --exported --with-expecter
+type auditLogger interface {
+	Log(ctx context.Context, action string, data interface{}) error
+}
+
+type ServiceDeps struct {
+	Repository repository
+
+	Validator   *validator.Validate
+	Logger      log.Logger
+	AuditLogger auditLogger
+}
+
+type Service struct {
+	repo        repository
+	validator   *validator.Validate
+	logger      log.Logger
+	auditLogger auditLogger
+
+	TimeNow func() time.Time
+}
+
+func NewService(deps ServiceDeps) *Service {
+	return &Service{
+		repo:        deps.Repository,
+		validator:   deps.Validator,
+		logger:      deps.Logger,
+		auditLogger: deps.AuditLogger,
+		TimeNow:     time.Now,
+	}
+}
+
+func (s *Service) GetByID(ctx context.Context, id string) (*domain.Delegation, error) {
+	if id == "" {
+		return nil, ErrEmptyIDParam
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *Service) Find(ctx context.Context, filter domain.ListDelegationsFilter) ([]*domain.Delegation, error) {
+	return s.repo.Find(ctx, filter)
+}
+
+// FindActive returns delegations that are currently in effect for the given delegators
+func (s *Service) FindActive(ctx context.Context, delegators []string) ([]*domain.Delegation, error) {
+	now := s.TimeNow()
+	return s.repo.Find(ctx, domain.ListDelegationsFilter{
+		Delegators: delegators,
+		ActiveAt:   &now,
+	})
+}
+
+func (s *Service) Create(ctx context.Context, d *domain.Delegation) error {
+	if err := s.validate(d); err != nil {
+		return err
+	}
+	if d.CreatedBy != d.Delegator {
+		return ErrInvalidDelegator
+	}
+
+	if err := s.repo.Create(ctx, d); err != nil {
+		return fmt.Errorf("inserting delegation into db: %w", err)
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyCreate, d); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	return nil
+}
+
+// Update updates the delegation window, scope and reason. Delegator and delegate can't be changed.
+func (s *Service) Update(ctx context.Context, actor string, payload *domain.Delegation) error {
+	existing, err := s.GetByID(ctx, payload.ID)
+	if err != nil {
+		return fmt.Errorf("getting delegation details: %w", err)
+	}
+	if existing.Delegator != actor {
+		return ErrInvalidDelegator
+	}
+
+	updated := *existing
+	updated.StartDate = payload.StartDate
+	updated.EndDate = payload.EndDate
+	updated.PolicyID = payload.PolicyID
+	updated.ProviderType = payload.ProviderType
+	updated.ProviderURN = payload.ProviderURN
+	updated.Reason = payload.Reason
+	if err := s.validate(&updated); err != nil {
+		return err
+	}
+
+	if err := s.repo.Update(ctx, &updated); err != nil {
+		return err
+	}
+	*payload = updated
+
+	if err := s.auditLogger.Log(ctx, AuditKeyUpdate, map[string]interface{}{
+		"delegation_id":      existing.ID,
+		"previous":           existing,
+		"updated_delegation": payload,
+	}); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	return nil
+}
+
+func (s *Service) Delete(ctx context.Context, actor, id string) error {
+	existing, err := s.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("getting delegation details: %w", err)
+	}
+	if existing.Delegator != actor {
+		return ErrInvalidDelegator
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyDelete, existing); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	return nil
+}
+
+func (s *Service) validate(d *domain.Delegation) error {
+	if err := s.validator.Struct(d); err != nil {
+		return err
+	}
+	if !d.EndDate.After(s.TimeNow()) {
+		return ErrDelegationWindowPast
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+package delegation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/raystack/guardian/core/delegation"
+	"github.com/raystack/guardian/core/delegation/mocks"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	mockRepository  *mocks.Repository
+	mockAuditLogger *mocks.AuditLogger
+	service         *delegation.Service
+	now             time.Time
+}
+
+func TestService(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) setup() {
+	s.mockRepository = new(mocks.Repository)
+	s.mockAuditLogger = new(mocks.AuditLogger)
+	s.service = delegation.NewService(delegation.ServiceDeps{
+		Repository:  s.mockRepository,
+		Validator:   validator.New(),
+		Logger:      log.NewNoop(),
+		AuditLogger: s.mockAuditLogger,
+	})
+	s.now = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.service.TimeNow = func() time.Time { return s.now }
+}
+
+func (s *ServiceTestSuite) TestFindActive() {
+	s.Run("should query delegations active at current time", func() {
+		s.setup()
+
+		expected := []*domain.Delegation{{ID: "1"}}
+		s.mockRepository.EXPECT().
+			Find(mock.AnythingOfType("context.backgroundCtx"), domain.ListDelegationsFilter{
+				Delegators: []string{"user@example.com"},
+				ActiveAt:   &s.now,
+			}).
+			Return(expected, nil).Once()
+
+		actual, err := s.service.FindActive(context.Background(), []string{"user@example.com"})
+
+		s.NoError(err)
+		s.Equal(expected, actual)
+		s.mockRepository.AssertExpectations(s.T())
+	})
+}
+
+func (s *ServiceTestSuite) TestCreate() {
+	validDelegation := func() *domain.Delegation {
+		return &domain.Delegation{
+			Delegator: "user@example.com",
+			Delegate:  "substitute@example.com",
+			StartDate: s.now,
+			EndDate:   s.now.Add(7 * 24 * time.Hour),
+			CreatedBy: "user@example.com",
+		}
+	}
+
+	s.Run("should return error if payload is invalid", func() {
+		s.setup()
+
+		testCases := []struct {
+			name    string
+			payload func() *domain.Delegation
+		}{
+			{
+				name: "delegate is the same as delegator",
+				payload: func() *domain.Delegation {
+					d := validDelegation()
+					d.Delegate = d.Delegator
+					return d
+				},
+			},
+			{
+				name: "end date is before start date",
+				payload: func() *domain.Delegation {
+					d := validDelegation()
+					d.EndDate = d.StartDate.Add(-time.Hour)
+					return d
+				},
+			},
+			{
+				name: "delegate is not an email",
+				payload: func() *domain.Delegation {
+					d := validDelegation()
+					d.Delegate = "substitute"
+					return d
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				err := s.service.Create(context.Background(), tc.payload())
+				s.Error(err)
+			})
+		}
+	})
+
+	s.Run("should return error if delegation window is already over", func() {
+		s.setup()
+
+		d := validDelegation()
+		d.StartDate = s.now.Add(-48 * time.Hour)
+		d.EndDate = s.now.Add(-24 * time.Hour)
+
+		err := s.service.Create(context.Background(), d)
+
+		s.ErrorIs(err, delegation.ErrDelegationWindowPast)
+	})
+
+	s.Run("should return error if creator is not the delegator", func() {
+		s.setup()
+
+		d := validDelegation()
+		d.CreatedBy = "someone.else@example.com"
+
+		err := s.service.Create(context.Background(), d)
+
+		s.ErrorIs(err, delegation.ErrInvalidDelegator)
+	})
+
+	s.Run("should return error if repository returns an error", func() {
+		s.setup()
+
+		expectedError := errors.New("unexpected error")
+		s.mockRepository.EXPECT().
+			Create(mock.AnythingOfType("context.backgroundCtx"), mock.Anything).
+			Return(expectedError).Once()
+
+		err := s.service.Create(context.Background(), validDelegation())
+
+		s.ErrorIs(err, expectedError)
+	})
+
+	s.Run("should store delegation and record audit log", func() {
+		s.setup()
+
+		d := validDelegation()
+		s.mockRepository.EXPECT().
+			Create(mock.AnythingOfType("context.backgroundCtx"), d).
+			Return(nil).Once()
+		s.mockAuditLogger.EXPECT().
+			Log(mock.AnythingOfType("context.backgroundCtx"), delegation.AuditKeyCreate, d).
+			Return(nil).Once()
+
+		err := s.service.Create(context.Background(), d)
+
+		s.NoError(err)
+		s.mockRepository.AssertExpectations(s.T())
+		s.mockAuditLogger.AssertExpectations(s.T())
+	})
+}
+
+func (s *ServiceTestSuite) TestUpdate() {
+	existing := func() *domain.Delegation {
+		return &domain.Delegation{
+			ID:        "delegation-id",
+			Delegator: "user@example.com",
+			Delegate:  "substitute@example.com",
+			StartDate: s.now,
+			EndDate:   s.now.Add(24 * time.Hour),
+			CreatedBy: "user@example.com",
+		}
+	}
+
+	s.Run("should return error if actor is not the delegator", func() {
+		s.setup()
+
+		s.mockRepository.EXPECT().
+			GetByID(mock.AnythingOfType("context.backgroundCtx"), "delegation-id").
+			Return(existing(), nil).Once()
+
+		err := s.service.Update(context.Background(), "someone.else@example.com", &domain.Delegation{ID: "delegation-id"})
+
+		s.ErrorIs(err, delegation.ErrInvalidDelegator)
+	})
+
+	s.Run("should only update window, scope and reason", func() {
+		s.setup()
+
+		s.mockRepository.EXPECT().
+			GetByID(mock.AnythingOfType("context.backgroundCtx"), "delegation-id").
+			Return(existing(), nil).Once()
+		payload := &domain.Delegation{
+			ID:           "delegation-id",
+			Delegate:     "attacker@example.com",
+			StartDate:    s.now,
+			EndDate:      s.now.Add(72 * time.Hour),
+			ProviderType: "bigquery",
+			Reason:       "extended leave",
+		}
+		expected := existing()
+		expected.EndDate = payload.EndDate
+		expected.ProviderType = payload.ProviderType
+		expected.Reason = payload.Reason
+		s.mockRepository.EXPECT().
+			Update(mock.AnythingOfType("context.backgroundCtx"), expected).
+			Return(nil).Once()
+		s.mockAuditLogger.EXPECT().
+			Log(mock.AnythingOfType("context.backgroundCtx"), delegation.AuditKeyUpdate, mock.Anything).
+			Return(nil).Once()
+
+		err := s.service.Update(context.Background(), "user@example.com", payload)
+
+		s.NoError(err)
+		s.Equal(expected, payload)
+		s.mockRepository.AssertExpectations(s.T())
+	})
+}
+
+func (s *ServiceTestSuite) TestDelete() {
+	s.Run("should return error if id is empty", func() {
+		s.setup()
+
+		err := s.service.Delete(context.Background(), "user@example.com", "")
+
+		s.ErrorIs(err, delegation.ErrEmptyIDParam)
+	})
+
+	s.Run("should delete delegation owned by the actor", func() {
+		s.setup()
+
+		d := &domain.Delegation{ID: "delegation-id", Delegator: "user@example.com"}
+		s.mockRepository.EXPECT().
+			GetByID(mock.AnythingOfType("context.backgroundCtx"), d.ID).
+			Return(d, nil).Once()
+		s.mockRepository.EXPECT().
+			Delete(mock.AnythingOfType("context.backgroundCtx"), d.ID).
+			Return(nil).Once()
+		s.mockAuditLogger.EXPECT().
+			Log(mock.AnythingOfType("context.backgroundCtx"), delegation.AuditKeyDelete, d).
+			Return(nil).Once()
+
+		err := s.service.Delete(context.Background(), "user@example.com", d.ID)
+
+		s.NoError(err)
+		s.mockRepository.AssertExpectations(s.T())
+	})
+}
@@ -131,6 +131,100 @@ func (_c *ProviderService_GetByID_Call) RunAndReturn(run func(context.Context, s
 	return _c
 }
 
+// GrantAccess provides a mock function with given fields: _a0, _a1
+func (_m *ProviderService) GrantAccess(_a0 context.Context, _a1 domain.Grant) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GrantAccess")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Grant) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProviderService_GrantAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantAccess'
+type ProviderService_GrantAccess_Call struct {
+	*mock.Call
+}
+
+// GrantAccess is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.Grant
+func (_e *ProviderService_Expecter) GrantAccess(_a0 interface{}, _a1 interface{}) *ProviderService_GrantAccess_Call {
+	return &ProviderService_GrantAccess_Call{Call: _e.mock.On("GrantAccess", _a0, _a1)}
+}
+
+func (_c *ProviderService_GrantAccess_Call) Run(run func(_a0 context.Context, _a1 domain.Grant)) *ProviderService_GrantAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Grant))
+	})
+	return _c
+}
+
+func (_c *ProviderService_GrantAccess_Call) Return(_a0 error) *ProviderService_GrantAccess_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProviderService_GrantAccess_Call) RunAndReturn(run func(context.Context, domain.Grant) error) *ProviderService_GrantAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsGrantObservable provides a mock function with given fields: _a0, _a1
+func (_m *ProviderService) IsGrantObservable(_a0 domain.Provider, _a1 *domain.Grant) bool {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsGrantObservable")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(domain.Provider, *domain.Grant) bool); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// ProviderService_IsGrantObservable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsGrantObservable'
+type ProviderService_IsGrantObservable_Call struct {
+	*mock.Call
+}
+
+// IsGrantObservable is a helper method to define mock.On call
+//   - _a0 domain.Provider
+//   - _a1 *domain.Grant
+func (_e *ProviderService_Expecter) IsGrantObservable(_a0 interface{}, _a1 interface{}) *ProviderService_IsGrantObservable_Call {
+	return &ProviderService_IsGrantObservable_Call{Call: _e.mock.On("IsGrantObservable", _a0, _a1)}
+}
+
+func (_c *ProviderService_IsGrantObservable_Call) Run(run func(_a0 domain.Provider, _a1 *domain.Grant)) *ProviderService_IsGrantObservable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.Provider), args[1].(*domain.Grant))
+	})
+	return _c
+}
+
+func (_c *ProviderService_IsGrantObservable_Call) Return(_a0 bool) *ProviderService_IsGrantObservable_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProviderService_IsGrantObservable_Call) RunAndReturn(run func(domain.Provider, *domain.Grant) bool) *ProviderService_IsGrantObservable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAccess provides a mock function with given fields: _a0, _a1, _a2
 func (_m *ProviderService) ListAccess(_a0 context.Context, _a1 domain.Provider, _a2 []*domain.Resource) (domain.MapResourceAccess, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -16,8 +16,13 @@ import (
 )
 
 const (
-	AuditKeyRevoke = "grant.revoke"
-	AuditKeyUpdate = "grant.update"
+	AuditKeyRevoke     = "grant.revoke"
+	AuditKeyUpdate     = "grant.update"
+	AuditKeyActivate   = "grant.activate"
+	AuditKeyDriftCheck = "grant.drift_check"
+
+	RevokeReasonForScheduledGrant        = "Automatically revoked for scheduled grant activation"
+	RevokeReasonForExpiredScheduledGrant = "Automatically revoked as the grant expired before its start date"
 )
 
 //go:generate mockery --name=repository --exported --with-expecter
@@ -32,10 +37,12 @@ type repository interface {
 //go:generate mockery --name=providerService --exported --with-expecter
 type providerService interface {
 	GetByID(context.Context, string) (*domain.Provider, error)
+	GrantAccess(context.Context, domain.Grant) error
 	RevokeAccess(context.Context, domain.Grant) error
 	ListAccess(context.Context, domain.Provider, []*domain.Resource) (domain.MapResourceAccess, error)
 	ListActivities(context.Context, domain.Provider, domain.ListActivitiesFilter) ([]*domain.Activity, error)
 	CorrelateGrantActivities(context.Context, domain.Provider, []*domain.Grant, []*domain.Activity) error
+	IsGrantObservable(domain.Provider, *domain.Grant) bool
 }
 
 //go:generate mockery --name=resourceService --exported --with-expecter
@@ -206,7 +213,8 @@ func (s *Service) Revoke(ctx context.Context, id, actor, reason string, opts ...
 
 	options := s.getOptions(opts...)
 
-	if !options.skipRevokeInProvider {
+	// scheduled grants haven't been given in the provider yet
+	if !options.skipRevokeInProvider && revokedGrant.Status != domain.GrantStatusScheduled {
 		if err := s.providerService.RevokeAccess(ctx, *grant); err != nil {
 			if err := s.repo.Update(ctx, grant); err != nil {
 				return nil, fmt.Errorf("failed to rollback grant status: %w", err)
@@ -249,6 +257,81 @@ func (s *Service) Revoke(ctx context.Context, id, actor, reason string, opts ...
 	return grant, nil
 }
 
+// ActivateScheduledGrants gives the access of scheduled grants whose start date has been reached. Scheduled grants
+// that already passed their expiration date are revoked without giving the access.
+func (s *Service) ActivateScheduledGrants(ctx context.Context) ([]*domain.Grant, error) {
+	now := time.Now()
+	grants, err := s.repo.List(ctx, domain.ListGrantsFilter{
+		Statuses:          []string{string(domain.GrantStatusScheduled)},
+		StartDateLessThan: now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing scheduled grants: %w", err)
+	}
+
+	var activatedGrants []*domain.Grant
+	for i := range grants {
+		g := &grants[i]
+		if g.ExpirationDate != nil && !g.ExpirationDate.IsZero() && !g.ExpirationDate.After(now) {
+			if _, err := s.Revoke(ctx, g.ID, domain.SystemActorName, RevokeReasonForExpiredScheduledGrant, SkipNotifications()); err != nil {
+				s.logger.Error("failed to revoke expired scheduled grant", "grant_id", g.ID, "error", err)
+			}
+			continue
+		}
+		if err := s.activate(ctx, g); err != nil {
+			s.logger.Error("failed to activate scheduled grant", "grant_id", g.ID, "error", err)
+			continue
+		}
+		activatedGrants = append(activatedGrants, g)
+	}
+
+	return activatedGrants, nil
+}
+
+func (s *Service) activate(ctx context.Context, g *domain.Grant) error {
+	// same as the appeal approval flow, an existing active grant with the same permissions gets replaced by the new
+	// one and the access in the provider is left untouched
+	previousGrants, err := s.repo.List(ctx, domain.ListGrantsFilter{
+		Statuses:    []string{string(domain.GrantStatusActive)},
+		AccountIDs:  []string{g.AccountID},
+		ResourceIDs: []string{g.ResourceID},
+		Permissions: g.Permissions,
+	})
+	if err != nil {
+		return fmt.Errorf("listing active grants: %w", err)
+	}
+
+	if err := g.Activate(); err != nil {
+		return err
+	}
+	if len(previousGrants) == 0 {
+		if err := s.providerService.GrantAccess(ctx, *g); err != nil {
+			return fmt.Errorf("granting access in provider: %w", err)
+		}
+	}
+	if err := s.repo.Update(ctx, g); err != nil {
+		return fmt.Errorf("updating grant record in db: %w", err)
+	}
+
+	for _, prev := range previousGrants {
+		if _, err := s.Revoke(ctx, prev.ID, domain.SystemActorName, RevokeReasonForScheduledGrant,
+			SkipNotifications(),
+			SkipRevokeAccessInProvider(),
+		); err != nil {
+			s.logger.Error("failed to revoke previous grant", "grant_id", prev.ID, "error", err)
+		}
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyActivate, map[string]interface{}{
+		"grant_id":   g.ID,
+		"start_date": g.StartDate,
+	}); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	return nil
+}
+
 func (s *Service) BulkRevoke(ctx context.Context, filter domain.RevokeGrantsFilter, actor, reason string) ([]*domain.Grant, error) {
 	if filter.AccountIDs == nil || len(filter.AccountIDs) == 0 {
 		return nil, fmt.Errorf("account_ids is required")
@@ -391,6 +474,132 @@ func (s *Service) ImportFromProvider(ctx context.Context, criteria ImportFromPro
 		return nil, fmt.Errorf("getting resources: %w", err)
 	}
 
+	diff, err := s.compareProviderAccess(ctx, p, resources, listGrantsFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	var newAndUpdatedGrants []*domain.Grant
+	for rURN, importedGrants := range diff.grantsByResource {
+		if err := s.repo.BulkUpsert(ctx, importedGrants); err != nil {
+			return nil, fmt.Errorf("inserting new and updated grants into the db for %q: %w", rURN, err)
+		}
+		newAndUpdatedGrants = append(newAndUpdatedGrants, importedGrants...)
+	}
+
+	// mark remaining active grants as inactive
+	for _, g := range diff.missingGrants {
+		g.StatusInProvider = domain.GrantStatusInactive
+	}
+	if len(diff.missingGrants) > 0 {
+		if err := s.repo.BulkUpsert(ctx, diff.missingGrants); err != nil {
+			return nil, fmt.Errorf("updating grants provider status: %w", err)
+		}
+	}
+
+	return newAndUpdatedGrants, nil
+}
+
+// DriftCheck compares the access in the provider with the active grants in guardian and remediates the
+// difference according to the criteria mode
+func (s *Service) DriftCheck(ctx context.Context, criteria domain.GrantDriftCheckCriteria) (*domain.GrantDrift, error) {
+	if err := criteria.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid drift check criteria: %w", err)
+	}
+
+	p, err := s.providerService.GetByID(ctx, criteria.ProviderID)
+	if err != nil {
+		return nil, fmt.Errorf("getting provider details: %w", err)
+	}
+
+	resources, err := s.resourceService.Find(ctx, domain.ListResourcesFilter{
+		ProviderType: p.Type,
+		ProviderURN:  p.URN,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting resources: %w", err)
+	}
+
+	diff, err := s.compareProviderAccess(ctx, p, resources, domain.ListGrantsFilter{
+		Statuses:      []string{string(domain.GrantStatusActive)},
+		ProviderTypes: []string{p.Type},
+		ProviderURNs:  []string{p.URN},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	drift := &domain.GrantDrift{
+		ProviderURN:     p.URN,
+		Mode:            criteria.Mode,
+		OutOfBandGrants: diff.outOfBandGrants,
+		MissingGrants:   diff.missingGrants,
+	}
+	s.logger.Info(fmt.Sprintf("found %d out-of-band and %d missing grants", len(drift.OutOfBandGrants), len(drift.MissingGrants)), "provider_urn", p.URN)
+
+	switch criteria.Mode {
+	case domain.GrantDriftModeImport:
+		if len(drift.OutOfBandGrants) > 0 {
+			if err := s.repo.BulkUpsert(ctx, drift.OutOfBandGrants); err != nil {
+				return nil, fmt.Errorf("importing out-of-band grants: %w", err)
+			}
+			drift.RemediatedGrants = drift.OutOfBandGrants
+		}
+	case domain.GrantDriftModeRevoke:
+		resourcesMap := make(map[string]*domain.Resource)
+		for _, r := range resources {
+			resourcesMap[r.ID] = r
+		}
+		for _, g := range drift.OutOfBandGrants {
+			grant := *g
+			grant.Resource = resourcesMap[g.ResourceID]
+			if err := s.providerService.RevokeAccess(ctx, grant); err != nil {
+				s.logger.Error("failed to revoke out-of-band access", "error", err, "account_id", g.AccountID, "resource_id", g.ResourceID, "provider_urn", p.URN)
+				continue
+			}
+			drift.RemediatedGrants = append(drift.RemediatedGrants, g)
+		}
+	case domain.GrantDriftModeReapply:
+		for _, g := range drift.MissingGrants {
+			if err := s.providerService.GrantAccess(ctx, *g); err != nil {
+				s.logger.Error("failed to re-apply missing grant", "error", err, "grant_id", g.ID, "provider_urn", p.URN)
+				continue
+			}
+			drift.RemediatedGrants = append(drift.RemediatedGrants, g)
+		}
+	}
+
+	if drift.HasDrift() {
+		var missingGrantIDs []string
+		for _, g := range drift.MissingGrants {
+			missingGrantIDs = append(missingGrantIDs, g.ID)
+		}
+		if err := s.auditLogger.Log(ctx, AuditKeyDriftCheck, map[string]interface{}{
+			"provider_urn":       p.URN,
+			"mode":               criteria.Mode,
+			"out_of_band_grants": len(drift.OutOfBandGrants),
+			"missing_grant_ids":  missingGrantIDs,
+			"remediated_grants":  len(drift.RemediatedGrants),
+		}); err != nil {
+			s.logger.Error("failed to record audit log", "error", err)
+		}
+	}
+
+	return drift, nil
+}
+
+// providerAccessDiff is the result of comparing the access in a provider with the active grants in guardian
+type providerAccessDiff struct {
+	// grantsByResource contains the provider access as grants grouped by resource urn, access already recorded
+	// in guardian is replaced by the existing grant
+	grantsByResource map[string][]*domain.Grant
+	// outOfBandGrants are grants present in the provider without any active grant in guardian
+	outOfBandGrants []*domain.Grant
+	// missingGrants are active grants in guardian that are absent in the provider
+	missingGrants []*domain.Grant
+}
+
+func (s *Service) compareProviderAccess(ctx context.Context, p *domain.Provider, resources []*domain.Resource, listGrantsFilter domain.ListGrantsFilter) (*providerAccessDiff, error) {
 	resourceAccess, err := s.providerService.ListAccess(ctx, *p, resources)
 	if err != nil {
 		return nil, fmt.Errorf("fetching access from provider: %w", err)
@@ -425,7 +634,9 @@ func (s *Service) ImportFromProvider(ctx context.Context, criteria ImportFromPro
 		activeGrantsMap[g.Resource.URN][accountSignature][g.PermissionsKey()] = &activeGrants[i]
 	}
 
-	var newAndUpdatedGrants []*domain.Grant
+	diff := &providerAccessDiff{
+		grantsByResource: map[string][]*domain.Grant{},
+	}
 	for rURN, accessEntries := range resourceAccess {
 		resource, ok := resourcesMap[rURN]
 		if !ok {
@@ -452,6 +663,8 @@ func (s *Service) ImportFromProvider(ctx context.Context, criteria ImportFromPro
 
 					// remove updated grant from active grants map
 					delete(activeGrantsMap[rURN][accountSignature], key)
+				} else {
+					diff.outOfBandGrants = append(diff.outOfBandGrants, g)
 				}
 			}
 
@@ -459,30 +672,19 @@ func (s *Service) ImportFromProvider(ctx context.Context, criteria ImportFromPro
 		}
 
 		if len(importedGrants) > 0 {
-			if err := s.repo.BulkUpsert(ctx, importedGrants); err != nil {
-				return nil, fmt.Errorf("inserting new and updated grants into the db for %q: %w", rURN, err)
-			}
-			newAndUpdatedGrants = append(newAndUpdatedGrants, importedGrants...)
+			diff.grantsByResource[rURN] = importedGrants
 		}
 	}
 
-	// mark remaining active grants as inactive
-	var deactivatedGrants []*domain.Grant
 	for _, v := range activeGrantsMap {
 		for _, v2 := range v {
 			for _, g := range v2 {
-				g.StatusInProvider = domain.GrantStatusInactive
-				deactivatedGrants = append(deactivatedGrants, g)
+				diff.missingGrants = append(diff.missingGrants, g)
 			}
 		}
 	}
-	if len(deactivatedGrants) > 0 {
-		if err := s.repo.BulkUpsert(ctx, deactivatedGrants); err != nil {
-			return nil, fmt.Errorf("updating grants provider status: %w", err)
-		}
-	}
 
-	return newAndUpdatedGrants, nil
+	return diff, nil
 }
 
 func (s *Service) DormancyCheck(ctx context.Context, criteria domain.DormancyCheckCriteria) error {
@@ -506,6 +708,19 @@ func (s *Service) DormancyCheck(ctx context.Context, criteria domain.DormancyChe
 	if err != nil {
 		return fmt.Errorf("listing active grants: %w", err)
 	}
+
+	// grants the provider can't record any activity for would always be considered dormant
+	observableGrants := make([]domain.Grant, 0, len(grants))
+	for i, g := range grants {
+		if s.providerService.IsGrantObservable(*provider, &grants[i]) {
+			observableGrants = append(observableGrants, g)
+		}
+	}
+	if skipped := len(grants) - len(observableGrants); skipped > 0 {
+		s.logger.Info(fmt.Sprintf("skipping %d grants without observable permissions", skipped), "provider_urn", provider.URN)
+	}
+	grants = observableGrants
+
 	if len(grants) == 0 {
 		s.logger.Info("no active grants found", "provider_urn", provider.URN)
 		return nil
@@ -608,6 +823,94 @@ prepare_notifications:
 	return nil
 }
 
+// ListRecommendations suggests a narrower role or a revocation for the active grants of a provider based on the
+// activities of their accounts within the period
+func (s *Service) ListRecommendations(ctx context.Context, filter domain.ListGrantRecommendationsFilter) ([]*domain.GrantRecommendation, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid grant recommendations filter: %w", err)
+	}
+	startDate := time.Now().Add(-filter.Period)
+
+	provider, err := s.providerService.GetByID(ctx, filter.ProviderID)
+	if err != nil {
+		return nil, fmt.Errorf("getting provider details: %w", err)
+	}
+
+	grants, err := s.List(ctx, domain.ListGrantsFilter{
+		Statuses:      []string{string(domain.GrantStatusActive)},
+		ProviderTypes: []string{provider.Type},
+		ProviderURNs:  []string{provider.URN},
+		AccountIDs:    filter.AccountIDs,
+		ResourceIDs:   filter.ResourceIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing active grants: %w", err)
+	}
+
+	// grants created within the period are only used to find roles already held by the account
+	var grantsPointer []*domain.Grant
+	var accountIDs []string
+	heldRoles := map[string]map[string]*domain.Grant{}
+	for i, g := range grants {
+		key := getAccountSignature(g.AccountType, g.AccountID) + ":" + g.ResourceID
+		if heldRoles[key] == nil {
+			heldRoles[key] = map[string]*domain.Grant{}
+		}
+		heldRoles[key][g.Role] = &grants[i]
+
+		if g.CreatedAt.After(startDate) {
+			continue
+		}
+		// grants the provider can't record any activity for would always be recommended to be revoked
+		if !s.providerService.IsGrantObservable(*provider, &grants[i]) {
+			continue
+		}
+		grantsPointer = append(grantsPointer, &grants[i])
+		accountIDs = append(accountIDs, g.AccountID)
+	}
+	recommendations := []*domain.GrantRecommendation{}
+	if len(grantsPointer) == 0 {
+		return recommendations, nil
+	}
+
+	activities, err := s.providerService.ListActivities(ctx, *provider, domain.ListActivitiesFilter{
+		AccountIDs:   slices.UniqueStringSlice(accountIDs),
+		TimestampGte: &startDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing activities for provider %q: %w", provider.URN, err)
+	}
+	if err := s.providerService.CorrelateGrantActivities(ctx, *provider, grantsPointer, activities); err != nil {
+		return nil, fmt.Errorf("correlating grant activities: %w", err)
+	}
+
+	resourceConfigs := map[string]*domain.ResourceConfig{}
+	if provider.Config != nil {
+		for _, rc := range provider.Config.Resources {
+			resourceConfigs[rc.Type] = rc
+		}
+	}
+
+	for _, g := range grantsPointer {
+		r := recommendGrant(g, resourceConfigs[g.Resource.Type], activities)
+		if r == nil {
+			continue
+		}
+		if r.Type == domain.GrantRecommendationTypeDowngrade {
+			key := getAccountSignature(g.AccountType, g.AccountID) + ":" + g.ResourceID
+			if held, ok := heldRoles[key][r.RecommendedRole]; ok {
+				r.Type = domain.GrantRecommendationTypeRevoke
+				r.Reason = fmt.Sprintf("the activities are covered by grant %s with role %s", held.ID, held.Role)
+				r.RecommendedRole = ""
+				r.RecommendedPermissions = nil
+			}
+		}
+		recommendations = append(recommendations, r)
+	}
+
+	return recommendations, nil
+}
+
 func getAccountSignature(accountType, accountID string) string {
 	return fmt.Sprintf("%s:%s", accountType, accountID)
 }
@@ -673,3 +976,81 @@ func getGrantIDs(grants []domain.Grant) []string {
 func (s *Service) GetGrantsTotalCount(ctx context.Context, filters domain.ListGrantsFilter) (int64, error) {
 	return s.repo.GetGrantsTotalCount(ctx, filters)
 }
+
+// recommendGrant suggests revoking a grant without any activity, or downgrading it to the narrowest configured role
+// still allowing all of its activities. Only roles whose permissions are a strict subset of the grant's are narrower,
+// the one allowing the least observed activities is recommended.
+func recommendGrant(g *domain.Grant, rc *domain.ResourceConfig, observed []*domain.Activity) *domain.GrantRecommendation {
+	if len(g.Activities) == 0 {
+		return &domain.GrantRecommendation{
+			Grant:  g,
+			Type:   domain.GrantRecommendationTypeRevoke,
+			Reason: "no activity within the period",
+		}
+	}
+
+	var usedPermissions []string
+	for _, a := range g.Activities {
+		for _, p := range g.Permissions {
+			if utils.ContainsString(a.RelatedPermissions, p) {
+				usedPermissions = append(usedPermissions, p)
+			}
+		}
+	}
+	usedPermissions = slices.UniqueStringSlice(usedPermissions)
+	sort.Strings(usedPermissions)
+
+	if rc == nil {
+		return nil
+	}
+
+	var recommendedRole *domain.Role
+	var recommendedAllowed map[*domain.Activity]bool
+	for _, role := range rc.Roles {
+		if role.ID == g.Role {
+			continue
+		}
+		permissions := role.GetOrderedPermissions()
+		if !utils.IsStrictSubset(permissions, g.Permissions) {
+			continue
+		}
+		if len(allowedActivities(permissions, g.Activities)) < len(g.Activities) {
+			continue
+		}
+
+		allowed := allowedActivities(permissions, observed)
+		if recommendedRole == nil ||
+			len(allowed) < len(recommendedAllowed) ||
+			(len(allowed) == len(recommendedAllowed) && len(role.Permissions) < len(recommendedRole.Permissions)) {
+			recommendedRole = role
+			recommendedAllowed = allowed
+		}
+	}
+	if recommendedRole == nil {
+		return nil
+	}
+
+	return &domain.GrantRecommendation{
+		Grant:                  g,
+		Type:                   domain.GrantRecommendationTypeDowngrade,
+		RecommendedRole:        recommendedRole.ID,
+		RecommendedPermissions: recommendedRole.GetOrderedPermissions(),
+		UsedPermissions:        usedPermissions,
+		ActivitiesCount:        len(g.Activities),
+		Reason:                 fmt.Sprintf("all %d activities within the period are allowed by role %s", len(g.Activities), recommendedRole.ID),
+	}
+}
+
+// allowedActivities returns the activities related to any of the permissions
+func allowedActivities(permissions []string, activities []*domain.Activity) map[*domain.Activity]bool {
+	allowed := map[*domain.Activity]bool{}
+	for _, a := range activities {
+		for _, p := range permissions {
+			if utils.ContainsString(a.RelatedPermissions, p) {
+				allowed[a] = true
+				break
+			}
+		}
+	}
+	return allowed
+}
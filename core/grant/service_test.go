@@ -339,6 +339,93 @@ func (s *ServiceTestSuite) TestRevoke() {
 	})
 }
 
+func (s *ServiceTestSuite) TestActivateScheduledGrants() {
+	s.Run("should give access in provider and activate the scheduled grants", func() {
+		s.setup()
+
+		startDate := time.Now().Add(-time.Minute)
+		scheduledGrant := domain.Grant{
+			ID:          "scheduled-grant",
+			Status:      domain.GrantStatusScheduled,
+			AccountID:   "user@example.com",
+			ResourceID:  "resource-id",
+			Permissions: []string{"viewer"},
+			StartDate:   &startDate,
+		}
+		s.mockRepository.EXPECT().
+			List(mock.Anything, mock.MatchedBy(func(f domain.ListGrantsFilter) bool {
+				return len(f.Statuses) == 1 && f.Statuses[0] == string(domain.GrantStatusScheduled) && !f.StartDateLessThan.IsZero()
+			})).
+			Return([]domain.Grant{scheduledGrant}, nil).Once()
+		s.mockRepository.EXPECT().
+			List(mock.Anything, domain.ListGrantsFilter{
+				Statuses:    []string{string(domain.GrantStatusActive)},
+				AccountIDs:  []string{scheduledGrant.AccountID},
+				ResourceIDs: []string{scheduledGrant.ResourceID},
+				Permissions: scheduledGrant.Permissions,
+			}).
+			Return(nil, nil).Once()
+		s.mockProviderService.EXPECT().
+			GrantAccess(mock.Anything, mock.MatchedBy(func(g domain.Grant) bool {
+				return g.ID == scheduledGrant.ID && g.Status == domain.GrantStatusActive
+			})).
+			Return(nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Grant")).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, grant.AuditKeyActivate, mock.Anything).Return(nil).Once()
+
+		activatedGrants, err := s.service.ActivateScheduledGrants(context.Background())
+
+		s.NoError(err)
+		s.Len(activatedGrants, 1)
+		s.Equal(domain.GrantStatusActive, activatedGrants[0].Status)
+		s.mockProviderService.AssertExpectations(s.T())
+	})
+
+	s.Run("should keep the grant scheduled if granting access in provider fails", func() {
+		s.setup()
+
+		scheduledGrant := domain.Grant{ID: "scheduled-grant", Status: domain.GrantStatusScheduled}
+		s.mockRepository.EXPECT().List(mock.Anything, mock.Anything).Return([]domain.Grant{scheduledGrant}, nil).Once()
+		s.mockRepository.EXPECT().List(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockProviderService.EXPECT().GrantAccess(mock.Anything, mock.Anything).Return(errors.New("provider error")).Once()
+
+		activatedGrants, err := s.service.ActivateScheduledGrants(context.Background())
+
+		s.NoError(err)
+		s.Empty(activatedGrants)
+		s.mockRepository.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should revoke the scheduled grant instead of activating it if it has expired", func() {
+		s.setup()
+
+		startDate := time.Now().Add(-2 * time.Hour)
+		expirationDate := time.Now().Add(-time.Hour)
+		scheduledGrant := domain.Grant{
+			ID:             "scheduled-grant",
+			Status:         domain.GrantStatusScheduled,
+			StartDate:      &startDate,
+			ExpirationDate: &expirationDate,
+		}
+		s.mockRepository.EXPECT().List(mock.Anything, mock.Anything).Return([]domain.Grant{scheduledGrant}, nil).Once()
+		s.mockRepository.EXPECT().GetByID(mock.Anything, scheduledGrant.ID).Return(&scheduledGrant, nil).Once()
+		s.mockRepository.EXPECT().
+			Update(mock.Anything, mock.MatchedBy(func(g *domain.Grant) bool {
+				return g.Status == domain.GrantStatusInactive && g.RevokeReason == grant.RevokeReasonForExpiredScheduledGrant
+			})).
+			Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, grant.AuditKeyRevoke, mock.Anything).Return(nil).Once()
+
+		activatedGrants, err := s.service.ActivateScheduledGrants(context.Background())
+
+		s.NoError(err)
+		s.Empty(activatedGrants)
+		s.mockRepository.AssertExpectations(s.T())
+		s.mockProviderService.AssertNotCalled(s.T(), "GrantAccess", mock.Anything, mock.Anything)
+		s.mockProviderService.AssertNotCalled(s.T(), "RevokeAccess", mock.Anything, mock.Anything)
+	})
+}
+
 func (s *ServiceTestSuite) TestBulkRevoke() {
 	actor := "test-actor@example.com"
 	reason := "test reason"
@@ -957,6 +1044,7 @@ func (s *ServiceTestSuite) TestDormancyCheck() {
 				s.Empty(cmp.Diff(expectedListGrantsFilter, f, cmpopts.EquateApproxTime(time.Second)))
 			}).
 			Return(dummyGrants, nil).Once()
+		s.mockProviderService.EXPECT().IsGrantObservable(*dummyProvider, mock.AnythingOfType("*domain.Grant")).Return(true)
 		timestampGte := timeNow.Add(-dormancyCheckCriteria.Period)
 		expectedListActivitiesFilter := domain.ListActivitiesFilter{
 			AccountIDs:   []string{"user@example.com", "user2@example.com"},
@@ -988,6 +1076,386 @@ func (s *ServiceTestSuite) TestDormancyCheck() {
 		err := s.service.DormancyCheck(context.Background(), dormancyCheckCriteria)
 		s.NoError(err)
 	})
+
+	s.Run("should skip grants whose permissions can't produce any activity", func() {
+		s.setup()
+
+		dummyProvider := &domain.Provider{ID: "test-provider-id"}
+		viewerGrant := domain.Grant{ID: "g1", AccountID: "user@example.com", Permissions: []string{"view"}, IsPermanent: true}
+		editorGrant := domain.Grant{ID: "g2", AccountID: "user2@example.com", Permissions: []string{"edit"}, IsPermanent: true}
+
+		s.mockProviderService.EXPECT().GetByID(mock.Anything, dummyProvider.ID).Return(dummyProvider, nil).Once()
+		s.mockRepository.EXPECT().List(mock.Anything, mock.AnythingOfType("domain.ListGrantsFilter")).
+			Return([]domain.Grant{viewerGrant, editorGrant}, nil).Once()
+		s.mockProviderService.EXPECT().IsGrantObservable(*dummyProvider, mock.MatchedBy(func(g *domain.Grant) bool {
+			return g.ID == viewerGrant.ID
+		})).Return(false)
+		s.mockProviderService.EXPECT().IsGrantObservable(*dummyProvider, mock.MatchedBy(func(g *domain.Grant) bool {
+			return g.ID == editorGrant.ID
+		})).Return(true)
+		s.mockProviderService.EXPECT().
+			ListActivities(mock.Anything, *dummyProvider, mock.MatchedBy(func(f domain.ListActivitiesFilter) bool {
+				return s.Equal([]string{"user2@example.com"}, f.AccountIDs)
+			})).
+			Return([]*domain.Activity{}, nil).Once()
+		s.mockProviderService.EXPECT().
+			CorrelateGrantActivities(mock.Anything, *dummyProvider, mock.AnythingOfType("[]*domain.Grant"), mock.Anything).
+			Return(nil).Once()
+		s.mockRepository.EXPECT().
+			BulkUpsert(mock.Anything, mock.MatchedBy(func(grants []*domain.Grant) bool {
+				return len(grants) == 1 && grants[0].ID == editorGrant.ID
+			})).
+			Return(nil).Once()
+		s.mockNotifier.EXPECT().Notify(mock.Anything).Return(nil).Once()
+
+		err := s.service.DormancyCheck(context.Background(), domain.DormancyCheckCriteria{
+			ProviderID:     dummyProvider.ID,
+			Period:         30 * 24 * time.Hour,
+			RetainDuration: 7 * 24 * time.Hour,
+		})
+
+		s.NoError(err)
+		s.mockRepository.AssertExpectations(s.T())
+	})
+}
+
+func (s *ServiceTestSuite) TestDriftCheck() {
+	dummyProvider := &domain.Provider{
+		ID:   "test-provider-id",
+		Type: "test-provider-type",
+		URN:  "test-provider-urn",
+		Config: &domain.ProviderConfig{
+			Type: "test-provider-type",
+			URN:  "test-provider-urn",
+			Resources: []*domain.ResourceConfig{
+				{
+					Type: "test-resource-type",
+					Roles: []*domain.Role{
+						{
+							ID:          "test-role-id",
+							Permissions: []interface{}{"test-permission"},
+						},
+					},
+				},
+			},
+		},
+	}
+	dummyResource := &domain.Resource{
+		ID:           "test-resource-id",
+		URN:          "test-resource-urn",
+		Type:         "test-resource-type",
+		ProviderType: "test-provider-type",
+		ProviderURN:  "test-provider-urn",
+	}
+	providerAccess := domain.MapResourceAccess{
+		"test-resource-urn": []domain.AccessEntry{
+			{
+				AccountID:   "existing@example.com",
+				AccountType: "user",
+				Permission:  "test-permission",
+			},
+			{
+				AccountID:   "out-of-band@example.com",
+				AccountType: "user",
+				Permission:  "test-permission",
+			},
+		},
+	}
+	expectedOutOfBandGrant := &domain.Grant{
+		Status:           domain.GrantStatusActive,
+		StatusInProvider: domain.GrantStatusActive,
+		ResourceID:       "test-resource-id",
+		AccountID:        "out-of-band@example.com",
+		AccountType:      "user",
+		Role:             "test-role-id",
+		Permissions:      []string{"test-permission"},
+		Owner:            "out-of-band@example.com",
+		Source:           domain.GrantSourceImport,
+		IsPermanent:      true,
+	}
+
+	setupMocks := func() {
+		s.mockProviderService.EXPECT().
+			GetByID(mock.AnythingOfType("context.backgroundCtx"), dummyProvider.ID).
+			Return(dummyProvider, nil).Once()
+		s.mockResourceService.EXPECT().
+			Find(mock.AnythingOfType("context.backgroundCtx"), domain.ListResourcesFilter{
+				ProviderType: "test-provider-type",
+				ProviderURN:  "test-provider-urn",
+			}).
+			Return([]*domain.Resource{dummyResource}, nil).Once()
+		s.mockProviderService.EXPECT().
+			ListAccess(mock.AnythingOfType("context.backgroundCtx"), *dummyProvider, []*domain.Resource{dummyResource}).
+			Return(providerAccess, nil).Once()
+		s.mockRepository.EXPECT().
+			List(mock.AnythingOfType("context.backgroundCtx"), domain.ListGrantsFilter{
+				Statuses:      []string{string(domain.GrantStatusActive)},
+				ProviderTypes: []string{"test-provider-type"},
+				ProviderURNs:  []string{"test-provider-urn"},
+			}).
+			Return([]domain.Grant{
+				{
+					ID:          "existing-grant",
+					AccountID:   "existing@example.com",
+					AccountType: "user",
+					Role:        "test-role-id",
+					Permissions: []string{"test-permission"},
+					Resource:    dummyResource,
+				},
+				{
+					ID:          "missing-grant",
+					AccountID:   "missing@example.com",
+					AccountType: "user",
+					Role:        "test-role-id",
+					Permissions: []string{"test-permission"},
+					Resource:    dummyResource,
+				},
+			}, nil).Once()
+		s.mockAuditLogger.EXPECT().
+			Log(mock.AnythingOfType("context.backgroundCtx"), grant.AuditKeyDriftCheck, mock.Anything).
+			Return(nil).Once()
+	}
+
+	s.Run("should return error if criteria is invalid", func() {
+		s.setup()
+
+		drift, err := s.service.DriftCheck(context.Background(), domain.GrantDriftCheckCriteria{
+			ProviderID: dummyProvider.ID,
+			Mode:       "invalid",
+		})
+
+		s.ErrorContains(err, "invalid drift check criteria")
+		s.Nil(drift)
+	})
+
+	s.Run("should only report out-of-band and missing grants in report mode", func() {
+		s.setup()
+		setupMocks()
+
+		drift, err := s.service.DriftCheck(context.Background(), domain.GrantDriftCheckCriteria{
+			ProviderID: dummyProvider.ID,
+			Mode:       domain.GrantDriftModeReport,
+		})
+
+		s.NoError(err)
+		s.Empty(cmp.Diff([]*domain.Grant{expectedOutOfBandGrant}, drift.OutOfBandGrants))
+		s.Len(drift.MissingGrants, 1)
+		s.Equal("missing-grant", drift.MissingGrants[0].ID)
+		s.Empty(drift.RemediatedGrants)
+		s.mockRepository.AssertNotCalled(s.T(), "BulkUpsert", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should import out-of-band grants in import mode", func() {
+		s.setup()
+		setupMocks()
+		s.mockRepository.EXPECT().
+			BulkUpsert(mock.AnythingOfType("context.backgroundCtx"), []*domain.Grant{expectedOutOfBandGrant}).
+			Return(nil).Once()
+
+		drift, err := s.service.DriftCheck(context.Background(), domain.GrantDriftCheckCriteria{
+			ProviderID: dummyProvider.ID,
+			Mode:       domain.GrantDriftModeImport,
+		})
+
+		s.NoError(err)
+		s.Empty(cmp.Diff([]*domain.Grant{expectedOutOfBandGrant}, drift.RemediatedGrants))
+	})
+
+	s.Run("should revoke out-of-band access from the provider in revoke mode", func() {
+		s.setup()
+		setupMocks()
+		expectedRevokedGrant := *expectedOutOfBandGrant
+		expectedRevokedGrant.Resource = dummyResource
+		s.mockProviderService.EXPECT().
+			RevokeAccess(mock.AnythingOfType("context.backgroundCtx"), expectedRevokedGrant).
+			Return(nil).Once()
+
+		drift, err := s.service.DriftCheck(context.Background(), domain.GrantDriftCheckCriteria{
+			ProviderID: dummyProvider.ID,
+			Mode:       domain.GrantDriftModeRevoke,
+		})
+
+		s.NoError(err)
+		s.Empty(cmp.Diff([]*domain.Grant{expectedOutOfBandGrant}, drift.RemediatedGrants))
+	})
+
+	s.Run("should re-apply missing grants in reapply mode", func() {
+		s.setup()
+		setupMocks()
+		s.mockProviderService.EXPECT().
+			GrantAccess(mock.AnythingOfType("context.backgroundCtx"), mock.AnythingOfType("domain.Grant")).
+			Run(func(_a0 context.Context, g domain.Grant) {
+				s.Equal("missing-grant", g.ID)
+			}).
+			Return(nil).Once()
+
+		drift, err := s.service.DriftCheck(context.Background(), domain.GrantDriftCheckCriteria{
+			ProviderID: dummyProvider.ID,
+			Mode:       domain.GrantDriftModeReapply,
+		})
+
+		s.NoError(err)
+		s.Len(drift.RemediatedGrants, 1)
+		s.Equal("missing-grant", drift.RemediatedGrants[0].ID)
+	})
+}
+
+func (s *ServiceTestSuite) TestListRecommendations() {
+	dummyProvider := &domain.Provider{
+		ID:   "test-provider-id",
+		Type: "test-provider-type",
+		URN:  "test-provider-urn",
+		Config: &domain.ProviderConfig{
+			Resources: []*domain.ResourceConfig{
+				{
+					Type: "dataset",
+					Roles: []*domain.Role{
+						{ID: "viewer", Permissions: []interface{}{"read"}},
+						{ID: "publisher", Permissions: []interface{}{"publish"}},
+						{ID: "editor", Permissions: []interface{}{"read", "write"}},
+						{ID: "owner", Permissions: []interface{}{"read", "write", "manage"}},
+					},
+				},
+			},
+		},
+	}
+	dummyResource := &domain.Resource{ID: "test-resource-id", Type: "dataset"}
+
+	s.Run("should return error if the filter is invalid", func() {
+		s.setup()
+
+		recommendations, err := s.service.ListRecommendations(context.Background(), domain.ListGrantRecommendationsFilter{
+			ProviderID: dummyProvider.ID,
+		})
+
+		s.ErrorContains(err, "period is required")
+		s.Nil(recommendations)
+	})
+
+	s.Run("should return error if getting provider returns an error", func() {
+		s.setup()
+
+		expectedError := errors.New("unexpected error")
+		s.mockProviderService.EXPECT().
+			GetByID(mock.AnythingOfType("context.backgroundCtx"), dummyProvider.ID).
+			Return(nil, expectedError).Once()
+
+		recommendations, err := s.service.ListRecommendations(context.Background(), domain.ListGrantRecommendationsFilter{
+			ProviderID: dummyProvider.ID,
+			Period:     30 * 24 * time.Hour,
+		})
+
+		s.ErrorIs(err, expectedError)
+		s.Nil(recommendations)
+	})
+
+	s.Run("should recommend a narrower role or a revocation based on the activities", func() {
+		s.setup()
+
+		timeNow := time.Now()
+		period := 30 * 24 * time.Hour
+		createdAt := timeNow.Add(-2 * period)
+		newGrant := func(id, accountID, role string, permissions []string, createdAt time.Time) domain.Grant {
+			return domain.Grant{
+				ID:          id,
+				AccountID:   accountID,
+				AccountType: "user",
+				ResourceID:  dummyResource.ID,
+				Resource:    dummyResource,
+				Role:        role,
+				Permissions: permissions,
+				CreatedAt:   createdAt,
+			}
+		}
+		editorPermissions := []string{"read", "write"}
+		ownerPermissions := []string{"manage", "read", "write"}
+		dummyGrants := []domain.Grant{
+			newGrant("g1", "user1@example.com", "editor", editorPermissions, createdAt),
+			newGrant("g2", "user2@example.com", "editor", editorPermissions, createdAt),
+			newGrant("g3", "user3@example.com", "owner", ownerPermissions, createdAt),
+			newGrant("g4", "user4@example.com", "owner", ownerPermissions, createdAt),
+			newGrant("g5", "user4@example.com", "viewer", []string{"read"}, timeNow),
+			newGrant("g6", "user5@example.com", "owner", ownerPermissions, createdAt),
+		}
+		readActivity := func(accountID string) *domain.Activity {
+			return &domain.Activity{AccountID: accountID, RelatedPermissions: []string{"manage", "read", "write"}}
+		}
+		dummyActivities := []*domain.Activity{
+			readActivity("user1@example.com"),
+			// also allowed by the publisher role, which isn't narrower as its permissions aren't a subset of the grant's
+			{AccountID: "user2@example.com", RelatedPermissions: []string{"manage", "publish", "write"}},
+			readActivity("user4@example.com"),
+		}
+
+		s.mockProviderService.EXPECT().
+			GetByID(mock.AnythingOfType("context.backgroundCtx"), dummyProvider.ID).
+			Return(dummyProvider, nil).Once()
+		expectedListGrantsFilter := domain.ListGrantsFilter{
+			Statuses:      []string{string(domain.GrantStatusActive)},
+			ProviderTypes: []string{dummyProvider.Type},
+			ProviderURNs:  []string{dummyProvider.URN},
+		}
+		s.mockRepository.EXPECT().
+			List(mock.AnythingOfType("context.backgroundCtx"), expectedListGrantsFilter).
+			Return(dummyGrants, nil).Once()
+		s.mockProviderService.EXPECT().IsGrantObservable(*dummyProvider, mock.MatchedBy(func(g *domain.Grant) bool {
+			return g.ID != "g6"
+		})).Return(true)
+		s.mockProviderService.EXPECT().IsGrantObservable(*dummyProvider, mock.MatchedBy(func(g *domain.Grant) bool {
+			return g.ID == "g6"
+		})).Return(false)
+		timestampGte := timeNow.Add(-period)
+		expectedListActivitiesFilter := domain.ListActivitiesFilter{
+			AccountIDs:   []string{"user1@example.com", "user2@example.com", "user3@example.com", "user4@example.com"},
+			TimestampGte: &timestampGte,
+		}
+		s.mockProviderService.EXPECT().
+			ListActivities(mock.AnythingOfType("context.backgroundCtx"), *dummyProvider, mock.AnythingOfType("domain.ListActivitiesFilter")).
+			Run(func(_a0 context.Context, _a1 domain.Provider, f domain.ListActivitiesFilter) {
+				s.Empty(cmp.Diff(expectedListActivitiesFilter, f,
+					cmpopts.EquateApproxTime(time.Second),
+					cmpopts.IgnoreUnexported(domain.ListActivitiesFilter{}),
+				))
+			}).
+			Return(dummyActivities, nil).Once()
+		s.mockProviderService.EXPECT().
+			CorrelateGrantActivities(mock.AnythingOfType("context.backgroundCtx"), *dummyProvider, mock.AnythingOfType("[]*domain.Grant"), dummyActivities).
+			Run(func(_a0 context.Context, _a1 domain.Provider, grants []*domain.Grant, activities []*domain.Activity) {
+				s.Len(grants, 4)
+				for _, g := range grants {
+					for _, a := range activities {
+						if a.AccountID == g.AccountID {
+							g.Activities = append(g.Activities, a)
+						}
+					}
+				}
+			}).
+			Return(nil).Once()
+
+		recommendations, err := s.service.ListRecommendations(context.Background(), domain.ListGrantRecommendationsFilter{
+			ProviderID: dummyProvider.ID,
+			Period:     period,
+		})
+
+		s.NoError(err)
+		s.Len(recommendations, 3)
+
+		s.Equal("g1", recommendations[0].Grant.ID)
+		s.Equal(domain.GrantRecommendationTypeDowngrade, recommendations[0].Type)
+		s.Equal("viewer", recommendations[0].RecommendedRole)
+		s.Equal([]string{"read"}, recommendations[0].RecommendedPermissions)
+		s.Equal([]string{"read", "write"}, recommendations[0].UsedPermissions)
+		s.Equal(1, recommendations[0].ActivitiesCount)
+
+		s.Equal("g3", recommendations[1].Grant.ID)
+		s.Equal(domain.GrantRecommendationTypeRevoke, recommendations[1].Type)
+		s.Empty(recommendations[1].RecommendedRole)
+
+		s.Equal("g4", recommendations[2].Grant.ID)
+		s.Equal(domain.GrantRecommendationTypeRevoke, recommendations[2].Type)
+		s.Empty(recommendations[2].RecommendedRole)
+		s.Contains(recommendations[2].Reason, "g5")
+	})
 }
 
 func (s *ServiceTestSuite) TestGetGrantsTotalCount() {
@@ -11,4 +11,9 @@ var (
 	ErrStepNameContainsWhitespaces = errors.New("step name should not contain whitespaces")
 	ErrInvalidApprovers            = errors.New("invalid approvers")
 	ErrStepDependencyDoesNotExists = errors.New("step dependency does not exists")
+	ErrInvalidMinApprovals         = errors.New("invalid min_approvals")
+	ErrInvalidStepTimeout          = errors.New("invalid step timeout")
+	ErrInvalidParallelGroup        = errors.New("invalid parallel group")
+	ErrInvalidExpression           = errors.New("invalid expression")
+	ErrApprovalWebhookNotFound     = errors.New("approval webhook is not configured")
 )
@@ -2,6 +2,7 @@ package policy
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -49,6 +50,8 @@ type Service struct {
 	resourceService resourceService
 	providerService providerService
 	iam             domain.IAMManager
+	// approvalWebhooks are the names of the approval webhooks configured in the server
+	approvalWebhooks []string
 
 	validator   *validator.Validate
 	logger      log.Logger
@@ -60,6 +63,8 @@ type ServiceDeps struct {
 	ResourceService resourceService
 	ProviderService providerService
 	IAMManager      domain.IAMManager
+	// ApprovalWebhooks are the names of the approval webhooks the external steps can refer to
+	ApprovalWebhooks []string
 
 	Validator   *validator.Validate
 	Logger      log.Logger
@@ -73,6 +78,7 @@ func NewService(deps ServiceDeps) *Service {
 		deps.ResourceService,
 		deps.ProviderService,
 		deps.IAMManager,
+		deps.ApprovalWebhooks,
 
 		deps.Validator,
 		deps.Logger,
@@ -235,7 +241,7 @@ func (s *Service) validatePolicy(ctx context.Context, p *domain.Policy, excluded
 		return err
 	}
 
-	if err := s.validateSteps(p.Steps); err != nil {
+	if err := s.validateSteps(p.Steps, p.GetExpressionLanguage()); err != nil {
 		return err
 	}
 
@@ -243,7 +249,7 @@ func (s *Service) validatePolicy(ctx context.Context, p *domain.Policy, excluded
 		return err
 	}
 
-	if err := s.validateRequirements(ctx, p.Requirements); err != nil {
+	if err := s.validateRequirements(ctx, p.Requirements, p.GetExpressionLanguage()); err != nil {
 		return fmt.Errorf("invalid requirements: %w", err)
 	}
 
@@ -267,8 +273,13 @@ func (s *Service) validatePolicy(ctx context.Context, p *domain.Policy, excluded
 	return nil
 }
 
-func (s *Service) validateRequirements(ctx context.Context, requirements []*domain.Requirement) error {
+func (s *Service) validateRequirements(ctx context.Context, requirements []*domain.Requirement, lang evaluator.Language) error {
 	for i, r := range requirements {
+		if r.On != nil && r.On.Expression != "" {
+			if err := evaluator.New(r.On.Expression, lang).Compile(); err != nil {
+				return fmt.Errorf("requirement[%v].on.expression: %w: %s", i, ErrInvalidExpression, err)
+			}
+		}
 		for j, aa := range r.Appeals {
 			resource, err := s.resourceService.Get(ctx, aa.Resource)
 			if err != nil {
@@ -294,33 +305,119 @@ func (s *Service) validateRequirements(ctx context.Context, requirements []*doma
 	return nil
 }
 
-func (s *Service) validateSteps(steps []*domain.Step) error {
-	for _, step := range steps {
+func (s *Service) validateSteps(steps []*domain.Step, lang evaluator.Language) error {
+	closedParallelGroups := map[string]bool{}
+	for i, step := range steps {
 		if containsWhitespaces(step.Name) {
 			return fmt.Errorf(`%w: "%s"`, ErrStepNameContainsWhitespaces, step.Name)
 		}
 
 		if step.Approvers != nil {
 			for _, approver := range step.Approvers {
-				if err := s.validateApprover(approver); err != nil {
+				if err := s.validateApprover(approver, lang); err != nil {
 					return fmt.Errorf(`validating approver "%s": %w`, approver, err)
 				}
 			}
 		}
+
+		if step.When != "" {
+			if err := evaluator.New(step.When, lang).Compile(); err != nil {
+				return fmt.Errorf(`%w: step "%s": when: %s`, ErrInvalidExpression, step.Name, err)
+			}
+		}
+		if step.ApproveIf != "" {
+			if err := evaluator.New(step.ApproveIf, lang).Compile(); err != nil {
+				return fmt.Errorf(`%w: step "%s": approve_if: %s`, ErrInvalidExpression, step.Name, err)
+			}
+		}
+
+		if step.MinApprovals > 1 {
+			if step.Strategy != domain.ApprovalStepStrategyManual {
+				return fmt.Errorf(`%w: step "%s" is not a manual step`, ErrInvalidMinApprovals, step.Name)
+			}
+			if s.hasStaticApprovers(step) && len(step.Approvers) < step.MinApprovals {
+				return fmt.Errorf(`%w: step "%s" requires %d approvals but only has %d approvers`, ErrInvalidMinApprovals, step.Name, step.MinApprovals, len(step.Approvers))
+			}
+		}
+
+		if step.Strategy == domain.ApprovalStepStrategyExternal && !utils.ContainsString(s.approvalWebhooks, step.Webhook) {
+			return fmt.Errorf(`%w: step "%s": "%s"`, ErrApprovalWebhookNotFound, step.Name, step.Webhook)
+		}
+
+		if err := s.validateStepTimeout(step, lang); err != nil {
+			return fmt.Errorf(`%w: step "%s": %s`, ErrInvalidStepTimeout, step.Name, err)
+		}
+
+		if step.ParallelGroup != "" {
+			if closedParallelGroups[step.ParallelGroup] {
+				return fmt.Errorf(`%w: step "%s": steps of parallel group "%s" should be consecutive`, ErrInvalidParallelGroup, step.Name, step.ParallelGroup)
+			}
+			if i == len(steps)-1 || steps[i+1].ParallelGroup != step.ParallelGroup {
+				closedParallelGroups[step.ParallelGroup] = true
+			}
+		}
 	}
 
 	return nil
 }
 
-func (s *Service) validateApprover(expr string) error {
-	if err := s.validator.Var(expr, "email"); err == nil {
+func (s *Service) validateStepTimeout(step *domain.Step, lang evaluator.Language) error {
+	if step.Timeout == "" {
+		if step.EscalateTo != nil || step.OnTimeout != "" {
+			return errors.New("timeout is required when escalate_to or on_timeout is set")
+		}
+		return nil
+	}
+
+	if step.Strategy != domain.ApprovalStepStrategyManual {
+		return errors.New("timeout is only applicable to manual steps")
+	}
+	if timeout, err := time.ParseDuration(step.Timeout); err != nil {
+		return fmt.Errorf("parsing timeout: %s", err)
+	} else if timeout <= 0 {
+		return errors.New("timeout should be a positive duration")
+	}
+	if step.EscalateTo == nil && step.OnTimeout == "" {
+		return errors.New("either escalate_to or on_timeout is required when timeout is set")
+	}
+	for _, approver := range step.EscalateTo {
+		if err := s.validateApprover(approver, lang); err != nil {
+			return fmt.Errorf(`validating escalation approver "%s": %s`, approver, err)
+		}
+	}
+
+	return nil
+}
+
+// hasStaticApprovers returns true if all approvers of the step are plain email addresses, meaning the number of approvers is known upfront
+func (s *Service) hasStaticApprovers(step *domain.Step) bool {
+	for _, approver := range step.Approvers {
+		if err := s.validator.Var(approver, "email"); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Service) validateApprover(approver string, lang evaluator.Language) error {
+	if err := s.validator.Var(approver, "email"); err == nil {
+		return nil
+	}
+
+	e := evaluator.New(approver, lang)
+	expr, ok := e.(evaluator.Expression)
+	if !ok {
+		// rego expressions are only compiled, their value type is checked once evaluated
+		if err := e.Compile(); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidExpression, err)
+		}
 		return nil
 	}
 
 	// skip validation if expression is accessing arbitrary value
-	if strings.Contains(expr, "$appeal.resource") ||
-		strings.Contains(expr, "$appeal.creator") ||
-		strings.Contains(expr, "$appeal.resource.created_by") {
+	if strings.Contains(expr.String(), "$appeal.resource") ||
+		strings.Contains(expr.String(), "$appeal.creator") ||
+		strings.Contains(expr.String(), "$appeal.resource.created_by") {
 		return nil
 	}
 
@@ -331,7 +428,7 @@ func (s *Service) validateApprover(expr string) error {
 	if err != nil {
 		return fmt.Errorf("parsing appeal to map: %w", err)
 	}
-	approvers, err := evaluator.Expression(expr).EvaluateWithVars(map[string]interface{}{
+	approvers, err := expr.EvaluateWithVars(map[string]interface{}{
 		"appeal": dummyAppealMap,
 	})
 	if err != nil {
@@ -367,6 +464,30 @@ func (s *Service) validateAppealConfig(cfg *domain.PolicyAppealConfig) error {
 			return fmt.Errorf("invalid appeal extension policy: %w", err)
 		}
 	}
+	if cfg != nil && cfg.PendingTTL != "" {
+		if ttl, err := cfg.GetPendingTTL(); err != nil {
+			return fmt.Errorf("invalid pending ttl: %w", err)
+		} else if ttl <= 0 {
+			return fmt.Errorf("invalid pending ttl: should be a positive duration")
+		}
+	}
+	if cfg != nil && cfg.MaxScheduleAhead != "" {
+		if d, err := cfg.GetMaxScheduleAhead(); err != nil {
+			return fmt.Errorf("invalid max schedule ahead: %w", err)
+		} else if d < 0 {
+			return fmt.Errorf("invalid max schedule ahead: should not be a negative duration")
+		}
+	}
+	if cfg != nil && cfg.AllowBreakGlass {
+		if len(cfg.BreakGlassReviewers) == 0 {
+			return fmt.Errorf("invalid break-glass config: break_glass_reviewers is required")
+		}
+		if d, err := cfg.GetBreakGlassMaxDuration(); err != nil {
+			return fmt.Errorf("invalid break-glass max duration: %w", err)
+		} else if d <= 0 {
+			return fmt.Errorf("invalid break-glass max duration: should be a positive duration")
+		}
+	}
 	return nil
 }
 
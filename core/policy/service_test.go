@@ -13,6 +13,7 @@ import (
 	"github.com/raystack/guardian/core/resource"
 	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/mocks"
+	"github.com/raystack/guardian/pkg/evaluator"
 	"github.com/raystack/guardian/plugins/identities"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -39,12 +40,13 @@ func (s *ServiceTestSuite) SetupTest() {
 	iamManager := identities.NewManager(s.mockCrypto, v)
 
 	s.service = policy.NewService(policy.ServiceDeps{
-		Repository:      s.mockPolicyRepository,
-		ResourceService: s.mockResourceService,
-		ProviderService: s.mockProviderService,
-		IAMManager:      iamManager,
-		AuditLogger:     s.mockAuditLogger,
-		Validator:       validator.New(),
+		Repository:       s.mockPolicyRepository,
+		ResourceService:  s.mockResourceService,
+		ProviderService:  s.mockProviderService,
+		IAMManager:       iamManager,
+		ApprovalWebhooks: []string{"security-check"},
+		AuditLogger:      s.mockAuditLogger,
+		Validator:        validator.New(),
 	})
 }
 
@@ -208,6 +210,206 @@ func (s *ServiceTestSuite) TestCreate() {
 				},
 				expectedError: policy.ErrStepNameContainsWhitespaces,
 			},
+			{
+				name: "step: min_approvals on non-manual step",
+				policy: &domain.Policy{
+					ID:      "test-id",
+					Version: 1,
+					Steps: []*domain.Step{
+						{
+							Name:         "step-1",
+							Strategy:     "auto",
+							ApproveIf:    "true",
+							MinApprovals: 2,
+						},
+					},
+				},
+				expectedError: policy.ErrInvalidMinApprovals,
+			},
+			{
+				name: "step: min_approvals exceeds number of approvers",
+				policy: &domain.Policy{
+					ID:      "test-id",
+					Version: 1,
+					Steps: []*domain.Step{
+						{
+							Name:         "step-1",
+							Strategy:     "manual",
+							Approvers:    []string{"approver@example.com"},
+							MinApprovals: 2,
+						},
+					},
+				},
+				expectedError: policy.ErrInvalidMinApprovals,
+			},
+			{
+				name: "step: invalid timeout",
+				policy: &domain.Policy{
+					ID:      "test-id",
+					Version: 1,
+					Steps: []*domain.Step{
+						{
+							Name:      "step-1",
+							Strategy:  "manual",
+							Approvers: []string{"approver@example.com"},
+							Timeout:   "1 day",
+							OnTimeout: domain.StepTimeoutActionReject,
+						},
+					},
+				},
+				expectedError: policy.ErrInvalidStepTimeout,
+			},
+			{
+				name: "step: timeout without escalation or resolution",
+				policy: &domain.Policy{
+					ID:      "test-id",
+					Version: 1,
+					Steps: []*domain.Step{
+						{
+							Name:      "step-1",
+							Strategy:  "manual",
+							Approvers: []string{"approver@example.com"},
+							Timeout:   "24h",
+						},
+					},
+				},
+				expectedError: policy.ErrInvalidStepTimeout,
+			},
+			{
+				name: "step: escalate_to without timeout",
+				policy: &domain.Policy{
+					ID:      "test-id",
+					Version: 1,
+					Steps: []*domain.Step{
+						{
+							Name:       "step-1",
+							Strategy:   "manual",
+							Approvers:  []string{"approver@example.com"},
+							EscalateTo: []string{"manager@example.com"},
+						},
+					},
+				},
+				expectedError: policy.ErrInvalidStepTimeout,
+			},
+			{
+				name: "step: parallel group is not consecutive",
+				policy: &domain.Policy{
+					ID:      "test-id",
+					Version: 1,
+					Steps: []*domain.Step{
+						{
+							Name:          "step-1",
+							Strategy:      "manual",
+							Approvers:     []string{"security@example.com"},
+							ParallelGroup: "review",
+						},
+						{
+							Name:      "step-2",
+							Strategy:  "manual",
+							Approvers: []string{"manager@example.com"},
+						},
+						{
+							Name:          "step-3",
+							Strategy:      "manual",
+							Approvers:     []string{"owner@example.com"},
+							ParallelGroup: "review",
+						},
+					},
+				},
+				expectedError: policy.ErrInvalidParallelGroup,
+			},
+			{
+				name: "step: unknown approval webhook",
+				policy: &domain.Policy{
+					ID:      "test-id",
+					Version: 1,
+					Steps: []*domain.Step{
+						{
+							Name:     "step-1",
+							Strategy: domain.ApprovalStepStrategyExternal,
+							Webhook:  "unknown-webhook",
+						},
+					},
+				},
+				expectedError: policy.ErrApprovalWebhookNotFound,
+			},
+			{
+				name: "step: invalid rego when expression",
+				policy: &domain.Policy{
+					ID:                 "test-id",
+					Version:            1,
+					ExpressionLanguage: evaluator.LanguageRego,
+					Steps: []*domain.Step{
+						{
+							Name:      "step-1",
+							When:      `input.appeal.resource.details.sensitive ==`,
+							Strategy:  "manual",
+							Approvers: []string{"approver@email.com"},
+						},
+					},
+				},
+				expectedError: policy.ErrInvalidExpression,
+			},
+			{
+				name: "step: invalid approve_if expression with rego prefix",
+				policy: &domain.Policy{
+					ID:      "test-id",
+					Version: 1,
+					Steps: []*domain.Step{
+						{
+							Name:      "step-1",
+							Strategy:  "auto",
+							ApproveIf: "rego:package guardian\n\nresult := {",
+						},
+					},
+				},
+				expectedError: policy.ErrInvalidExpression,
+			},
+			{
+				name: "step: invalid rego approvers expression",
+				policy: &domain.Policy{
+					ID:                 "test-id",
+					Version:            1,
+					ExpressionLanguage: evaluator.LanguageRego,
+					Steps: []*domain.Step{
+						{
+							Name:      "step-1",
+							Strategy:  "manual",
+							Approvers: []string{"[x | x := input.appeal.creator.manager"},
+						},
+					},
+				},
+				expectedError: policy.ErrInvalidExpression,
+			},
+			{
+				name: "requirement: invalid rego expression",
+				policy: &domain.Policy{
+					ID:                 "test-id",
+					Version:            1,
+					ExpressionLanguage: evaluator.LanguageRego,
+					Steps: []*domain.Step{
+						{
+							Name:      "step-1",
+							Strategy:  "manual",
+							Approvers: []string{"approver@email.com"},
+						},
+					},
+					Requirements: []*domain.Requirement{
+						{
+							On: &domain.RequirementTrigger{
+								Expression: `input.appeal.role ==`,
+							},
+							Appeals: []*domain.AdditionalAppeal{
+								{
+									Resource: &domain.ResourceIdentifier{ID: "test-resource-id"},
+									Role:     "viewer",
+								},
+							},
+						},
+					},
+				},
+				expectedError: policy.ErrInvalidExpression,
+			},
 			{
 				name: "step: invalid approvers key",
 				policy: &domain.Policy{
@@ -224,6 +426,77 @@ func (s *ServiceTestSuite) TestCreate() {
 					},
 				},
 			},
+			{
+				name: "appeal config: invalid PendingTTL",
+				policy: &domain.Policy{
+					ID:      "test-id",
+					Version: 1,
+					Steps: []*domain.Step{
+						{
+							Name:      "step-1",
+							Strategy:  "manual",
+							Approvers: []string{"approver@email.com"},
+						},
+					},
+					AppealConfig: &domain.PolicyAppealConfig{
+						PendingTTL: "a week",
+					},
+				},
+			},
+			{
+				name: "appeal config: invalid MaxScheduleAhead",
+				policy: &domain.Policy{
+					ID:      "test-id",
+					Version: 1,
+					Steps: []*domain.Step{
+						{
+							Name:      "step-1",
+							Strategy:  "manual",
+							Approvers: []string{"approver@email.com"},
+						},
+					},
+					AppealConfig: &domain.PolicyAppealConfig{
+						MaxScheduleAhead: "next month",
+					},
+				},
+			},
+			{
+				name: "appeal config: break-glass without reviewers",
+				policy: &domain.Policy{
+					ID:      "test-id",
+					Version: 1,
+					Steps: []*domain.Step{
+						{
+							Name:      "step-1",
+							Strategy:  "manual",
+							Approvers: []string{"approver@email.com"},
+						},
+					},
+					AppealConfig: &domain.PolicyAppealConfig{
+						AllowBreakGlass:       true,
+						BreakGlassMaxDuration: "2h",
+					},
+				},
+			},
+			{
+				name: "appeal config: invalid BreakGlassMaxDuration",
+				policy: &domain.Policy{
+					ID:      "test-id",
+					Version: 1,
+					Steps: []*domain.Step{
+						{
+							Name:      "step-1",
+							Strategy:  "manual",
+							Approvers: []string{"approver@email.com"},
+						},
+					},
+					AppealConfig: &domain.PolicyAppealConfig{
+						AllowBreakGlass:       true,
+						BreakGlassMaxDuration: "a while",
+						BreakGlassReviewers:   []string{"security@email.com"},
+					},
+				},
+			},
 			{
 				name: "step: invalid AllowActiveAccessExtensionIn",
 				policy: &domain.Policy{
@@ -393,6 +666,36 @@ func (s *ServiceTestSuite) TestCreate() {
 			s.mockPolicyRepository.AssertNotCalled(s.T(), "Create")
 			s.mockAuditLogger.AssertNotCalled(s.T(), "Log")
 		})
+
+		s.Run("with valid rego policy should not return error", func() {
+			ctx := policy.WithDryRun(context.Background())
+			p := &domain.Policy{
+				ID:                 "test-rego",
+				Version:            1,
+				ExpressionLanguage: evaluator.LanguageRego,
+				Steps: []*domain.Step{
+					{
+						Name:      "step-1",
+						When:      `input.appeal.resource.details.sensitive == true`,
+						Strategy:  "auto",
+						ApproveIf: `input.appeal.creator.role == "admin"`,
+					},
+					{
+						Name:     "step-2",
+						Strategy: "manual",
+						Approvers: []string{
+							"input.appeal.creator.manager",
+							"expr:$appeal.resource.details.owner",
+							"approver@email.com",
+						},
+					},
+				},
+			}
+
+			actualError := s.service.Create(ctx, p)
+
+			s.Nil(actualError)
+		})
 	})
 }
 
@@ -0,0 +1,146 @@
+package policy
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/raystack/guardian/domain"
+)
+
+// TestSuite is a set of appeal fixtures evaluated against a policy, so that policies kept in version control can be
+// unit tested before they are applied
+type TestSuite struct {
+	// PolicyFile is the path to the policy under test, relative to the test suite file. Ignored if Policy is set.
+	PolicyFile string         `json:"policy_file,omitempty" yaml:"policy_file,omitempty"`
+	Policy     *domain.Policy `json:"policy,omitempty" yaml:"policy,omitempty"`
+	Tests      []*TestCase    `json:"tests" yaml:"tests"`
+}
+
+type TestCase struct {
+	Name string `json:"name" yaml:"name"`
+	// Appeal is the fixture to evaluate. Its resource and creator are used as is, nothing is fetched.
+	Appeal *domain.Appeal  `json:"appeal" yaml:"appeal"`
+	Expect TestExpectation `json:"expect" yaml:"expect"`
+}
+
+// TestExpectation is the expected outcome of a test case. Empty fields are not checked.
+type TestExpectation struct {
+	Status string `json:"status,omitempty" yaml:"status,omitempty"`
+	// Steps holds the expected approvals keyed by step name
+	Steps map[string]*StepExpectation `json:"steps,omitempty" yaml:"steps,omitempty"`
+	// Requirements are the indexes of the policy requirements expected to be triggered. Use an empty list to
+	// expect no requirement to be triggered.
+	Requirements []int `json:"requirements,omitempty" yaml:"requirements,omitempty"`
+}
+
+type StepExpectation struct {
+	Status    string   `json:"status,omitempty" yaml:"status,omitempty"`
+	Approvers []string `json:"approvers,omitempty" yaml:"approvers,omitempty"`
+}
+
+type TestResult struct {
+	Name string
+	// Failures lists the differences between the expected and the actual outcome
+	Failures []string
+	// Error is set if the policy can't be evaluated against the appeal
+	Error error
+	// Simulation is the actual outcome of the test case
+	Simulation *domain.AppealSimulation
+}
+
+func (r *TestResult) Passed() bool {
+	return r.Error == nil && len(r.Failures) == 0
+}
+
+// Run evaluates every test case against the policy of the suite
+func (s *TestSuite) Run() []*TestResult {
+	var results []*TestResult
+	for i, tc := range s.Tests {
+		result := &TestResult{Name: tc.Name}
+		if result.Name == "" {
+			result.Name = fmt.Sprintf("tests[%d]", i)
+		}
+		results = append(results, result)
+
+		if s.Policy == nil {
+			result.Error = fmt.Errorf("policy is empty")
+			continue
+		}
+		if tc.Appeal == nil {
+			result.Error = fmt.Errorf("appeal is empty")
+			continue
+		}
+
+		a := *tc.Appeal
+		a.SetDefaults()
+		if a.CreatedBy == "" {
+			a.CreatedBy = a.AccountID
+		}
+		simulation, err := s.Policy.Simulate(&a)
+		if err != nil {
+			result.Error = err
+			continue
+		}
+
+		result.Simulation = simulation
+		result.Failures = tc.Expect.compare(s.Policy, simulation)
+	}
+	return results
+}
+
+func (e TestExpectation) compare(p *domain.Policy, simulation *domain.AppealSimulation) []string {
+	var failures []string
+	if e.Status != "" && e.Status != simulation.Appeal.Status {
+		failures = append(failures, fmt.Sprintf("status: expected %q, got %q", e.Status, simulation.Appeal.Status))
+	}
+
+	stepNames := make([]string, 0, len(e.Steps))
+	for name := range e.Steps {
+		stepNames = append(stepNames, name)
+	}
+	sort.Strings(stepNames)
+	for _, name := range stepNames {
+		expected := e.Steps[name]
+		approval := simulation.Appeal.GetApproval(name)
+		if approval == nil {
+			failures = append(failures, fmt.Sprintf("steps.%s: step not found in the policy", name))
+			continue
+		}
+		if expected == nil {
+			continue
+		}
+		if expected.Status != "" && expected.Status != approval.Status {
+			failures = append(failures, fmt.Sprintf("steps.%s.status: expected %q, got %q", name, expected.Status, approval.Status))
+		}
+		if expected.Approvers != nil && !equalStringSet(expected.Approvers, approval.Approvers) {
+			failures = append(failures, fmt.Sprintf("steps.%s.approvers: expected %q, got %q", name, expected.Approvers, approval.Approvers))
+		}
+	}
+
+	if e.Requirements != nil {
+		actual := []int{}
+		for i, r := range p.Requirements {
+			for _, matched := range simulation.MatchedRequirements {
+				if r == matched {
+					actual = append(actual, i)
+				}
+			}
+		}
+		expected := append([]int{}, e.Requirements...)
+		sort.Ints(expected)
+		if !reflect.DeepEqual(expected, actual) {
+			failures = append(failures, fmt.Sprintf("requirements: expected %v, got %v", e.Requirements, actual))
+		}
+	}
+
+	return failures
+}
+
+func equalStringSet(expected, actual []string) bool {
+	e := append([]string{}, expected...)
+	a := append([]string{}, actual...)
+	sort.Strings(e)
+	sort.Strings(a)
+	return reflect.DeepEqual(e, a)
+}
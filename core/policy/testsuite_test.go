@@ -0,0 +1,142 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/raystack/guardian/core/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const testSuiteYAML = `
+policy:
+  id: test-policy
+  version: 1
+  steps:
+    - name: auto_approve_admin
+      strategy: auto
+      approve_if: $appeal.creator.role == "admin"
+      allow_failed: true
+    - name: sensitive_only
+      when: $appeal.resource.details.sensitive == true
+      strategy: manual
+      approvers:
+        - security@example.com
+    - name: manager_approval
+      strategy: manual
+      approvers:
+        - $appeal.creator.manager
+  requirements:
+    - on:
+        role: ^editor$
+      appeals:
+        - resource:
+            id: other-resource-id
+          role: viewer
+tests:
+  - name: admin on non-sensitive resource
+    appeal:
+      account_id: user@example.com
+      role: viewer
+      resource:
+        urn: my-dataset
+        details:
+          sensitive: false
+      creator:
+        role: admin
+        manager: manager@example.com
+    expect:
+      status: pending
+      steps:
+        auto_approve_admin:
+          status: approved
+        sensitive_only:
+          status: skipped
+        manager_approval:
+          status: pending
+          approvers:
+            - manager@example.com
+      requirements: []
+  - name: editor triggers requirement
+    appeal:
+      account_id: user@example.com
+      role: editor
+      resource:
+        urn: my-dataset
+        details:
+          sensitive: true
+      creator:
+        role: member
+        manager: manager@example.com
+    expect:
+      steps:
+        auto_approve_admin:
+          status: approved
+        sensitive_only:
+          status: blocked
+          approvers:
+            - someone@example.com
+      requirements: [0]
+  - name: unknown step
+    appeal:
+      role: viewer
+      resource:
+        urn: my-dataset
+        details:
+          sensitive: false
+      creator:
+        role: admin
+        manager: manager@example.com
+    expect:
+      steps:
+        unknown_step:
+          status: approved
+  - name: invalid approver expression
+    appeal:
+      role: viewer
+      resource:
+        urn: my-dataset
+    expect:
+      status: pending
+`
+
+func TestTestSuiteRun(t *testing.T) {
+	var suite policy.TestSuite
+	require.NoError(t, yaml.Unmarshal([]byte(testSuiteYAML), &suite))
+
+	results := suite.Run()
+	require.Len(t, results, 4)
+
+	t.Run("should pass if outcome matches the expectation", func(t *testing.T) {
+		assert.Equal(t, "admin on non-sensitive resource", results[0].Name)
+		assert.True(t, results[0].Passed(), results[0].Failures)
+	})
+
+	t.Run("should report every mismatch", func(t *testing.T) {
+		assert.False(t, results[1].Passed())
+		assert.Equal(t, []string{
+			`steps.auto_approve_admin.status: expected "approved", got "skipped"`,
+			`steps.sensitive_only.status: expected "blocked", got "pending"`,
+			`steps.sensitive_only.approvers: expected ["someone@example.com"], got ["security@example.com"]`,
+		}, results[1].Failures)
+	})
+
+	t.Run("should report steps that are not in the policy", func(t *testing.T) {
+		assert.Equal(t, []string{"steps.unknown_step: step not found in the policy"}, results[2].Failures)
+	})
+
+	t.Run("should return error if the policy can't be evaluated", func(t *testing.T) {
+		assert.False(t, results[3].Passed())
+		assert.Error(t, results[3].Error)
+	})
+
+	t.Run("should return error if policy is empty", func(t *testing.T) {
+		s := policy.TestSuite{Tests: []*policy.TestCase{{}}}
+
+		results := s.Run()
+
+		assert.Equal(t, "tests[0]", results[0].Name)
+		assert.EqualError(t, results[0].Error, "policy is empty")
+	})
+}
@@ -37,6 +37,51 @@ func (m PermissionManager) GetPermissions(pc *domain.ProviderConfig, resourceTyp
 	return nil, ErrInvalidResourceType
 }
 
+// ActivityCorrelator correlates grants with the activities of the same account on the same resource, where the grant
+// has any of the activity's related permissions
+type ActivityCorrelator struct {
+	// ObservablePermissions are the permissions whose usage can produce an activity, e.g. when the provider only
+	// records write events, reading with a viewer permission leaves no trace. All permissions are observable if empty.
+	ObservablePermissions []string
+}
+
+func (c ActivityCorrelator) CorrelateGrantActivities(_ context.Context, _ domain.Provider, grants []*domain.Grant, activities []*domain.Activity) error {
+	for _, g := range grants {
+		if g.Resource == nil || !c.IsGrantObservable(g) {
+			continue
+		}
+		for _, a := range activities {
+			if a.Resource == nil || a.AccountID != g.AccountID || a.Resource.Type != g.Resource.Type || a.Resource.URN != g.Resource.URN {
+				continue
+			}
+			if containsAny(g.Permissions, a.RelatedPermissions) {
+				g.Activities = append(g.Activities, a)
+			}
+		}
+	}
+	return nil
+}
+
+// IsGrantObservable returns false if none of the permissions of the grant can produce an activity, in which case the
+// lack of activities doesn't tell whether the grant is used
+func (c ActivityCorrelator) IsGrantObservable(g *domain.Grant) bool {
+	if len(c.ObservablePermissions) == 0 {
+		return true
+	}
+	return containsAny(g.Permissions, c.ObservablePermissions)
+}
+
+func containsAny(s []string, values []string) bool {
+	for _, v := range values {
+		for _, item := range s {
+			if item == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 type UnimplementedClient struct{}
 
 func (c *UnimplementedClient) CreateConfig(*domain.ProviderConfig) error {
@@ -1,6 +1,7 @@
 package provider_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/raystack/guardian/core/provider"
@@ -78,3 +79,48 @@ func TestGetPermissions(t *testing.T) {
 		assert.Nil(t, actualPermissions)
 	})
 }
+
+func TestActivityCorrelator(t *testing.T) {
+	dashboard := &domain.Resource{Type: "dashboard", URN: "1"}
+	viewActivity := &domain.Activity{
+		AccountID:          "user@example.com",
+		Resource:           &domain.Resource{Type: "dashboard", URN: "1"},
+		RelatedPermissions: []string{"view", "edit", "admin"},
+	}
+	editActivity := &domain.Activity{
+		AccountID:          "user@example.com",
+		Resource:           &domain.Resource{Type: "dashboard", URN: "1"},
+		RelatedPermissions: []string{"edit", "admin"},
+	}
+	otherAccountActivity := &domain.Activity{
+		AccountID:          "other@example.com",
+		Resource:           &domain.Resource{Type: "dashboard", URN: "1"},
+		RelatedPermissions: []string{"view"},
+	}
+	otherResourceActivity := &domain.Activity{
+		AccountID:          "user@example.com",
+		Resource:           &domain.Resource{Type: "dashboard", URN: "2"},
+		RelatedPermissions: []string{"view"},
+	}
+	viewerGrant := &domain.Grant{AccountID: "user@example.com", Resource: dashboard, Permissions: []string{"view"}}
+	editorGrant := &domain.Grant{AccountID: "user@example.com", Resource: dashboard, Permissions: []string{"edit"}}
+
+	err := provider.ActivityCorrelator{}.CorrelateGrantActivities(
+		context.Background(),
+		domain.Provider{},
+		[]*domain.Grant{viewerGrant, editorGrant},
+		[]*domain.Activity{viewActivity, editActivity, otherAccountActivity, otherResourceActivity},
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*domain.Activity{viewActivity}, viewerGrant.Activities)
+	assert.Equal(t, []*domain.Activity{viewActivity, editActivity}, editorGrant.Activities)
+}
+
+func TestActivityCorrelator_IsGrantObservable(t *testing.T) {
+	correlator := provider.ActivityCorrelator{ObservablePermissions: []string{"edit", "admin"}}
+
+	assert.False(t, correlator.IsGrantObservable(&domain.Grant{Permissions: []string{"view"}}))
+	assert.True(t, correlator.IsGrantObservable(&domain.Grant{Permissions: []string{"view", "edit"}}))
+	assert.True(t, provider.ActivityCorrelator{}.IsGrantObservable(&domain.Grant{Permissions: []string{"view"}}))
+}
@@ -54,6 +54,10 @@ type dormancyChecker interface {
 	CorrelateGrantActivities(context.Context, domain.Provider, []*domain.Grant, []*domain.Activity) error
 }
 
+type activityObserver interface {
+	IsGrantObservable(*domain.Grant) bool
+}
+
 //go:generate mockery --name=resourceService --exported --with-expecter
 type resourceService interface {
 	Find(context.Context, domain.ListResourcesFilter) ([]*domain.Resource, error)
@@ -532,6 +536,17 @@ func (s *Service) CorrelateGrantActivities(ctx context.Context, p domain.Provide
 	return activityClient.CorrelateGrantActivities(ctx, p, grants, activities)
 }
 
+// IsGrantObservable returns false if the provider can't record any activity for the permissions of the grant. Grants
+// of providers not declaring their observable permissions are considered observable.
+func (s *Service) IsGrantObservable(p domain.Provider, g *domain.Grant) bool {
+	c := s.getClient(p.Type)
+	observer, ok := c.(activityObserver)
+	if !ok {
+		return true
+	}
+	return observer.IsGrantObservable(g)
+}
+
 func (s *Service) getResources(ctx context.Context, p *domain.Provider) ([]*domain.Resource, error) {
 	c := s.getClient(p.Type)
 	if c == nil {
@@ -0,0 +1,14 @@
+package review
+
+import "errors"
+
+var (
+	ErrEmptyIDParam          = errors.New("review id can't be empty")
+	ErrCampaignNotFound      = errors.New("review campaign not found")
+	ErrItemNotFound          = errors.New("review item not found")
+	ErrCampaignClosed        = errors.New("review campaign is already closed")
+	ErrNotReviewer           = errors.New("user is not a reviewer of the review item")
+	ErrNoGrantsInScope       = errors.New("no active grants found in the campaign scope")
+	ErrRevokeDecisionsFailed = errors.New("failed to revoke some grants")
+	ErrActionForbidden       = errors.New("user is not allowed to close the review campaign")
+)
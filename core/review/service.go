@@ -0,0 +1,346 @@
+package review
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/raystack/guardian/core/grant"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/utils"
+	"github.com/raystack/salt/log"
+)
+
+const (
+	AuditKeyCreateCampaign = "review_campaign.create"
+	AuditKeyCloseCampaign  = "review_campaign.close"
+	AuditKeyDecide         = "review_item.decide"
+
+	RevokeReasonReviewCampaign = "Revoked by access review campaign %q"
+)
+
+//go:generate mockery --name=repository --exported --with-expecter
+type repository interface {
+	CreateCampaign(context.Context, *domain.ReviewCampaign, []*domain.ReviewItem) error
+	UpdateCampaign(context.Context, *domain.ReviewCampaign) error
+	GetCampaignByID(ctx context.Context, id string) (*domain.ReviewCampaign, error)
+	FindCampaigns(context.Context, domain.ListReviewCampaignsFilter) ([]*domain.ReviewCampaign, error)
+	GetItemByID(ctx context.Context, id string) (*domain.ReviewItem, error)
+	FindItems(context.Context, domain.ListReviewItemsFilter) ([]*domain.ReviewItem, error)
+	UpdateItem(context.Context, *domain.ReviewItem) error
+}
+
+//go:generate mockery --name=grantService --exported --with-expecter
+type grantService interface {
+	List(context.Context, domain.ListGrantsFilter) ([]domain.Grant, error)
+	GetByID(context.Context, string) (*domain.Grant, error)
+	Revoke(ctx context.Context, id, actor, reason string, opts ...grant.Option) (*domain.Grant, error)
+}
+
+//go:generate mockery --name=appealService --exported --with-expecter
+type appealService interface {
+	GetByID(context.Context, string) (*domain.Appeal, error)
+}
+
+//go:generate mockery --name=auditLogger --exported --with-expecter
+type auditLogger interface {
+	Log(ctx context.Context, action string, data interface{}) error
+}
+
+type ServiceDeps struct {
+	Repository    repository
+	GrantService  grantService
+	AppealService appealService
+
+	// Admins are allowed to close any campaign, besides its creator
+	Admins []string
+
+	Validator   *validator.Validate
+	Logger      log.Logger
+	AuditLogger auditLogger
+}
+
+type Service struct {
+	repo          repository
+	grantService  grantService
+	appealService appealService
+
+	admins []string
+
+	validator   *validator.Validate
+	logger      log.Logger
+	auditLogger auditLogger
+
+	TimeNow func() time.Time
+}
+
+func NewService(deps ServiceDeps) *Service {
+	return &Service{
+		repo:          deps.Repository,
+		grantService:  deps.GrantService,
+		appealService: deps.AppealService,
+		admins:        deps.Admins,
+		validator:     deps.Validator,
+		logger:        deps.Logger,
+		auditLogger:   deps.AuditLogger,
+		TimeNow:       time.Now,
+	}
+}
+
+func (s *Service) GetCampaignByID(ctx context.Context, id string) (*domain.ReviewCampaign, error) {
+	if id == "" {
+		return nil, ErrEmptyIDParam
+	}
+	return s.repo.GetCampaignByID(ctx, id)
+}
+
+func (s *Service) FindCampaigns(ctx context.Context, filter domain.ListReviewCampaignsFilter) ([]*domain.ReviewCampaign, error) {
+	return s.repo.FindCampaigns(ctx, filter)
+}
+
+func (s *Service) FindItems(ctx context.Context, filter domain.ListReviewItemsFilter) ([]*domain.ReviewItem, error) {
+	return s.repo.FindItems(ctx, filter)
+}
+
+// CreateCampaign starts a campaign over the active grants in the campaign scope, assigning each grant to its
+// reviewers based on the campaign reviewer strategy
+func (s *Service) CreateCampaign(ctx context.Context, c *domain.ReviewCampaign) ([]*domain.ReviewItem, error) {
+	c.SetDefaults()
+	if err := s.validator.Struct(c); err != nil {
+		return nil, err
+	}
+
+	grants, err := s.grantService.List(ctx, c.Scope.ToListGrantsFilter())
+	if err != nil {
+		return nil, fmt.Errorf("listing grants: %w", err)
+	}
+	if len(grants) == 0 {
+		return nil, ErrNoGrantsInScope
+	}
+
+	items := make([]*domain.ReviewItem, 0, len(grants))
+	for _, g := range grants {
+		reviewers, err := s.getReviewers(ctx, c, g)
+		if err != nil {
+			return nil, fmt.Errorf("getting reviewers of grant %q: %w", g.ID, err)
+		}
+		items = append(items, domain.NewReviewItem(c.ID, g, reviewers))
+	}
+
+	if err := s.repo.CreateCampaign(ctx, c, items); err != nil {
+		return nil, fmt.Errorf("inserting review campaign into db: %w", err)
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyCreateCampaign, map[string]interface{}{
+		"campaign":    c,
+		"items_count": len(items),
+	}); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	return items, nil
+}
+
+// Decide records the keep/revoke decision of a reviewer. Revocation only takes effect when the campaign closes.
+func (s *Service) Decide(ctx context.Context, itemID, actor string, decision domain.ReviewDecision, reason string) (*domain.ReviewItem, error) {
+	if itemID == "" {
+		return nil, ErrEmptyIDParam
+	}
+	item, err := s.repo.GetItemByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("getting review item: %w", err)
+	}
+	if !item.IsReviewer(actor) {
+		return nil, ErrNotReviewer
+	}
+
+	c, err := s.repo.GetCampaignByID(ctx, item.CampaignID)
+	if err != nil {
+		return nil, fmt.Errorf("getting review campaign: %w", err)
+	}
+	if !c.IsActive() {
+		return nil, ErrCampaignClosed
+	}
+
+	if err := item.Decide(actor, decision, reason); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateItem(ctx, item); err != nil {
+		return nil, fmt.Errorf("updating review item: %w", err)
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyDecide, map[string]interface{}{
+		"campaign_id": item.CampaignID,
+		"item_id":     item.ID,
+		"grant_id":    item.GrantID,
+		"decision":    item.Decision,
+		"reason":      item.Reason,
+	}); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	return item, nil
+}
+
+// CloseCampaign applies the campaign default action to the items that haven't been reviewed and revokes the grants
+// decided to be revoked. The campaign stays active if any revocation fails so that closing it again retries the
+// remaining revocations. Only the campaign creator and the admins are allowed to close it.
+func (s *Service) CloseCampaign(ctx context.Context, id, actor string) (*domain.ReviewCampaign, error) {
+	c, err := s.GetCampaignByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting review campaign: %w", err)
+	}
+	if actor != c.CreatedBy && !utils.ContainsString(s.admins, actor) {
+		return nil, ErrActionForbidden
+	}
+	if !c.IsActive() {
+		return nil, ErrCampaignClosed
+	}
+
+	items, err := s.repo.FindItems(ctx, domain.ListReviewItemsFilter{CampaignID: c.ID})
+	if err != nil {
+		return nil, fmt.Errorf("listing review items: %w", err)
+	}
+
+	var failedGrantIDs []string
+	for _, item := range items {
+		if item.IsPending() {
+			now := s.TimeNow()
+			item.Decision = c.DefaultAction
+			item.DecidedBy = actor
+			item.DecidedAt = &now
+			item.Defaulted = true
+			if err := s.repo.UpdateItem(ctx, item); err != nil {
+				return nil, fmt.Errorf("applying default action to review item %q: %w", item.ID, err)
+			}
+		}
+
+		if item.Decision != domain.ReviewDecisionRevoke {
+			continue
+		}
+		if err := s.revokeGrant(ctx, c, item, actor); err != nil {
+			s.logger.Error("failed to revoke grant of review item", "item_id", item.ID, "grant_id", item.GrantID, "error", err)
+			failedGrantIDs = append(failedGrantIDs, item.GrantID)
+		}
+	}
+	if len(failedGrantIDs) > 0 {
+		return nil, fmt.Errorf("%w: %v", ErrRevokeDecisionsFailed, failedGrantIDs)
+	}
+
+	now := s.TimeNow()
+	c.Status = domain.ReviewCampaignStatusClosed
+	c.ClosedBy = actor
+	c.ClosedAt = &now
+	if err := s.repo.UpdateCampaign(ctx, c); err != nil {
+		return nil, fmt.Errorf("updating review campaign: %w", err)
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyCloseCampaign, map[string]interface{}{
+		"campaign_id": c.ID,
+		"summary":     domain.NewReviewCampaignSummary(nil, items),
+	}); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	return c, nil
+}
+
+// GetSummary returns the campaign along with all of its items and decision counts
+func (s *Service) GetSummary(ctx context.Context, id string) (*domain.ReviewCampaignSummary, error) {
+	c, err := s.GetCampaignByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting review campaign: %w", err)
+	}
+
+	items, err := s.repo.FindItems(ctx, domain.ListReviewItemsFilter{CampaignID: c.ID})
+	if err != nil {
+		return nil, fmt.Errorf("listing review items: %w", err)
+	}
+
+	return domain.NewReviewCampaignSummary(c, items), nil
+}
+
+func (s *Service) revokeGrant(ctx context.Context, c *domain.ReviewCampaign, item *domain.ReviewItem, actor string) error {
+	g, err := s.grantService.GetByID(ctx, item.GrantID)
+	if err != nil {
+		return fmt.Errorf("getting grant details: %w", err)
+	}
+	// the grant might have been revoked or expired since the campaign started
+	if g.Status != domain.GrantStatusActive {
+		return nil
+	}
+
+	_, err = s.grantService.Revoke(ctx, item.GrantID, actor, fmt.Sprintf(RevokeReasonReviewCampaign, c.Name))
+	return err
+}
+
+// getReviewers resolves the reviewers of a grant. The campaign creator reviews grants for which the strategy
+// resolves nobody, so that every grant in scope gets a decision.
+func (s *Service) getReviewers(ctx context.Context, c *domain.ReviewCampaign, g domain.Grant) ([]string, error) {
+	var reviewers []string
+	switch c.ReviewerStrategy {
+	case domain.ReviewerStrategyGrantOwner:
+		if g.Owner != "" {
+			reviewers = append(reviewers, g.Owner)
+		}
+	case domain.ReviewerStrategyResourceOwner:
+		if g.Resource != nil {
+			reviewers = getResourceOwners(g.Resource, c.ResourceOwnerKey)
+		}
+	case domain.ReviewerStrategyPolicyApprovers:
+		if g.AppealID != "" {
+			a, err := s.appealService.GetByID(ctx, g.AppealID)
+			if err != nil {
+				return nil, fmt.Errorf("getting appeal details: %w", err)
+			}
+			if a != nil {
+				reviewers = getAppealApprovers(a)
+			}
+		}
+	}
+
+	if len(reviewers) == 0 {
+		reviewers = []string{c.CreatedBy}
+	}
+	return reviewers, nil
+}
+
+func getResourceOwners(r *domain.Resource, key string) []string {
+	var owners []string
+	switch v := r.Details[key].(type) {
+	case string:
+		if v != "" {
+			owners = append(owners, v)
+		}
+	case []string:
+		owners = append(owners, v...)
+	case []interface{}:
+		for _, o := range v {
+			if str, ok := o.(string); ok && str != "" {
+				owners = append(owners, str)
+			}
+		}
+	}
+	return owners
+}
+
+func getAppealApprovers(a *domain.Appeal) []string {
+	approvers := map[string]bool{}
+	for _, approval := range a.Approvals {
+		if !approval.IsManualApproval() {
+			continue
+		}
+		for _, approver := range approval.Approvers {
+			approvers[approver] = true
+		}
+	}
+
+	var result []string
+	for approver := range approvers {
+		result = append(result, approver)
+	}
+	sort.Strings(result)
+	return result
+}
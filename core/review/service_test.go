@@ -0,0 +1,302 @@
+package review_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/raystack/guardian/core/review"
+	"github.com/raystack/guardian/core/review/mocks"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	mockRepository    *mocks.Repository
+	mockGrantService  *mocks.GrantService
+	mockAppealService *mocks.AppealService
+	mockAuditLogger   *mocks.AuditLogger
+	service           *review.Service
+	now               time.Time
+}
+
+func TestService(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) setup() {
+	s.mockRepository = new(mocks.Repository)
+	s.mockGrantService = new(mocks.GrantService)
+	s.mockAppealService = new(mocks.AppealService)
+	s.mockAuditLogger = new(mocks.AuditLogger)
+	s.service = review.NewService(review.ServiceDeps{
+		Repository:    s.mockRepository,
+		GrantService:  s.mockGrantService,
+		AppealService: s.mockAppealService,
+		Admins:        []string{"admin@example.com"},
+		Validator:     validator.New(),
+		Logger:        log.NewNoop(),
+		AuditLogger:   s.mockAuditLogger,
+	})
+	s.now = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.service.TimeNow = func() time.Time { return s.now }
+}
+
+func (s *ServiceTestSuite) TestCreateCampaign() {
+	grants := []domain.Grant{
+		{
+			ID:       "grant-1",
+			Owner:    "user@example.com",
+			AppealID: "appeal-1",
+			Resource: &domain.Resource{Details: map[string]interface{}{"owner": "resource.owner@example.com"}},
+		},
+		{
+			ID:       "grant-2",
+			Resource: &domain.Resource{Details: map[string]interface{}{"owner": []interface{}{"a@example.com", "b@example.com"}}},
+		},
+	}
+
+	s.Run("should return error if campaign is invalid", func() {
+		s.setup()
+
+		_, err := s.service.CreateCampaign(context.Background(), &domain.ReviewCampaign{
+			Name:             "q1",
+			ReviewerStrategy: "unknown",
+		})
+
+		s.Error(err)
+		s.mockGrantService.AssertNotCalled(s.T(), "List", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should return error if no grants are in scope", func() {
+		s.setup()
+
+		s.mockGrantService.EXPECT().List(mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+		_, err := s.service.CreateCampaign(context.Background(), &domain.ReviewCampaign{
+			Name:             "q1",
+			ReviewerStrategy: domain.ReviewerStrategyGrantOwner,
+		})
+
+		s.ErrorIs(err, review.ErrNoGrantsInScope)
+	})
+
+	testCases := []struct {
+		name              string
+		strategy          domain.ReviewerStrategy
+		setupMocks        func()
+		expectedReviewers [][]string
+	}{
+		{
+			name:              "should assign grants to the grant owner",
+			strategy:          domain.ReviewerStrategyGrantOwner,
+			expectedReviewers: [][]string{{"user@example.com"}, {"admin@example.com"}},
+		},
+		{
+			name:              "should assign grants to the resource owners",
+			strategy:          domain.ReviewerStrategyResourceOwner,
+			expectedReviewers: [][]string{{"resource.owner@example.com"}, {"a@example.com", "b@example.com"}},
+		},
+		{
+			name:     "should assign grants to the approvers of the appeal",
+			strategy: domain.ReviewerStrategyPolicyApprovers,
+			setupMocks: func() {
+				s.mockAppealService.EXPECT().GetByID(mock.Anything, "appeal-1").Return(&domain.Appeal{
+					Approvals: []*domain.Approval{
+						{Approvers: []string{"approver.2@example.com", "approver.1@example.com"}},
+						{Approvers: []string{"approver.1@example.com"}},
+					},
+				}, nil).Once()
+			},
+			expectedReviewers: [][]string{{"approver.1@example.com", "approver.2@example.com"}, {"admin@example.com"}},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup()
+
+			scope := &domain.ReviewCampaignScope{ProviderTypes: []string{"bigquery"}}
+			s.mockGrantService.EXPECT().
+				List(mock.Anything, domain.ListGrantsFilter{
+					Statuses:      []string{string(domain.GrantStatusActive)},
+					ProviderTypes: []string{"bigquery"},
+				}).
+				Return(grants, nil).Once()
+			if tc.setupMocks != nil {
+				tc.setupMocks()
+			}
+			s.mockRepository.EXPECT().
+				CreateCampaign(mock.Anything, mock.AnythingOfType("*domain.ReviewCampaign"), mock.AnythingOfType("[]*domain.ReviewItem")).
+				Return(nil).Once()
+			s.mockAuditLogger.EXPECT().Log(mock.Anything, review.AuditKeyCreateCampaign, mock.Anything).Return(nil).Once()
+
+			c := &domain.ReviewCampaign{
+				Name:             "q1",
+				Scope:            scope,
+				ReviewerStrategy: tc.strategy,
+				CreatedBy:        "admin@example.com",
+			}
+			items, err := s.service.CreateCampaign(context.Background(), c)
+
+			s.NoError(err)
+			s.Equal(domain.ReviewCampaignStatusActive, c.Status)
+			s.Equal(domain.ReviewDecisionKeep, c.DefaultAction)
+			s.Require().Len(items, len(tc.expectedReviewers))
+			for i, reviewers := range tc.expectedReviewers {
+				s.Equal(grants[i].ID, items[i].GrantID)
+				s.Equal(reviewers, items[i].Reviewers)
+			}
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestDecide() {
+	s.Run("should return error if actor is not a reviewer", func() {
+		s.setup()
+
+		s.mockRepository.EXPECT().GetItemByID(mock.Anything, "item-1").
+			Return(&domain.ReviewItem{ID: "item-1", Reviewers: []string{"reviewer@example.com"}}, nil).Once()
+
+		_, err := s.service.Decide(context.Background(), "item-1", "someone@example.com", domain.ReviewDecisionKeep, "")
+
+		s.ErrorIs(err, review.ErrNotReviewer)
+	})
+
+	s.Run("should return error if campaign is closed", func() {
+		s.setup()
+
+		s.mockRepository.EXPECT().GetItemByID(mock.Anything, "item-1").
+			Return(&domain.ReviewItem{ID: "item-1", CampaignID: "campaign-1", Reviewers: []string{"reviewer@example.com"}}, nil).Once()
+		s.mockRepository.EXPECT().GetCampaignByID(mock.Anything, "campaign-1").
+			Return(&domain.ReviewCampaign{ID: "campaign-1", Status: domain.ReviewCampaignStatusClosed}, nil).Once()
+
+		_, err := s.service.Decide(context.Background(), "item-1", "reviewer@example.com", domain.ReviewDecisionKeep, "")
+
+		s.ErrorIs(err, review.ErrCampaignClosed)
+	})
+
+	s.Run("should record decision of the reviewer", func() {
+		s.setup()
+
+		s.mockRepository.EXPECT().GetItemByID(mock.Anything, "item-1").
+			Return(&domain.ReviewItem{ID: "item-1", CampaignID: "campaign-1", Reviewers: []string{"reviewer@example.com"}}, nil).Once()
+		s.mockRepository.EXPECT().GetCampaignByID(mock.Anything, "campaign-1").
+			Return(&domain.ReviewCampaign{ID: "campaign-1", Status: domain.ReviewCampaignStatusActive}, nil).Once()
+		s.mockRepository.EXPECT().UpdateItem(mock.Anything, mock.AnythingOfType("*domain.ReviewItem")).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, review.AuditKeyDecide, mock.Anything).Return(nil).Once()
+
+		item, err := s.service.Decide(context.Background(), "item-1", "reviewer@example.com", domain.ReviewDecisionRevoke, "left the team")
+
+		s.NoError(err)
+		s.Equal(domain.ReviewDecisionRevoke, item.Decision)
+		s.Equal("reviewer@example.com", item.DecidedBy)
+		s.Equal("left the team", item.Reason)
+		s.NotNil(item.DecidedAt)
+	})
+}
+
+func (s *ServiceTestSuite) TestCloseCampaign() {
+	newItems := func() []*domain.ReviewItem {
+		return []*domain.ReviewItem{
+			{ID: "item-1", GrantID: "grant-1", Decision: domain.ReviewDecisionKeep},
+			{ID: "item-2", GrantID: "grant-2", Decision: domain.ReviewDecisionRevoke},
+			{ID: "item-3", GrantID: "grant-3"},
+			{ID: "item-4", GrantID: "grant-4", Decision: domain.ReviewDecisionRevoke},
+		}
+	}
+	campaign := func() *domain.ReviewCampaign {
+		return &domain.ReviewCampaign{
+			ID:            "campaign-1",
+			Name:          "q1",
+			Status:        domain.ReviewCampaignStatusActive,
+			DefaultAction: domain.ReviewDecisionRevoke,
+			CreatedBy:     "creator@example.com",
+		}
+	}
+
+	s.Run("should apply default action and revoke grants", func() {
+		s.setup()
+
+		items := newItems()
+		s.mockRepository.EXPECT().GetCampaignByID(mock.Anything, "campaign-1").Return(campaign(), nil).Once()
+		s.mockRepository.EXPECT().FindItems(mock.Anything, domain.ListReviewItemsFilter{CampaignID: "campaign-1"}).Return(items, nil).Once()
+		s.mockRepository.EXPECT().UpdateItem(mock.Anything, items[2]).Return(nil).Once()
+		s.mockGrantService.EXPECT().GetByID(mock.Anything, "grant-2").Return(&domain.Grant{ID: "grant-2", Status: domain.GrantStatusActive}, nil).Once()
+		s.mockGrantService.EXPECT().GetByID(mock.Anything, "grant-3").Return(&domain.Grant{ID: "grant-3", Status: domain.GrantStatusActive}, nil).Once()
+		s.mockGrantService.EXPECT().GetByID(mock.Anything, "grant-4").Return(&domain.Grant{ID: "grant-4", Status: domain.GrantStatusInactive}, nil).Once()
+		expectedReason := `Revoked by access review campaign "q1"`
+		s.mockGrantService.EXPECT().Revoke(mock.Anything, "grant-2", "admin@example.com", expectedReason).Return(&domain.Grant{}, nil).Once()
+		s.mockGrantService.EXPECT().Revoke(mock.Anything, "grant-3", "admin@example.com", expectedReason).Return(&domain.Grant{}, nil).Once()
+		s.mockRepository.EXPECT().UpdateCampaign(mock.Anything, mock.AnythingOfType("*domain.ReviewCampaign")).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, review.AuditKeyCloseCampaign, mock.Anything).Return(nil).Once()
+
+		c, err := s.service.CloseCampaign(context.Background(), "campaign-1", "admin@example.com")
+
+		s.NoError(err)
+		s.Equal(domain.ReviewCampaignStatusClosed, c.Status)
+		s.Equal("admin@example.com", c.ClosedBy)
+		s.Equal(domain.ReviewDecisionRevoke, items[2].Decision)
+		s.True(items[2].Defaulted)
+		s.mockGrantService.AssertExpectations(s.T())
+		s.mockGrantService.AssertNotCalled(s.T(), "Revoke", mock.Anything, "grant-4", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should keep campaign active if a revocation fails", func() {
+		s.setup()
+
+		items := newItems()[:2]
+		s.mockRepository.EXPECT().GetCampaignByID(mock.Anything, "campaign-1").Return(campaign(), nil).Once()
+		s.mockRepository.EXPECT().FindItems(mock.Anything, mock.Anything).Return(items, nil).Once()
+		s.mockGrantService.EXPECT().GetByID(mock.Anything, "grant-2").Return(&domain.Grant{ID: "grant-2", Status: domain.GrantStatusActive}, nil).Once()
+		s.mockGrantService.EXPECT().Revoke(mock.Anything, "grant-2", mock.Anything, mock.Anything).Return(nil, errors.New("provider error")).Once()
+
+		_, err := s.service.CloseCampaign(context.Background(), "campaign-1", "admin@example.com")
+
+		s.ErrorIs(err, review.ErrRevokeDecisionsFailed)
+		s.mockRepository.AssertNotCalled(s.T(), "UpdateCampaign", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should return error if campaign is already closed", func() {
+		s.setup()
+
+		c := campaign()
+		c.Status = domain.ReviewCampaignStatusClosed
+		s.mockRepository.EXPECT().GetCampaignByID(mock.Anything, "campaign-1").Return(c, nil).Once()
+
+		_, err := s.service.CloseCampaign(context.Background(), "campaign-1", "admin@example.com")
+
+		s.ErrorIs(err, review.ErrCampaignClosed)
+	})
+
+	s.Run("should allow the campaign creator to close the campaign", func() {
+		s.setup()
+
+		s.mockRepository.EXPECT().GetCampaignByID(mock.Anything, "campaign-1").Return(campaign(), nil).Once()
+		s.mockRepository.EXPECT().FindItems(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockRepository.EXPECT().UpdateCampaign(mock.Anything, mock.AnythingOfType("*domain.ReviewCampaign")).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, review.AuditKeyCloseCampaign, mock.Anything).Return(nil).Once()
+
+		c, err := s.service.CloseCampaign(context.Background(), "campaign-1", "creator@example.com")
+
+		s.NoError(err)
+		s.Equal(domain.ReviewCampaignStatusClosed, c.Status)
+		s.Equal("creator@example.com", c.ClosedBy)
+	})
+
+	s.Run("should return error if actor is neither the campaign creator nor an admin", func() {
+		s.setup()
+
+		s.mockRepository.EXPECT().GetCampaignByID(mock.Anything, "campaign-1").Return(campaign(), nil).Once()
+
+		_, err := s.service.CloseCampaign(context.Background(), "campaign-1", "user@example.com")
+
+		s.ErrorIs(err, review.ErrActionForbidden)
+		s.mockRepository.AssertNotCalled(s.T(), "FindItems", mock.Anything, mock.Anything)
+		s.mockRepository.AssertNotCalled(s.T(), "UpdateCampaign", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,9 @@
+package sod
+
+import "errors"
+
+var (
+	ErrEmptyIDParam      = errors.New("separation of duties rule id can't be empty")
+	ErrRuleNotFound      = errors.New("separation of duties rule not found")
+	ErrDuplicateRuleName = errors.New("separation of duties rule with the same name already exists")
+)
@@ -0,0 +1,243 @@
+package sod
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/log"
+)
+
+const (
+	AuditKeyCreate = "sod_rule.create"
+	AuditKeyUpdate = "sod_rule.update"
+	AuditKeyDelete = "sod_rule.delete"
+)
+
+//go:generate mockery --name=repository --exported --with-expecter
+type repository interface {
+	Create(context.Context, *domain.SoDRule) error
+	Update(context.Context, *domain.SoDRule) error
+	GetByID(ctx context.Context, id string) (*domain.SoDRule, error)
+	Find(context.Context, domain.ListSoDRulesFilter) ([]*domain.SoDRule, error)
+	Delete(ctx context.Context, id string) error
+}
+
+//go:generate mockery --name=grantService --exported --with-expecter
+type grantService interface {
+	List(context.Context, domain.ListGrantsFilter) ([]domain.Grant, error)
+}
+
+//go:generate mockery --name=auditLogger --exported --with-expecter
+type auditLogger interface {
+	Log(ctx context.Context, action string, data interface{}) error
+}
+
+type ServiceDeps struct {
+	Repository   repository
+	GrantService grantService
+
+	Validator   *validator.Validate
+	Logger      log.Logger
+	AuditLogger auditLogger
+}
+
+type Service struct {
+	repo         repository
+	grantService grantService
+
+	validator   *validator.Validate
+	logger      log.Logger
+	auditLogger auditLogger
+}
+
+func NewService(deps ServiceDeps) *Service {
+	return &Service{
+		repo:         deps.Repository,
+		grantService: deps.GrantService,
+		validator:    deps.Validator,
+		logger:       deps.Logger,
+		auditLogger:  deps.AuditLogger,
+	}
+}
+
+func (s *Service) GetByID(ctx context.Context, id string) (*domain.SoDRule, error) {
+	if id == "" {
+		return nil, ErrEmptyIDParam
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *Service) Find(ctx context.Context, filter domain.ListSoDRulesFilter) ([]*domain.SoDRule, error) {
+	return s.repo.Find(ctx, filter)
+}
+
+func (s *Service) Create(ctx context.Context, r *domain.SoDRule) error {
+	if err := s.validate(ctx, r); err != nil {
+		return err
+	}
+
+	if err := s.repo.Create(ctx, r); err != nil {
+		return fmt.Errorf("inserting separation of duties rule into db: %w", err)
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyCreate, r); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	return nil
+}
+
+// Update replaces the name, description, selectors, action and approvers of the rule. Appeals already created are
+// not re-evaluated.
+func (s *Service) Update(ctx context.Context, payload *domain.SoDRule) error {
+	existing, err := s.GetByID(ctx, payload.ID)
+	if err != nil {
+		return fmt.Errorf("getting separation of duties rule details: %w", err)
+	}
+
+	updated := *existing
+	updated.Name = payload.Name
+	updated.Description = payload.Description
+	updated.Selectors = payload.Selectors
+	updated.Action = payload.Action
+	updated.Approvers = payload.Approvers
+	if err := s.validate(ctx, &updated); err != nil {
+		return err
+	}
+
+	if err := s.repo.Update(ctx, &updated); err != nil {
+		return err
+	}
+	*payload = updated
+
+	if err := s.auditLogger.Log(ctx, AuditKeyUpdate, map[string]interface{}{
+		"sod_rule_id":  existing.ID,
+		"previous":     existing,
+		"updated_rule": payload,
+	}); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	return nil
+}
+
+func (s *Service) Delete(ctx context.Context, id string) error {
+	existing, err := s.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("getting separation of duties rule details: %w", err)
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.auditLogger.Log(ctx, AuditKeyDelete, existing); err != nil {
+		s.logger.Error("failed to record audit log", "error", err)
+	}
+
+	return nil
+}
+
+// FindAppealViolations returns the rules that would be violated if the appeal is granted, given the active grants of
+// the appeal account and its other appeals that may still be granted, e.g. the pending ones or the ones created in the
+// same batch
+func (s *Service) FindAppealViolations(ctx context.Context, a *domain.Appeal, otherAppeals []*domain.Appeal) ([]*domain.SoDViolation, error) {
+	rules, err := s.repo.Find(ctx, domain.ListSoDRulesFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("listing separation of duties rules: %w", err)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	grants, err := s.grantService.List(ctx, domain.ListGrantsFilter{
+		Statuses:   []string{string(domain.GrantStatusActive)},
+		AccountIDs: []string{a.AccountID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing active grants: %w", err)
+	}
+
+	var accountAppeals []*domain.Appeal
+	for _, other := range otherAppeals {
+		if other != a && other.AccountID == a.AccountID && (other.ID == "" || other.ID != a.ID) {
+			accountAppeals = append(accountAppeals, other)
+		}
+	}
+
+	var violations []*domain.SoDViolation
+	for _, r := range rules {
+		v, err := r.FindViolation(grants, accountAppeals, a)
+		if err != nil {
+			return nil, fmt.Errorf("checking rule %q: %w", r.Name, err)
+		}
+		if v != nil {
+			violations = append(violations, v)
+		}
+	}
+	return violations, nil
+}
+
+// ListViolations reports the accounts whose active grants already violate the rules
+func (s *Service) ListViolations(ctx context.Context, filter domain.ListSoDViolationsFilter) ([]*domain.SoDViolation, error) {
+	rules, err := s.repo.Find(ctx, domain.ListSoDRulesFilter{IDs: filter.RuleIDs})
+	if err != nil {
+		return nil, fmt.Errorf("listing separation of duties rules: %w", err)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	grants, err := s.grantService.List(ctx, domain.ListGrantsFilter{
+		Statuses:   []string{string(domain.GrantStatusActive)},
+		AccountIDs: filter.AccountIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing active grants: %w", err)
+	}
+
+	grantsByAccount := map[string][]domain.Grant{}
+	var accountIDs []string
+	for _, g := range grants {
+		if _, exists := grantsByAccount[g.AccountID]; !exists {
+			accountIDs = append(accountIDs, g.AccountID)
+		}
+		grantsByAccount[g.AccountID] = append(grantsByAccount[g.AccountID], g)
+	}
+	sort.Strings(accountIDs)
+
+	var violations []*domain.SoDViolation
+	for _, accountID := range accountIDs {
+		for _, r := range rules {
+			v, err := r.FindViolation(grantsByAccount[accountID], nil, nil)
+			if err != nil {
+				return nil, fmt.Errorf("checking rule %q: %w", r.Name, err)
+			}
+			if v != nil {
+				violations = append(violations, v)
+			}
+		}
+	}
+	return violations, nil
+}
+
+func (s *Service) validate(ctx context.Context, r *domain.SoDRule) error {
+	if err := s.validator.Struct(r); err != nil {
+		return err
+	}
+
+	existingRules, err := s.repo.Find(ctx, domain.ListSoDRulesFilter{Names: []string{r.Name}})
+	if err != nil {
+		return fmt.Errorf("listing separation of duties rules: %w", err)
+	}
+	for _, existing := range existingRules {
+		if existing.ID != r.ID {
+			return ErrDuplicateRuleName
+		}
+	}
+
+	return nil
+}
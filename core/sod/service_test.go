@@ -0,0 +1,256 @@
+package sod_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/raystack/guardian/core/sod"
+	"github.com/raystack/guardian/core/sod/mocks"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	mockRepository   *mocks.Repository
+	mockGrantService *mocks.GrantService
+	mockAuditLogger  *mocks.AuditLogger
+	service          *sod.Service
+}
+
+func TestService(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) setup() {
+	s.mockRepository = new(mocks.Repository)
+	s.mockGrantService = new(mocks.GrantService)
+	s.mockAuditLogger = new(mocks.AuditLogger)
+	s.service = sod.NewService(sod.ServiceDeps{
+		Repository:   s.mockRepository,
+		GrantService: s.mockGrantService,
+		Validator:    validator.New(),
+		Logger:       log.NewNoop(),
+		AuditLogger:  s.mockAuditLogger,
+	})
+}
+
+func (s *ServiceTestSuite) validRule() *domain.SoDRule {
+	return &domain.SoDRule{
+		Name: "finance-writer-vs-reporting-admin",
+		Selectors: []*domain.RequirementTrigger{
+			{ResourceURN: "^finance-raw$", Role: "^writer$"},
+			{ResourceURN: "^reporting$", Role: "^admin$"},
+		},
+		Action:    domain.SoDRuleActionReject,
+		CreatedBy: "admin@example.com",
+	}
+}
+
+func (s *ServiceTestSuite) TestCreate() {
+	s.Run("should return error if payload is invalid", func() {
+		s.setup()
+
+		r := s.validRule()
+		r.Selectors = r.Selectors[:1]
+
+		err := s.service.Create(context.Background(), r)
+
+		s.Error(err)
+		s.mockRepository.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should return error if require_approval rule has no approvers", func() {
+		s.setup()
+
+		r := s.validRule()
+		r.Action = domain.SoDRuleActionRequireApproval
+
+		err := s.service.Create(context.Background(), r)
+
+		s.Error(err)
+		s.mockRepository.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should return error if rule name is already used", func() {
+		s.setup()
+
+		r := s.validRule()
+		s.mockRepository.EXPECT().
+			Find(mock.Anything, domain.ListSoDRulesFilter{Names: []string{r.Name}}).
+			Return([]*domain.SoDRule{{ID: "existing-rule", Name: r.Name}}, nil).Once()
+
+		err := s.service.Create(context.Background(), r)
+
+		s.ErrorIs(err, sod.ErrDuplicateRuleName)
+	})
+
+	s.Run("should create rule and record audit log", func() {
+		s.setup()
+
+		r := s.validRule()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockRepository.EXPECT().Create(mock.Anything, r).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, sod.AuditKeyCreate, r).Return(nil).Once()
+
+		err := s.service.Create(context.Background(), r)
+
+		s.NoError(err)
+		s.mockRepository.AssertExpectations(s.T())
+		s.mockAuditLogger.AssertExpectations(s.T())
+	})
+}
+
+func (s *ServiceTestSuite) TestUpdate() {
+	s.Run("should return error if rule is not found", func() {
+		s.setup()
+
+		s.mockRepository.EXPECT().GetByID(mock.Anything, "rule-1").Return(nil, sod.ErrRuleNotFound).Once()
+
+		err := s.service.Update(context.Background(), &domain.SoDRule{ID: "rule-1"})
+
+		s.ErrorIs(err, sod.ErrRuleNotFound)
+	})
+
+	s.Run("should keep creator of the existing rule", func() {
+		s.setup()
+
+		existing := s.validRule()
+		existing.ID = "rule-1"
+		payload := s.validRule()
+		payload.ID = "rule-1"
+		payload.CreatedBy = "someone-else@example.com"
+		payload.Action = domain.SoDRuleActionRequireApproval
+		payload.Approvers = []string{"compliance@example.com"}
+
+		s.mockRepository.EXPECT().GetByID(mock.Anything, "rule-1").Return(existing, nil).Once()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.SoDRule{existing}, nil).Once()
+		s.mockRepository.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.SoDRule")).Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, sod.AuditKeyUpdate, mock.Anything).Return(nil).Once()
+
+		err := s.service.Update(context.Background(), payload)
+
+		s.NoError(err)
+		s.Equal("admin@example.com", payload.CreatedBy)
+		s.Equal(domain.SoDRuleActionRequireApproval, payload.Action)
+	})
+}
+
+func (s *ServiceTestSuite) TestDelete() {
+	s.Run("should delete rule and record audit log", func() {
+		s.setup()
+
+		existing := s.validRule()
+		existing.ID = "rule-1"
+		s.mockRepository.EXPECT().GetByID(mock.Anything, "rule-1").Return(existing, nil).Once()
+		s.mockRepository.EXPECT().Delete(mock.Anything, "rule-1").Return(nil).Once()
+		s.mockAuditLogger.EXPECT().Log(mock.Anything, sod.AuditKeyDelete, existing).Return(nil).Once()
+
+		err := s.service.Delete(context.Background(), "rule-1")
+
+		s.NoError(err)
+	})
+}
+
+func (s *ServiceTestSuite) TestFindAppealViolations() {
+	a := &domain.Appeal{
+		AccountID: "user@example.com",
+		Role:      "admin",
+		Resource:  &domain.Resource{URN: "reporting"},
+	}
+
+	s.Run("should not list grants if there is no rule", func() {
+		s.setup()
+
+		s.mockRepository.EXPECT().Find(mock.Anything, domain.ListSoDRulesFilter{}).Return(nil, nil).Once()
+
+		violations, err := s.service.FindAppealViolations(context.Background(), a, nil)
+
+		s.NoError(err)
+		s.Empty(violations)
+		s.mockGrantService.AssertNotCalled(s.T(), "List", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should return error if listing grants fails", func() {
+		s.setup()
+
+		expectedError := errors.New("unexpected error")
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.SoDRule{s.validRule()}, nil).Once()
+		s.mockGrantService.EXPECT().List(mock.Anything, mock.Anything).Return(nil, expectedError).Once()
+
+		_, err := s.service.FindAppealViolations(context.Background(), a, nil)
+
+		s.ErrorIs(err, expectedError)
+	})
+
+	s.Run("should return rules violated by the appeal and the active grants of the account", func() {
+		s.setup()
+
+		rule := s.validRule()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.SoDRule{rule}, nil).Once()
+		s.mockGrantService.EXPECT().
+			List(mock.Anything, domain.ListGrantsFilter{
+				Statuses:   []string{string(domain.GrantStatusActive)},
+				AccountIDs: []string{"user@example.com"},
+			}).
+			Return([]domain.Grant{
+				{ID: "grant-1", AccountID: "user@example.com", Role: "writer", Resource: &domain.Resource{URN: "finance-raw"}},
+			}, nil).Once()
+
+		violations, err := s.service.FindAppealViolations(context.Background(), a, nil)
+
+		s.NoError(err)
+		s.Require().Len(violations, 1)
+		s.Equal(rule, violations[0].Rule)
+		s.Equal([]string{"grant-1"}, violations[0].GrantIDs)
+	})
+
+	s.Run("should only include the other appeals of the same account", func() {
+		s.setup()
+
+		rule := s.validRule()
+		s.mockRepository.EXPECT().Find(mock.Anything, mock.Anything).Return([]*domain.SoDRule{rule}, nil).Twice()
+		s.mockGrantService.EXPECT().List(mock.Anything, mock.Anything).Return(nil, nil).Twice()
+		financeWriter := func(accountID string) *domain.Appeal {
+			return &domain.Appeal{ID: "appeal-2", AccountID: accountID, Role: "writer", Resource: &domain.Resource{URN: "finance-raw"}}
+		}
+
+		violations, err := s.service.FindAppealViolations(context.Background(), a, []*domain.Appeal{financeWriter("other@example.com")})
+		s.NoError(err)
+		s.Empty(violations)
+
+		violations, err = s.service.FindAppealViolations(context.Background(), a, []*domain.Appeal{financeWriter(a.AccountID)})
+		s.NoError(err)
+		s.Require().Len(violations, 1)
+		s.Equal(rule, violations[0].Rule)
+	})
+}
+
+func (s *ServiceTestSuite) TestListViolations() {
+	s.Run("should return violations grouped by account", func() {
+		s.setup()
+
+		rule := s.validRule()
+		s.mockRepository.EXPECT().Find(mock.Anything, domain.ListSoDRulesFilter{IDs: []string{"rule-1"}}).Return([]*domain.SoDRule{rule}, nil).Once()
+		s.mockGrantService.EXPECT().List(mock.Anything, mock.Anything).Return([]domain.Grant{
+			{ID: "grant-1", AccountID: "b@example.com", Role: "writer", Resource: &domain.Resource{URN: "finance-raw"}},
+			{ID: "grant-2", AccountID: "a@example.com", Role: "writer", Resource: &domain.Resource{URN: "finance-raw"}},
+			{ID: "grant-3", AccountID: "b@example.com", Role: "admin", Resource: &domain.Resource{URN: "reporting"}},
+			{ID: "grant-4", AccountID: "a@example.com", Role: "admin", Resource: &domain.Resource{URN: "reporting"}},
+			{ID: "grant-5", AccountID: "c@example.com", Role: "admin", Resource: &domain.Resource{URN: "reporting"}},
+		}, nil).Once()
+
+		violations, err := s.service.ListViolations(context.Background(), domain.ListSoDViolationsFilter{RuleIDs: []string{"rule-1"}})
+
+		s.NoError(err)
+		s.Require().Len(violations, 2)
+		s.Equal("a@example.com", violations[0].AccountID)
+		s.Equal([]string{"grant-2", "grant-4"}, violations[0].GrantIDs)
+		s.Equal("b@example.com", violations[1].AccountID)
+		s.Equal([]string{"grant-1", "grant-3"}, violations[1].GrantIDs)
+	})
+}
@@ -70,6 +70,9 @@ module.exports = {
         "reference/api",
         "reference/cli",
         "reference/appeal",
+        "reference/bundle",
+        "reference/review",
+        "reference/sod",
         "reference/policy",
         "reference/provider",
         "reference/resource",
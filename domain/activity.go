@@ -75,3 +75,26 @@ func (f *ListActivitiesFilter) GetResources() []*Resource {
 
 	return resources
 }
+
+// IsMatch returns true if the activity is done by any of the filtered accounts within the filtered time range
+func (f *ListActivitiesFilter) IsMatch(a *Activity) bool {
+	if len(f.AccountIDs) > 0 {
+		var found bool
+		for _, accountID := range f.AccountIDs {
+			if a.AccountID == accountID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.TimestampGte != nil && a.Timestamp.Before(*f.TimestampGte) {
+		return false
+	}
+	if f.TimestampLte != nil && a.Timestamp.After(*f.TimestampLte) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListActivitiesFilter_IsMatch(t *testing.T) {
+	now := time.Now()
+	yesterday := now.Add(-24 * time.Hour)
+	lastWeek := now.Add(-7 * 24 * time.Hour)
+	activity := &domain.Activity{
+		AccountID: "user@example.com",
+		Timestamp: yesterday,
+	}
+
+	testCases := []struct {
+		name     string
+		filter   domain.ListActivitiesFilter
+		expected bool
+	}{
+		{
+			name:     "should match any activity if filter is empty",
+			filter:   domain.ListActivitiesFilter{},
+			expected: true,
+		},
+		{
+			name:     "should match activity of the filtered accounts",
+			filter:   domain.ListActivitiesFilter{AccountIDs: []string{"other@example.com", "user@example.com"}},
+			expected: true,
+		},
+		{
+			name:     "should not match activity of other accounts",
+			filter:   domain.ListActivitiesFilter{AccountIDs: []string{"other@example.com"}},
+			expected: false,
+		},
+		{
+			name:     "should match activity within the time range",
+			filter:   domain.ListActivitiesFilter{TimestampGte: &lastWeek, TimestampLte: &now},
+			expected: true,
+		},
+		{
+			name:     "should not match activity before the time range",
+			filter:   domain.ListActivitiesFilter{TimestampGte: &now},
+			expected: false,
+		},
+		{
+			name:     "should not match activity after the time range",
+			filter:   domain.ListActivitiesFilter{TimestampLte: &lastWeek},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.filter.IsMatch(activity))
+		})
+	}
+}
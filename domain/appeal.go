@@ -11,13 +11,16 @@ import (
 )
 
 const (
-	AppealActionNameApprove = "approve"
-	AppealActionNameReject  = "reject"
+	AppealActionNameApprove     = "approve"
+	AppealActionNameReject      = "reject"
+	AppealActionNameRequestInfo = "request_info"
 
-	AppealStatusPending  = "pending"
-	AppealStatusCanceled = "canceled"
-	AppealStatusApproved = "approved"
-	AppealStatusRejected = "rejected"
+	AppealStatusPending   = "pending"
+	AppealStatusNeedsInfo = "needs_info"
+	AppealStatusCanceled  = "canceled"
+	AppealStatusApproved  = "approved"
+	AppealStatusRejected  = "rejected"
+	AppealStatusExpired   = "expired"
 
 	SystemActorName = "system"
 
@@ -25,6 +28,9 @@ const (
 	PermanentDurationLabel   = "Permanent"
 
 	ExpirationDateReasonFromAppeal = "Expiration date is set based on the appeal options"
+
+	// BreakGlassReviewApprovalName is the name of the post-hoc review approval attached to break-glass appeals
+	BreakGlassReviewApprovalName = "break_glass_review"
 )
 
 var (
@@ -35,6 +41,13 @@ var (
 type AppealOptions struct {
 	ExpirationDate *time.Time `json:"expiration_date,omitempty" yaml:"expiration_date,omitempty"`
 	Duration       string     `json:"duration" yaml:"duration"`
+	// StartDate schedules the access to be given at a later time instead of right after the appeal is approved
+	StartDate *time.Time `json:"start_date,omitempty" yaml:"start_date,omitempty" mapstructure:"start_date"`
+	// BreakGlass requests emergency access that is granted immediately and reviewed afterwards
+	BreakGlass bool `json:"break_glass,omitempty" yaml:"break_glass,omitempty" mapstructure:"break_glass"`
+	// ReplaceGrantID is the grant revoked once the appeal is approved. It is only set when downgrading the grant to a
+	// narrower role, hence not decoded from the appeal options of the requests
+	ReplaceGrantID string `json:"replace_grant_id,omitempty" yaml:"replace_grant_id,omitempty" mapstructure:"-"`
 }
 
 // Appeal struct
@@ -54,12 +67,19 @@ type Appeal struct {
 	Details       map[string]interface{} `json:"details" yaml:"details"`
 	Labels        map[string]string      `json:"labels" yaml:"labels"`
 	Description   string                 `json:"description" yaml:"description"`
+	// BundleID is the bundle the appeal was requested through, if any
+	BundleID string `json:"bundle_id,omitempty" yaml:"bundle_id,omitempty"`
+	// Risk is computed from the risk scoring rules when the appeal is created or updated
+	Risk *AppealRisk `json:"risk,omitempty" yaml:"risk,omitempty"`
 
 	Policy    *Policy     `json:"-" yaml:"-"`
 	Resource  *Resource   `json:"resource,omitempty" yaml:"resource,omitempty"`
 	Approvals []*Approval `json:"approvals,omitempty" yaml:"approvals,omitempty"`
 	Grant     *Grant      `json:"grant,omitempty" yaml:"grant,omitempty"`
 
+	// Revisions are the previous versions of the appeal, oldest first
+	Revisions []*AppealRevision `json:"revisions,omitempty" yaml:"revisions,omitempty"`
+
 	CreatedAt time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
 	UpdatedAt time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
 }
@@ -73,6 +93,110 @@ func (a *Appeal) GetNextPendingApproval() *Approval {
 	return nil
 }
 
+// GetPendingApprovals returns the manual approvals waiting for a response. Steps of a parallel group are pending at
+// the same time.
+func (a *Appeal) GetPendingApprovals() []*Approval {
+	var approvals []*Approval
+	for _, approval := range a.Approvals {
+		if approval.Status == ApprovalStatusPending && approval.IsManualApproval() {
+			approvals = append(approvals, approval)
+		}
+	}
+	return approvals
+}
+
+// UnblockNextApprovals sets the steps following the approval at the given index as pending, once the approval and
+// the rest of its parallel group are resolved
+func (a *Appeal) UnblockNextApprovals(index int) {
+	current := a.Approvals[index]
+	next := index + 1
+	for i, approval := range a.Approvals {
+		if i != index && !approval.IsParallelWith(current) {
+			continue
+		}
+		if !approval.IsResolved() {
+			return
+		}
+		if i >= next {
+			next = i + 1
+		}
+	}
+
+	for i := next; i < len(a.Approvals); i++ {
+		if i > next && !a.Approvals[i].IsParallelWith(a.Approvals[next]) {
+			break
+		}
+		if a.Approvals[i].Status == ApprovalStatusBlocked {
+			a.Approvals[i].Status = ApprovalStatusPending
+		}
+	}
+}
+
+// IsApprovalCompleted returns true if every step of the appeal is approved or skipped
+func (a *Appeal) IsApprovalCompleted() bool {
+	if len(a.Approvals) == 0 {
+		return false
+	}
+	for _, approval := range a.Approvals {
+		if !approval.IsResolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// GetApprovalPendingSince returns the time the approval started waiting for a response, which is the latest of
+// the appeal creation, the resolution of the preceding steps, and the escalation of the approval
+func (a *Appeal) GetApprovalPendingSince(approval *Approval) time.Time {
+	since := a.CreatedAt
+	for _, prev := range a.Approvals {
+		if prev.Index >= approval.Index || prev.IsParallelWith(approval) {
+			continue
+		}
+		for _, d := range prev.Decisions {
+			if d.CreatedAt.After(since) {
+				since = d.CreatedAt
+			}
+		}
+		// auto-approved and skipped steps have no decisions, they are resolved when last updated
+		if len(prev.Decisions) == 0 && prev.IsResolved() && prev.UpdatedAt.After(since) {
+			since = prev.UpdatedAt
+		}
+	}
+	if approval.EscalatedAt != nil && approval.EscalatedAt.After(since) {
+		since = *approval.EscalatedAt
+	}
+	return since
+}
+
+func (a *Appeal) IsBreakGlass() bool {
+	return a.Options != nil && a.Options.BreakGlass
+}
+
+// isSelf returns true if the user is the creator of the appeal or the account the access is requested for
+func (a *Appeal) isSelf(user string) bool {
+	return user == a.CreatedBy || user == a.AccountID
+}
+
+// excludeSelf returns the users other than the appeal creator and the requested account
+func (a *Appeal) excludeSelf(users []string) []string {
+	var others []string
+	for _, u := range users {
+		if !a.isSelf(u) {
+			others = append(others, u)
+		}
+	}
+	return others
+}
+
+// GetBreakGlassReview returns the post-hoc review approval of a break-glass appeal
+func (a *Appeal) GetBreakGlassReview() *Approval {
+	if !a.IsBreakGlass() {
+		return nil
+	}
+	return a.GetApproval(BreakGlassReviewApprovalName)
+}
+
 func (a *Appeal) Init(policy *Policy) {
 	a.Status = AppealStatusPending
 	a.PolicyID = policy.ID
@@ -83,6 +207,83 @@ func (a *Appeal) Cancel() {
 	a.Status = AppealStatusCanceled
 }
 
+// RequestInfo puts the appeal on hold until the creator answers the question of an approver by updating the appeal
+func (a *Appeal) RequestInfo() {
+	a.Status = AppealStatusNeedsInfo
+}
+
+// IsUpdatable returns true if the appeal is still waiting for approval, including while waiting for more information
+// from the creator
+func (a *Appeal) IsUpdatable() bool {
+	return a.Status == AppealStatusPending || a.Status == AppealStatusNeedsInfo
+}
+
+// Update applies the changes of the creator. The current version of the appeal is kept in the revisions along with
+// its approvals, which are to be re-evaluated by the caller.
+func (a *Appeal) Update(u AppealUpdate) {
+	revision := &AppealRevision{
+		Status:      a.Status,
+		Role:        a.Role,
+		Permissions: a.Permissions,
+		Details:     a.Details,
+		Description: a.Description,
+		UpdatedBy:   u.Actor,
+		UpdatedAt:   time.Now(),
+	}
+	if a.Options != nil {
+		options := *a.Options
+		revision.Options = &options
+	}
+	for _, approval := range a.Approvals {
+		previous := *approval
+		previous.Appeal = nil
+		revision.Approvals = append(revision.Approvals, &previous)
+	}
+	a.Revisions = append(a.Revisions, revision)
+
+	if u.Description != "" {
+		a.Description = u.Description
+	}
+	if u.Role != "" {
+		a.Role = u.Role
+	}
+	if u.Duration != "" {
+		options := AppealOptions{}
+		if a.Options != nil {
+			options = *a.Options
+		}
+		options.Duration = u.Duration
+		a.Options = &options
+	}
+	if len(u.Details) > 0 {
+		details := map[string]interface{}{}
+		for k, v := range a.Details {
+			details[k] = v
+		}
+		for k, v := range u.Details {
+			details[k] = v
+		}
+		a.Details = details
+	}
+}
+
+func (a *Appeal) Expire() {
+	a.Status = AppealStatusExpired
+}
+
+// IsScheduled returns true if the access is requested to start in the future
+func (a *Appeal) IsScheduled() bool {
+	return a.Options != nil && a.Options.StartDate != nil && a.Options.StartDate.After(time.Now())
+}
+
+// GetAccessStartTime returns the time the access starts, which is the scheduled start date or the current time
+func (a *Appeal) GetAccessStartTime() time.Time {
+	if a.IsScheduled() {
+		return *a.Options.StartDate
+	}
+	return time.Now()
+}
+
 func (a *Appeal) Approve() error {
 	a.Status = AppealStatusApproved
 
@@ -96,7 +297,7 @@ func (a *Appeal) Approve() error {
 		return nil
 	}
 
-	expirationDate := time.Now().Add(duration)
+	expirationDate := a.GetAccessStartTime().Add(duration)
 	a.Options.ExpirationDate = &expirationDate
 	return nil
 }
@@ -149,6 +350,12 @@ func (a Appeal) ToGrant() (*Grant, error) {
 		CreatedBy:   a.CreatedBy,
 	}
 
+	startTime := a.GetAccessStartTime()
+	if a.IsScheduled() {
+		grant.Status = GrantStatusScheduled
+		grant.StartDate = &startTime
+	}
+
 	if a.Options != nil && a.Options.Duration != "" {
 		duration, err := time.ParseDuration(a.Options.Duration)
 		if err != nil {
@@ -157,7 +364,7 @@ func (a Appeal) ToGrant() (*Grant, error) {
 		if duration == 0 {
 			grant.IsPermanent = true
 		} else {
-			expDate := time.Now().Add(duration)
+			expDate := startTime.Add(duration)
 			grant.ExpirationDate = &expDate
 			grant.RequestedExpirationDate = &expDate
 			grant.ExpirationDateReason = ExpirationDateReasonFromAppeal
@@ -166,6 +373,10 @@ func (a Appeal) ToGrant() (*Grant, error) {
 		grant.IsPermanent = true
 	}
 
+	if a.IsBreakGlass() {
+		grant.Source = GrantSourceBreakGlass
+	}
+
 	return grant, nil
 }
 
@@ -200,7 +411,8 @@ func (a *Appeal) AdvanceApproval(policy *Policy) error {
 		if approval.Status == ApprovalStatusRejected {
 			break
 		}
-		if approval.Status == ApprovalStatusPending {
+		// approvals added on top of the policy steps, e.g. by separation-of-duties rules, are always manual
+		if approval.Status == ApprovalStatusPending && approval.Index < len(policy.Steps) {
 			stepConfig := policy.Steps[approval.Index]
 
 			appealMap, err := utils.StructToMap(a)
@@ -209,7 +421,7 @@ func (a *Appeal) AdvanceApproval(policy *Policy) error {
 			}
 
 			if stepConfig.When != "" {
-				v, err := evaluator.Expression(stepConfig.When).EvaluateWithVars(map[string]interface{}{
+				v, err := evaluator.New(stepConfig.When, policy.GetExpressionLanguage()).EvaluateWithVars(map[string]interface{}{
 					"appeal": appealMap,
 				})
 				if err != nil {
@@ -219,14 +431,12 @@ func (a *Appeal) AdvanceApproval(policy *Policy) error {
 				isFalsy := reflect.ValueOf(v).IsZero()
 				if isFalsy {
 					approval.Status = ApprovalStatusSkipped
-					if i < len(a.Approvals)-1 {
-						a.Approvals[i+1].Status = ApprovalStatusPending
-					}
+					a.UnblockNextApprovals(i)
 				}
 			}
 
 			if approval.Status != ApprovalStatusSkipped && stepConfig.Strategy == ApprovalStepStrategyAuto {
-				v, err := evaluator.Expression(stepConfig.ApproveIf).EvaluateWithVars(map[string]interface{}{
+				v, err := evaluator.New(stepConfig.ApproveIf, policy.GetExpressionLanguage()).EvaluateWithVars(map[string]interface{}{
 					"appeal": appealMap,
 				})
 				if err != nil {
@@ -237,43 +447,91 @@ func (a *Appeal) AdvanceApproval(policy *Policy) error {
 				if isFalsy {
 					if stepConfig.AllowFailed {
 						approval.Status = ApprovalStatusSkipped
-						if i+1 <= len(a.Approvals)-1 {
-							a.Approvals[i+1].Status = ApprovalStatusPending
-						}
+						a.UnblockNextApprovals(i)
 					} else {
 						approval.Status = ApprovalStatusRejected
 						approval.Reason = stepConfig.RejectionReason
 						a.Status = AppealStatusRejected
+						// the other steps of a parallel group are left as they are once the appeal is rejected
+						return nil
 					}
 				} else {
 					approval.Status = ApprovalStatusApproved
-					if i+1 <= len(a.Approvals)-1 {
-						a.Approvals[i+1].Status = ApprovalStatusPending
-					}
+					a.UnblockNextApprovals(i)
 				}
 			}
 		}
-		if i == len(a.Approvals)-1 && (approval.Status == ApprovalStatusSkipped || approval.Status == ApprovalStatusApproved) {
-			a.Status = AppealStatusApproved
-		}
+	}
+
+	if a.Status != AppealStatusRejected && a.IsApprovalCompleted() {
+		a.Status = AppealStatusApproved
 	}
 
 	return nil
 }
 
+// AppealSimulation is the outcome of running a policy against a hypothetical appeal. Approvals of the appeal show
+// which steps would be skipped, auto-approved, rejected or left pending, along with their resolved approvers.
+type AppealSimulation struct {
+	Appeal *Appeal `json:"appeal" yaml:"appeal"`
+	// MatchedRequirements are the policy requirements the appeal matches, whose additional appeals would be created
+	// once the appeal is approved
+	MatchedRequirements []*Requirement `json:"matched_requirements,omitempty" yaml:"matched_requirements,omitempty"`
+}
+
+// AppealRevision is a previous version of an appeal along with the approvals and decisions made on it
+type AppealRevision struct {
+	Status      string                 `json:"status" yaml:"status"`
+	Role        string                 `json:"role" yaml:"role"`
+	Permissions []string               `json:"permissions" yaml:"permissions"`
+	Options     *AppealOptions         `json:"options" yaml:"options"`
+	Details     map[string]interface{} `json:"details" yaml:"details"`
+	Description string                 `json:"description" yaml:"description"`
+	Approvals   []*Approval            `json:"approvals,omitempty" yaml:"approvals,omitempty"`
+	// UpdatedBy is the user who replaced this version with a newer one
+	UpdatedBy string    `json:"updated_by" yaml:"updated_by"`
+	UpdatedAt time.Time `json:"updated_at" yaml:"updated_at"`
+}
+
+// AppealUpdate holds the changes made by the creator to a pending appeal. Empty fields are left unchanged, details
+// are merged into the existing ones.
+type AppealUpdate struct {
+	AppealID    string                 `validate:"required" json:"appeal_id"`
+	Actor       string                 `validate:"email" json:"actor"`
+	Description string                 `json:"description,omitempty"`
+	Role        string                 `json:"role,omitempty"`
+	Duration    string                 `json:"duration,omitempty"`
+	Details     map[string]interface{} `json:"details,omitempty"`
+}
+
 type ApprovalActionType string
 
 const (
-	ApprovalActionApprove ApprovalActionType = "approve"
-	ApprovalActionReject  ApprovalActionType = "reject"
+	ApprovalActionApprove     ApprovalActionType = "approve"
+	ApprovalActionReject      ApprovalActionType = "reject"
+	ApprovalActionRequestInfo ApprovalActionType = "request_info"
 )
 
 type ApprovalAction struct {
 	AppealID     string `validate:"required" json:"appeal_id"`
 	ApprovalName string `validate:"required" json:"approval_name"`
 	Actor        string `validate:"email" json:"actor"`
-	Action       string `validate:"required,oneof=approve reject" json:"action"`
-	Reason       string `json:"reason"`
+	Action       string `validate:"required,oneof=approve reject request_info" json:"action"`
+	// Reason is the question to the creator for the request_info action
+	Reason string `json:"reason"`
+
+	// Role and Duration let the approver approve a lesser role or a shorter access than requested. Only allowed for
+	// the approve action, left unchanged if empty.
+	Role     string `json:"role,omitempty"`
+	Duration string `json:"duration,omitempty"`
+
+	// OnBehalfOf is the approver who delegated their approval to the actor, filled in by the service
+	OnBehalfOf string `json:"on_behalf_of,omitempty"`
+}
+
+// HasOverrides returns true if the approver changes the role or the duration of the appeal
+func (a ApprovalAction) HasOverrides() bool {
+	return a.Role != "" || a.Duration != ""
 }
 
 type ListAppealsFilter struct {
@@ -284,9 +542,12 @@ type ListAppealsFilter struct {
 	AccountIDs                []string  `mapstructure:"account_ids" validate:"omitempty,required"`
 	ResourceID                string    `mapstructure:"resource_id" validate:"omitempty,required"`
 	Role                      string    `mapstructure:"role" validate:"omitempty,required"`
+	BundleID                  string    `mapstructure:"bundle_id" validate:"omitempty,required"`
 	Statuses                  []string  `mapstructure:"statuses" validate:"omitempty,min=1"`
 	ExpirationDateLessThan    time.Time `mapstructure:"expiration_date_lt" validate:"omitempty,required"`
 	ExpirationDateGreaterThan time.Time `mapstructure:"expiration_date_gt" validate:"omitempty,required"`
+	CreatedAtLessThan         time.Time `mapstructure:"created_at_lt" validate:"omitempty,required"`
+	CreatedAtGreaterThan      time.Time `mapstructure:"created_at_gt" validate:"omitempty,required"`
 	ProviderTypes             []string  `mapstructure:"provider_types" validate:"omitempty,min=1"`
 	ProviderURNs              []string  `mapstructure:"provider_urns" validate:"omitempty,min=1"`
 	ResourceTypes             []string  `mapstructure:"resource_types" validate:"omitempty,min=1"`
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/pkg/evaluator"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAppeal_GetNextPendingApproval(t *testing.T) {
@@ -74,6 +76,86 @@ func TestAppeal_Cancel(t *testing.T) {
 	assert.Equal(t, a.Status, domain.AppealStatusCanceled)
 }
 
+func TestAppeal_RequestInfo(t *testing.T) {
+	a := domain.Appeal{Status: domain.AppealStatusPending}
+	a.RequestInfo()
+
+	assert.Equal(t, domain.AppealStatusNeedsInfo, a.Status)
+	assert.True(t, a.IsUpdatable())
+}
+
+func TestAppeal_Update(t *testing.T) {
+	t.Run("should apply the changes and keep the previous version", func(t *testing.T) {
+		approval := &domain.Approval{
+			Name:   "step-1",
+			Status: domain.ApprovalStatusPending,
+			Decisions: []*domain.ApprovalDecision{
+				{Actor: "approver@example.com", Action: domain.AppealActionNameRequestInfo, Reason: "why?"},
+			},
+		}
+		a := domain.Appeal{
+			Status:      domain.AppealStatusNeedsInfo,
+			Role:        "viewer",
+			Permissions: []string{"roles/viewer"},
+			Options:     &domain.AppealOptions{Duration: "24h"},
+			Description: "need access",
+			Details: map[string]interface{}{
+				"__policy_questions": map[string]interface{}{"team": "data"},
+				"__provider_parameters": map[string]interface{}{
+					"username": "user",
+				},
+			},
+			Approvals: []*domain.Approval{approval},
+		}
+
+		a.Update(domain.AppealUpdate{
+			Actor:    "user@example.com",
+			Role:     "editor",
+			Duration: "1h",
+			Details: map[string]interface{}{
+				"__policy_questions": map[string]interface{}{"team": "data", "reason": "backfill"},
+			},
+		})
+
+		assert.Equal(t, "editor", a.Role)
+		assert.Equal(t, "1h", a.Options.Duration)
+		assert.Equal(t, "need access", a.Description)
+		assert.Equal(t, map[string]interface{}{
+			"__policy_questions": map[string]interface{}{"team": "data", "reason": "backfill"},
+			"__provider_parameters": map[string]interface{}{
+				"username": "user",
+			},
+		}, a.Details)
+
+		assert.Len(t, a.Revisions, 1)
+		revision := a.Revisions[0]
+		assert.Equal(t, domain.AppealStatusNeedsInfo, revision.Status)
+		assert.Equal(t, "viewer", revision.Role)
+		assert.Equal(t, []string{"roles/viewer"}, revision.Permissions)
+		assert.Equal(t, "24h", revision.Options.Duration)
+		assert.Equal(t, map[string]interface{}{"team": "data"}, revision.Details["__policy_questions"])
+		assert.Equal(t, "user@example.com", revision.UpdatedBy)
+		assert.Len(t, revision.Approvals, 1)
+		assert.Equal(t, approval.Decisions, revision.Approvals[0].Decisions)
+		assert.NotSame(t, approval, revision.Approvals[0])
+	})
+
+	t.Run("should leave empty fields unchanged", func(t *testing.T) {
+		a := domain.Appeal{
+			Role:        "viewer",
+			Description: "need access",
+		}
+
+		a.Update(domain.AppealUpdate{Actor: "user@example.com"})
+
+		assert.Equal(t, "viewer", a.Role)
+		assert.Equal(t, "need access", a.Description)
+		assert.Nil(t, a.Options)
+		assert.Nil(t, a.Details)
+		assert.Len(t, a.Revisions, 1)
+	})
+}
+
 func TestAppeal_Reject(t *testing.T) {
 	a := domain.Appeal{}
 	a.Reject()
@@ -343,6 +425,27 @@ func TestAppeal_ToGrant(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("should return scheduled grant if start date is in the future", func(t *testing.T) {
+		startDate := time.Now().Add(24 * time.Hour)
+		a := domain.Appeal{
+			ID:         "appeal-1",
+			AccountID:  "account-1",
+			ResourceID: "resource-1",
+			Role:       "role-1",
+			Options: &domain.AppealOptions{
+				Duration:  "1h",
+				StartDate: &startDate,
+			},
+		}
+
+		got, err := a.ToGrant()
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GrantStatusScheduled, got.Status)
+		assert.Equal(t, startDate, *got.StartDate)
+		assert.Equal(t, startDate.Add(time.Hour), *got.ExpirationDate)
+	})
 }
 
 func TestAppeal_AdvanceApproval(t *testing.T) {
@@ -475,6 +578,48 @@ func TestAppeal_AdvanceApproval(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "should keep approvals added on top of the policy steps pending",
+			appeal: &domain.Appeal{
+				PolicyID:      "test-id",
+				PolicyVersion: 1,
+				Resource:      &domain.Resource{Name: "grafana"},
+				Policy: &domain.Policy{
+					ID:      "test-id",
+					Version: 1,
+					Steps: []*domain.Step{
+						{
+							Name:      "step-1",
+							Strategy:  "auto",
+							ApproveIf: `true`,
+						},
+					},
+				},
+				Approvals: []*domain.Approval{
+					{
+						Status: domain.ApprovalStatusPending,
+						Index:  0,
+					},
+					{
+						Name:   domain.SoDApprovalName,
+						Status: domain.ApprovalStatusBlocked,
+						Index:  1,
+					},
+				},
+			},
+			wantErr: false,
+			wantApprovals: []*domain.Approval{
+				{
+					Status: domain.ApprovalStatusApproved,
+					Index:  0,
+				},
+				{
+					Name:   domain.SoDApprovalName,
+					Status: domain.ApprovalStatusPending,
+					Index:  1,
+				},
+			},
+		},
 		{
 			name: "should return error if invalid expression",
 			appeal: &domain.Appeal{
@@ -663,6 +808,229 @@ func TestAppeal_AdvanceApproval_UpdateApprovalStatuses(t *testing.T) {
 	}
 }
 
+func TestAppeal_AdvanceApproval__ParallelGroup(t *testing.T) {
+	steps := []*domain.Step{
+		{
+			Name:      "auto",
+			Strategy:  domain.ApprovalStepStrategyAuto,
+			ApproveIf: "true",
+		},
+		{
+			Name:          "security",
+			Strategy:      domain.ApprovalStepStrategyManual,
+			Approvers:     []string{"security@example.com"},
+			ParallelGroup: "review",
+		},
+		{
+			Name:          "owner",
+			Strategy:      domain.ApprovalStepStrategyManual,
+			Approvers:     []string{"owner@example.com"},
+			ParallelGroup: "review",
+		},
+		{
+			Name:      "manager",
+			Strategy:  domain.ApprovalStepStrategyManual,
+			Approvers: []string{"manager@example.com"},
+		},
+	}
+	appeal := &domain.Appeal{Resource: &domain.Resource{}}
+	policy := &domain.Policy{Steps: steps}
+	require.NoError(t, appeal.ApplyPolicy(policy))
+
+	getStatuses := func() []string {
+		var statuses []string
+		for _, a := range appeal.Approvals {
+			statuses = append(statuses, a.Status)
+		}
+		return statuses
+	}
+
+	require.NoError(t, appeal.AdvanceApproval(policy))
+	assert.Equal(t, []string{"approved", "pending", "pending", "blocked"}, getStatuses())
+	assert.Equal(t, []*domain.Approval{appeal.Approvals[1], appeal.Approvals[2]}, appeal.GetPendingApprovals())
+
+	appeal.Approvals[2].Approve()
+	appeal.UnblockNextApprovals(2)
+	require.NoError(t, appeal.AdvanceApproval(policy))
+	assert.Equal(t, []string{"approved", "pending", "approved", "blocked"}, getStatuses())
+	assert.Equal(t, domain.AppealStatusPending, appeal.Status)
+
+	appeal.Approvals[1].Approve()
+	appeal.UnblockNextApprovals(1)
+	require.NoError(t, appeal.AdvanceApproval(policy))
+	assert.Equal(t, []string{"approved", "approved", "approved", "pending"}, getStatuses())
+	assert.Equal(t, domain.AppealStatusPending, appeal.Status)
+
+	appeal.Approvals[3].Approve()
+	appeal.UnblockNextApprovals(3)
+	require.NoError(t, appeal.AdvanceApproval(policy))
+	assert.Equal(t, domain.AppealStatusApproved, appeal.Status)
+}
+
+func TestAppeal_AdvanceApproval__ParallelGroupRejected(t *testing.T) {
+	policy := &domain.Policy{
+		Steps: []*domain.Step{
+			{
+				Name:            "security",
+				Strategy:        domain.ApprovalStepStrategyAuto,
+				ApproveIf:       "false",
+				RejectionReason: "rejected by security",
+				ParallelGroup:   "review",
+			},
+			{
+				Name:          "owner",
+				Strategy:      domain.ApprovalStepStrategyAuto,
+				ApproveIf:     "true",
+				ParallelGroup: "review",
+			},
+			{
+				Name:      "manager",
+				Strategy:  domain.ApprovalStepStrategyManual,
+				Approvers: []string{"manager@example.com"},
+			},
+		},
+	}
+	appeal := &domain.Appeal{Resource: &domain.Resource{}}
+	require.NoError(t, appeal.ApplyPolicy(policy))
+
+	require.NoError(t, appeal.AdvanceApproval(policy))
+
+	assert.Equal(t, domain.AppealStatusRejected, appeal.Status)
+	assert.Equal(t, domain.ApprovalStatusRejected, appeal.Approvals[0].Status)
+	assert.Equal(t, "rejected by security", appeal.Approvals[0].Reason)
+	assert.Equal(t, domain.ApprovalStatusPending, appeal.Approvals[1].Status)
+	assert.Equal(t, domain.ApprovalStatusBlocked, appeal.Approvals[2].Status)
+}
+
+func TestAppeal_AdvanceApproval__Rego(t *testing.T) {
+	policy := &domain.Policy{
+		ExpressionLanguage: evaluator.LanguageRego,
+		Steps: []*domain.Step{
+			{
+				Name:     "sensitive_only",
+				When:     `input.appeal.resource.details.sensitive == true`,
+				Strategy: domain.ApprovalStepStrategyAuto,
+				ApproveIf: `package guardian.sensitive
+
+default result := false
+
+result {
+	input.appeal.creator.role == "admin"
+}`,
+				AllowFailed: true,
+			},
+			{
+				Name:      "admin_only",
+				When:      `input.appeal.creator.role == "admin"`,
+				Strategy:  domain.ApprovalStepStrategyAuto,
+				ApproveIf: "true",
+			},
+			{
+				Name:     "manager",
+				Strategy: domain.ApprovalStepStrategyManual,
+				Approvers: []string{
+					"input.appeal.creator.manager",
+					"expr:$appeal.resource.details.owner",
+				},
+			},
+		},
+	}
+	appeal := &domain.Appeal{
+		Resource: &domain.Resource{
+			Details: map[string]interface{}{
+				"sensitive": true,
+				"owner":     "owner@example.com",
+			},
+		},
+		Creator: map[string]interface{}{
+			"role":    "member",
+			"manager": "manager@example.com",
+		},
+	}
+
+	require.NoError(t, appeal.ApplyPolicy(policy))
+	require.NoError(t, appeal.AdvanceApproval(policy))
+
+	assert.Equal(t, domain.ApprovalStatusSkipped, appeal.Approvals[0].Status)
+	assert.Equal(t, domain.ApprovalStatusSkipped, appeal.Approvals[1].Status)
+	assert.Equal(t, domain.ApprovalStatusPending, appeal.Approvals[2].Status)
+	assert.Equal(t, []string{"manager@example.com", "owner@example.com"}, appeal.Approvals[2].Approvers)
+	assert.Equal(t, domain.AppealStatusPending, appeal.Status)
+}
+
+func TestAppeal_UnblockNextApprovals(t *testing.T) {
+	tests := []struct {
+		name           string
+		approvals      []*domain.Approval
+		index          int
+		expectedStatus []string
+	}{
+		{
+			name: "should unblock the next step",
+			approvals: []*domain.Approval{
+				{Status: domain.ApprovalStatusApproved},
+				{Status: domain.ApprovalStatusBlocked},
+				{Status: domain.ApprovalStatusBlocked},
+			},
+			index:          0,
+			expectedStatus: []string{"approved", "pending", "blocked"},
+		},
+		{
+			name: "should unblock every step of the next parallel group",
+			approvals: []*domain.Approval{
+				{Status: domain.ApprovalStatusSkipped},
+				{Status: domain.ApprovalStatusBlocked, ParallelGroup: "review"},
+				{Status: domain.ApprovalStatusBlocked, ParallelGroup: "review"},
+				{Status: domain.ApprovalStatusBlocked},
+			},
+			index:          0,
+			expectedStatus: []string{"skipped", "pending", "pending", "blocked"},
+		},
+		{
+			name: "should keep the next steps blocked until the whole parallel group is resolved",
+			approvals: []*domain.Approval{
+				{Status: domain.ApprovalStatusPending, ParallelGroup: "review"},
+				{Status: domain.ApprovalStatusApproved, ParallelGroup: "review"},
+				{Status: domain.ApprovalStatusBlocked},
+			},
+			index:          1,
+			expectedStatus: []string{"pending", "approved", "blocked"},
+		},
+		{
+			name: "should unblock the next steps once the whole parallel group is resolved",
+			approvals: []*domain.Approval{
+				{Status: domain.ApprovalStatusApproved, ParallelGroup: "review"},
+				{Status: domain.ApprovalStatusApproved, ParallelGroup: "review"},
+				{Status: domain.ApprovalStatusBlocked},
+			},
+			index:          0,
+			expectedStatus: []string{"approved", "approved", "pending"},
+		},
+		{
+			name: "should do nothing if the step is not resolved",
+			approvals: []*domain.Approval{
+				{Status: domain.ApprovalStatusPending},
+				{Status: domain.ApprovalStatusBlocked},
+			},
+			index:          0,
+			expectedStatus: []string{"pending", "blocked"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			appeal := &domain.Appeal{Approvals: tt.approvals}
+
+			appeal.UnblockNextApprovals(tt.index)
+
+			var statuses []string
+			for _, a := range appeal.Approvals {
+				statuses = append(statuses, a.Status)
+			}
+			assert.Equal(t, tt.expectedStatus, statuses)
+		})
+	}
+}
+
 func TestAppeal_ApplyPolicy(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -708,6 +1076,48 @@ func TestAppeal_ApplyPolicy(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:   "should set every step of the first parallel group as pending",
+			appeal: &domain.Appeal{},
+			policy: &domain.Policy{
+				Steps: []*domain.Step{
+					{
+						Strategy:      domain.ApprovalStepStrategyManual,
+						Approvers:     []string{"security@example.com"},
+						ParallelGroup: "review",
+					},
+					{
+						Strategy:      domain.ApprovalStepStrategyManual,
+						Approvers:     []string{"owner@example.com"},
+						ParallelGroup: "review",
+					},
+					{
+						Strategy:  domain.ApprovalStepStrategyManual,
+						Approvers: []string{"john.doe@example.com"},
+					},
+				},
+			},
+			wantApprovals: []*domain.Approval{
+				{
+					Index:         0,
+					Status:        domain.ApprovalStatusPending,
+					Approvers:     []string{"security@example.com"},
+					ParallelGroup: "review",
+				},
+				{
+					Index:         1,
+					Status:        domain.ApprovalStatusPending,
+					Approvers:     []string{"owner@example.com"},
+					ParallelGroup: "review",
+				},
+				{
+					Index:     2,
+					Status:    domain.ApprovalStatusBlocked,
+					Approvers: []string{"john.doe@example.com"},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name:   "should return error if failed to resolve approvers",
 			appeal: &domain.Appeal{},
@@ -731,3 +1141,82 @@ func TestAppeal_ApplyPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestAppeal_GetApprovalPendingSince(t *testing.T) {
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	decidedAt := createdAt.Add(2 * time.Hour)
+	escalatedAt := createdAt.Add(5 * time.Hour)
+
+	tests := []struct {
+		name          string
+		appeal        domain.Appeal
+		approvalIndex int
+		want          time.Time
+	}{
+		{
+			name: "should return appeal creation time for the first step",
+			appeal: domain.Appeal{
+				CreatedAt: createdAt,
+				Approvals: []*domain.Approval{{Index: 0}},
+			},
+			approvalIndex: 0,
+			want:          createdAt,
+		},
+		{
+			name: "should return the last decision time of the previous steps",
+			appeal: domain.Appeal{
+				CreatedAt: createdAt,
+				Approvals: []*domain.Approval{
+					{Index: 0, Decisions: []*domain.ApprovalDecision{{CreatedAt: createdAt.Add(time.Hour)}, {CreatedAt: decidedAt}}},
+					{Index: 1},
+				},
+			},
+			approvalIndex: 1,
+			want:          decidedAt,
+		},
+		{
+			name: "should return escalation time if the approval has been escalated",
+			appeal: domain.Appeal{
+				CreatedAt: createdAt,
+				Approvals: []*domain.Approval{
+					{Index: 0, Decisions: []*domain.ApprovalDecision{{CreatedAt: decidedAt}}},
+					{Index: 1, EscalatedAt: &escalatedAt},
+				},
+			},
+			approvalIndex: 1,
+			want:          escalatedAt,
+		},
+		{
+			name: "should ignore decisions on the steps of the same parallel group",
+			appeal: domain.Appeal{
+				CreatedAt: createdAt,
+				Approvals: []*domain.Approval{
+					{Index: 0, Decisions: []*domain.ApprovalDecision{{CreatedAt: decidedAt}}},
+					{Index: 1, ParallelGroup: "review", Decisions: []*domain.ApprovalDecision{{CreatedAt: escalatedAt}}},
+					{Index: 2, ParallelGroup: "review"},
+				},
+			},
+			approvalIndex: 2,
+			want:          decidedAt,
+		},
+		{
+			name: "should return the resolution time of the previous auto-approved or skipped steps",
+			appeal: domain.Appeal{
+				CreatedAt: createdAt,
+				Approvals: []*domain.Approval{
+					{Index: 0, Status: domain.ApprovalStatusApproved, Decisions: []*domain.ApprovalDecision{{CreatedAt: createdAt.Add(time.Hour)}}, UpdatedAt: escalatedAt},
+					{Index: 1, Status: domain.ApprovalStatusSkipped, UpdatedAt: decidedAt},
+					{Index: 2},
+				},
+			},
+			approvalIndex: 2,
+			want:          decidedAt,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			approval := tt.appeal.Approvals[tt.approvalIndex]
+			assert.Equal(t, tt.want, tt.appeal.GetApprovalPendingSince(approval))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"time"
 )
 
@@ -12,6 +13,32 @@ const (
 	ApprovalStatusRejected = "rejected"
 )
 
+var (
+	ErrApproverAlreadyDecided = errors.New("approver has already submitted a decision for this approval")
+	ErrInsufficientApprovers  = errors.New("number of approvers is less than the minimum approvals")
+	ErrNoApproversLeft        = errors.New("no approvers left after excluding the appeal creator")
+
+	ErrSelfApprovalNotAllowed     = errors.New("approving own appeal is not allowed by the policy")
+	ErrRepeatedApproverNotAllowed = errors.New("approving more than one step of the same appeal is not allowed by the policy")
+)
+
+// ApprovalDecision is an individual approver's response on an approval step
+type ApprovalDecision struct {
+	Actor      string    `json:"actor" yaml:"actor"`
+	OnBehalfOf string    `json:"on_behalf_of,omitempty" yaml:"on_behalf_of,omitempty"`
+	Action     string    `json:"action" yaml:"action"`
+	Reason     string    `json:"reason,omitempty" yaml:"reason,omitempty"`
+	CreatedAt  time.Time `json:"created_at" yaml:"created_at"`
+}
+
+// approver returns the identity counted towards the quorum. Decisions made by a delegate count for the delegator.
+func (d *ApprovalDecision) approver() string {
+	if d.OnBehalfOf != "" {
+		return d.OnBehalfOf
+	}
+	return d.Actor
+}
+
 type Approval struct {
 	ID            string  `json:"id" yaml:"id"`
 	Name          string  `json:"name" yaml:"name"`
@@ -23,6 +50,16 @@ type Approval struct {
 	PolicyID      string  `json:"policy_id" yaml:"policy_id"`
 	PolicyVersion uint    `json:"policy_version" yaml:"policy_version"`
 
+	// ParallelGroup is the parallel group of the step, copied from Step.ParallelGroup
+	ParallelGroup string `json:"parallel_group,omitempty" yaml:"parallel_group,omitempty"`
+
+	// MinApprovals is the number of distinct approvers required to approve the step, copied from Step.MinApprovals
+	MinApprovals int                 `json:"min_approvals,omitempty" yaml:"min_approvals,omitempty"`
+	Decisions    []*ApprovalDecision `json:"decisions,omitempty" yaml:"decisions,omitempty"`
+
+	// EscalatedAt is the time the step was escalated after passing its timeout
+	EscalatedAt *time.Time `json:"escalated_at,omitempty" yaml:"escalated_at,omitempty"`
+
 	Approvers []string `json:"approvers,omitempty" yaml:"approvers,omitempty"`
 	Appeal    *Appeal  `json:"appeal,omitempty" yaml:"appeal,omitempty"`
 
@@ -42,10 +79,100 @@ func (a *Approval) Skip() {
 	a.Status = ApprovalStatusSkipped
 }
 
+// IsResolved returns true if the step no longer holds the next steps back
+func (a *Approval) IsResolved() bool {
+	return a.Status == ApprovalStatusApproved || a.Status == ApprovalStatusSkipped
+}
+
+// IsParallelWith returns true if both steps belong to the same parallel group
+func (a *Approval) IsParallelWith(other *Approval) bool {
+	return a.ParallelGroup != "" && a.ParallelGroup == other.ParallelGroup
+}
+
 func (a *Approval) IsManualApproval() bool {
 	return len(a.Approvers) > 0
 }
 
+// AddDecision records the decision of an approver. Each approver can only decide once.
+func (a *Approval) AddDecision(d *ApprovalDecision) error {
+	for _, existing := range a.Decisions {
+		if existing.approver() == d.approver() {
+			return ErrApproverAlreadyDecided
+		}
+	}
+	a.Decisions = append(a.Decisions, d)
+	return nil
+}
+
+// GetRequiredApprovals returns the quorum of the approval, at least one
+func (a *Approval) GetRequiredApprovals() int {
+	if a.MinApprovals < 1 {
+		return 1
+	}
+	return a.MinApprovals
+}
+
+// CountDecisions returns the number of distinct approvers that responded with the given action
+func (a *Approval) CountDecisions(action string) int {
+	count := 0
+	for _, d := range a.Decisions {
+		if d.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+// IsQuorumReached returns true if enough distinct approvers have approved
+func (a *Approval) IsQuorumReached() bool {
+	return a.CountDecisions(AppealActionNameApprove) >= a.GetRequiredApprovals()
+}
+
+// IsQuorumReachable returns false if the remaining approvers can no longer fulfill the quorum
+func (a *Approval) IsQuorumReachable() bool {
+	decided := map[string]bool{}
+	for _, d := range a.Decisions {
+		decided[d.approver()] = true
+	}
+	undecided := 0
+	for _, approver := range a.Approvers {
+		if !decided[approver] {
+			undecided++
+		}
+	}
+	return a.CountDecisions(AppealActionNameApprove)+undecided >= a.GetRequiredApprovals()
+}
+
+// isApprovedBy returns true if the user, or their delegate, approved the step. Approvals decided before decisions
+// were recorded only hold the actor.
+func (a *Approval) isApprovedBy(user string) bool {
+	for _, d := range a.Decisions {
+		if d.Action == AppealActionNameApprove && (d.Actor == user || d.OnBehalfOf == user) {
+			return true
+		}
+	}
+	return len(a.Decisions) == 0 && a.Status == ApprovalStatusApproved && a.Actor != nil && *a.Actor == user
+}
+
+const (
+	// ExternalApprovalDecisionPending is returned by approval webhooks that decide asynchronously. The decision is
+	// sent later through the external approval callback.
+	ExternalApprovalDecisionPending = "pending"
+)
+
+// ExternalApprovalRequest is the payload sent to the approval webhook of an external step
+type ExternalApprovalRequest struct {
+	AppealID     string  `json:"appeal_id"`
+	ApprovalName string  `json:"approval_name"`
+	Appeal       *Appeal `json:"appeal"`
+}
+
+// ExternalApprovalResponse is the decision of an approval webhook on an external step
+type ExternalApprovalResponse struct {
+	Decision string `json:"decision" validate:"required,oneof=approve reject pending"`
+	Reason   string `json:"reason,omitempty"`
+}
+
 type ListApprovalsFilter struct {
 	Q              string   `mapstructure:"q" validate:"omitempty"`
 	AccountID      string   `mapstructure:"account_id" validate:"omitempty,required"`
@@ -57,4 +184,6 @@ type ListApprovalsFilter struct {
 	Size           int      `mapstructure:"size" validate:"omitempty"`
 	Offset         int      `mapstructure:"offset" validate:"omitempty"`
 	AppealStatuses []string `mapstructure:"appeal_statuses" validate:"omitempty,min=1"`
+	// Delegations to the CreatedBy approver, whose delegators' approvals within their scope are listed as well
+	Delegations []*Delegation `mapstructure:"delegations" validate:"omitempty"`
 }
@@ -0,0 +1,104 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApproval_AddDecision(t *testing.T) {
+	t.Run("should return error if approver already decided", func(t *testing.T) {
+		a := &domain.Approval{
+			Decisions: []*domain.ApprovalDecision{
+				{Actor: "user@example.com", Action: domain.AppealActionNameApprove},
+			},
+		}
+
+		err := a.AddDecision(&domain.ApprovalDecision{Actor: "user@example.com", Action: domain.AppealActionNameReject})
+
+		assert.ErrorIs(t, err, domain.ErrApproverAlreadyDecided)
+		assert.Len(t, a.Decisions, 1)
+	})
+
+	t.Run("should count delegate decision towards the delegator", func(t *testing.T) {
+		a := &domain.Approval{
+			Decisions: []*domain.ApprovalDecision{
+				{Actor: "user@example.com", Action: domain.AppealActionNameApprove},
+			},
+		}
+
+		err := a.AddDecision(&domain.ApprovalDecision{
+			Actor:      "substitute@example.com",
+			OnBehalfOf: "user@example.com",
+			Action:     domain.AppealActionNameApprove,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrApproverAlreadyDecided)
+	})
+
+	t.Run("should append decision", func(t *testing.T) {
+		a := &domain.Approval{}
+
+		err := a.AddDecision(&domain.ApprovalDecision{Actor: "user@example.com", Action: domain.AppealActionNameApprove})
+
+		assert.NoError(t, err)
+		assert.Len(t, a.Decisions, 1)
+	})
+}
+
+func TestApproval_Quorum(t *testing.T) {
+	approvers := []string{"a@example.com", "b@example.com", "c@example.com"}
+	approve := func(actor string) *domain.ApprovalDecision {
+		return &domain.ApprovalDecision{Actor: actor, Action: domain.AppealActionNameApprove}
+	}
+	reject := func(actor string) *domain.ApprovalDecision {
+		return &domain.ApprovalDecision{Actor: actor, Action: domain.AppealActionNameReject}
+	}
+
+	tests := []struct {
+		name              string
+		approval          domain.Approval
+		wantReached       bool
+		wantStillPossible bool
+	}{
+		{
+			name:              "should require a single approval by default",
+			approval:          domain.Approval{Approvers: approvers, Decisions: []*domain.ApprovalDecision{approve("a@example.com")}},
+			wantReached:       true,
+			wantStillPossible: true,
+		},
+		{
+			name:              "should not be reached with fewer approvals than the minimum",
+			approval:          domain.Approval{Approvers: approvers, MinApprovals: 2, Decisions: []*domain.ApprovalDecision{approve("a@example.com")}},
+			wantReached:       false,
+			wantStillPossible: true,
+		},
+		{
+			name:              "should be reached with the minimum approvals",
+			approval:          domain.Approval{Approvers: approvers, MinApprovals: 2, Decisions: []*domain.ApprovalDecision{approve("a@example.com"), reject("b@example.com"), approve("c@example.com")}},
+			wantReached:       true,
+			wantStillPossible: true,
+		},
+		{
+			name:              "should not be reachable if too many approvers rejected",
+			approval:          domain.Approval{Approvers: approvers, MinApprovals: 2, Decisions: []*domain.ApprovalDecision{reject("a@example.com"), reject("b@example.com")}},
+			wantReached:       false,
+			wantStillPossible: false,
+		},
+		{
+			name: "should count the rejection of a delegate as the decision of the delegator",
+			approval: domain.Approval{Approvers: []string{"a@example.com", "b@example.com"}, MinApprovals: 2, Decisions: []*domain.ApprovalDecision{
+				{Actor: "delegate@example.com", OnBehalfOf: "a@example.com", Action: domain.AppealActionNameReject},
+			}},
+			wantReached:       false,
+			wantStillPossible: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantReached, tt.approval.IsQuorumReached())
+			assert.Equal(t, tt.wantStillPossible, tt.approval.IsQuorumReachable())
+		})
+	}
+}
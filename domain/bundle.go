@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	BundleAppealStatusPending           = "pending"
+	BundleAppealStatusApproved          = "approved"
+	BundleAppealStatusPartiallyApproved = "partially_approved"
+	BundleAppealStatusRejected          = "rejected"
+	BundleAppealStatusExpired           = "expired"
+	BundleAppealStatusCanceled          = "canceled"
+)
+
+// Bundle is a named set of accesses that can be requested together, e.g. everything a new engineer needs on their
+// first day. Creating an appeal against a bundle fans out into one appeal for each item.
+type Bundle struct {
+	ID          string `json:"id" yaml:"id"`
+	Name        string `json:"name" yaml:"name" validate:"required"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// RequiredPolicy is checked against the policy of each appeal of the bundle. It doesn't override the policy
+	// configured for the resource in the provider config, appeals are rejected if it is a different policy.
+	RequiredPolicy *PolicyConfig     `json:"required_policy,omitempty" yaml:"required_policy,omitempty" validate:"omitempty"`
+	Items          []*BundleItem     `json:"items" yaml:"items" validate:"required,min=1,dive"`
+	Labels         map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	CreatedBy      string            `json:"created_by" yaml:"created_by"`
+
+	CreatedAt time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+}
+
+type BundleItem struct {
+	Resource *ResourceIdentifier `json:"resource" yaml:"resource" validate:"required"`
+	Role     string              `json:"role" yaml:"role" validate:"required"`
+	// Duration of the access, e.g. "720h". Empty or "0h" requests a permanent access.
+	Duration string `json:"duration,omitempty" yaml:"duration,omitempty"`
+}
+
+// ToAppeal creates the appeal of the bundle item based on the appeal requested against the bundle
+func (i *BundleItem) ToAppeal(b *Bundle, r *Resource, a *Appeal) *Appeal {
+	appeal := &Appeal{
+		ResourceID:  r.ID,
+		Resource:    r,
+		AccountID:   a.AccountID,
+		AccountType: a.AccountType,
+		CreatedBy:   a.CreatedBy,
+		Role:        i.Role,
+		Details:     a.Details,
+		Description: a.Description,
+		BundleID:    b.ID,
+	}
+	if i.Duration != "" {
+		appeal.Options = &AppealOptions{
+			Duration: i.Duration,
+		}
+	}
+	return appeal
+}
+
+// GetBundleAppealStatus rolls up the statuses of the appeals created for a bundle. Appeals waiting for more
+// information are still pending. Without any approved appeal, a rejected appeal rejects the bundle, otherwise the
+// bundle is canceled if all of its appeals are canceled, and expired if the rest of them expired.
+func GetBundleAppealStatus(appeals []*Appeal) string {
+	var approved, rejected, expired, canceled int
+	for _, a := range appeals {
+		switch a.Status {
+		case AppealStatusPending, AppealStatusNeedsInfo:
+			return BundleAppealStatusPending
+		case AppealStatusApproved:
+			approved++
+		case AppealStatusRejected:
+			rejected++
+		case AppealStatusExpired:
+			expired++
+		case AppealStatusCanceled:
+			canceled++
+		}
+	}
+
+	switch {
+	case approved == len(appeals):
+		return BundleAppealStatusApproved
+	case approved > 0:
+		return BundleAppealStatusPartiallyApproved
+	case rejected > 0:
+		return BundleAppealStatusRejected
+	case canceled == len(appeals):
+		return BundleAppealStatusCanceled
+	case expired+canceled == len(appeals):
+		return BundleAppealStatusExpired
+	default:
+		return BundleAppealStatusRejected
+	}
+}
+
+// GetBundleItemKey identifies the bundle item an appeal was created for
+func GetBundleItemKey(resourceID, role string) string {
+	return fmt.Sprintf("%s:%s", resourceID, role)
+}
+
+type ListBundlesFilter struct {
+	Names     []string
+	CreatedBy string
+}
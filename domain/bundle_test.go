@@ -0,0 +1,75 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBundleAppealStatus(t *testing.T) {
+	appeals := func(statuses ...string) []*domain.Appeal {
+		var result []*domain.Appeal
+		for _, s := range statuses {
+			result = append(result, &domain.Appeal{Status: s})
+		}
+		return result
+	}
+
+	tests := []struct {
+		name    string
+		appeals []*domain.Appeal
+		want    string
+	}{
+		{
+			name:    "should be pending if any appeal is still pending",
+			appeals: appeals(domain.AppealStatusApproved, domain.AppealStatusPending, domain.AppealStatusRejected),
+			want:    domain.BundleAppealStatusPending,
+		},
+		{
+			name:    "should be pending if any appeal needs more information",
+			appeals: appeals(domain.AppealStatusApproved, domain.AppealStatusNeedsInfo),
+			want:    domain.BundleAppealStatusPending,
+		},
+		{
+			name:    "should be approved if all appeals are approved",
+			appeals: appeals(domain.AppealStatusApproved, domain.AppealStatusApproved),
+			want:    domain.BundleAppealStatusApproved,
+		},
+		{
+			name:    "should be partially approved if some appeals are approved",
+			appeals: appeals(domain.AppealStatusApproved, domain.AppealStatusCanceled),
+			want:    domain.BundleAppealStatusPartiallyApproved,
+		},
+		{
+			name:    "should be rejected if no appeal is approved",
+			appeals: appeals(domain.AppealStatusRejected, domain.AppealStatusCanceled),
+			want:    domain.BundleAppealStatusRejected,
+		},
+		{
+			name:    "should be rejected if any appeal is rejected and none is approved",
+			appeals: appeals(domain.AppealStatusExpired, domain.AppealStatusRejected),
+			want:    domain.BundleAppealStatusRejected,
+		},
+		{
+			name:    "should be canceled if all appeals are canceled",
+			appeals: appeals(domain.AppealStatusCanceled, domain.AppealStatusCanceled),
+			want:    domain.BundleAppealStatusCanceled,
+		},
+		{
+			name:    "should be expired if all appeals are expired",
+			appeals: appeals(domain.AppealStatusExpired, domain.AppealStatusExpired),
+			want:    domain.BundleAppealStatusExpired,
+		},
+		{
+			name:    "should be expired if the appeals are either expired or canceled",
+			appeals: appeals(domain.AppealStatusExpired, domain.AppealStatusCanceled),
+			want:    domain.BundleAppealStatusExpired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, domain.GetBundleAppealStatus(tt.appeals))
+		})
+	}
+}
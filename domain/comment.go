@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// Comment is a message posted on an appeal by its creator, approvers or anyone reviewing the access request
+type Comment struct {
+	ID        string `json:"id" yaml:"id"`
+	AppealID  string `json:"appeal_id" yaml:"appeal_id" validate:"required"`
+	CreatedBy string `json:"created_by" yaml:"created_by" validate:"required"`
+	Body      string `json:"body" yaml:"body" validate:"required"`
+
+	CreatedAt time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+}
+
+type ListCommentsFilter struct {
+	AppealID string
+	// OrderBy accepts "created_at" or "created_at:desc". Comments are sorted from the oldest by default.
+	OrderBy []string
+}
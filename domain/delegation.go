@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// Delegation hands over the approval rights of the delegator to the delegate for a time window,
+// e.g. while the delegator is out of office. The scope can optionally be narrowed down to a
+// policy and/or a provider.
+type Delegation struct {
+	ID           string    `json:"id" yaml:"id"`
+	Delegator    string    `json:"delegator" yaml:"delegator" validate:"required,email"`
+	Delegate     string    `json:"delegate" yaml:"delegate" validate:"required,email,nefield=Delegator"`
+	StartDate    time.Time `json:"start_date" yaml:"start_date" validate:"required"`
+	EndDate      time.Time `json:"end_date" yaml:"end_date" validate:"required,gtfield=StartDate"`
+	PolicyID     string    `json:"policy_id,omitempty" yaml:"policy_id,omitempty"`
+	ProviderType string    `json:"provider_type,omitempty" yaml:"provider_type,omitempty"`
+	ProviderURN  string    `json:"provider_urn,omitempty" yaml:"provider_urn,omitempty"`
+	Reason       string    `json:"reason,omitempty" yaml:"reason,omitempty"`
+	CreatedBy    string    `json:"created_by" yaml:"created_by"`
+
+	CreatedAt time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+}
+
+// IsActive returns true if t is within the delegation time window
+func (d *Delegation) IsActive(t time.Time) bool {
+	return !t.Before(d.StartDate) && t.Before(d.EndDate)
+}
+
+// Covers returns true if the delegation scope includes the given policy and provider.
+// Empty scope fields match anything.
+func (d *Delegation) Covers(policyID, providerType, providerURN string) bool {
+	if d.PolicyID != "" && d.PolicyID != policyID {
+		return false
+	}
+	if d.ProviderType != "" && d.ProviderType != providerType {
+		return false
+	}
+	if d.ProviderURN != "" && d.ProviderURN != providerURN {
+		return false
+	}
+	return true
+}
+
+type ListDelegationsFilter struct {
+	Delegators []string
+	Delegates  []string
+	ActiveAt   *time.Time
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"sort"
 	"strings"
 	"time"
@@ -13,9 +14,14 @@ type GrantSource string
 const (
 	GrantStatusActive   GrantStatus = "active"
 	GrantStatusInactive GrantStatus = "inactive"
+	// GrantStatusScheduled is for grants of approved appeals with a future start date, the access is given once the
+	// start date is reached
+	GrantStatusScheduled GrantStatus = "scheduled"
 
 	GrantSourceAppeal GrantSource = "appeal"
 	GrantSourceImport GrantSource = "import"
+	// GrantSourceBreakGlass marks grants given immediately through break-glass appeals, pending a post-hoc review
+	GrantSourceBreakGlass GrantSource = "break_glass"
 
 	GrantExpirationReasonDormant = "grant/access hasn't been used for a while"
 )
@@ -30,6 +36,7 @@ type Grant struct {
 	Role                    string      `json:"role" yaml:"role"`
 	Permissions             []string    `json:"permissions" yaml:"permissions"`
 	IsPermanent             bool        `json:"is_permanent" yaml:"is_permanent"`
+	StartDate               *time.Time  `json:"start_date,omitempty" yaml:"start_date,omitempty"`
 	ExpirationDate          *time.Time  `json:"expiration_date" yaml:"expiration_date"`
 	RequestedExpirationDate *time.Time  `json:"requested_expiration_date,omitempty" yaml:"requested_expiration_date,omitempty"`
 	ExpirationDateReason    string      `json:"expiration_date_reason,omitempty" yaml:"expiration_date_reason,omitempty"`
@@ -48,6 +55,25 @@ type Grant struct {
 	Activities []*Activity `json:"activities,omitempty" yaml:"activities,omitempty"`
 }
 
+// toAppeal represents the grant as an appeal so that appeal matchers, e.g. RequirementTrigger, can be evaluated
+// against it
+func (g Grant) toAppeal() *Appeal {
+	resource := g.Resource
+	if resource == nil {
+		resource = &Resource{ID: g.ResourceID}
+	}
+	return &Appeal{
+		ID:          g.AppealID,
+		ResourceID:  g.ResourceID,
+		Resource:    resource,
+		AccountID:   g.AccountID,
+		AccountType: g.AccountType,
+		CreatedBy:   g.Owner,
+		Role:        g.Role,
+		Permissions: g.Permissions,
+	}
+}
+
 func (g Grant) PermissionsKey() string {
 	permissions := make([]string, len(g.Permissions))
 	copy(permissions, g.Permissions)
@@ -78,6 +104,19 @@ func (g *Grant) Revoke(actor, reason string) error {
 	return nil
 }
 
+// Activate marks a scheduled grant as active
+func (g *Grant) Activate() error {
+	if g == nil {
+		return errors.New("grant is nil")
+	}
+	if g.Status != GrantStatusScheduled {
+		return fmt.Errorf("unable to activate grant with status %q", g.Status)
+	}
+
+	g.Status = GrantStatusActive
+	return nil
+}
+
 func (g *Grant) GetPermissions() []string {
 	var permissions []string
 	for _, p := range g.Permissions {
@@ -104,6 +143,7 @@ type ListGrantsFilter struct {
 	ExpirationDateGreaterThan time.Time
 	IsPermanent               *bool
 	CreatedAtLte              time.Time
+	StartDateLessThan         time.Time
 	Size                      int    `mapstructure:"size" validate:"omitempty"`
 	Offset                    int    `mapstructure:"offset" validate:"omitempty"`
 	Q                         string `mapstructure:"q" validate:"omitempty"`
@@ -167,3 +207,96 @@ func (c DormancyCheckCriteria) Validate() error {
 	}
 	return nil
 }
+
+type GrantDriftMode string
+
+const (
+	// GrantDriftModeReport only reports the drift without changing anything
+	GrantDriftModeReport GrantDriftMode = "report"
+	// GrantDriftModeImport records out-of-band access as imported grants
+	GrantDriftModeImport GrantDriftMode = "import"
+	// GrantDriftModeRevoke revokes out-of-band access from the provider
+	GrantDriftModeRevoke GrantDriftMode = "revoke"
+	// GrantDriftModeReapply gives the access of missing grants back in the provider
+	GrantDriftModeReapply GrantDriftMode = "reapply"
+)
+
+func (m GrantDriftMode) IsValid() bool {
+	switch m {
+	case GrantDriftModeReport, GrantDriftModeImport, GrantDriftModeRevoke, GrantDriftModeReapply:
+		return true
+	}
+	return false
+}
+
+type GrantDriftCheckCriteria struct {
+	ProviderID string
+	Mode       GrantDriftMode
+}
+
+func (c GrantDriftCheckCriteria) Validate() error {
+	if c.ProviderID == "" {
+		return errors.New("provider id is required")
+	}
+	if !c.Mode.IsValid() {
+		return fmt.Errorf("invalid mode %q", c.Mode)
+	}
+	return nil
+}
+
+// GrantDrift is the difference between the access in a provider and the active grants in guardian
+type GrantDrift struct {
+	ProviderURN string
+	Mode        GrantDriftMode
+	// OutOfBandGrants are access present in the provider without any active grant in guardian
+	OutOfBandGrants []*Grant
+	// MissingGrants are active grants in guardian whose access is absent in the provider
+	MissingGrants []*Grant
+	// RemediatedGrants are the grants successfully imported, revoked or re-applied according to the mode
+	RemediatedGrants []*Grant
+}
+
+func (d GrantDrift) HasDrift() bool {
+	return len(d.OutOfBandGrants) > 0 || len(d.MissingGrants) > 0
+}
+
+type GrantRecommendationType string
+
+const (
+	// GrantRecommendationTypeDowngrade suggests replacing the grant with a narrower role
+	GrantRecommendationTypeDowngrade GrantRecommendationType = "downgrade"
+	// GrantRecommendationTypeRevoke suggests revoking the grant
+	GrantRecommendationTypeRevoke GrantRecommendationType = "revoke"
+)
+
+type ListGrantRecommendationsFilter struct {
+	ProviderID  string
+	Period      time.Duration
+	AccountIDs  []string
+	ResourceIDs []string
+}
+
+func (f ListGrantRecommendationsFilter) Validate() error {
+	if f.ProviderID == "" {
+		return errors.New("provider id is required")
+	}
+	if f.Period == 0 {
+		return errors.New("period is required")
+	} else if f.Period < 0 {
+		return errors.New("period must be positive")
+	}
+	return nil
+}
+
+// GrantRecommendation is a least-privilege suggestion for an active grant based on the activities of its account
+type GrantRecommendation struct {
+	Grant *Grant
+	Type  GrantRecommendationType
+	// RecommendedRole is the narrower role of a downgrade recommendation
+	RecommendedRole        string
+	RecommendedPermissions []string
+	// UsedPermissions are the grant permissions related to the activities within the period
+	UsedPermissions []string
+	ActivitiesCount int
+	Reason          string
+}
@@ -9,6 +9,13 @@ type NotificationMessages struct {
 	OthersAppealApproved string `mapstructure:"others_appeal_approved"`
 	GrantOwnerChanged    string `mapstructure:"grant_owner_changed"`
 	UnusedGrant          string `mapstructure:"unused_grant"`
+	ApprovalEscalated    string `mapstructure:"approval_escalated"`
+	AppealExpired        string `mapstructure:"appeal_expired"`
+	BreakGlassReview     string `mapstructure:"break_glass_review"`
+	AppealNeedsInfo      string `mapstructure:"appeal_needs_info"`
+	NewComment           string `mapstructure:"new_comment"`
+	GrantDriftSummary    string `mapstructure:"grant_drift_summary"`
+	GrantRecommendation  string `mapstructure:"grant_recommendation"`
 }
 
 const (
@@ -20,6 +27,13 @@ const (
 	NotificationTypeApproverNotification   = "ApproverNotification"
 	NotificationTypeGrantOwnerChanged      = "GrantOwnerChanged"
 	NotificationTypeUnusedGrant            = "UnusedGrant"
+	NotificationTypeApprovalEscalated      = "ApprovalEscalated"
+	NotificationTypeAppealExpired          = "AppealExpired"
+	NotificationTypeBreakGlassReview       = "BreakGlassReview"
+	NotificationTypeAppealNeedsInfo        = "AppealNeedsInfo"
+	NotificationTypeNewComment             = "NewComment"
+	NotificationTypeGrantDriftSummary      = "GrantDriftSummary"
+	NotificationTypeGrantRecommendation    = "GrantRecommendation"
 )
 
 type NotificationMessage struct {
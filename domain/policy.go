@@ -18,6 +18,9 @@ import (
 
 const (
 	ApproversKeyResource = "$resource"
+
+	DefaultBreakGlassMaxDuration = time.Hour
+	DefaultMaxScheduleAhead      = 30 * 24 * time.Hour
 )
 
 var (
@@ -30,6 +33,15 @@ type ApprovalStepStrategy string
 const (
 	ApprovalStepStrategyAuto   ApprovalStepStrategy = "auto"
 	ApprovalStepStrategyManual ApprovalStepStrategy = "manual"
+	// ApprovalStepStrategyExternal resolves the step by calling an approval webhook configured in the server
+	ApprovalStepStrategyExternal ApprovalStepStrategy = "external"
+)
+
+type StepTimeoutAction string
+
+const (
+	StepTimeoutActionReject StepTimeoutAction = "reject"
+	StepTimeoutActionSkip   StepTimeoutAction = "skip"
 )
 
 // MatchCondition is for determining the requirement of the condition
@@ -88,7 +100,7 @@ type Step struct {
 	When string `json:"when,omitempty" yaml:"when,omitempty"`
 
 	// Strategy defines if the step requires manual approval or not
-	Strategy ApprovalStepStrategy `json:"strategy" yaml:"strategy" validate:"required,oneof=auto manual"`
+	Strategy ApprovalStepStrategy `json:"strategy" yaml:"strategy" validate:"required,oneof=auto manual external"`
 
 	// RejectionReason message fills `Approval.Reason` if the approval step gets rejected based on `ApproveIf` expression.
 	RejectionReason string `json:"rejection_reason" yaml:"rejection_reason"`
@@ -106,16 +118,94 @@ type Step struct {
 	// Accessible parameters:
 	// $appeal = Appeal object
 	ApproveIf string `json:"approve_if,omitempty" yaml:"approve_if,omitempty" validate:"required_if=Strategy auto"`
+
+	// Webhook is the name of the approval webhook called to resolve the step. Required if the strategy is external.
+	Webhook string `json:"webhook,omitempty" yaml:"webhook,omitempty" validate:"required_if=Strategy external"`
+
+	// MinApprovals is the number of distinct approvers required before a manual step is approved.
+	// Defaults to one, meaning the first approver who acts resolves the step.
+	MinApprovals int `json:"min_approvals,omitempty" yaml:"min_approvals,omitempty" validate:"omitempty,min=1"`
+
+	// FailOnAnyRejection rejects the step as soon as any approver rejects it, even if MinApprovals is still reachable.
+	// Only applies when MinApprovals is greater than one.
+	FailOnAnyRejection bool `json:"fail_on_any_rejection,omitempty" yaml:"fail_on_any_rejection,omitempty"`
+
+	// Timeout is the maximum duration the step can stay pending before it is considered overdue, e.g. "24h".
+	// Overdue steps are handled by the approval step timeout job.
+	Timeout string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	// EscalateTo is a list of email or Expression of the approvers added to the step once it is overdue.
+	// The timeout starts over after the escalation, then OnTimeout applies.
+	//
+	// Accessible parameters:
+	// $appeal = Appeal object
+	EscalateTo []string `json:"escalate_to,omitempty" yaml:"escalate_to,omitempty"`
+
+	// OnTimeout determines the resolution of an overdue step that has nobody left to escalate to.
+	// Possible values are "reject" or "skip". If empty, the step stays pending.
+	OnTimeout StepTimeoutAction `json:"on_timeout,omitempty" yaml:"on_timeout,omitempty" validate:"omitempty,oneof=reject skip"`
+
+	// ParallelGroup lets consecutive steps with the same value be pending at the same time. The steps after the group
+	// are unblocked once every step of the group is approved or skipped. If empty, the step runs on its own.
+	ParallelGroup string `json:"parallel_group,omitempty" yaml:"parallel_group,omitempty"`
+}
+
+// ResolveEscalationApprovers returns the email addresses of the approvers the step escalates to
+func (s Step) ResolveEscalationApprovers(a *Appeal, p *Policy) ([]string, error) {
+	escalation := Step{
+		Name:      s.Name,
+		Strategy:  ApprovalStepStrategyManual,
+		Approvers: s.EscalateTo,
+	}
+	return escalation.ResolveApprovers(a, p)
 }
 
-func (s Step) ResolveApprovers(a *Appeal) ([]string, error) {
+// ResolveApprovers returns the email addresses of the step approvers. If the policy disallows self-approval, the appeal
+// creator and the requested account are left out, and the fallback approvers are used if nobody else is left.
+// Delegates are not part of the approvers, their delegations are resolved when they act on the appeal.
+func (s Step) ResolveApprovers(a *Appeal, p *Policy) ([]string, error) {
 	if s.Strategy != ApprovalStepStrategyManual {
 		return nil, nil
 	}
 
+	lang := p.GetExpressionLanguage()
+	approvers, err := evaluateApprovers(s.Approvers, a, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	var c *PolicyAppealConfig
+	if p != nil {
+		c = p.AppealConfig
+	}
+	disallowSelfApproval := c != nil && c.DisallowSelfApproval
+	if disallowSelfApproval {
+		approvers = a.excludeSelf(approvers)
+		if len(approvers) == 0 && len(s.Approvers) > 0 {
+			fallbackApprovers, err := evaluateApprovers(c.SelfApprovalFallbackApprovers, a, lang)
+			if err != nil {
+				return nil, fmt.Errorf("resolving self-approval fallback approvers: %w", err)
+			}
+			approvers = a.excludeSelf(fallbackApprovers)
+			if len(approvers) == 0 {
+				return nil, fmt.Errorf("%w: step %q", ErrNoApproversLeft, s.Name)
+			}
+		}
+	}
+
+	distinctApprovers := slices.UniqueStringSlice(approvers)
+	if err := validate.Var(distinctApprovers, "dive,email"); err != nil {
+		return nil, err
+	}
+
+	return distinctApprovers, nil
+}
+
+// evaluateApprovers resolves a list of emails and expressions into email addresses
+func evaluateApprovers(exprs []string, a *Appeal, lang evaluator.Language) ([]string, error) {
 	var approvers []string
 
-	for _, expr := range s.Approvers {
+	for _, expr := range exprs {
 		if err := validate.Var(expr, "email"); err == nil {
 			approvers = append(approvers, expr)
 		} else {
@@ -127,7 +217,7 @@ func (s Step) ResolveApprovers(a *Appeal) ([]string, error) {
 				"appeal": appealMap,
 			}
 
-			approversValue, err := evaluator.Expression(expr).EvaluateWithVars(params)
+			approversValue, err := evaluator.New(expr, lang).EvaluateWithVars(params)
 			if err != nil {
 				return nil, fmt.Errorf("evaluating aprrovers expression: %w", err)
 			} else if approversValue == nil {
@@ -154,19 +244,17 @@ func (s Step) ResolveApprovers(a *Appeal) ([]string, error) {
 		}
 	}
 
-	distinctApprovers := slices.UniqueStringSlice(approvers)
-	if err := validate.Var(distinctApprovers, "dive,email"); err != nil {
-		return nil, err
-	}
-
-	return distinctApprovers, nil
+	return approvers, nil
 }
 
 func (s Step) ToApproval(a *Appeal, p *Policy, index int) (*Approval, error) {
-	approvers, err := s.ResolveApprovers(a)
+	approvers, err := s.ResolveApprovers(a, p)
 	if err != nil {
 		return nil, fmt.Errorf("resolving approvers `%s`: %w", s.Approvers, err)
 	}
+	if s.Strategy == ApprovalStepStrategyManual && s.MinApprovals > len(approvers) {
+		return nil, fmt.Errorf("%w: step %q requires %d approvals but only %d approvers resolved", ErrInsufficientApprovers, s.Name, s.MinApprovals, len(approvers))
+	}
 
 	approval := &Approval{
 		Index:         index,
@@ -175,9 +263,11 @@ func (s Step) ToApproval(a *Appeal, p *Policy, index int) (*Approval, error) {
 		PolicyVersion: p.Version,
 		Approvers:     approvers,
 		Status:        ApprovalStatusPending,
+		MinApprovals:  s.MinApprovals,
+		ParallelGroup: s.ParallelGroup,
 	}
 
-	if index > 0 {
+	if index > 0 && !p.isInFirstParallelGroup(index) {
 		approval.Status = ApprovalStatusBlocked
 	}
 
@@ -195,7 +285,15 @@ type RequirementTrigger struct {
 	Expression string       `json:"expression" yaml:"expression" validate:"required_without_all=ProviderType ProviderURN ResourceType ResourceType Role Conditions"`
 }
 
+// IsMatch checks whether the appeal matches the trigger, evaluating Expression as an expr expression unless it has a
+// language prefix
 func (r *RequirementTrigger) IsMatch(a *Appeal) (bool, error) {
+	return r.IsMatchWithLanguage(a, evaluator.LanguageExpr)
+}
+
+// IsMatchWithLanguage checks whether the appeal matches the trigger, evaluating Expression in the given language
+// unless it has a language prefix
+func (r *RequirementTrigger) IsMatchWithLanguage(a *Appeal, lang evaluator.Language) (bool, error) {
 	if r.ProviderType != "" {
 		if match, err := regexp.MatchString(r.ProviderType, a.Resource.ProviderType); err != nil {
 			return match, err
@@ -246,7 +344,7 @@ func (r *RequirementTrigger) IsMatch(a *Appeal) (bool, error) {
 			return false, fmt.Errorf("parsing appeal to map: %w", err)
 		}
 		params := map[string]interface{}{"appeal": appealMap}
-		v, err := evaluator.Expression(r.Expression).EvaluateWithVars(params)
+		v, err := evaluator.New(r.Expression, lang).EvaluateWithVars(params)
 		if err != nil {
 			return false, fmt.Errorf("evaluating expression %q: %w", r.Expression, err)
 		}
@@ -291,9 +389,15 @@ type Policy struct {
 	Requirements []*Requirement      `json:"requirements,omitempty" yaml:"requirements,omitempty" validate:"omitempty,min=1,dive"`
 	Labels       map[string]string   `json:"labels,omitempty" yaml:"labels,omitempty"`
 	IAM          *IAMConfig          `json:"iam,omitempty" yaml:"iam,omitempty" validate:"omitempty,dive"`
-	CreatedBy    string              `json:"created_by" yaml:"created_by"`
-	CreatedAt    time.Time           `json:"created_at,omitempty" yaml:"created_at,omitempty"`
-	UpdatedAt    time.Time           `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+	// ExpressionLanguage is the language of the policy expressions: step when, approve_if and approvers, and
+	// requirement expressions. Possible values are "expr" (default) and "rego". A single expression can use another
+	// language with an "expr:" or "rego:" prefix. Rego expressions get the same variables as the expr ones in their
+	// input, i.e. the appeal as input.appeal including its creator and resource, e.g. input.appeal.creator.department
+	// for $appeal.creator.department.
+	ExpressionLanguage evaluator.Language `json:"expression_language,omitempty" yaml:"expression_language,omitempty" validate:"omitempty,oneof=expr rego"`
+	CreatedBy          string             `json:"created_by" yaml:"created_by"`
+	CreatedAt          time.Time          `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt          time.Time          `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
 }
 
 type PolicyAppealConfig struct {
@@ -308,6 +412,99 @@ type PolicyAppealConfig struct {
 	// Note: any expression that tries to access `$appeal.creator.*` is still evaluated as usual, it might need to have
 	// proper nil checking to avoid accessing nil value.
 	AllowCreatorDetailsFailure bool `json:"allow_creator_details_failure" yaml:"allow_creator_details_failure"`
+	// PendingTTL is the maximum duration an appeal can stay pending, e.g. "168h". Pending appeals older than this are
+	// marked as expired by the expire pending appeals job. Leave it empty to keep pending appeals indefinitely.
+	PendingTTL string `json:"pending_ttl,omitempty" yaml:"pending_ttl,omitempty"`
+	// AllowBreakGlass lets appeal creators request emergency access by setting the `break_glass` appeal option. The
+	// access is granted immediately without going through the approval steps, and BreakGlassReviewers are asked to
+	// review it afterwards.
+	AllowBreakGlass bool `json:"allow_break_glass,omitempty" yaml:"allow_break_glass,omitempty"`
+	// BreakGlassMaxDuration caps the duration of break-glass access, e.g. "2h". Defaults to DefaultBreakGlassMaxDuration.
+	BreakGlassMaxDuration string `json:"break_glass_max_duration,omitempty" yaml:"break_glass_max_duration,omitempty"`
+	// BreakGlassReviewers accepts the same values as step approvers: emails or expressions resolving to emails
+	BreakGlassReviewers []string `json:"break_glass_reviewers,omitempty" yaml:"break_glass_reviewers,omitempty"`
+	// MaxScheduleAhead limits how far in the future the `start_date` appeal option can be, e.g. "168h". Defaults to
+	// DefaultMaxScheduleAhead.
+	MaxScheduleAhead string `json:"max_schedule_ahead,omitempty" yaml:"max_schedule_ahead,omitempty"`
+	// DisallowSelfApproval removes the appeal creator and the requested account from the approvers of every step, and
+	// forbids them from acting on the appeal approvals even if they are added later on.
+	DisallowSelfApproval bool `json:"disallow_self_approval,omitempty" yaml:"disallow_self_approval,omitempty"`
+	// DisallowRepeatedApprover forbids approvers who approved a step of an appeal from acting on its following steps
+	DisallowRepeatedApprover bool `json:"disallow_repeated_approver,omitempty" yaml:"disallow_repeated_approver,omitempty"`
+	// SelfApprovalFallbackApprovers are the approvers of steps left without approvers once the appeal creator is
+	// removed. Accepts the same values as step approvers. If empty, creating such appeal fails.
+	SelfApprovalFallbackApprovers []string `json:"self_approval_fallback_approvers,omitempty" yaml:"self_approval_fallback_approvers,omitempty"`
+}
+
+// GetPendingTTL returns the parsed PendingTTL, zero means pending appeals never expire
+func (c *PolicyAppealConfig) GetPendingTTL() (time.Duration, error) {
+	if c == nil || c.PendingTTL == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(c.PendingTTL)
+}
+
+// GetBreakGlassMaxDuration returns the parsed BreakGlassMaxDuration or DefaultBreakGlassMaxDuration if it's not set
+func (c *PolicyAppealConfig) GetBreakGlassMaxDuration() (time.Duration, error) {
+	if c == nil || c.BreakGlassMaxDuration == "" {
+		return DefaultBreakGlassMaxDuration, nil
+	}
+	return time.ParseDuration(c.BreakGlassMaxDuration)
+}
+
+// GetMaxScheduleAhead returns the parsed MaxScheduleAhead or DefaultMaxScheduleAhead if it's not set
+func (c *PolicyAppealConfig) GetMaxScheduleAhead() (time.Duration, error) {
+	if c == nil || c.MaxScheduleAhead == "" {
+		return DefaultMaxScheduleAhead, nil
+	}
+	return time.ParseDuration(c.MaxScheduleAhead)
+}
+
+// ValidateApprover returns an error if the actor of the approval action is not allowed to act on the approval by the
+// self-approval settings. Steps of the same parallel group count as previous steps of each other regardless of their
+// order.
+func (c *PolicyAppealConfig) ValidateApprover(a *Appeal, approval *Approval, action ApprovalAction) error {
+	if c == nil {
+		return nil
+	}
+
+	users := []string{action.Actor}
+	if action.OnBehalfOf != "" {
+		users = append(users, action.OnBehalfOf)
+	}
+
+	for _, user := range users {
+		if c.DisallowSelfApproval && a.isSelf(user) {
+			return ErrSelfApprovalNotAllowed
+		}
+		if c.DisallowRepeatedApprover {
+			for _, previous := range a.Approvals {
+				isPrevious := previous.Index < approval.Index || (previous != approval && previous.IsParallelWith(approval))
+				if isPrevious && previous.isApprovedBy(user) {
+					return ErrRepeatedApproverNotAllowed
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// ToBreakGlassReview creates the post-hoc review approval for a break-glass appeal
+func (c *PolicyAppealConfig) ToBreakGlassReview(a *Appeal, p *Policy) (*Approval, error) {
+	step := Step{
+		Name:      BreakGlassReviewApprovalName,
+		Strategy:  ApprovalStepStrategyManual,
+		Approvers: c.BreakGlassReviewers,
+	}
+	approval, err := step.ToApproval(a, p, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(approval.Approvers) == 0 {
+		return nil, fmt.Errorf("%w: no break-glass reviewers resolved", ErrInsufficientApprovers)
+	}
+	return approval, nil
 }
 
 type Question struct {
@@ -327,6 +524,55 @@ type AppealDurationOption struct {
 	Value string `json:"value" yaml:"value" validate:"required"`
 }
 
+// isInFirstParallelGroup returns true if the step at the given index runs in parallel with the first step
+func (p *Policy) isInFirstParallelGroup(index int) bool {
+	if index >= len(p.Steps) || p.Steps[0].ParallelGroup == "" {
+		return false
+	}
+	for _, s := range p.Steps[:index+1] {
+		if s.ParallelGroup != p.Steps[0].ParallelGroup {
+			return false
+		}
+	}
+	return true
+}
+
+// GetExpressionLanguage returns the language of the policy expressions, defaults to expr
+func (p *Policy) GetExpressionLanguage() evaluator.Language {
+	if p == nil || p.ExpressionLanguage == "" {
+		return evaluator.LanguageExpr
+	}
+	return p.ExpressionLanguage
+}
+
 func (p *Policy) HasIAMConfig() bool {
 	return p.IAM != nil
 }
+
+// Simulate evaluates the policy against the appeal the same way it is evaluated when the appeal is created. The
+// resource, creator and delegations of the appeal need to be populated beforehand.
+func (p *Policy) Simulate(a *Appeal) (*AppealSimulation, error) {
+	if a.Resource == nil {
+		return nil, fmt.Errorf("appeal has no resource")
+	}
+	if err := a.ApplyPolicy(p); err != nil {
+		return nil, fmt.Errorf("populating approvals: %w", err)
+	}
+	if err := a.AdvanceApproval(p); err != nil {
+		return nil, fmt.Errorf("initializing approval step statuses: %w", err)
+	}
+	a.Policy = nil
+
+	simulation := &AppealSimulation{Appeal: a}
+	for i, r := range p.Requirements {
+		isMatch, err := r.On.IsMatchWithLanguage(a, p.GetExpressionLanguage())
+		if err != nil {
+			return nil, fmt.Errorf("evaluating requirements[%v]: %w", i, err)
+		}
+		if isMatch {
+			simulation.MatchedRequirements = append(simulation.MatchedRequirements, r)
+		}
+	}
+
+	return simulation, nil
+}
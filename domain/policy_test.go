@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/pkg/evaluator"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -187,7 +188,7 @@ func TestStep_ResolveApprovers(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := tt.step.ResolveApprovers(tt.appeal)
+			got, err := tt.step.ResolveApprovers(tt.appeal, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Step.ResolveApprovers() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -199,6 +200,177 @@ func TestStep_ResolveApprovers(t *testing.T) {
 	}
 }
 
+func TestStep_ResolveApprovers__DisallowSelfApproval(t *testing.T) {
+	config := &domain.PolicyAppealConfig{DisallowSelfApproval: true}
+
+	t.Run("should exclude the appeal creator and the requested account", func(t *testing.T) {
+		appeal := &domain.Appeal{
+			AccountID: "user@example.com",
+			CreatedBy: "creator@example.com",
+		}
+		step := domain.Step{
+			Strategy:  domain.ApprovalStepStrategyManual,
+			Approvers: []string{"creator@example.com", "user@example.com", "approver@example.com"},
+		}
+
+		approvers, err := step.ResolveApprovers(appeal, &domain.Policy{AppealConfig: config})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"approver@example.com"}, approvers)
+	})
+
+	t.Run("should use the fallback approvers if no approver is left", func(t *testing.T) {
+		appeal := &domain.Appeal{
+			AccountID: "user@example.com",
+			CreatedBy: "user@example.com",
+			Creator: map[string]interface{}{
+				"manager": "manager@example.com",
+			},
+		}
+		step := domain.Step{
+			Strategy:  domain.ApprovalStepStrategyManual,
+			Approvers: []string{"user@example.com"},
+		}
+		config := &domain.PolicyAppealConfig{
+			DisallowSelfApproval:          true,
+			SelfApprovalFallbackApprovers: []string{"$appeal.creator.manager", "user@example.com"},
+		}
+
+		approvers, err := step.ResolveApprovers(appeal, &domain.Policy{AppealConfig: config})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"manager@example.com"}, approvers)
+	})
+
+	t.Run("should return error if no approver is left and no fallback approvers are set", func(t *testing.T) {
+		appeal := &domain.Appeal{
+			AccountID: "user@example.com",
+			CreatedBy: "user@example.com",
+		}
+		step := domain.Step{
+			Name:      "owner_approval",
+			Strategy:  domain.ApprovalStepStrategyManual,
+			Approvers: []string{"user@example.com"},
+		}
+
+		approvers, err := step.ResolveApprovers(appeal, &domain.Policy{AppealConfig: config})
+
+		assert.ErrorIs(t, err, domain.ErrNoApproversLeft)
+		assert.Nil(t, approvers)
+	})
+
+	t.Run("should keep the creator if self-approval is allowed", func(t *testing.T) {
+		appeal := &domain.Appeal{
+			AccountID: "user@example.com",
+			CreatedBy: "user@example.com",
+		}
+		step := domain.Step{
+			Strategy:  domain.ApprovalStepStrategyManual,
+			Approvers: []string{"user@example.com"},
+		}
+
+		approvers, err := step.ResolveApprovers(appeal, &domain.Policy{AppealConfig: &domain.PolicyAppealConfig{}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"user@example.com"}, approvers)
+	})
+}
+
+func TestPolicyAppealConfig_ValidateApprover(t *testing.T) {
+	approver := "approver@example.com"
+	newAppeal := func() *domain.Appeal {
+		return &domain.Appeal{
+			AccountID: "user@example.com",
+			CreatedBy: "creator@example.com",
+			Approvals: []*domain.Approval{
+				{
+					Name:   "step-1",
+					Index:  0,
+					Status: domain.ApprovalStatusApproved,
+					Decisions: []*domain.ApprovalDecision{
+						{Actor: approver, Action: domain.AppealActionNameApprove},
+					},
+				},
+				{
+					Name:   "step-2",
+					Index:  1,
+					Status: domain.ApprovalStatusPending,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		config      *domain.PolicyAppealConfig
+		action      domain.ApprovalAction
+		expectedErr error
+	}{
+		{
+			name:   "should allow anyone if config is empty",
+			config: nil,
+			action: domain.ApprovalAction{Actor: "creator@example.com"},
+		},
+		{
+			name:        "should return error if the creator approves their own appeal",
+			config:      &domain.PolicyAppealConfig{DisallowSelfApproval: true},
+			action:      domain.ApprovalAction{Actor: "creator@example.com"},
+			expectedErr: domain.ErrSelfApprovalNotAllowed,
+		},
+		{
+			name:        "should return error if the requested account approves on behalf of an approver",
+			config:      &domain.PolicyAppealConfig{DisallowSelfApproval: true},
+			action:      domain.ApprovalAction{Actor: "user@example.com", OnBehalfOf: "owner@example.com"},
+			expectedErr: domain.ErrSelfApprovalNotAllowed,
+		},
+		{
+			name:        "should return error if the approver approved a previous step",
+			config:      &domain.PolicyAppealConfig{DisallowRepeatedApprover: true},
+			action:      domain.ApprovalAction{Actor: approver},
+			expectedErr: domain.ErrRepeatedApproverNotAllowed,
+		},
+		{
+			name:   "should allow the approver of a previous step if repeated approvers are allowed",
+			config: &domain.PolicyAppealConfig{DisallowSelfApproval: true},
+			action: domain.ApprovalAction{Actor: approver},
+		},
+		{
+			name:   "should allow other approvers",
+			config: &domain.PolicyAppealConfig{DisallowSelfApproval: true, DisallowRepeatedApprover: true},
+			action: domain.ApprovalAction{Actor: "owner@example.com"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := newAppeal()
+
+			err := tc.config.ValidateApprover(a, a.Approvals[1], tc.action)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+
+	t.Run("should return error if the approver approved another step of the same parallel group in any order", func(t *testing.T) {
+		config := &domain.PolicyAppealConfig{DisallowRepeatedApprover: true}
+		for _, approvedIndex := range []int{0, 1} {
+			a := &domain.Appeal{
+				Approvals: []*domain.Approval{
+					{Name: "step-1", Index: 0, ParallelGroup: "review", Status: domain.ApprovalStatusPending},
+					{Name: "step-2", Index: 1, ParallelGroup: "review", Status: domain.ApprovalStatusPending},
+				},
+			}
+			approved := a.Approvals[approvedIndex]
+			approved.Status = domain.ApprovalStatusApproved
+			approved.Decisions = []*domain.ApprovalDecision{{Actor: approver, Action: domain.AppealActionNameApprove}}
+
+			err := config.ValidateApprover(a, a.Approvals[1-approvedIndex], domain.ApprovalAction{Actor: approver})
+
+			assert.ErrorIs(t, err, domain.ErrRepeatedApproverNotAllowed, "approved step index %d", approvedIndex)
+		}
+	})
+}
+
 func TestRequirementTrigger(t *testing.T) {
 	t.Run("should return error if got error when parsing appeal to map", func(t *testing.T) {
 		brokenAppeal := &domain.Appeal{
@@ -241,6 +413,42 @@ func TestRequirementTrigger(t *testing.T) {
 		assert.False(t, match)
 	})
 
+	t.Run("should evaluate expression in the given language", func(t *testing.T) {
+		appeal := &domain.Appeal{
+			Role:     "editor",
+			Resource: &domain.Resource{ProviderType: "bigquery"},
+		}
+		r := domain.RequirementTrigger{
+			Expression: `input.appeal.resource.provider_type == "bigquery"; input.appeal.role == "editor"`,
+		}
+
+		match, err := r.IsMatchWithLanguage(appeal, evaluator.LanguageRego)
+		assert.NoError(t, err)
+		assert.True(t, match)
+
+		appeal.Role = "viewer"
+		match, err = r.IsMatchWithLanguage(appeal, evaluator.LanguageRego)
+		assert.NoError(t, err)
+		assert.False(t, match)
+	})
+
+	t.Run("should evaluate a creator condition the same way in expr and rego", func(t *testing.T) {
+		triggers := map[evaluator.Language]domain.RequirementTrigger{
+			evaluator.LanguageExpr: {Expression: `$appeal.creator.department == "data"`},
+			evaluator.LanguageRego: {Expression: `input.appeal.creator.department == "data"`},
+		}
+		for _, department := range []string{"data", "finance"} {
+			appeal := &domain.Appeal{
+				Creator: map[string]interface{}{"department": department},
+			}
+			for lang, r := range triggers {
+				match, err := r.IsMatchWithLanguage(appeal, lang)
+				assert.NoError(t, err)
+				assert.Equal(t, department == "data", match, "language %q, department %q", lang, department)
+			}
+		}
+	})
+
 	t.Run("test trigger matching", func(t *testing.T) {
 		testCases := []struct {
 			name          string
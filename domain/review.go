@@ -0,0 +1,214 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrInvalidReviewDecision = errors.New("review decision should be either keep or revoke")
+
+type ReviewerStrategy string
+type ReviewDecision string
+
+const (
+	ReviewCampaignStatusActive = "active"
+	ReviewCampaignStatusClosed = "closed"
+
+	// ReviewerStrategyGrantOwner assigns the grant to the owner of the grant
+	ReviewerStrategyGrantOwner ReviewerStrategy = "grant_owner"
+	// ReviewerStrategyResourceOwner assigns the grant to the owner(s) listed in the resource details
+	ReviewerStrategyResourceOwner ReviewerStrategy = "resource_owner"
+	// ReviewerStrategyPolicyApprovers assigns the grant to the approvers of the appeal it was granted from
+	ReviewerStrategyPolicyApprovers ReviewerStrategy = "policy_approvers"
+
+	ReviewDecisionKeep   ReviewDecision = "keep"
+	ReviewDecisionRevoke ReviewDecision = "revoke"
+
+	DefaultResourceOwnerDetailsKey = "owner"
+)
+
+// ReviewCampaign is a periodic review of active grants where reviewers confirm whether each grant is still needed
+type ReviewCampaign struct {
+	ID               string               `json:"id" yaml:"id"`
+	Name             string               `json:"name" yaml:"name" validate:"required"`
+	Description      string               `json:"description,omitempty" yaml:"description,omitempty"`
+	Scope            *ReviewCampaignScope `json:"scope,omitempty" yaml:"scope,omitempty"`
+	ReviewerStrategy ReviewerStrategy     `json:"reviewer_strategy" yaml:"reviewer_strategy" validate:"required,oneof=grant_owner resource_owner policy_approvers"`
+	// ResourceOwnerKey is the key in the resource details holding the resource owner(s). Used by the
+	// resource_owner strategy, defaults to "owner".
+	ResourceOwnerKey string `json:"resource_owner_key,omitempty" yaml:"resource_owner_key,omitempty"`
+	// DefaultAction is the decision applied to grants that are not reviewed by the time the campaign closes.
+	// Defaults to "keep".
+	DefaultAction ReviewDecision `json:"default_action,omitempty" yaml:"default_action,omitempty" validate:"omitempty,oneof=keep revoke"`
+	Status        string         `json:"status" yaml:"status"`
+	CreatedBy     string         `json:"created_by" yaml:"created_by"`
+	ClosedBy      string         `json:"closed_by,omitempty" yaml:"closed_by,omitempty"`
+	ClosedAt      *time.Time     `json:"closed_at,omitempty" yaml:"closed_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+}
+
+func (c *ReviewCampaign) SetDefaults() {
+	if c.ResourceOwnerKey == "" {
+		c.ResourceOwnerKey = DefaultResourceOwnerDetailsKey
+	}
+	if c.DefaultAction == "" {
+		c.DefaultAction = ReviewDecisionKeep
+	}
+	if c.Scope == nil {
+		c.Scope = &ReviewCampaignScope{}
+	}
+	c.Status = ReviewCampaignStatusActive
+}
+
+func (c *ReviewCampaign) IsActive() bool {
+	return c.Status == ReviewCampaignStatusActive
+}
+
+// ReviewCampaignScope narrows down the active grants included in a campaign. An empty scope includes all active
+// grants.
+type ReviewCampaignScope struct {
+	AccountIDs    []string `json:"account_ids,omitempty" yaml:"account_ids,omitempty"`
+	AccountTypes  []string `json:"account_types,omitempty" yaml:"account_types,omitempty"`
+	ResourceIDs   []string `json:"resource_ids,omitempty" yaml:"resource_ids,omitempty"`
+	Roles         []string `json:"roles,omitempty" yaml:"roles,omitempty"`
+	ProviderTypes []string `json:"provider_types,omitempty" yaml:"provider_types,omitempty"`
+	ProviderURNs  []string `json:"provider_urns,omitempty" yaml:"provider_urns,omitempty"`
+	ResourceTypes []string `json:"resource_types,omitempty" yaml:"resource_types,omitempty"`
+	ResourceURNs  []string `json:"resource_urns,omitempty" yaml:"resource_urns,omitempty"`
+	Owner         string   `json:"owner,omitempty" yaml:"owner,omitempty"`
+}
+
+func (s *ReviewCampaignScope) ToListGrantsFilter() ListGrantsFilter {
+	filter := ListGrantsFilter{
+		Statuses: []string{string(GrantStatusActive)},
+	}
+	if s == nil {
+		return filter
+	}
+	filter.AccountIDs = s.AccountIDs
+	filter.AccountTypes = s.AccountTypes
+	filter.ResourceIDs = s.ResourceIDs
+	filter.Roles = s.Roles
+	filter.ProviderTypes = s.ProviderTypes
+	filter.ProviderURNs = s.ProviderURNs
+	filter.ResourceTypes = s.ResourceTypes
+	filter.ResourceURNs = s.ResourceURNs
+	filter.Owner = s.Owner
+	return filter
+}
+
+// ReviewItem is a grant to be reviewed within a campaign. Grant details are copied at the time the campaign is
+// created so that the campaign summary stays the same after the grant is revoked.
+type ReviewItem struct {
+	ID           string         `json:"id" yaml:"id"`
+	CampaignID   string         `json:"campaign_id" yaml:"campaign_id"`
+	GrantID      string         `json:"grant_id" yaml:"grant_id"`
+	AccountID    string         `json:"account_id" yaml:"account_id"`
+	AccountType  string         `json:"account_type" yaml:"account_type"`
+	ResourceID   string         `json:"resource_id" yaml:"resource_id"`
+	ResourceURN  string         `json:"resource_urn" yaml:"resource_urn"`
+	ProviderType string         `json:"provider_type" yaml:"provider_type"`
+	Role         string         `json:"role" yaml:"role"`
+	Reviewers    []string       `json:"reviewers" yaml:"reviewers"`
+	Decision     ReviewDecision `json:"decision,omitempty" yaml:"decision,omitempty"`
+	Reason       string         `json:"reason,omitempty" yaml:"reason,omitempty"`
+	DecidedBy    string         `json:"decided_by,omitempty" yaml:"decided_by,omitempty"`
+	DecidedAt    *time.Time     `json:"decided_at,omitempty" yaml:"decided_at,omitempty"`
+	// Defaulted is true if the decision comes from the campaign default action instead of a reviewer
+	Defaulted bool `json:"defaulted,omitempty" yaml:"defaulted,omitempty"`
+
+	CreatedAt time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+}
+
+func NewReviewItem(campaignID string, g Grant, reviewers []string) *ReviewItem {
+	item := &ReviewItem{
+		CampaignID:  campaignID,
+		GrantID:     g.ID,
+		AccountID:   g.AccountID,
+		AccountType: g.AccountType,
+		ResourceID:  g.ResourceID,
+		Role:        g.Role,
+		Reviewers:   reviewers,
+	}
+	if g.Resource != nil {
+		item.ResourceURN = g.Resource.URN
+		item.ProviderType = g.Resource.ProviderType
+	}
+	return item
+}
+
+func (i *ReviewItem) IsPending() bool {
+	return i.Decision == ""
+}
+
+func (i *ReviewItem) IsReviewer(user string) bool {
+	for _, r := range i.Reviewers {
+		if r == user {
+			return true
+		}
+	}
+	return false
+}
+
+// Decide records the decision of the reviewer. Reviewers can change their decision while the campaign is active.
+func (i *ReviewItem) Decide(actor string, decision ReviewDecision, reason string) error {
+	if decision != ReviewDecisionKeep && decision != ReviewDecisionRevoke {
+		return fmt.Errorf("%w: %q", ErrInvalidReviewDecision, decision)
+	}
+	now := time.Now()
+	i.Decision = decision
+	i.Reason = reason
+	i.DecidedBy = actor
+	i.DecidedAt = &now
+	i.Defaulted = false
+	return nil
+}
+
+// ReviewCampaignSummary is the outcome of a campaign, exported as evidence for auditors
+type ReviewCampaignSummary struct {
+	Campaign  *ReviewCampaign `json:"campaign" yaml:"campaign"`
+	Total     int             `json:"total" yaml:"total"`
+	Kept      int             `json:"kept" yaml:"kept"`
+	Revoked   int             `json:"revoked" yaml:"revoked"`
+	Pending   int             `json:"pending" yaml:"pending"`
+	Defaulted int             `json:"defaulted" yaml:"defaulted"`
+	Items     []*ReviewItem   `json:"items" yaml:"items"`
+}
+
+func NewReviewCampaignSummary(c *ReviewCampaign, items []*ReviewItem) *ReviewCampaignSummary {
+	summary := &ReviewCampaignSummary{
+		Campaign: c,
+		Total:    len(items),
+		Items:    items,
+	}
+	for _, i := range items {
+		switch i.Decision {
+		case ReviewDecisionKeep:
+			summary.Kept++
+		case ReviewDecisionRevoke:
+			summary.Revoked++
+		default:
+			summary.Pending++
+		}
+		if i.Defaulted {
+			summary.Defaulted++
+		}
+	}
+	return summary
+}
+
+type ListReviewCampaignsFilter struct {
+	Statuses  []string
+	CreatedBy string
+}
+
+type ListReviewItemsFilter struct {
+	CampaignID       string
+	CampaignStatuses []string
+	Reviewer         string
+	PendingOnly      bool
+}
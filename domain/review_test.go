@@ -0,0 +1,46 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReviewCampaignSummary(t *testing.T) {
+	items := []*domain.ReviewItem{
+		{Decision: domain.ReviewDecisionKeep},
+		{Decision: domain.ReviewDecisionKeep, Defaulted: true},
+		{Decision: domain.ReviewDecisionRevoke},
+		{},
+	}
+
+	summary := domain.NewReviewCampaignSummary(&domain.ReviewCampaign{ID: "campaign-1"}, items)
+
+	assert.Equal(t, 4, summary.Total)
+	assert.Equal(t, 2, summary.Kept)
+	assert.Equal(t, 1, summary.Revoked)
+	assert.Equal(t, 1, summary.Pending)
+	assert.Equal(t, 1, summary.Defaulted)
+}
+
+func TestReviewItem_Decide(t *testing.T) {
+	t.Run("should return error on unknown decision", func(t *testing.T) {
+		item := &domain.ReviewItem{}
+
+		err := item.Decide("reviewer@example.com", "maybe", "")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidReviewDecision)
+		assert.True(t, item.IsPending())
+	})
+
+	t.Run("should clear defaulted flag when reviewer decides", func(t *testing.T) {
+		item := &domain.ReviewItem{Decision: domain.ReviewDecisionKeep, Defaulted: true}
+
+		err := item.Decide("reviewer@example.com", domain.ReviewDecisionRevoke, "no longer needed")
+
+		assert.NoError(t, err)
+		assert.False(t, item.Defaulted)
+		assert.Equal(t, domain.ReviewDecisionRevoke, item.Decision)
+	})
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/raystack/guardian/pkg/evaluator"
+	"github.com/raystack/guardian/utils"
+)
+
+const (
+	RiskLevelLow    = "low"
+	RiskLevelMedium = "medium"
+	RiskLevelHigh   = "high"
+)
+
+// DefaultRiskLevels are used if the risk scoring config doesn't set any level
+var DefaultRiskLevels = []*RiskLevel{
+	{Name: RiskLevelLow, MinScore: 0},
+	{Name: RiskLevelMedium, MinScore: 30},
+	{Name: RiskLevelHigh, MinScore: 70},
+}
+
+// AppealRisk is the risk of giving the access requested by an appeal, computed when the appeal is created and
+// accessible in policy expressions as $appeal.risk
+type AppealRisk struct {
+	Score float64 `json:"score" yaml:"score"`
+	Level string  `json:"level" yaml:"level"`
+	// Factors are the names of the rules contributing to the score
+	Factors []string `json:"factors,omitempty" yaml:"factors,omitempty"`
+}
+
+// RiskScoringConfig holds the rules used to compute the risk score of appeals. The score is the sum of the scores of
+// the matching rules, and the level is the one with the highest min score reached.
+type RiskScoringConfig struct {
+	Rules  []*RiskRule  `mapstructure:"rules" json:"rules" yaml:"rules" validate:"dive"`
+	Levels []*RiskLevel `mapstructure:"levels" json:"levels,omitempty" yaml:"levels,omitempty" validate:"dive"`
+}
+
+// RiskRule adds Score to the appeal risk score if When evaluates to true. When is an expression with the following
+// accessible parameters:
+// $appeal = Appeal object, including the resource and creator details
+// $duration_hours = requested access duration in hours, 0 for permanent access
+// $permanent = true if the requested access is permanent
+// $rejected_appeals = number of rejected appeals previously created by the appeal creator
+type RiskRule struct {
+	Name  string  `mapstructure:"name" json:"name" yaml:"name" validate:"required"`
+	When  string  `mapstructure:"when" json:"when" yaml:"when" validate:"required"`
+	Score float64 `mapstructure:"score" json:"score" yaml:"score"`
+}
+
+type RiskLevel struct {
+	Name     string  `mapstructure:"name" json:"name" yaml:"name" validate:"required"`
+	MinScore float64 `mapstructure:"min_score" json:"min_score" yaml:"min_score"`
+}
+
+// Validate checks the config and compiles the rule expressions. An empty config is valid.
+func (c *RiskScoringConfig) Validate(v *validator.Validate) error {
+	if c == nil {
+		return nil
+	}
+	if err := v.Struct(c); err != nil {
+		return err
+	}
+	for _, r := range c.Rules {
+		if err := evaluator.New(r.When, evaluator.LanguageExpr).Compile(); err != nil {
+			return fmt.Errorf("rule %q: %w", r.Name, err)
+		}
+	}
+	return nil
+}
+
+func (c *RiskScoringConfig) IsEnabled() bool {
+	return c != nil && len(c.Rules) > 0
+}
+
+// Score computes the risk of the appeal. rejectedAppeals is the number of rejected appeals previously created by the
+// appeal creator.
+func (c *RiskScoringConfig) Score(a *Appeal, rejectedAppeals int64) (*AppealRisk, error) {
+	appealMap, err := utils.StructToMap(a)
+	if err != nil {
+		return nil, fmt.Errorf("parsing appeal to map: %w", err)
+	}
+	duration, err := a.GetDuration()
+	if err != nil {
+		return nil, fmt.Errorf("parsing duration %q: %w", a.Options.Duration, err)
+	}
+	params := map[string]interface{}{
+		"appeal":           appealMap,
+		"duration_hours":   duration.Hours(),
+		"permanent":        duration == 0,
+		"rejected_appeals": rejectedAppeals,
+	}
+
+	risk := &AppealRisk{}
+	for _, r := range c.Rules {
+		v, err := evaluator.New(r.When, evaluator.LanguageExpr).EvaluateWithVars(params)
+		if err != nil {
+			return nil, fmt.Errorf("evaluating risk rule %q: %w", r.Name, err)
+		}
+		match, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("risk rule %q did not evaluate to a boolean, evaluated value: %v", r.Name, v)
+		}
+		if match {
+			risk.Score += r.Score
+			risk.Factors = append(risk.Factors, r.Name)
+		}
+	}
+	risk.Level = c.getLevel(risk.Score)
+
+	return risk, nil
+}
+
+func (c *RiskScoringConfig) getLevel(score float64) string {
+	levels := c.Levels
+	if len(levels) == 0 {
+		levels = DefaultRiskLevels
+	}
+	levels = append([]*RiskLevel{}, levels...)
+	sort.SliceStable(levels, func(i, j int) bool {
+		return levels[i].MinScore < levels[j].MinScore
+	})
+
+	level := levels[0].Name
+	for _, l := range levels {
+		if score >= l.MinScore {
+			level = l.Name
+		}
+	}
+	return level
+}
@@ -0,0 +1,177 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/raystack/guardian/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRiskScoringConfig_Score(t *testing.T) {
+	config := &domain.RiskScoringConfig{
+		Rules: []*domain.RiskRule{
+			{
+				Name:  "sensitive_resource",
+				When:  `$appeal.resource.labels?.sensitivity == "high"`,
+				Score: 40,
+			},
+			{
+				Name:  "privileged_role",
+				When:  `$appeal.role in ["owner", "admin"]`,
+				Score: 30,
+			},
+			{
+				Name:  "permanent_access",
+				When:  `$permanent`,
+				Score: 20,
+			},
+			{
+				Name:  "long_access",
+				When:  `$duration_hours > 24 * 30`,
+				Score: 10,
+			},
+			{
+				Name:  "previously_rejected",
+				When:  `$rejected_appeals > 2`,
+				Score: 10,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		appeal          *domain.Appeal
+		rejectedAppeals int64
+		expectedRisk    *domain.AppealRisk
+	}{
+		{
+			name: "should return low risk if no rule matches",
+			appeal: &domain.Appeal{
+				Role:     "viewer",
+				Resource: &domain.Resource{},
+				Options:  &domain.AppealOptions{Duration: "24h"},
+			},
+			expectedRisk: &domain.AppealRisk{
+				Score: 0,
+				Level: domain.RiskLevelLow,
+			},
+		},
+		{
+			name: "should sum the scores of the matching rules",
+			appeal: &domain.Appeal{
+				Role: "owner",
+				Resource: &domain.Resource{
+					Labels: map[string]string{"sensitivity": "high"},
+				},
+				Options: &domain.AppealOptions{Duration: "24h"},
+			},
+			expectedRisk: &domain.AppealRisk{
+				Score:   70,
+				Level:   domain.RiskLevelHigh,
+				Factors: []string{"sensitive_resource", "privileged_role"},
+			},
+		},
+		{
+			name: "should consider an empty duration as permanent access",
+			appeal: &domain.Appeal{
+				Role:     "viewer",
+				Resource: &domain.Resource{},
+			},
+			expectedRisk: &domain.AppealRisk{
+				Score:   20,
+				Level:   domain.RiskLevelLow,
+				Factors: []string{"permanent_access"},
+			},
+		},
+		{
+			name: "should use the duration and previously rejected appeals",
+			appeal: &domain.Appeal{
+				Role:     "admin",
+				Resource: &domain.Resource{},
+				Options:  &domain.AppealOptions{Duration: "2160h"},
+			},
+			rejectedAppeals: 3,
+			expectedRisk: &domain.AppealRisk{
+				Score:   50,
+				Level:   domain.RiskLevelMedium,
+				Factors: []string{"privileged_role", "long_access", "previously_rejected"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			risk, err := config.Score(tc.appeal, tc.rejectedAppeals)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRisk, risk)
+		})
+	}
+
+	t.Run("should use the configured levels", func(t *testing.T) {
+		config := &domain.RiskScoringConfig{
+			Rules: []*domain.RiskRule{
+				{Name: "always", When: "true", Score: 15},
+			},
+			Levels: []*domain.RiskLevel{
+				{Name: "critical", MinScore: 50},
+				{Name: "minor", MinScore: 0},
+				{Name: "major", MinScore: 10},
+			},
+		}
+
+		risk, err := config.Score(&domain.Appeal{}, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, "major", risk.Level)
+	})
+
+	t.Run("should return error if a rule doesn't evaluate to a boolean", func(t *testing.T) {
+		config := &domain.RiskScoringConfig{
+			Rules: []*domain.RiskRule{
+				{Name: "invalid", When: "$appeal.role", Score: 10},
+			},
+		}
+
+		risk, err := config.Score(&domain.Appeal{Role: "viewer"}, 0)
+
+		assert.ErrorContains(t, err, `risk rule "invalid" did not evaluate to a boolean`)
+		assert.Nil(t, risk)
+	})
+
+	t.Run("should return error if the duration is invalid", func(t *testing.T) {
+		risk, err := config.Score(&domain.Appeal{Options: &domain.AppealOptions{Duration: "invalid"}}, 0)
+
+		assert.Error(t, err)
+		assert.Nil(t, risk)
+	})
+}
+
+func TestRiskScoringConfig_Validate(t *testing.T) {
+	v := validator.New()
+
+	t.Run("should return nil if the config is empty", func(t *testing.T) {
+		var config *domain.RiskScoringConfig
+
+		assert.NoError(t, config.Validate(v))
+		assert.False(t, config.IsEnabled())
+	})
+
+	t.Run("should return error if a rule has no name", func(t *testing.T) {
+		config := &domain.RiskScoringConfig{
+			Rules: []*domain.RiskRule{{When: "true"}},
+		}
+
+		assert.Error(t, config.Validate(v))
+	})
+
+	t.Run("should return error if a rule expression is invalid", func(t *testing.T) {
+		config := &domain.RiskScoringConfig{
+			Rules: []*domain.RiskRule{{Name: "invalid", When: `$appeal.role ==`}},
+		}
+
+		assert.ErrorContains(t, config.Validate(v), `rule "invalid"`)
+	})
+}
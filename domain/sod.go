@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type SoDRuleAction string
+
+const (
+	// SoDRuleActionReject rejects appeals that would create the conflicting combination
+	SoDRuleActionReject SoDRuleAction = "reject"
+	// SoDRuleActionRequireApproval adds an extra approval step to appeals that would create the conflicting
+	// combination
+	SoDRuleActionRequireApproval SoDRuleAction = "require_approval"
+
+	// SoDApprovalName is the name of the extra approval step added by require_approval rules
+	SoDApprovalName = "separation_of_duties"
+)
+
+// SoDRule is a separation-of-duties rule describing a toxic combination of accesses that one account should not hold
+// at the same time, e.g. write access to the raw finance dataset along with admin access to the reporting workspace
+type SoDRule struct {
+	ID          string `json:"id" yaml:"id"`
+	Name        string `json:"name" yaml:"name" validate:"required"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// Selectors match the accesses of the combination. An account violates the rule when each selector matches at
+	// least one of its accesses.
+	Selectors []*RequirementTrigger `json:"selectors" yaml:"selectors" validate:"required,min=2,dive"`
+	Action    SoDRuleAction         `json:"action" yaml:"action" validate:"required,oneof=reject require_approval"`
+	// Approvers of the extra approval step, used by the require_approval action. Accepts email addresses and
+	// expressions, the same way as the approvers of a policy step.
+	Approvers []string `json:"approvers,omitempty" yaml:"approvers,omitempty" validate:"required_if=Action require_approval"`
+	CreatedBy string   `json:"created_by" yaml:"created_by"`
+
+	CreatedAt time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+}
+
+// FindViolation checks the active grants of an account against the rule. If an appeal is given, the rule is only
+// violated if the appeal is part of the conflicting combination. The other appeals of the account that may still be
+// granted, e.g. the pending ones, are part of the combination the same way as the grants.
+func (r *SoDRule) FindViolation(grants []Grant, otherAppeals []*Appeal, a *Appeal) (*SoDViolation, error) {
+	isAppealInvolved := false
+	matchedGrantIDs := map[string]bool{}
+
+	for i, selector := range r.Selectors {
+		isMatch := false
+
+		if a != nil {
+			match, err := selector.IsMatch(a)
+			if err != nil {
+				return nil, fmt.Errorf("evaluating selectors[%d]: %w", i, err)
+			}
+			if match {
+				isMatch = true
+				isAppealInvolved = true
+			}
+		}
+
+		for _, g := range grants {
+			match, err := selector.IsMatch(g.toAppeal())
+			if err != nil {
+				return nil, fmt.Errorf("evaluating selectors[%d]: %w", i, err)
+			}
+			if match {
+				isMatch = true
+				matchedGrantIDs[g.ID] = true
+			}
+		}
+
+		for _, other := range otherAppeals {
+			match, err := selector.IsMatch(other)
+			if err != nil {
+				return nil, fmt.Errorf("evaluating selectors[%d]: %w", i, err)
+			}
+			if match {
+				isMatch = true
+			}
+		}
+
+		if !isMatch {
+			return nil, nil
+		}
+	}
+
+	if a != nil && !isAppealInvolved {
+		return nil, nil
+	}
+
+	violation := &SoDViolation{Rule: r}
+	for _, g := range grants {
+		if matchedGrantIDs[g.ID] {
+			violation.AccountID = g.AccountID
+			violation.GrantIDs = append(violation.GrantIDs, g.ID)
+		}
+	}
+	if a != nil {
+		violation.AccountID = a.AccountID
+	}
+	return violation, nil
+}
+
+// GetSoDApproval returns the extra approval step added to appeals violating require_approval rules. Approvers of all
+// violated rules are merged into a single step placed after the policy steps.
+func GetSoDApproval(violations []*SoDViolation, a *Appeal, p *Policy) (*Approval, error) {
+	var approvers []string
+	for _, v := range violations {
+		approvers = append(approvers, v.Rule.Approvers...)
+	}
+	step := Step{
+		Name:      SoDApprovalName,
+		Strategy:  ApprovalStepStrategyManual,
+		Approvers: approvers,
+	}
+	return step.ToApproval(a, p, len(p.Steps))
+}
+
+// SoDViolation is a combination of accesses of an account that violates a separation-of-duties rule
+type SoDViolation struct {
+	Rule      *SoDRule `json:"rule" yaml:"rule"`
+	AccountID string   `json:"account_id" yaml:"account_id"`
+	// GrantIDs are the active grants of the account that are part of the conflicting combination
+	GrantIDs []string `json:"grant_ids" yaml:"grant_ids"`
+}
+
+type ListSoDRulesFilter struct {
+	IDs   []string
+	Names []string
+}
+
+type ListSoDViolationsFilter struct {
+	RuleIDs    []string
+	AccountIDs []string
+}
@@ -0,0 +1,142 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSoDRule_FindViolation(t *testing.T) {
+	rule := &domain.SoDRule{
+		Name: "finance-writer-vs-reporting-admin",
+		Selectors: []*domain.RequirementTrigger{
+			{ResourceURN: "^finance-raw$", Role: "^writer$"},
+			{ResourceURN: "^reporting$", Role: "^admin$"},
+		},
+		Action: domain.SoDRuleActionReject,
+	}
+	financeWriter := domain.Grant{
+		ID:        "grant-1",
+		AccountID: "user@example.com",
+		Role:      "writer",
+		Resource:  &domain.Resource{URN: "finance-raw"},
+	}
+	reportingAdmin := domain.Grant{
+		ID:        "grant-2",
+		AccountID: "user@example.com",
+		Role:      "admin",
+		Resource:  &domain.Resource{URN: "reporting"},
+	}
+	unrelated := domain.Grant{
+		ID:        "grant-3",
+		AccountID: "user@example.com",
+		Role:      "viewer",
+		Resource:  &domain.Resource{URN: "reporting"},
+	}
+
+	t.Run("should return violation if the appeal completes the combination", func(t *testing.T) {
+		a := &domain.Appeal{
+			AccountID: "user@example.com",
+			Role:      "admin",
+			Resource:  &domain.Resource{URN: "reporting"},
+		}
+
+		v, err := rule.FindViolation([]domain.Grant{financeWriter, unrelated}, nil, a)
+
+		require.NoError(t, err)
+		require.NotNil(t, v)
+		assert.Equal(t, rule, v.Rule)
+		assert.Equal(t, "user@example.com", v.AccountID)
+		assert.Equal(t, []string{"grant-1"}, v.GrantIDs)
+	})
+
+	t.Run("should return nil if a selector is not matched", func(t *testing.T) {
+		a := &domain.Appeal{
+			AccountID: "user@example.com",
+			Role:      "viewer",
+			Resource:  &domain.Resource{URN: "reporting"},
+		}
+
+		v, err := rule.FindViolation([]domain.Grant{financeWriter}, nil, a)
+
+		assert.NoError(t, err)
+		assert.Nil(t, v)
+	})
+
+	t.Run("should return nil if the appeal is not part of an existing combination", func(t *testing.T) {
+		a := &domain.Appeal{
+			AccountID: "user@example.com",
+			Role:      "viewer",
+			Resource:  &domain.Resource{URN: "reporting"},
+		}
+
+		v, err := rule.FindViolation([]domain.Grant{financeWriter, reportingAdmin}, nil, a)
+
+		assert.NoError(t, err)
+		assert.Nil(t, v)
+	})
+
+	t.Run("should return violation if the appeal completes the combination with another appeal", func(t *testing.T) {
+		a := &domain.Appeal{
+			AccountID: "user@example.com",
+			Role:      "admin",
+			Resource:  &domain.Resource{URN: "reporting"},
+		}
+		pendingAppeal := &domain.Appeal{
+			AccountID: "user@example.com",
+			Role:      "writer",
+			Resource:  &domain.Resource{URN: "finance-raw"},
+		}
+
+		v, err := rule.FindViolation([]domain.Grant{unrelated}, []*domain.Appeal{pendingAppeal}, a)
+
+		require.NoError(t, err)
+		require.NotNil(t, v)
+		assert.Equal(t, "user@example.com", v.AccountID)
+		assert.Empty(t, v.GrantIDs)
+	})
+
+	t.Run("should return violation from grants only if appeal is nil", func(t *testing.T) {
+		v, err := rule.FindViolation([]domain.Grant{financeWriter, reportingAdmin, unrelated}, nil, nil)
+
+		require.NoError(t, err)
+		require.NotNil(t, v)
+		assert.Equal(t, "user@example.com", v.AccountID)
+		assert.Equal(t, []string{"grant-1", "grant-2"}, v.GrantIDs)
+	})
+
+	t.Run("should return error if selector is invalid", func(t *testing.T) {
+		invalidRule := &domain.SoDRule{
+			Selectors: []*domain.RequirementTrigger{{ResourceURN: "("}, {Role: "admin"}},
+		}
+
+		_, err := invalidRule.FindViolation([]domain.Grant{financeWriter}, nil, nil)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGetSoDApproval(t *testing.T) {
+	p := &domain.Policy{
+		ID:      "policy-1",
+		Version: 1,
+		Steps: []*domain.Step{
+			{Name: "step-1", Strategy: domain.ApprovalStepStrategyManual, Approvers: []string{"approver@example.com"}},
+		},
+	}
+	a := &domain.Appeal{AccountID: "user@example.com", Resource: &domain.Resource{}}
+	violations := []*domain.SoDViolation{
+		{Rule: &domain.SoDRule{Approvers: []string{"compliance@example.com"}}},
+		{Rule: &domain.SoDRule{Approvers: []string{"security@example.com"}}},
+	}
+
+	approval, err := domain.GetSoDApproval(violations, a, p)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.SoDApprovalName, approval.Name)
+	assert.Equal(t, 1, approval.Index)
+	assert.Equal(t, domain.ApprovalStatusBlocked, approval.Status)
+	assert.Equal(t, []string{"compliance@example.com", "security@example.com"}, approval.Approvers)
+}
@@ -6,10 +6,12 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/internal/store"
 	"github.com/raystack/guardian/jobs"
 	"github.com/raystack/guardian/pkg/auth"
 	"github.com/raystack/guardian/pkg/tracing"
+	"github.com/raystack/guardian/plugins/approvalwebhooks"
 	"github.com/raystack/guardian/plugins/notifiers"
 	"github.com/raystack/salt/config"
 )
@@ -26,11 +28,18 @@ type Auth struct {
 }
 
 type Jobs struct {
-	FetchResources             jobs.Job `mapstructure:"fetch_resources"`
-	RevokeExpiredGrants        jobs.Job `mapstructure:"revoke_expired_grants"`
-	ExpiringGrantNotification  jobs.Job `mapstructure:"expiring_grant_notification"`
-	RevokeGrantsByUserCriteria jobs.Job `mapstructure:"revoke_grants_by_user_criteria"`
-	GrantDormancyCheck         jobs.Job `mapstructure:"grant_dormancy_check"`
+	FetchResources                  jobs.Job `mapstructure:"fetch_resources"`
+	RevokeExpiredGrants             jobs.Job `mapstructure:"revoke_expired_grants"`
+	ExpiringGrantNotification       jobs.Job `mapstructure:"expiring_grant_notification"`
+	RevokeGrantsByUserCriteria      jobs.Job `mapstructure:"revoke_grants_by_user_criteria"`
+	GrantDormancyCheck              jobs.Job `mapstructure:"grant_dormancy_check"`
+	ApprovalStepTimeout             jobs.Job `mapstructure:"approval_step_timeout"`
+	ExpirePendingAppeals            jobs.Job `mapstructure:"expire_pending_appeals"`
+	BreakGlassReviewReminder        jobs.Job `mapstructure:"break_glass_review_reminder"`
+	ActivateScheduledGrants         jobs.Job `mapstructure:"activate_scheduled_grants"`
+	RequestExternalApprovals        jobs.Job `mapstructure:"request_external_approvals"`
+	GrantDriftCheck                 jobs.Job `mapstructure:"grant_drift_check"`
+	GrantRecommendationNotification jobs.Job `mapstructure:"grant_recommendation_notification"`
 
 	// Deprecated: use ExpiringGrantNotification instead
 	ExpiringAccessNotification jobs.Job `mapstructure:"expiring_access_notification"`
@@ -51,6 +60,12 @@ type Config struct {
 	Jobs                       Jobs           `mapstructure:"jobs"`
 	Telemetry                  tracing.Config `mapstructure:"telemetry"`
 	Auth                       Auth           `mapstructure:"auth"`
+	// ApprovalWebhooks are the webhooks called by the external approval steps, referred by name in the policies
+	ApprovalWebhooks []*approvalwebhooks.Config `mapstructure:"approval_webhooks"`
+	// RiskScoring holds the rules computing the risk of appeals, accessible in the policies as $appeal.risk
+	RiskScoring *domain.RiskScoringConfig `mapstructure:"risk_scoring"`
+	// ReviewCampaignAdmins are allowed to close any review campaign, besides the campaign creator
+	ReviewCampaignAdmins []string `mapstructure:"review_campaign_admins"`
 }
 
 type GRPCConfig struct {
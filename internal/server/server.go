@@ -75,6 +75,7 @@ func RunServer(config *Config) error {
 		logger,
 		services.GrantService,
 		services.ProviderService,
+		services.AppealService,
 		notifier,
 		crypto,
 		validator,
@@ -86,6 +87,11 @@ func RunServer(config *Config) error {
 		jobs.TypeFetchResources:            jobHandler.FetchResources,
 		jobs.TypeExpiringGrantNotification: jobHandler.GrantExpirationReminder,
 		jobs.TypeRevokeExpiredGrants:       jobHandler.RevokeExpiredGrants,
+		jobs.TypeRequestExternalApprovals:  jobHandler.RequestExternalApprovals,
+		jobs.TypeApprovalStepTimeout:       jobHandler.ApprovalStepTimeout,
+		jobs.TypeExpirePendingAppeals:      jobHandler.ExpirePendingAppeals,
+		jobs.TypeBreakGlassReviewReminder:  jobHandler.BreakGlassReviewReminder,
+		jobs.TypeActivateScheduledGrants:   jobHandler.ActivateScheduledGrants,
 	}
 
 	enabledJobs := fetchJobsToRun(config)
@@ -148,6 +154,10 @@ func RunServer(config *Config) error {
 		services.ApprovalService,
 		services.GrantService,
 		services.NamespaceService,
+		services.DelegationService,
+		services.BundleService,
+		services.ReviewService,
+		services.SoDService,
 		protoAdapter,
 		authUserContextKey[config.Auth.Provider],
 	))
@@ -293,6 +303,36 @@ func fetchJobsToRun(config *Config) []*jobs.Job {
 		jobsToRun = append(jobsToRun, &job)
 	}
 
+	if config.Jobs.RequestExternalApprovals.Enabled {
+		job := config.Jobs.RequestExternalApprovals
+		job.Type = jobs.TypeRequestExternalApprovals
+		jobsToRun = append(jobsToRun, &job)
+	}
+
+	if config.Jobs.ApprovalStepTimeout.Enabled {
+		job := config.Jobs.ApprovalStepTimeout
+		job.Type = jobs.TypeApprovalStepTimeout
+		jobsToRun = append(jobsToRun, &job)
+	}
+
+	if config.Jobs.ExpirePendingAppeals.Enabled {
+		job := config.Jobs.ExpirePendingAppeals
+		job.Type = jobs.TypeExpirePendingAppeals
+		jobsToRun = append(jobsToRun, &job)
+	}
+
+	if config.Jobs.BreakGlassReviewReminder.Enabled {
+		job := config.Jobs.BreakGlassReviewReminder
+		job.Type = jobs.TypeBreakGlassReviewReminder
+		jobsToRun = append(jobsToRun, &job)
+	}
+
+	if config.Jobs.ActivateScheduledGrants.Enabled {
+		job := config.Jobs.ActivateScheduledGrants
+		job.Type = jobs.TypeActivateScheduledGrants
+		jobsToRun = append(jobsToRun, &job)
+	}
+
 	jobScheduleMapping := fetchDefaultJobScheduleMapping()
 	for _, jobConfig := range jobsToRun {
 		schedule, ok := jobScheduleMapping[jobConfig.Type]
@@ -309,6 +349,11 @@ func fetchDefaultJobScheduleMapping() map[jobs.Type]string {
 		jobs.TypeFetchResources:            "0 */2 * * *",
 		jobs.TypeRevokeExpiredGrants:       "*/20 * * * *",
 		jobs.TypeExpiringGrantNotification: "0 9 * * *",
+		jobs.TypeRequestExternalApprovals:  "*/5 * * * *",
+		jobs.TypeApprovalStepTimeout:       "*/15 * * * *",
+		jobs.TypeExpirePendingAppeals:      "0 * * * *",
+		jobs.TypeBreakGlassReviewReminder:  "0 9 * * *",
+		jobs.TypeActivateScheduledGrants:   "*/5 * * * *",
 	}
 }
 
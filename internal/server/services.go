@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/raystack/guardian/core/namespace"
 
@@ -13,13 +14,18 @@ import (
 	"github.com/raystack/guardian/core/activity"
 	"github.com/raystack/guardian/core/appeal"
 	"github.com/raystack/guardian/core/approval"
+	"github.com/raystack/guardian/core/bundle"
+	"github.com/raystack/guardian/core/delegation"
 	"github.com/raystack/guardian/core/grant"
 	"github.com/raystack/guardian/core/policy"
 	"github.com/raystack/guardian/core/provider"
 	"github.com/raystack/guardian/core/resource"
+	"github.com/raystack/guardian/core/review"
+	"github.com/raystack/guardian/core/sod"
 	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/internal/store/postgres"
 	"github.com/raystack/guardian/pkg/auth"
+	"github.com/raystack/guardian/plugins/approvalwebhooks"
 	"github.com/raystack/guardian/plugins/identities"
 	"github.com/raystack/guardian/plugins/notifiers"
 	"github.com/raystack/guardian/plugins/providers/bigquery"
@@ -37,14 +43,18 @@ import (
 )
 
 type Services struct {
-	ResourceService  *resource.Service
-	ActivityService  *activity.Service
-	ProviderService  *provider.Service
-	PolicyService    *policy.Service
-	ApprovalService  *approval.Service
-	AppealService    *appeal.Service
-	GrantService     *grant.Service
-	NamespaceService *namespace.Service
+	ResourceService   *resource.Service
+	ActivityService   *activity.Service
+	ProviderService   *provider.Service
+	PolicyService     *policy.Service
+	ApprovalService   *approval.Service
+	AppealService     *appeal.Service
+	GrantService      *grant.Service
+	NamespaceService  *namespace.Service
+	DelegationService *delegation.Service
+	BundleService     *bundle.Service
+	ReviewService     *review.Service
+	SoDService        *sod.Service
 }
 
 type ServiceDeps struct {
@@ -105,6 +115,11 @@ func InitServices(deps ServiceDeps) (*Services, error) {
 	approvalRepository := postgres.NewApprovalRepository(store)
 	grantRepository := postgres.NewGrantRepository(store)
 	namespaceRepository := postgres.NewNamespaceRepository(store)
+	delegationRepository := postgres.NewDelegationRepository(store)
+	bundleRepository := postgres.NewBundleRepository(store)
+	reviewRepository := postgres.NewReviewRepository(store)
+	sodRuleRepository := postgres.NewSoDRuleRepository(store)
+	commentRepository := postgres.NewCommentRepository(store)
 
 	providerClients := []provider.Client{
 		bigquery.NewProvider(domain.ProviderTypeBigQuery, deps.Crypto, deps.Logger),
@@ -120,6 +135,18 @@ func InitServices(deps ServiceDeps) (*Services, error) {
 
 	iamManager := identities.NewManager(deps.Crypto, deps.Validator)
 
+	approvalWebhooksClient, err := approvalwebhooks.NewClient(deps.Config.ApprovalWebhooks)
+	if err != nil {
+		return nil, fmt.Errorf("initializing approval webhooks: %w", err)
+	}
+	var approvalWebhookNames []string
+	for _, w := range deps.Config.ApprovalWebhooks {
+		approvalWebhookNames = append(approvalWebhookNames, w.Name)
+	}
+	if err := deps.Config.RiskScoring.Validate(deps.Validator); err != nil {
+		return nil, fmt.Errorf("invalid risk scoring config: %w", err)
+	}
+
 	resourceService := resource.NewService(resource.ServiceDeps{
 		Repository:  resourceRepository,
 		Logger:      deps.Logger,
@@ -141,13 +168,14 @@ func InitServices(deps ServiceDeps) (*Services, error) {
 		AuditLogger:     auditLogger,
 	})
 	policyService := policy.NewService(policy.ServiceDeps{
-		Repository:      policyRepository,
-		ResourceService: resourceService,
-		ProviderService: providerService,
-		IAMManager:      iamManager,
-		Validator:       deps.Validator,
-		Logger:          deps.Logger,
-		AuditLogger:     auditLogger,
+		Repository:       policyRepository,
+		ResourceService:  resourceService,
+		ProviderService:  providerService,
+		IAMManager:       iamManager,
+		ApprovalWebhooks: approvalWebhookNames,
+		Validator:        deps.Validator,
+		Logger:           deps.Logger,
+		AuditLogger:      auditLogger,
 	})
 	grantService := grant.NewService(grant.ServiceDeps{
 		Repository:      grantRepository,
@@ -163,19 +191,55 @@ func InitServices(deps ServiceDeps) (*Services, error) {
 		Repository:    approvalRepository,
 		PolicyService: policyService,
 	})
+	delegationService := delegation.NewService(delegation.ServiceDeps{
+		Repository:  delegationRepository,
+		Validator:   deps.Validator,
+		Logger:      deps.Logger,
+		AuditLogger: auditLogger,
+	})
+	sodService := sod.NewService(sod.ServiceDeps{
+		Repository:   sodRuleRepository,
+		GrantService: grantService,
+		Validator:    deps.Validator,
+		Logger:       deps.Logger,
+		AuditLogger:  auditLogger,
+	})
 	appealService := appeal.NewService(appeal.ServiceDeps{
-		Repository:      appealRepository,
+		Repository:        appealRepository,
+		ResourceService:   resourceService,
+		ApprovalService:   approvalService,
+		ProviderService:   providerService,
+		PolicyService:     policyService,
+		GrantService:      grantService,
+		IAMManager:        iamManager,
+		DelegationService: delegationService,
+		SoDService:        sodService,
+		CommentRepository: commentRepository,
+		ExternalApprover:  approvalWebhooksClient,
+		RiskScoring:       deps.Config.RiskScoring,
+		Notifier:          deps.Notifier,
+		Validator:         deps.Validator,
+		Logger:            deps.Logger,
+		AuditLogger:       auditLogger,
+	})
+	bundleService := bundle.NewService(bundle.ServiceDeps{
+		Repository:      bundleRepository,
 		ResourceService: resourceService,
-		ApprovalService: approvalService,
-		ProviderService: providerService,
 		PolicyService:   policyService,
-		GrantService:    grantService,
-		IAMManager:      iamManager,
-		Notifier:        deps.Notifier,
+		AppealService:   appealService,
 		Validator:       deps.Validator,
 		Logger:          deps.Logger,
 		AuditLogger:     auditLogger,
 	})
+	reviewService := review.NewService(review.ServiceDeps{
+		Repository:    reviewRepository,
+		GrantService:  grantService,
+		AppealService: appealService,
+		Admins:        deps.Config.ReviewCampaignAdmins,
+		Validator:     deps.Validator,
+		Logger:        deps.Logger,
+		AuditLogger:   auditLogger,
+	})
 
 	return &Services{
 		resourceService,
@@ -186,6 +250,10 @@ func InitServices(deps ServiceDeps) (*Services, error) {
 		appealService,
 		grantService,
 		namespaceService,
+		delegationService,
+		bundleService,
+		reviewService,
+		sodService,
 	}, nil
 }
 
@@ -19,6 +19,7 @@ var (
 		domain.AppealStatusApproved,
 		domain.AppealStatusRejected,
 		domain.AppealStatusCanceled,
+		domain.AppealStatusExpired,
 	}
 )
 
@@ -92,12 +93,21 @@ func (r *AppealRepository) Find(ctx context.Context, filters *domain.ListAppeals
 		if filters.Role != "" {
 			db = db.Where(`"appeals"."role" = ?`, filters.Role)
 		}
+		if filters.BundleID != "" {
+			db = db.Where(`"appeals"."bundle_id" = ?`, filters.BundleID)
+		}
 		if !filters.ExpirationDateLessThan.IsZero() {
 			db = db.Where(`"options" -> 'expiration_date' < ?`, filters.ExpirationDateLessThan)
 		}
 		if !filters.ExpirationDateGreaterThan.IsZero() {
 			db = db.Where(`"options" -> 'expiration_date' > ?`, filters.ExpirationDateGreaterThan)
 		}
+		if !filters.CreatedAtLessThan.IsZero() {
+			db = db.Where(`"appeals"."created_at" < ?`, filters.CreatedAtLessThan)
+		}
+		if !filters.CreatedAtGreaterThan.IsZero() {
+			db = db.Where(`"appeals"."created_at" > ?`, filters.CreatedAtGreaterThan)
+		}
 		if filters.OrderBy != nil {
 			db = addOrderByClause(db, filters.OrderBy, addOrderByClauseOptions{
 				statusColumnName: `"appeals"."status"`,
@@ -252,12 +262,21 @@ func applyAppealFilter(db *gorm.DB, filters *domain.ListAppealsFilter) *gorm.DB
 	if filters.Role != "" {
 		db = db.Where(`"appeals"."role" = ?`, filters.Role)
 	}
+	if filters.BundleID != "" {
+		db = db.Where(`"appeals"."bundle_id" = ?`, filters.BundleID)
+	}
 	if !filters.ExpirationDateLessThan.IsZero() {
 		db = db.Where(`"options" -> 'expiration_date' < ?`, filters.ExpirationDateLessThan)
 	}
 	if !filters.ExpirationDateGreaterThan.IsZero() {
 		db = db.Where(`"options" -> 'expiration_date' > ?`, filters.ExpirationDateGreaterThan)
 	}
+	if !filters.CreatedAtLessThan.IsZero() {
+		db = db.Where(`"appeals"."created_at" < ?`, filters.CreatedAtLessThan)
+	}
+	if !filters.CreatedAtGreaterThan.IsZero() {
+		db = db.Where(`"appeals"."created_at" > ?`, filters.CreatedAtGreaterThan)
+	}
 	if filters.OrderBy != nil {
 		db = addOrderByClause(db, filters.OrderBy, addOrderByClauseOptions{
 			statusColumnName: `"appeals"."status"`,
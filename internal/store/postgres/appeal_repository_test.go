@@ -311,6 +311,18 @@ func (s *AppealRepositoryTestSuite) TestFind() {
 				},
 				expectedResult: []*domain.Appeal{dummyAppeals[0]},
 			},
+			{
+				filters: &domain.ListAppealsFilter{
+					CreatedAtLessThan: timeNow,
+				},
+				expectedResult: dummyAppeals,
+			},
+			{
+				filters: &domain.ListAppealsFilter{
+					CreatedAtGreaterThan: timeNow,
+				},
+				expectedResult: []*domain.Appeal{},
+			},
 			{
 				filters: &domain.ListAppealsFilter{
 					ProviderTypes: []string{s.dummyProvider.Type},
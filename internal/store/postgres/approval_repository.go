@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/raystack/guardian/core/appeal"
 	"github.com/raystack/guardian/domain"
@@ -140,10 +141,27 @@ func (r *ApprovalRepository) DeleteApprover(ctx context.Context, approvalID, ema
 	return nil
 }
 
+// DeleteApproval removes the approval along with its approvers
+func (r *ApprovalRepository) DeleteApproval(ctx context.Context, id string) error {
+	return r.store.Tx(ctx, func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", id).Delete(&model.Approval{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return appeal.ErrApprovalNotFound
+		}
+
+		return tx.Where("approval_id = ?", id).Delete(&model.Approver{}).Error
+	})
+}
+
 func applyFilter(db *gorm.DB, filter *domain.ListApprovalsFilter) *gorm.DB {
 	db = db.Joins("Appeal").
-		Joins("Appeal.Resource").
-		Joins(`JOIN "approvers" ON "approvals"."id" = "approvers"."approval_id"`)
+		Joins("Appeal.Resource")
+	if filter.CreatedBy == "" {
+		db = db.Joins(`JOIN "approvers" ON "approvals"."id" = "approvers"."approval_id"`)
+	}
 
 	if filter.Q != "" {
 		// NOTE: avoid adding conditions before this grouped where clause.
@@ -155,7 +173,8 @@ func applyFilter(db *gorm.DB, filter *domain.ListApprovalsFilter) *gorm.DB {
 		)
 	}
 	if filter.CreatedBy != "" {
-		db = db.Where(`"approvers"."email" = ?`, filter.CreatedBy)
+		condition, args := approverCondition(filter.CreatedBy, filter.Delegations)
+		db = db.Where(condition, args...)
 	}
 	if filter.Statuses != nil {
 		db = db.Where(`"approvals"."status" IN ?`, filter.Statuses)
@@ -171,7 +190,7 @@ func applyFilter(db *gorm.DB, filter *domain.ListApprovalsFilter) *gorm.DB {
 	}
 
 	if len(filter.AppealStatuses) == 0 {
-		db = db.Where(`"Appeal"."status" != ?`, domain.AppealStatusCanceled)
+		db = db.Where(`"Appeal"."status" NOT IN ?`, []string{domain.AppealStatusCanceled, domain.AppealStatusExpired})
 	} else {
 		db = db.Where(`"Appeal"."status" IN ?`, filter.AppealStatuses)
 	}
@@ -185,3 +204,32 @@ func applyFilter(db *gorm.DB, filter *domain.ListApprovalsFilter) *gorm.DB {
 
 	return db
 }
+
+// approverCondition matches the approvals of the approver, and the ones of their delegators within the scope of the
+// delegations. Each approval is matched once even if several of them are its approvers.
+func approverCondition(approver string, delegations []*domain.Delegation) (string, []interface{}) {
+	conditions := []string{`"approvers"."email" = ?`}
+	args := []interface{}{approver}
+	for _, d := range delegations {
+		condition := `"approvers"."email" = ?`
+		args = append(args, d.Delegator)
+		if d.PolicyID != "" {
+			condition += ` AND "Appeal"."policy_id" = ?`
+			args = append(args, d.PolicyID)
+		}
+		if d.ProviderType != "" {
+			condition += ` AND "Appeal__Resource"."provider_type" = ?`
+			args = append(args, d.ProviderType)
+		}
+		if d.ProviderURN != "" {
+			condition += ` AND "Appeal__Resource"."provider_urn" = ?`
+			args = append(args, d.ProviderURN)
+		}
+		conditions = append(conditions, fmt.Sprintf("(%s)", condition))
+	}
+
+	return fmt.Sprintf(
+		`EXISTS (SELECT 1 FROM "approvers" WHERE "approvers"."approval_id" = "approvals"."id" AND "approvers"."deleted_at" IS NULL AND (%s))`,
+		strings.Join(conditions, " OR "),
+	), args
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/ory/dockertest/v3"
+	"github.com/raystack/guardian/core/appeal"
 	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/internal/store/postgres"
 	"github.com/raystack/salt/log"
@@ -305,6 +306,23 @@ func (s *ApprovalRepositoryTestSuite) TestListApprovals() {
 		s.Equal(dummyApprovals[3].ID, approvals[0].ID)
 	})
 
+	s.Run("should return approvals of the delegators within the scope of the delegations", func() {
+		approvals, err := s.repository.ListApprovals(context.Background(), &domain.ListApprovalsFilter{
+			CreatedBy: dummyApprover[0].Email,
+			Delegations: []*domain.Delegation{
+				{Delegator: dummyApprover[1].Email, Delegate: dummyApprover[0].Email, PolicyID: s.dummyAppeal.PolicyID},
+				{Delegator: dummyApprover[3].Email, Delegate: dummyApprover[0].Email, PolicyID: "another-policy"},
+			},
+		})
+
+		s.NoError(err)
+		var ids []string
+		for _, a := range approvals {
+			ids = append(ids, a.ID)
+		}
+		s.ElementsMatch([]string{dummyApprovals[0].ID, dummyApprovals[1].ID, dummyApprovals[2].ID}, ids)
+	})
+
 	s.Run("should return error if conditions invalid", func() {
 		approvals, err := s.repository.ListApprovals(context.Background(), &domain.ListApprovalsFilter{
 			AccountID: "",
@@ -536,3 +554,44 @@ func (s *ApprovalRepositoryTestSuite) TestDeleteApprover() {
 		s.Error(err)
 	})
 }
+
+func (s *ApprovalRepositoryTestSuite) TestDeleteApproval() {
+	dummyApprovals := []*domain.Approval{
+		{
+			Name:          domain.SoDApprovalName,
+			Index:         0,
+			AppealID:      s.dummyAppeal.ID,
+			Status:        domain.ApprovalStatusBlocked,
+			PolicyID:      "policy_1",
+			PolicyVersion: 1,
+		},
+	}
+
+	ctx := context.Background()
+	err := s.repository.BulkInsert(ctx, dummyApprovals)
+	s.Require().NoError(err)
+
+	dummyApprover := &domain.Approver{
+		ApprovalID: dummyApprovals[0].ID,
+		Email:      "security@example.com",
+		AppealID:   s.dummyAppeal.ID,
+	}
+	err = s.repository.AddApprover(ctx, dummyApprover)
+	s.Require().NoError(err)
+
+	s.Run("should remove the approval and its approvers on success", func() {
+		err := s.repository.DeleteApproval(ctx, dummyApprovals[0].ID)
+		s.NoError(err)
+
+		approvals, err := s.repository.ListApprovals(ctx, &domain.ListApprovalsFilter{
+			CreatedBy: dummyApprover.Email,
+		})
+		s.NoError(err)
+		s.Empty(approvals)
+	})
+
+	s.Run("should return error if the approval doesn't exist", func() {
+		err := s.repository.DeleteApproval(ctx, dummyApprovals[0].ID)
+		s.ErrorIs(err, appeal.ErrApprovalNotFound)
+	})
+}
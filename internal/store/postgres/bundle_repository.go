@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/raystack/guardian/core/bundle"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/internal/store/postgres/model"
+	"gorm.io/gorm"
+)
+
+type BundleRepository struct {
+	store *Store
+}
+
+func NewBundleRepository(store *Store) *BundleRepository {
+	return &BundleRepository{
+		store: store,
+	}
+}
+
+func (r *BundleRepository) Find(ctx context.Context, filter domain.ListBundlesFilter) ([]*domain.Bundle, error) {
+	var models []*model.Bundle
+	if err := r.store.Tx(ctx, func(tx *gorm.DB) error {
+		if filter.Names != nil {
+			tx = tx.Where(`"name" IN ?`, filter.Names)
+		}
+		if filter.CreatedBy != "" {
+			tx = tx.Where(`"created_by" = ?`, filter.CreatedBy)
+		}
+		return tx.Order(`"name"`).Find(&models).Error
+	}); err != nil {
+		return nil, err
+	}
+
+	var results []*domain.Bundle
+	for _, m := range models {
+		b := &domain.Bundle{}
+		if err := m.ToDomain(b); err != nil {
+			return nil, fmt.Errorf("failed to convert model %q to domain: %w", m.ID, err)
+		}
+		results = append(results, b)
+	}
+	return results, nil
+}
+
+func (r *BundleRepository) GetByID(ctx context.Context, id string) (*domain.Bundle, error) {
+	var m model.Bundle
+	if err := r.store.Tx(ctx, func(tx *gorm.DB) error {
+		return tx.Where("id = ?", id).First(&m).Error
+	}); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, bundle.ErrBundleNotFound
+		}
+		return nil, err
+	}
+
+	b := &domain.Bundle{}
+	if err := m.ToDomain(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (r *BundleRepository) Create(ctx context.Context, b *domain.Bundle) error {
+	m := new(model.Bundle)
+	if err := m.FromDomain(b); err != nil {
+		return fmt.Errorf("failed to convert domain to model: %w", err)
+	}
+	m.NamespaceID = namespaceFromContext(ctx)
+
+	return r.store.Tx(ctx, func(tx *gorm.DB) error {
+		if err := tx.Create(m).Error; err != nil {
+			return err
+		}
+		return m.ToDomain(b)
+	})
+}
+
+func (r *BundleRepository) Update(ctx context.Context, b *domain.Bundle) error {
+	if b == nil || b.ID == "" {
+		return bundle.ErrEmptyIDParam
+	}
+
+	m := new(model.Bundle)
+	if err := m.FromDomain(b); err != nil {
+		return fmt.Errorf("failed to convert domain to model: %w", err)
+	}
+
+	return r.store.Tx(ctx, func(tx *gorm.DB) error {
+		// select columns explicitly so that the policy can be cleared
+		if err := tx.Model(m).
+			Select("name", "description", "policy_id", "policy_version", "items", "labels").
+			Updates(m).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id = ?", m.ID).First(m).Error; err != nil {
+			return err
+		}
+		return m.ToDomain(b)
+	})
+}
+
+func (r *BundleRepository) Delete(ctx context.Context, id string) error {
+	if id == "" {
+		return bundle.ErrEmptyIDParam
+	}
+	var result *gorm.DB
+	_ = r.store.Tx(ctx, func(tx *gorm.DB) error {
+		result = tx.Where("id = ?", id).Delete(&model.Bundle{})
+		return nil
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return bundle.ErrBundleNotFound
+	}
+
+	return nil
+}
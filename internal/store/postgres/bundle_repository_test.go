@@ -0,0 +1,127 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raystack/guardian/core/bundle"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/internal/store/postgres"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type BundleRepositoryTestSuite struct {
+	suite.Suite
+
+	store      *postgres.Store
+	repository *postgres.BundleRepository
+}
+
+func TestBundleRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	suite.Run(t, new(BundleRepositoryTestSuite))
+}
+
+func (s *BundleRepositoryTestSuite) SetupSuite() {
+	logger := log.NewLogrus(log.LogrusWithLevel("debug"))
+	store, pool, resource, err := newTestStore(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.store = store
+	s.repository = postgres.NewBundleRepository(store)
+
+	s.T().Cleanup(func() {
+		db, err := s.store.DB().DB()
+		if err != nil {
+			s.T().Fatal(err)
+		}
+		if err := db.Close(); err != nil {
+			s.T().Fatal(err)
+		}
+		if err := purgeTestDocker(pool, resource); err != nil {
+			s.T().Fatal(err)
+		}
+	})
+}
+
+func (s *BundleRepositoryTestSuite) AfterTest(_, _ string) {
+	if err := truncateTable(s.store, "bundles"); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *BundleRepositoryTestSuite) TestCreateAndFind() {
+	ctx := context.Background()
+
+	b := &domain.Bundle{
+		Name:           "new-joiner",
+		RequiredPolicy: &domain.PolicyConfig{ID: "onboarding", Version: 1},
+		Items: []*domain.BundleItem{
+			{Resource: &domain.ResourceIdentifier{ID: "resource-1"}, Role: "viewer", Duration: "720h"},
+		},
+		Labels:    map[string]string{"team": "platform"},
+		CreatedBy: "admin@example.com",
+	}
+	s.Require().NoError(s.repository.Create(ctx, b))
+	s.NotEmpty(b.ID)
+
+	s.Run("should return bundle with its items and policy", func() {
+		actual, err := s.repository.GetByID(ctx, b.ID)
+
+		s.NoError(err)
+		s.Equal(b.Name, actual.Name)
+		s.Equal(b.RequiredPolicy, actual.RequiredPolicy)
+		s.Equal(b.Items, actual.Items)
+		s.Equal(b.Labels, actual.Labels)
+	})
+
+	s.Run("should filter bundles by name", func() {
+		actual, err := s.repository.Find(ctx, domain.ListBundlesFilter{Names: []string{"new-joiner"}})
+		s.NoError(err)
+		s.Len(actual, 1)
+
+		actual, err = s.repository.Find(ctx, domain.ListBundlesFilter{Names: []string{"unknown"}})
+		s.NoError(err)
+		s.Empty(actual)
+	})
+}
+
+func (s *BundleRepositoryTestSuite) TestUpdateAndDelete() {
+	ctx := context.Background()
+
+	b := &domain.Bundle{
+		Name:           "new-joiner",
+		RequiredPolicy: &domain.PolicyConfig{ID: "onboarding", Version: 1},
+		Items: []*domain.BundleItem{
+			{Resource: &domain.ResourceIdentifier{ID: "resource-1"}, Role: "viewer"},
+		},
+		CreatedBy: "admin@example.com",
+	}
+	s.Require().NoError(s.repository.Create(ctx, b))
+
+	s.Run("should clear policy on update", func() {
+		b.RequiredPolicy = nil
+
+		err := s.repository.Update(ctx, b)
+		s.NoError(err)
+
+		actual, err := s.repository.GetByID(ctx, b.ID)
+		s.NoError(err)
+		s.Nil(actual.RequiredPolicy)
+	})
+
+	s.Run("should return not found error after deletion", func() {
+		s.NoError(s.repository.Delete(ctx, b.ID))
+
+		_, err := s.repository.GetByID(ctx, b.ID)
+		s.ErrorIs(err, bundle.ErrBundleNotFound)
+
+		err = s.repository.Delete(ctx, b.ID)
+		s.ErrorIs(err, bundle.ErrBundleNotFound)
+	})
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/internal/store/postgres/model"
+	"gorm.io/gorm"
+)
+
+type CommentRepository struct {
+	store *Store
+}
+
+func NewCommentRepository(store *Store) *CommentRepository {
+	return &CommentRepository{
+		store: store,
+	}
+}
+
+func (r *CommentRepository) List(ctx context.Context, filter domain.ListCommentsFilter) ([]*domain.Comment, error) {
+	var models []*model.Comment
+	if err := r.store.Tx(ctx, func(tx *gorm.DB) error {
+		if filter.AppealID != "" {
+			tx = tx.Where(`"appeal_id" = ?`, filter.AppealID)
+		}
+		if filter.OrderBy != nil {
+			tx = addOrderByClause(tx, filter.OrderBy, addOrderByClauseOptions{})
+		} else {
+			tx = tx.Order(`"created_at"`)
+		}
+		return tx.Find(&models).Error
+	}); err != nil {
+		return nil, err
+	}
+
+	var results []*domain.Comment
+	for _, m := range models {
+		c := &domain.Comment{}
+		m.ToDomain(c)
+		results = append(results, c)
+	}
+	return results, nil
+}
+
+func (r *CommentRepository) Create(ctx context.Context, c *domain.Comment) error {
+	m := new(model.Comment)
+	if err := m.FromDomain(c); err != nil {
+		return fmt.Errorf("failed to convert domain to model: %w", err)
+	}
+	m.NamespaceID = namespaceFromContext(ctx)
+
+	return r.store.Tx(ctx, func(tx *gorm.DB) error {
+		if err := tx.Create(m).Error; err != nil {
+			return err
+		}
+		m.ToDomain(c)
+		return nil
+	})
+}
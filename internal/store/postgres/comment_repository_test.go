@@ -0,0 +1,149 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/internal/store/postgres"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type CommentRepositoryTestSuite struct {
+	suite.Suite
+
+	store      *postgres.Store
+	repository *postgres.CommentRepository
+
+	dummyAppeal *domain.Appeal
+}
+
+func TestCommentRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	suite.Run(t, new(CommentRepositoryTestSuite))
+}
+
+func (s *CommentRepositoryTestSuite) SetupSuite() {
+	logger := log.NewLogrus(log.LogrusWithLevel("debug"))
+	store, pool, resource, err := newTestStore(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.store = store
+	s.repository = postgres.NewCommentRepository(store)
+
+	ctx := context.Background()
+	dummyPolicy := &domain.Policy{
+		ID:      "policy_test",
+		Version: 1,
+	}
+	s.Require().NoError(postgres.NewPolicyRepository(store).Create(ctx, dummyPolicy))
+
+	dummyProvider := &domain.Provider{
+		Type: "provider_test",
+		URN:  "provider_urn_test",
+		Config: &domain.ProviderConfig{
+			Resources: []*domain.ResourceConfig{
+				{
+					Type: "resource_type_test",
+					Policy: &domain.PolicyConfig{
+						ID:      dummyPolicy.ID,
+						Version: int(dummyPolicy.Version),
+					},
+				},
+			},
+		},
+	}
+	s.Require().NoError(postgres.NewProviderRepository(store).Create(ctx, dummyProvider))
+
+	dummyResource := &domain.Resource{
+		ProviderType: dummyProvider.Type,
+		ProviderURN:  dummyProvider.URN,
+		Type:         "resource_type_test",
+		URN:          "resource_urn_test",
+		Name:         "resource_name_test",
+	}
+	s.Require().NoError(postgres.NewResourceRepository(store).BulkUpsert(ctx, []*domain.Resource{dummyResource}))
+
+	s.dummyAppeal = &domain.Appeal{
+		ResourceID:    dummyResource.ID,
+		PolicyID:      dummyPolicy.ID,
+		PolicyVersion: dummyPolicy.Version,
+		AccountID:     "user@example.com",
+		AccountType:   domain.DefaultAppealAccountType,
+		Role:          "role_test",
+		Status:        domain.AppealStatusPending,
+		CreatedBy:     "user@example.com",
+	}
+	s.Require().NoError(postgres.NewAppealRepository(store).BulkUpsert(ctx, []*domain.Appeal{s.dummyAppeal}))
+
+	s.T().Cleanup(func() {
+		db, err := s.store.DB().DB()
+		if err != nil {
+			s.T().Fatal(err)
+		}
+		if err := db.Close(); err != nil {
+			s.T().Fatal(err)
+		}
+		if err := purgeTestDocker(pool, resource); err != nil {
+			s.T().Fatal(err)
+		}
+	})
+}
+
+func (s *CommentRepositoryTestSuite) AfterTest(_, _ string) {
+	if err := truncateTable(s.store, "appeal_comments"); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *CommentRepositoryTestSuite) TestCreateAndList() {
+	ctx := context.Background()
+
+	first := &domain.Comment{
+		AppealID:  s.dummyAppeal.ID,
+		CreatedBy: "user@example.com",
+		Body:      "need this access for the quarterly report",
+	}
+	s.Require().NoError(s.repository.Create(ctx, first))
+	s.NotEmpty(first.ID)
+	s.NotEmpty(first.CreatedAt)
+
+	second := &domain.Comment{
+		AppealID:  s.dummyAppeal.ID,
+		CreatedBy: "approver@example.com",
+		Body:      "is read access enough?",
+	}
+	s.Require().NoError(s.repository.Create(ctx, second))
+
+	s.Run("should return comments of the appeal from the oldest", func() {
+		actual, err := s.repository.List(ctx, domain.ListCommentsFilter{AppealID: s.dummyAppeal.ID})
+
+		s.NoError(err)
+		s.Require().Len(actual, 2)
+		s.Equal(first.ID, actual[0].ID)
+		s.Equal(first.Body, actual[0].Body)
+		s.Equal(second.ID, actual[1].ID)
+	})
+
+	s.Run("should sort comments by the order by filter", func() {
+		actual, err := s.repository.List(ctx, domain.ListCommentsFilter{
+			AppealID: s.dummyAppeal.ID,
+			OrderBy:  []string{"created_at:desc"},
+		})
+
+		s.NoError(err)
+		s.Require().Len(actual, 2)
+		s.Equal(second.ID, actual[0].ID)
+	})
+
+	s.Run("should return error if appeal id is invalid", func() {
+		err := s.repository.Create(ctx, &domain.Comment{AppealID: "invalid-id", Body: "hello"})
+
+		s.Error(err)
+	})
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/raystack/guardian/core/delegation"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/internal/store/postgres/model"
+	"gorm.io/gorm"
+)
+
+type DelegationRepository struct {
+	store *Store
+}
+
+func NewDelegationRepository(store *Store) *DelegationRepository {
+	return &DelegationRepository{
+		store: store,
+	}
+}
+
+func (r *DelegationRepository) Find(ctx context.Context, filter domain.ListDelegationsFilter) ([]*domain.Delegation, error) {
+	var models []*model.Delegation
+	if err := r.store.Tx(ctx, func(tx *gorm.DB) error {
+		if filter.Delegators != nil {
+			tx = tx.Where(`"delegator" IN ?`, filter.Delegators)
+		}
+		if filter.Delegates != nil {
+			tx = tx.Where(`"delegate" IN ?`, filter.Delegates)
+		}
+		if filter.ActiveAt != nil {
+			tx = tx.Where(`"start_date" <= ? AND "end_date" > ?`, *filter.ActiveAt, *filter.ActiveAt)
+		}
+		return tx.Order(`"start_date" DESC`).Find(&models).Error
+	}); err != nil {
+		return nil, err
+	}
+
+	var results []*domain.Delegation
+	for _, m := range models {
+		d := &domain.Delegation{}
+		if err := m.ToDomain(d); err != nil {
+			return nil, fmt.Errorf("failed to convert model %q to domain: %w", m.ID, err)
+		}
+		results = append(results, d)
+	}
+	return results, nil
+}
+
+func (r *DelegationRepository) GetByID(ctx context.Context, id string) (*domain.Delegation, error) {
+	var m model.Delegation
+	if err := r.store.Tx(ctx, func(tx *gorm.DB) error {
+		return tx.Where("id = ?", id).First(&m).Error
+	}); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, delegation.ErrDelegationNotFound
+		}
+		return nil, err
+	}
+
+	d := &domain.Delegation{}
+	if err := m.ToDomain(d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (r *DelegationRepository) Create(ctx context.Context, d *domain.Delegation) error {
+	m := new(model.Delegation)
+	if err := m.FromDomain(d); err != nil {
+		return fmt.Errorf("failed to convert domain to model: %w", err)
+	}
+	m.NamespaceID = namespaceFromContext(ctx)
+
+	return r.store.Tx(ctx, func(tx *gorm.DB) error {
+		if err := tx.Create(m).Error; err != nil {
+			return err
+		}
+		return m.ToDomain(d)
+	})
+}
+
+func (r *DelegationRepository) Update(ctx context.Context, d *domain.Delegation) error {
+	if d == nil || d.ID == "" {
+		return delegation.ErrEmptyIDParam
+	}
+
+	m := new(model.Delegation)
+	if err := m.FromDomain(d); err != nil {
+		return fmt.Errorf("failed to convert domain to model: %w", err)
+	}
+
+	return r.store.Tx(ctx, func(tx *gorm.DB) error {
+		// select columns explicitly so that scope fields can be cleared
+		if err := tx.Model(m).
+			Select("start_date", "end_date", "policy_id", "provider_type", "provider_urn", "reason").
+			Updates(m).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id = ?", m.ID).First(m).Error; err != nil {
+			return err
+		}
+		return m.ToDomain(d)
+	})
+}
+
+func (r *DelegationRepository) Delete(ctx context.Context, id string) error {
+	if id == "" {
+		return delegation.ErrEmptyIDParam
+	}
+	var result *gorm.DB
+	_ = r.store.Tx(ctx, func(tx *gorm.DB) error {
+		result = tx.Where("id = ?", id).Delete(&model.Delegation{})
+		return nil
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return delegation.ErrDelegationNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raystack/guardian/core/delegation"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/internal/store/postgres"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type DelegationRepositoryTestSuite struct {
+	suite.Suite
+
+	store      *postgres.Store
+	repository *postgres.DelegationRepository
+}
+
+func TestDelegationRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	suite.Run(t, new(DelegationRepositoryTestSuite))
+}
+
+func (s *DelegationRepositoryTestSuite) SetupSuite() {
+	logger := log.NewLogrus(log.LogrusWithLevel("debug"))
+	store, pool, resource, err := newTestStore(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.store = store
+	s.repository = postgres.NewDelegationRepository(store)
+
+	s.T().Cleanup(func() {
+		db, err := s.store.DB().DB()
+		if err != nil {
+			s.T().Fatal(err)
+		}
+		if err := db.Close(); err != nil {
+			s.T().Fatal(err)
+		}
+		if err := purgeTestDocker(pool, resource); err != nil {
+			s.T().Fatal(err)
+		}
+	})
+}
+
+func (s *DelegationRepositoryTestSuite) AfterTest(_, _ string) {
+	if err := truncateTable(s.store, "delegations"); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *DelegationRepositoryTestSuite) TestCreateAndFind() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	active := &domain.Delegation{
+		Delegator: "user@example.com",
+		Delegate:  "substitute@example.com",
+		StartDate: now.Add(-time.Hour),
+		EndDate:   now.Add(time.Hour),
+		CreatedBy: "user@example.com",
+	}
+	expired := &domain.Delegation{
+		Delegator: "user@example.com",
+		Delegate:  "previous.substitute@example.com",
+		StartDate: now.Add(-48 * time.Hour),
+		EndDate:   now.Add(-24 * time.Hour),
+		CreatedBy: "user@example.com",
+	}
+	s.Require().NoError(s.repository.Create(ctx, active))
+	s.Require().NoError(s.repository.Create(ctx, expired))
+	s.NotEmpty(active.ID)
+
+	s.Run("should return all delegations of the delegator", func() {
+		actual, err := s.repository.Find(ctx, domain.ListDelegationsFilter{
+			Delegators: []string{"user@example.com"},
+		})
+
+		s.NoError(err)
+		s.Len(actual, 2)
+	})
+
+	s.Run("should only return delegations active at the given time", func() {
+		actual, err := s.repository.Find(ctx, domain.ListDelegationsFilter{
+			Delegators: []string{"user@example.com"},
+			ActiveAt:   &now,
+		})
+
+		s.NoError(err)
+		s.Len(actual, 1)
+		s.Equal(active.ID, actual[0].ID)
+	})
+}
+
+func (s *DelegationRepositoryTestSuite) TestUpdateAndDelete() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	d := &domain.Delegation{
+		Delegator:    "user@example.com",
+		Delegate:     "substitute@example.com",
+		StartDate:    now,
+		EndDate:      now.Add(time.Hour),
+		ProviderType: "bigquery",
+		CreatedBy:    "user@example.com",
+	}
+	s.Require().NoError(s.repository.Create(ctx, d))
+
+	s.Run("should clear scope fields on update", func() {
+		d.ProviderType = ""
+		d.EndDate = now.Add(2 * time.Hour)
+
+		err := s.repository.Update(ctx, d)
+		s.NoError(err)
+
+		actual, err := s.repository.GetByID(ctx, d.ID)
+		s.NoError(err)
+		s.Empty(actual.ProviderType)
+		s.True(actual.EndDate.Equal(now.Add(2 * time.Hour)))
+	})
+
+	s.Run("should return not found error after deletion", func() {
+		s.NoError(s.repository.Delete(ctx, d.ID))
+
+		_, err := s.repository.GetByID(ctx, d.ID)
+		s.ErrorIs(err, delegation.ErrDelegationNotFound)
+
+		err = s.repository.Delete(ctx, d.ID)
+		s.ErrorIs(err, delegation.ErrDelegationNotFound)
+	})
+}
@@ -17,6 +17,7 @@ var (
 	GrantStatusDefaultSort = []string{
 		string(domain.GrantStatusActive),
 		string(domain.GrantStatusInactive),
+		string(domain.GrantStatusScheduled),
 	}
 )
 
@@ -79,6 +80,9 @@ func (r *GrantRepository) List(ctx context.Context, filter domain.ListGrantsFilt
 		if !filter.ExpirationDateGreaterThan.IsZero() {
 			tx = tx.Where(`"grants"."expiration_date" > ?`, filter.ExpirationDateGreaterThan)
 		}
+		if !filter.StartDateLessThan.IsZero() {
+			tx = tx.Where(`"grants"."start_date" < ?`, filter.StartDateLessThan)
+		}
 		if filter.ProviderTypes != nil {
 			tx = tx.Where(`"Resource"."provider_type" IN ?`, filter.ProviderTypes)
 		}
@@ -322,6 +326,9 @@ func applyGrantFilter(db *gorm.DB, filter domain.ListGrantsFilter) *gorm.DB {
 	if !filter.ExpirationDateGreaterThan.IsZero() {
 		db = db.Where(`"grants"."expiration_date" > ?`, filter.ExpirationDateGreaterThan)
 	}
+	if !filter.StartDateLessThan.IsZero() {
+		db = db.Where(`"grants"."start_date" < ?`, filter.StartDateLessThan)
+	}
 	db = db.Joins("Resource")
 	if filter.ProviderTypes != nil {
 		db = db.Where(`"Resource"."provider_type" IN ?`, filter.ProviderTypes)
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "delegations";
\ No newline at end of file
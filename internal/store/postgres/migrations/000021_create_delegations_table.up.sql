@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS "delegations" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "namespace_id" uuid NOT NULL DEFAULT uuid_nil(),
+  "delegator" text NOT NULL,
+  "delegate" text NOT NULL,
+  "start_date" timestamptz NOT NULL,
+  "end_date" timestamptz NOT NULL,
+  "policy_id" text,
+  "provider_type" text,
+  "provider_urn" text,
+  "reason" text,
+  "created_by" text,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_delegations_namespace_id" ON "delegations" ("namespace_id");
+
+CREATE INDEX IF NOT EXISTS "idx_delegations_delegator" ON "delegations" ("delegator");
+
+CREATE INDEX IF NOT EXISTS "idx_delegations_delegate" ON "delegations" ("delegate");
+
+CREATE INDEX IF NOT EXISTS "idx_delegations_deleted_at" ON "delegations" ("deleted_at");
+
+ALTER TABLE "delegations" ENABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS delegations_isolation_policy ON delegations;
+CREATE POLICY delegations_isolation_policy on delegations USING (namespace_id = current_setting('app.current_tenant')::UUID);
@@ -0,0 +1,2 @@
+ALTER TABLE approvals DROP COLUMN IF EXISTS min_approvals;
+ALTER TABLE approvals DROP COLUMN IF EXISTS decisions;
@@ -0,0 +1,2 @@
+ALTER TABLE approvals ADD COLUMN IF NOT EXISTS min_approvals int NOT NULL DEFAULT 0;
+ALTER TABLE approvals ADD COLUMN IF NOT EXISTS decisions jsonb;
@@ -0,0 +1 @@
+ALTER TABLE approvals ADD COLUMN IF NOT EXISTS escalated_at timestamptz;
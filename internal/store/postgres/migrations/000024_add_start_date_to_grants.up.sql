@@ -0,0 +1 @@
+ALTER TABLE grants ADD COLUMN IF NOT EXISTS start_date timestamptz;
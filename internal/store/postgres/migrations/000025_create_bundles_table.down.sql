@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "idx_appeals_bundle_id";
+ALTER TABLE appeals DROP COLUMN IF EXISTS bundle_id;
+DROP TABLE IF EXISTS "bundles";
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS "bundles" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "namespace_id" uuid NOT NULL DEFAULT uuid_nil(),
+  "name" text NOT NULL,
+  "description" text,
+  "policy_id" text,
+  "policy_version" integer,
+  "items" jsonb,
+  "labels" jsonb,
+  "created_by" text,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_bundles_namespace_id" ON "bundles" ("namespace_id");
+
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_bundles_name" ON "bundles" ("namespace_id", "name") WHERE "deleted_at" IS NULL;
+
+CREATE INDEX IF NOT EXISTS "idx_bundles_deleted_at" ON "bundles" ("deleted_at");
+
+ALTER TABLE "bundles" ENABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS bundles_isolation_policy ON bundles;
+CREATE POLICY bundles_isolation_policy on bundles USING (namespace_id = current_setting('app.current_tenant')::UUID);
+
+ALTER TABLE appeals ADD COLUMN IF NOT EXISTS bundle_id uuid;
+
+CREATE INDEX IF NOT EXISTS "idx_appeals_bundle_id" ON "appeals" ("bundle_id");
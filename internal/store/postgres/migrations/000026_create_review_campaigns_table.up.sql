@@ -0,0 +1,65 @@
+CREATE TABLE IF NOT EXISTS "review_campaigns" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "namespace_id" uuid NOT NULL DEFAULT uuid_nil(),
+  "name" text NOT NULL,
+  "description" text,
+  "scope" jsonb,
+  "reviewer_strategy" text NOT NULL,
+  "resource_owner_key" text,
+  "default_action" text NOT NULL,
+  "status" text NOT NULL,
+  "created_by" text,
+  "closed_by" text,
+  "closed_at" timestamptz,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_review_campaigns_namespace_id" ON "review_campaigns" ("namespace_id");
+
+CREATE INDEX IF NOT EXISTS "idx_review_campaigns_status" ON "review_campaigns" ("status");
+
+CREATE INDEX IF NOT EXISTS "idx_review_campaigns_deleted_at" ON "review_campaigns" ("deleted_at");
+
+ALTER TABLE "review_campaigns" ENABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS review_campaigns_isolation_policy ON review_campaigns;
+CREATE POLICY review_campaigns_isolation_policy on review_campaigns USING (namespace_id = current_setting('app.current_tenant')::UUID);
+
+CREATE TABLE IF NOT EXISTS "review_items" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "namespace_id" uuid NOT NULL DEFAULT uuid_nil(),
+  "campaign_id" uuid NOT NULL REFERENCES "review_campaigns" ("id"),
+  "grant_id" uuid NOT NULL,
+  "account_id" text,
+  "account_type" text,
+  "resource_id" text,
+  "resource_urn" text,
+  "provider_type" text,
+  "role" text,
+  "reviewers" text[],
+  "decision" text,
+  "reason" text,
+  "decided_by" text,
+  "decided_at" timestamptz,
+  "defaulted" boolean NOT NULL DEFAULT false,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_review_items_namespace_id" ON "review_items" ("namespace_id");
+
+CREATE INDEX IF NOT EXISTS "idx_review_items_campaign_id" ON "review_items" ("campaign_id");
+
+CREATE INDEX IF NOT EXISTS "idx_review_items_reviewers" ON "review_items" USING GIN ("reviewers");
+
+CREATE INDEX IF NOT EXISTS "idx_review_items_deleted_at" ON "review_items" ("deleted_at");
+
+ALTER TABLE "review_items" ENABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS review_items_isolation_policy ON review_items;
+CREATE POLICY review_items_isolation_policy on review_items USING (namespace_id = current_setting('app.current_tenant')::UUID);
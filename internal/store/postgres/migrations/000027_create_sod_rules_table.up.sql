@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS "sod_rules" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "namespace_id" uuid NOT NULL DEFAULT uuid_nil(),
+  "name" text NOT NULL,
+  "description" text,
+  "selectors" jsonb,
+  "action" text,
+  "approvers" text[],
+  "created_by" text,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_sod_rules_namespace_id" ON "sod_rules" ("namespace_id");
+
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_sod_rules_name" ON "sod_rules" ("namespace_id", "name") WHERE "deleted_at" IS NULL;
+
+CREATE INDEX IF NOT EXISTS "idx_sod_rules_deleted_at" ON "sod_rules" ("deleted_at");
+
+ALTER TABLE "sod_rules" ENABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS sod_rules_isolation_policy ON sod_rules;
+CREATE POLICY sod_rules_isolation_policy on sod_rules USING (namespace_id = current_setting('app.current_tenant')::UUID);
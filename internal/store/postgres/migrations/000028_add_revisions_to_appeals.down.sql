@@ -0,0 +1 @@
+ALTER TABLE appeals DROP COLUMN IF EXISTS revisions;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "appeal_comments";
\ No newline at end of file
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS "appeal_comments" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "namespace_id" uuid NOT NULL DEFAULT uuid_nil(),
+  "appeal_id" uuid NOT NULL,
+  "created_by" text,
+  "body" text,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_appeal_comments_appeal" FOREIGN KEY ("appeal_id") REFERENCES "appeals"("id")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_appeal_comments_namespace_id" ON "appeal_comments" ("namespace_id");
+
+CREATE INDEX IF NOT EXISTS "idx_appeal_comments_appeal_id" ON "appeal_comments" ("appeal_id");
+
+CREATE INDEX IF NOT EXISTS "idx_appeal_comments_deleted_at" ON "appeal_comments" ("deleted_at");
+
+ALTER TABLE "appeal_comments" ENABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS appeal_comments_isolation_policy ON appeal_comments;
+CREATE POLICY appeal_comments_isolation_policy on appeal_comments USING (namespace_id = current_setting('app.current_tenant')::UUID);
@@ -0,0 +1 @@
+ALTER TABLE approvals ADD COLUMN IF NOT EXISTS parallel_group text;
@@ -0,0 +1 @@
+ALTER TABLE appeals ADD COLUMN IF NOT EXISTS risk jsonb;
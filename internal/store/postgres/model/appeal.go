@@ -1,6 +1,7 @@
 package model
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -30,6 +31,9 @@ type Appeal struct {
 	Labels        datatypes.JSON
 	Details       datatypes.JSON
 	Description   string
+	BundleID      sql.NullString `gorm:"type:uuid"`
+	Revisions     datatypes.JSON
+	Risk          datatypes.JSON
 
 	Resource  *Resource `gorm:"ForeignKey:ResourceID;References:ID"`
 	Policy    Policy    `gorm:"ForeignKey:PolicyID,PolicyVersion;References:ID,Version"`
@@ -63,6 +67,16 @@ func (m *Appeal) FromDomain(a *domain.Appeal) error {
 		return err
 	}
 
+	revisions, err := json.Marshal(a.Revisions)
+	if err != nil {
+		return fmt.Errorf("parsing revisions: %w", err)
+	}
+
+	risk, err := json.Marshal(a.Risk)
+	if err != nil {
+		return fmt.Errorf("parsing risk: %w", err)
+	}
+
 	var approvals []*Approval
 	if a.Approvals != nil {
 		for _, approval := range a.Approvals {
@@ -113,6 +127,9 @@ func (m *Appeal) FromDomain(a *domain.Appeal) error {
 	m.Labels = datatypes.JSON(labels)
 	m.Details = datatypes.JSON(details)
 	m.Description = a.Description
+	m.BundleID = sql.NullString{String: a.BundleID, Valid: a.BundleID != ""}
+	m.Revisions = datatypes.JSON(revisions)
+	m.Risk = datatypes.JSON(risk)
 	m.Approvals = approvals
 	m.CreatedAt = a.CreatedAt
 	m.UpdatedAt = a.UpdatedAt
@@ -148,6 +165,20 @@ func (m *Appeal) ToDomain() (*domain.Appeal, error) {
 		}
 	}
 
+	var revisions []*domain.AppealRevision
+	if m.Revisions != nil {
+		if err := json.Unmarshal(m.Revisions, &revisions); err != nil {
+			return nil, fmt.Errorf("parsing revisions: %w", err)
+		}
+	}
+
+	var risk *domain.AppealRisk
+	if m.Risk != nil {
+		if err := json.Unmarshal(m.Risk, &risk); err != nil {
+			return nil, fmt.Errorf("parsing risk: %w", err)
+		}
+	}
+
 	var approvals []*domain.Approval
 	if m.Approvals != nil {
 		for _, a := range m.Approvals {
@@ -194,10 +225,13 @@ func (m *Appeal) ToDomain() (*domain.Appeal, error) {
 		Options:       options,
 		Details:       details,
 		Description:   m.Description,
+		BundleID:      m.BundleID.String,
 		Labels:        labels,
 		Approvals:     approvals,
 		Resource:      resource,
 		Grant:         grant,
+		Revisions:     revisions,
+		Risk:          risk,
 		CreatedAt:     m.CreatedAt,
 		UpdatedAt:     m.UpdatedAt,
 	}, nil
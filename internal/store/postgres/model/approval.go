@@ -1,11 +1,13 @@
 package model
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/raystack/guardian/domain"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -21,6 +23,10 @@ type Approval struct {
 	Reason        string
 	PolicyID      string
 	PolicyVersion uint
+	MinApprovals  int
+	ParallelGroup string
+	Decisions     datatypes.JSON
+	EscalatedAt   *time.Time
 
 	Approvers []Approver
 	Appeal    *Appeal
@@ -51,6 +57,11 @@ func (m *Approval) FromDomain(a *domain.Approval) error {
 		m.Appeal = appealModel
 	}
 
+	decisions, err := json.Marshal(a.Decisions)
+	if err != nil {
+		return fmt.Errorf("marshaling decisions: %w", err)
+	}
+
 	var id uuid.UUID
 	if a.ID != "" {
 		uuid, err := uuid.Parse(a.ID)
@@ -68,6 +79,10 @@ func (m *Approval) FromDomain(a *domain.Approval) error {
 	m.Reason = a.Reason
 	m.PolicyID = a.PolicyID
 	m.PolicyVersion = a.PolicyVersion
+	m.MinApprovals = a.MinApprovals
+	m.ParallelGroup = a.ParallelGroup
+	m.Decisions = datatypes.JSON(decisions)
+	m.EscalatedAt = a.EscalatedAt
 	m.Approvers = approvers
 	m.CreatedAt = a.CreatedAt
 	m.UpdatedAt = a.UpdatedAt
@@ -94,6 +109,13 @@ func (m *Approval) ToDomain() (*domain.Approval, error) {
 		appeal = a
 	}
 
+	var decisions []*domain.ApprovalDecision
+	if m.Decisions != nil {
+		if err := json.Unmarshal(m.Decisions, &decisions); err != nil {
+			return nil, fmt.Errorf("parsing decisions: %w", err)
+		}
+	}
+
 	return &domain.Approval{
 		ID:            m.ID.String(),
 		Name:          m.Name,
@@ -104,6 +126,10 @@ func (m *Approval) ToDomain() (*domain.Approval, error) {
 		Reason:        m.Reason,
 		PolicyID:      m.PolicyID,
 		PolicyVersion: m.PolicyVersion,
+		MinApprovals:  m.MinApprovals,
+		ParallelGroup: m.ParallelGroup,
+		Decisions:     decisions,
+		EscalatedAt:   m.EscalatedAt,
 		Approvers:     approvers,
 		Appeal:        appeal,
 		CreatedAt:     m.CreatedAt,
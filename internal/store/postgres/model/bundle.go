@@ -0,0 +1,104 @@
+package model
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/guardian/domain"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type Bundle struct {
+	ID            uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	NamespaceID   uuid.UUID `gorm:"type:uuid"`
+	Name          string
+	Description   string
+	PolicyID      sql.NullString
+	PolicyVersion sql.NullInt32
+	Items         datatypes.JSON
+	Labels        datatypes.JSON
+	CreatedBy     string
+	CreatedAt     time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt     gorm.DeletedAt `gorm:"index"`
+}
+
+func (Bundle) TableName() string {
+	return "bundles"
+}
+
+func (m *Bundle) FromDomain(b *domain.Bundle) error {
+	if b.ID != "" {
+		id, err := uuid.Parse(b.ID)
+		if err != nil {
+			return fmt.Errorf("failed to parse id: %w", err)
+		}
+		m.ID = id
+	}
+
+	items, err := json.Marshal(b.Items)
+	if err != nil {
+		return fmt.Errorf("failed to marshal items: %w", err)
+	}
+	labels, err := json.Marshal(b.Labels)
+	if err != nil {
+		return fmt.Errorf("failed to marshal labels: %w", err)
+	}
+
+	m.PolicyID = sql.NullString{}
+	m.PolicyVersion = sql.NullInt32{}
+	if b.RequiredPolicy != nil {
+		m.PolicyID = sql.NullString{String: b.RequiredPolicy.ID, Valid: true}
+		m.PolicyVersion = sql.NullInt32{Int32: int32(b.RequiredPolicy.Version), Valid: true}
+	}
+
+	m.Name = b.Name
+	m.Description = b.Description
+	m.Items = datatypes.JSON(items)
+	m.Labels = datatypes.JSON(labels)
+	m.CreatedBy = b.CreatedBy
+	m.CreatedAt = b.CreatedAt
+	m.UpdatedAt = b.UpdatedAt
+	return nil
+}
+
+func (m *Bundle) ToDomain(b *domain.Bundle) error {
+	if b == nil {
+		return fmt.Errorf("bundle target can't be nil")
+	}
+
+	var items []*domain.BundleItem
+	if m.Items != nil {
+		if err := json.Unmarshal(m.Items, &items); err != nil {
+			return fmt.Errorf("failed to unmarshal items: %w", err)
+		}
+	}
+	var labels map[string]string
+	if m.Labels != nil {
+		if err := json.Unmarshal(m.Labels, &labels); err != nil {
+			return fmt.Errorf("failed to unmarshal labels: %w", err)
+		}
+	}
+
+	b.RequiredPolicy = nil
+	if m.PolicyID.Valid {
+		b.RequiredPolicy = &domain.PolicyConfig{
+			ID:      m.PolicyID.String,
+			Version: int(m.PolicyVersion.Int32),
+		}
+	}
+
+	b.ID = m.ID.String()
+	b.Name = m.Name
+	b.Description = m.Description
+	b.Items = items
+	b.Labels = labels
+	b.CreatedBy = m.CreatedBy
+	b.CreatedAt = m.CreatedAt
+	b.UpdatedAt = m.UpdatedAt
+	return nil
+}
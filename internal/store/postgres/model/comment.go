@@ -0,0 +1,56 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/guardian/domain"
+	"gorm.io/gorm"
+)
+
+type Comment struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	NamespaceID uuid.UUID `gorm:"type:uuid"`
+	AppealID    uuid.UUID `gorm:"type:uuid"`
+	CreatedBy   string
+	Body        string
+	CreatedAt   time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+}
+
+func (Comment) TableName() string {
+	return "appeal_comments"
+}
+
+func (m *Comment) FromDomain(c *domain.Comment) error {
+	if c.ID != "" {
+		id, err := uuid.Parse(c.ID)
+		if err != nil {
+			return fmt.Errorf("failed to parse id: %w", err)
+		}
+		m.ID = id
+	}
+
+	appealID, err := uuid.Parse(c.AppealID)
+	if err != nil {
+		return fmt.Errorf("failed to parse appeal id: %w", err)
+	}
+
+	m.AppealID = appealID
+	m.CreatedBy = c.CreatedBy
+	m.Body = c.Body
+	m.CreatedAt = c.CreatedAt
+	m.UpdatedAt = c.UpdatedAt
+	return nil
+}
+
+func (m *Comment) ToDomain(c *domain.Comment) {
+	c.ID = m.ID.String()
+	c.AppealID = m.AppealID.String()
+	c.CreatedBy = m.CreatedBy
+	c.Body = m.Body
+	c.CreatedAt = m.CreatedAt
+	c.UpdatedAt = m.UpdatedAt
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/guardian/domain"
+	"gorm.io/gorm"
+)
+
+type Delegation struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	NamespaceID  uuid.UUID `gorm:"type:uuid"`
+	Delegator    string    `gorm:"index"`
+	Delegate     string    `gorm:"index"`
+	StartDate    time.Time
+	EndDate      time.Time
+	PolicyID     string
+	ProviderType string
+	ProviderURN  string
+	Reason       string
+	CreatedBy    string
+	CreatedAt    time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
+}
+
+func (Delegation) TableName() string {
+	return "delegations"
+}
+
+func (m *Delegation) FromDomain(d *domain.Delegation) error {
+	if d.ID != "" {
+		id, err := uuid.Parse(d.ID)
+		if err != nil {
+			return fmt.Errorf("failed to parse id: %w", err)
+		}
+		m.ID = id
+	}
+	m.Delegator = d.Delegator
+	m.Delegate = d.Delegate
+	m.StartDate = d.StartDate
+	m.EndDate = d.EndDate
+	m.PolicyID = d.PolicyID
+	m.ProviderType = d.ProviderType
+	m.ProviderURN = d.ProviderURN
+	m.Reason = d.Reason
+	m.CreatedBy = d.CreatedBy
+	m.CreatedAt = d.CreatedAt
+	m.UpdatedAt = d.UpdatedAt
+	return nil
+}
+
+func (m *Delegation) ToDomain(d *domain.Delegation) error {
+	if d == nil {
+		return fmt.Errorf("delegation target can't be nil")
+	}
+
+	d.ID = m.ID.String()
+	d.Delegator = m.Delegator
+	d.Delegate = m.Delegate
+	d.StartDate = m.StartDate
+	d.EndDate = m.EndDate
+	d.PolicyID = m.PolicyID
+	d.ProviderType = m.ProviderType
+	d.ProviderURN = m.ProviderURN
+	d.Reason = m.Reason
+	d.CreatedBy = m.CreatedBy
+	d.CreatedAt = m.CreatedAt
+	d.UpdatedAt = m.UpdatedAt
+	return nil
+}
@@ -22,6 +22,7 @@ type Grant struct {
 	Role                    string
 	Permissions             pq.StringArray `gorm:"type:text[]"`
 	IsPermanent             bool
+	StartDate               sql.NullTime
 	ExpirationDate          time.Time
 	RequestedExpirationDate sql.NullTime
 	ExpirationDateReason    sql.NullString
@@ -71,6 +72,12 @@ func (m *Grant) FromDomain(g domain.Grant) error {
 		m.Appeal = appeal
 	}
 
+	if g.StartDate != nil {
+		m.StartDate = sql.NullTime{
+			Time:  *g.StartDate,
+			Valid: true,
+		}
+	}
 	if g.ExpirationDate != nil {
 		m.ExpirationDate = *g.ExpirationDate
 	}
@@ -151,6 +158,9 @@ func (m Grant) ToDomain() (*domain.Grant, error) {
 		grant.Appeal = a
 	}
 
+	if m.StartDate.Valid {
+		grant.StartDate = &m.StartDate.Time
+	}
 	if !m.ExpirationDate.IsZero() {
 		grant.ExpirationDate = &m.ExpirationDate
 	}
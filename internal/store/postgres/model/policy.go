@@ -7,26 +7,28 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/pkg/evaluator"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
 // Policy is the database model for policy
 type Policy struct {
-	ID           string `gorm:"primaryKey"`
-	Title        string
-	NamespaceID  uuid.UUID `gorm:"type:uuid"`
-	Version      uint      `gorm:"primaryKey"`
-	Description  string
-	Steps        datatypes.JSON
-	AppealConfig datatypes.JSON
-	Labels       datatypes.JSON
-	Requirements datatypes.JSON
-	IAM          datatypes.JSON
-	CreatedBy    string
-	CreatedAt    time.Time      `gorm:"autoCreateTime"`
-	UpdatedAt    time.Time      `gorm:"autoUpdateTime"`
-	DeletedAt    gorm.DeletedAt `gorm:"index"`
+	ID                 string `gorm:"primaryKey"`
+	Title              string
+	NamespaceID        uuid.UUID `gorm:"type:uuid"`
+	Version            uint      `gorm:"primaryKey"`
+	Description        string
+	Steps              datatypes.JSON
+	AppealConfig       datatypes.JSON
+	Labels             datatypes.JSON
+	Requirements       datatypes.JSON
+	IAM                datatypes.JSON
+	ExpressionLanguage string
+	CreatedBy          string
+	CreatedAt          time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt          time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt          gorm.DeletedAt `gorm:"index"`
 }
 
 // TableName overrides the table name
@@ -70,6 +72,7 @@ func (m *Policy) FromDomain(p *domain.Policy) error {
 	m.Labels = datatypes.JSON(labels)
 	m.Requirements = datatypes.JSON(requirements)
 	m.IAM = datatypes.JSON(iam)
+	m.ExpressionLanguage = string(p.ExpressionLanguage)
 	m.CreatedAt = p.CreatedAt
 	m.UpdatedAt = p.UpdatedAt
 	m.CreatedBy = p.CreatedBy
@@ -111,17 +114,18 @@ func (m *Policy) ToDomain() (*domain.Policy, error) {
 	}
 
 	return &domain.Policy{
-		ID:           m.ID,
-		Title:        m.Title,
-		Version:      m.Version,
-		Description:  m.Description,
-		Steps:        steps,
-		AppealConfig: appealConfig,
-		Labels:       labels,
-		Requirements: requirements,
-		IAM:          iam,
-		CreatedBy:    m.CreatedBy,
-		CreatedAt:    m.CreatedAt,
-		UpdatedAt:    m.UpdatedAt,
+		ID:                 m.ID,
+		Title:              m.Title,
+		Version:            m.Version,
+		Description:        m.Description,
+		Steps:              steps,
+		AppealConfig:       appealConfig,
+		Labels:             labels,
+		Requirements:       requirements,
+		IAM:                iam,
+		ExpressionLanguage: evaluator.Language(m.ExpressionLanguage),
+		CreatedBy:          m.CreatedBy,
+		CreatedAt:          m.CreatedAt,
+		UpdatedAt:          m.UpdatedAt,
 	}, nil
 }
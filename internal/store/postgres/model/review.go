@@ -0,0 +1,200 @@
+package model
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/raystack/guardian/domain"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type ReviewCampaign struct {
+	ID               uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	NamespaceID      uuid.UUID `gorm:"type:uuid"`
+	Name             string
+	Description      string
+	Scope            datatypes.JSON
+	ReviewerStrategy string
+	ResourceOwnerKey string
+	DefaultAction    string
+	Status           string `gorm:"index"`
+	CreatedBy        string
+	ClosedBy         string
+	ClosedAt         sql.NullTime
+	CreatedAt        time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt        time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
+}
+
+func (ReviewCampaign) TableName() string {
+	return "review_campaigns"
+}
+
+func (m *ReviewCampaign) FromDomain(c *domain.ReviewCampaign) error {
+	if c.ID != "" {
+		id, err := uuid.Parse(c.ID)
+		if err != nil {
+			return fmt.Errorf("failed to parse id: %w", err)
+		}
+		m.ID = id
+	}
+
+	scope, err := json.Marshal(c.Scope)
+	if err != nil {
+		return fmt.Errorf("failed to marshal scope: %w", err)
+	}
+
+	m.ClosedAt = sql.NullTime{}
+	if c.ClosedAt != nil {
+		m.ClosedAt = sql.NullTime{Time: *c.ClosedAt, Valid: true}
+	}
+
+	m.Name = c.Name
+	m.Description = c.Description
+	m.Scope = datatypes.JSON(scope)
+	m.ReviewerStrategy = string(c.ReviewerStrategy)
+	m.ResourceOwnerKey = c.ResourceOwnerKey
+	m.DefaultAction = string(c.DefaultAction)
+	m.Status = c.Status
+	m.CreatedBy = c.CreatedBy
+	m.ClosedBy = c.ClosedBy
+	m.CreatedAt = c.CreatedAt
+	m.UpdatedAt = c.UpdatedAt
+	return nil
+}
+
+func (m *ReviewCampaign) ToDomain(c *domain.ReviewCampaign) error {
+	if c == nil {
+		return fmt.Errorf("review campaign target can't be nil")
+	}
+
+	var scope *domain.ReviewCampaignScope
+	if m.Scope != nil {
+		if err := json.Unmarshal(m.Scope, &scope); err != nil {
+			return fmt.Errorf("failed to unmarshal scope: %w", err)
+		}
+	}
+
+	c.ClosedAt = nil
+	if m.ClosedAt.Valid {
+		closedAt := m.ClosedAt.Time
+		c.ClosedAt = &closedAt
+	}
+
+	c.ID = m.ID.String()
+	c.Name = m.Name
+	c.Description = m.Description
+	c.Scope = scope
+	c.ReviewerStrategy = domain.ReviewerStrategy(m.ReviewerStrategy)
+	c.ResourceOwnerKey = m.ResourceOwnerKey
+	c.DefaultAction = domain.ReviewDecision(m.DefaultAction)
+	c.Status = m.Status
+	c.CreatedBy = m.CreatedBy
+	c.ClosedBy = m.ClosedBy
+	c.CreatedAt = m.CreatedAt
+	c.UpdatedAt = m.UpdatedAt
+	return nil
+}
+
+type ReviewItem struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	NamespaceID  uuid.UUID `gorm:"type:uuid"`
+	CampaignID   uuid.UUID `gorm:"type:uuid;index"`
+	GrantID      uuid.UUID `gorm:"type:uuid"`
+	AccountID    string
+	AccountType  string
+	ResourceID   string
+	ResourceURN  string
+	ProviderType string
+	Role         string
+	Reviewers    pq.StringArray `gorm:"type:text[]"`
+	Decision     string
+	Reason       string
+	DecidedBy    string
+	DecidedAt    sql.NullTime
+	Defaulted    bool
+	CreatedAt    time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
+}
+
+func (ReviewItem) TableName() string {
+	return "review_items"
+}
+
+func (m *ReviewItem) FromDomain(i *domain.ReviewItem) error {
+	if i.ID != "" {
+		id, err := uuid.Parse(i.ID)
+		if err != nil {
+			return fmt.Errorf("failed to parse id: %w", err)
+		}
+		m.ID = id
+	}
+	if i.CampaignID != "" {
+		campaignID, err := uuid.Parse(i.CampaignID)
+		if err != nil {
+			return fmt.Errorf("failed to parse campaign id: %w", err)
+		}
+		m.CampaignID = campaignID
+	}
+	grantID, err := uuid.Parse(i.GrantID)
+	if err != nil {
+		return fmt.Errorf("failed to parse grant id: %w", err)
+	}
+
+	m.DecidedAt = sql.NullTime{}
+	if i.DecidedAt != nil {
+		m.DecidedAt = sql.NullTime{Time: *i.DecidedAt, Valid: true}
+	}
+
+	m.GrantID = grantID
+	m.AccountID = i.AccountID
+	m.AccountType = i.AccountType
+	m.ResourceID = i.ResourceID
+	m.ResourceURN = i.ResourceURN
+	m.ProviderType = i.ProviderType
+	m.Role = i.Role
+	m.Reviewers = pq.StringArray(i.Reviewers)
+	m.Decision = string(i.Decision)
+	m.Reason = i.Reason
+	m.DecidedBy = i.DecidedBy
+	m.Defaulted = i.Defaulted
+	m.CreatedAt = i.CreatedAt
+	m.UpdatedAt = i.UpdatedAt
+	return nil
+}
+
+func (m *ReviewItem) ToDomain(i *domain.ReviewItem) error {
+	if i == nil {
+		return fmt.Errorf("review item target can't be nil")
+	}
+
+	i.DecidedAt = nil
+	if m.DecidedAt.Valid {
+		decidedAt := m.DecidedAt.Time
+		i.DecidedAt = &decidedAt
+	}
+
+	i.ID = m.ID.String()
+	i.CampaignID = m.CampaignID.String()
+	i.GrantID = m.GrantID.String()
+	i.AccountID = m.AccountID
+	i.AccountType = m.AccountType
+	i.ResourceID = m.ResourceID
+	i.ResourceURN = m.ResourceURN
+	i.ProviderType = m.ProviderType
+	i.Role = m.Role
+	i.Reviewers = []string(m.Reviewers)
+	i.Decision = domain.ReviewDecision(m.Decision)
+	i.Reason = m.Reason
+	i.DecidedBy = m.DecidedBy
+	i.Defaulted = m.Defaulted
+	i.CreatedAt = m.CreatedAt
+	i.UpdatedAt = m.UpdatedAt
+	return nil
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/raystack/guardian/domain"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type SoDRule struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	NamespaceID uuid.UUID `gorm:"type:uuid"`
+	Name        string
+	Description string
+	Selectors   datatypes.JSON
+	Action      string
+	Approvers   pq.StringArray `gorm:"type:text[]"`
+	CreatedBy   string
+	CreatedAt   time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+}
+
+func (SoDRule) TableName() string {
+	return "sod_rules"
+}
+
+func (m *SoDRule) FromDomain(r *domain.SoDRule) error {
+	if r.ID != "" {
+		id, err := uuid.Parse(r.ID)
+		if err != nil {
+			return fmt.Errorf("failed to parse id: %w", err)
+		}
+		m.ID = id
+	}
+
+	selectors, err := json.Marshal(r.Selectors)
+	if err != nil {
+		return fmt.Errorf("failed to marshal selectors: %w", err)
+	}
+
+	m.Name = r.Name
+	m.Description = r.Description
+	m.Selectors = datatypes.JSON(selectors)
+	m.Action = string(r.Action)
+	m.Approvers = pq.StringArray(r.Approvers)
+	m.CreatedBy = r.CreatedBy
+	m.CreatedAt = r.CreatedAt
+	m.UpdatedAt = r.UpdatedAt
+	return nil
+}
+
+func (m *SoDRule) ToDomain(r *domain.SoDRule) error {
+	if r == nil {
+		return fmt.Errorf("sod rule target can't be nil")
+	}
+
+	var selectors []*domain.RequirementTrigger
+	if m.Selectors != nil {
+		if err := json.Unmarshal(m.Selectors, &selectors); err != nil {
+			return fmt.Errorf("failed to unmarshal selectors: %w", err)
+		}
+	}
+
+	r.ID = m.ID.String()
+	r.Name = m.Name
+	r.Description = m.Description
+	r.Selectors = selectors
+	r.Action = domain.SoDRuleAction(m.Action)
+	r.Approvers = []string(m.Approvers)
+	r.CreatedBy = m.CreatedBy
+	r.CreatedAt = m.CreatedAt
+	r.UpdatedAt = m.UpdatedAt
+	return nil
+}
@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/raystack/guardian/core/review"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/internal/store/postgres/model"
+	"gorm.io/gorm"
+)
+
+type ReviewRepository struct {
+	store *Store
+}
+
+func NewReviewRepository(store *Store) *ReviewRepository {
+	return &ReviewRepository{
+		store: store,
+	}
+}
+
+// CreateCampaign inserts the campaign along with its items in a single transaction
+func (r *ReviewRepository) CreateCampaign(ctx context.Context, c *domain.ReviewCampaign, items []*domain.ReviewItem) error {
+	m := new(model.ReviewCampaign)
+	if err := m.FromDomain(c); err != nil {
+		return fmt.Errorf("failed to convert domain to model: %w", err)
+	}
+	namespaceID := namespaceFromContext(ctx)
+	m.NamespaceID = namespaceID
+
+	return r.store.Tx(ctx, func(tx *gorm.DB) error {
+		if err := tx.Create(m).Error; err != nil {
+			return err
+		}
+		if err := m.ToDomain(c); err != nil {
+			return err
+		}
+
+		if len(items) == 0 {
+			return nil
+		}
+		itemModels := make([]*model.ReviewItem, 0, len(items))
+		for _, i := range items {
+			i.CampaignID = c.ID
+			im := new(model.ReviewItem)
+			if err := im.FromDomain(i); err != nil {
+				return fmt.Errorf("failed to convert review item of grant %q: %w", i.GrantID, err)
+			}
+			im.NamespaceID = namespaceID
+			itemModels = append(itemModels, im)
+		}
+		if err := tx.Create(itemModels).Error; err != nil {
+			return err
+		}
+		for idx, im := range itemModels {
+			if err := im.ToDomain(items[idx]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *ReviewRepository) UpdateCampaign(ctx context.Context, c *domain.ReviewCampaign) error {
+	if c == nil || c.ID == "" {
+		return review.ErrEmptyIDParam
+	}
+
+	m := new(model.ReviewCampaign)
+	if err := m.FromDomain(c); err != nil {
+		return fmt.Errorf("failed to convert domain to model: %w", err)
+	}
+
+	return r.store.Tx(ctx, func(tx *gorm.DB) error {
+		if err := tx.Model(m).
+			Select("name", "description", "status", "closed_by", "closed_at").
+			Updates(m).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id = ?", m.ID).First(m).Error; err != nil {
+			return err
+		}
+		return m.ToDomain(c)
+	})
+}
+
+func (r *ReviewRepository) GetCampaignByID(ctx context.Context, id string) (*domain.ReviewCampaign, error) {
+	var m model.ReviewCampaign
+	if err := r.store.Tx(ctx, func(tx *gorm.DB) error {
+		return tx.Where("id = ?", id).First(&m).Error
+	}); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, review.ErrCampaignNotFound
+		}
+		return nil, err
+	}
+
+	c := &domain.ReviewCampaign{}
+	if err := m.ToDomain(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *ReviewRepository) FindCampaigns(ctx context.Context, filter domain.ListReviewCampaignsFilter) ([]*domain.ReviewCampaign, error) {
+	var models []*model.ReviewCampaign
+	if err := r.store.Tx(ctx, func(tx *gorm.DB) error {
+		if filter.Statuses != nil {
+			tx = tx.Where(`"status" IN ?`, filter.Statuses)
+		}
+		if filter.CreatedBy != "" {
+			tx = tx.Where(`"created_by" = ?`, filter.CreatedBy)
+		}
+		return tx.Order(`"created_at" DESC`).Find(&models).Error
+	}); err != nil {
+		return nil, err
+	}
+
+	var results []*domain.ReviewCampaign
+	for _, m := range models {
+		c := &domain.ReviewCampaign{}
+		if err := m.ToDomain(c); err != nil {
+			return nil, fmt.Errorf("failed to convert model %q to domain: %w", m.ID, err)
+		}
+		results = append(results, c)
+	}
+	return results, nil
+}
+
+func (r *ReviewRepository) GetItemByID(ctx context.Context, id string) (*domain.ReviewItem, error) {
+	var m model.ReviewItem
+	if err := r.store.Tx(ctx, func(tx *gorm.DB) error {
+		return tx.Where("id = ?", id).First(&m).Error
+	}); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, review.ErrItemNotFound
+		}
+		return nil, err
+	}
+
+	i := &domain.ReviewItem{}
+	if err := m.ToDomain(i); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (r *ReviewRepository) FindItems(ctx context.Context, filter domain.ListReviewItemsFilter) ([]*domain.ReviewItem, error) {
+	var models []*model.ReviewItem
+	if err := r.store.Tx(ctx, func(tx *gorm.DB) error {
+		if filter.CampaignID != "" {
+			tx = tx.Where(`"review_items"."campaign_id" = ?`, filter.CampaignID)
+		}
+		if filter.CampaignStatuses != nil {
+			tx = tx.Joins(`JOIN "review_campaigns" ON "review_campaigns"."id" = "review_items"."campaign_id"`).
+				Where(`"review_campaigns"."status" IN ?`, filter.CampaignStatuses)
+		}
+		if filter.Reviewer != "" {
+			tx = tx.Where(`? = ANY("review_items"."reviewers")`, filter.Reviewer)
+		}
+		if filter.PendingOnly {
+			tx = tx.Where(`COALESCE("review_items"."decision", '') = ''`)
+		}
+		return tx.Order(`"review_items"."created_at"`).Find(&models).Error
+	}); err != nil {
+		return nil, err
+	}
+
+	var results []*domain.ReviewItem
+	for _, m := range models {
+		i := &domain.ReviewItem{}
+		if err := m.ToDomain(i); err != nil {
+			return nil, fmt.Errorf("failed to convert model %q to domain: %w", m.ID, err)
+		}
+		results = append(results, i)
+	}
+	return results, nil
+}
+
+func (r *ReviewRepository) UpdateItem(ctx context.Context, i *domain.ReviewItem) error {
+	if i == nil || i.ID == "" {
+		return review.ErrEmptyIDParam
+	}
+
+	m := new(model.ReviewItem)
+	if err := m.FromDomain(i); err != nil {
+		return fmt.Errorf("failed to convert domain to model: %w", err)
+	}
+
+	return r.store.Tx(ctx, func(tx *gorm.DB) error {
+		// select columns explicitly so that defaulted can be reset to false
+		if err := tx.Model(m).
+			Select("decision", "reason", "decided_by", "decided_at", "defaulted").
+			Updates(m).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id = ?", m.ID).First(m).Error; err != nil {
+			return err
+		}
+		return m.ToDomain(i)
+	})
+}
@@ -0,0 +1,127 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/raystack/guardian/core/review"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/internal/store/postgres"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReviewRepositoryTestSuite struct {
+	suite.Suite
+
+	store      *postgres.Store
+	repository *postgres.ReviewRepository
+}
+
+func TestReviewRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	suite.Run(t, new(ReviewRepositoryTestSuite))
+}
+
+func (s *ReviewRepositoryTestSuite) SetupSuite() {
+	logger := log.NewLogrus(log.LogrusWithLevel("debug"))
+	store, pool, resource, err := newTestStore(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.store = store
+	s.repository = postgres.NewReviewRepository(store)
+
+	s.T().Cleanup(func() {
+		db, err := s.store.DB().DB()
+		if err != nil {
+			s.T().Fatal(err)
+		}
+		if err := db.Close(); err != nil {
+			s.T().Fatal(err)
+		}
+		if err := purgeTestDocker(pool, resource); err != nil {
+			s.T().Fatal(err)
+		}
+	})
+}
+
+func (s *ReviewRepositoryTestSuite) AfterTest(_, _ string) {
+	if err := truncateTable(s.store, "review_items"); err != nil {
+		s.T().Fatal(err)
+	}
+	if err := truncateTable(s.store, "review_campaigns"); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *ReviewRepositoryTestSuite) TestCreateCampaign() {
+	ctx := context.Background()
+
+	c := &domain.ReviewCampaign{
+		Name:             "q1",
+		Scope:            &domain.ReviewCampaignScope{ProviderTypes: []string{"bigquery"}},
+		ReviewerStrategy: domain.ReviewerStrategyGrantOwner,
+		DefaultAction:    domain.ReviewDecisionKeep,
+		Status:           domain.ReviewCampaignStatusActive,
+		CreatedBy:        "admin@example.com",
+	}
+	items := []*domain.ReviewItem{
+		{GrantID: uuid.NewString(), AccountID: "a@example.com", Reviewers: []string{"reviewer.1@example.com"}},
+		{GrantID: uuid.NewString(), AccountID: "b@example.com", Reviewers: []string{"reviewer.1@example.com", "reviewer.2@example.com"}},
+	}
+	s.Require().NoError(s.repository.CreateCampaign(ctx, c, items))
+	s.NotEmpty(c.ID)
+	s.NotEmpty(items[0].ID)
+	s.Equal(c.ID, items[0].CampaignID)
+
+	s.Run("should return campaign with its scope", func() {
+		actual, err := s.repository.GetCampaignByID(ctx, c.ID)
+
+		s.NoError(err)
+		s.Equal(c.Scope, actual.Scope)
+		s.Equal(c.ReviewerStrategy, actual.ReviewerStrategy)
+	})
+
+	s.Run("should filter items by reviewer and decision", func() {
+		actual, err := s.repository.FindItems(ctx, domain.ListReviewItemsFilter{
+			CampaignStatuses: []string{domain.ReviewCampaignStatusActive},
+			Reviewer:         "reviewer.2@example.com",
+			PendingOnly:      true,
+		})
+		s.NoError(err)
+		s.Require().Len(actual, 1)
+		s.Equal(items[1].ID, actual[0].ID)
+
+		s.Require().NoError(actual[0].Decide("reviewer.2@example.com", domain.ReviewDecisionRevoke, ""))
+		s.Require().NoError(s.repository.UpdateItem(ctx, actual[0]))
+
+		actual, err = s.repository.FindItems(ctx, domain.ListReviewItemsFilter{
+			Reviewer:    "reviewer.2@example.com",
+			PendingOnly: true,
+		})
+		s.NoError(err)
+		s.Empty(actual)
+	})
+
+	s.Run("should update campaign status", func() {
+		c.Status = domain.ReviewCampaignStatusClosed
+		c.ClosedBy = "admin@example.com"
+		s.NoError(s.repository.UpdateCampaign(ctx, c))
+
+		actual, err := s.repository.FindCampaigns(ctx, domain.ListReviewCampaignsFilter{
+			Statuses: []string{domain.ReviewCampaignStatusClosed},
+		})
+		s.NoError(err)
+		s.Len(actual, 1)
+	})
+
+	s.Run("should return not found error for unknown campaign", func() {
+		_, err := s.repository.GetCampaignByID(ctx, uuid.NewString())
+		s.ErrorIs(err, review.ErrCampaignNotFound)
+	})
+}
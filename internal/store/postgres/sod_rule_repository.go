@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/raystack/guardian/core/sod"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/internal/store/postgres/model"
+	"gorm.io/gorm"
+)
+
+type SoDRuleRepository struct {
+	store *Store
+}
+
+func NewSoDRuleRepository(store *Store) *SoDRuleRepository {
+	return &SoDRuleRepository{
+		store: store,
+	}
+}
+
+func (r *SoDRuleRepository) Find(ctx context.Context, filter domain.ListSoDRulesFilter) ([]*domain.SoDRule, error) {
+	var models []*model.SoDRule
+	if err := r.store.Tx(ctx, func(tx *gorm.DB) error {
+		if filter.IDs != nil {
+			tx = tx.Where(`"id" IN ?`, filter.IDs)
+		}
+		if filter.Names != nil {
+			tx = tx.Where(`"name" IN ?`, filter.Names)
+		}
+		return tx.Order(`"name"`).Find(&models).Error
+	}); err != nil {
+		return nil, err
+	}
+
+	var results []*domain.SoDRule
+	for _, m := range models {
+		rule := &domain.SoDRule{}
+		if err := m.ToDomain(rule); err != nil {
+			return nil, fmt.Errorf("failed to convert model %q to domain: %w", m.ID, err)
+		}
+		results = append(results, rule)
+	}
+	return results, nil
+}
+
+func (r *SoDRuleRepository) GetByID(ctx context.Context, id string) (*domain.SoDRule, error) {
+	var m model.SoDRule
+	if err := r.store.Tx(ctx, func(tx *gorm.DB) error {
+		return tx.Where("id = ?", id).First(&m).Error
+	}); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, sod.ErrRuleNotFound
+		}
+		return nil, err
+	}
+
+	rule := &domain.SoDRule{}
+	if err := m.ToDomain(rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *SoDRuleRepository) Create(ctx context.Context, rule *domain.SoDRule) error {
+	m := new(model.SoDRule)
+	if err := m.FromDomain(rule); err != nil {
+		return fmt.Errorf("failed to convert domain to model: %w", err)
+	}
+	m.NamespaceID = namespaceFromContext(ctx)
+
+	return r.store.Tx(ctx, func(tx *gorm.DB) error {
+		if err := tx.Create(m).Error; err != nil {
+			return err
+		}
+		return m.ToDomain(rule)
+	})
+}
+
+func (r *SoDRuleRepository) Update(ctx context.Context, rule *domain.SoDRule) error {
+	if rule == nil || rule.ID == "" {
+		return sod.ErrEmptyIDParam
+	}
+
+	m := new(model.SoDRule)
+	if err := m.FromDomain(rule); err != nil {
+		return fmt.Errorf("failed to convert domain to model: %w", err)
+	}
+
+	return r.store.Tx(ctx, func(tx *gorm.DB) error {
+		// select columns explicitly so that the description and approvers can be cleared
+		if err := tx.Model(m).
+			Select("name", "description", "selectors", "action", "approvers").
+			Updates(m).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id = ?", m.ID).First(m).Error; err != nil {
+			return err
+		}
+		return m.ToDomain(rule)
+	})
+}
+
+func (r *SoDRuleRepository) Delete(ctx context.Context, id string) error {
+	if id == "" {
+		return sod.ErrEmptyIDParam
+	}
+	var result *gorm.DB
+	_ = r.store.Tx(ctx, func(tx *gorm.DB) error {
+		result = tx.Where("id = ?", id).Delete(&model.SoDRule{})
+		return nil
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return sod.ErrRuleNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raystack/guardian/core/sod"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/internal/store/postgres"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type SoDRuleRepositoryTestSuite struct {
+	suite.Suite
+
+	store      *postgres.Store
+	repository *postgres.SoDRuleRepository
+}
+
+func TestSoDRuleRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	suite.Run(t, new(SoDRuleRepositoryTestSuite))
+}
+
+func (s *SoDRuleRepositoryTestSuite) SetupSuite() {
+	logger := log.NewLogrus(log.LogrusWithLevel("debug"))
+	store, pool, resource, err := newTestStore(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.store = store
+	s.repository = postgres.NewSoDRuleRepository(store)
+
+	s.T().Cleanup(func() {
+		db, err := s.store.DB().DB()
+		if err != nil {
+			s.T().Fatal(err)
+		}
+		if err := db.Close(); err != nil {
+			s.T().Fatal(err)
+		}
+		if err := purgeTestDocker(pool, resource); err != nil {
+			s.T().Fatal(err)
+		}
+	})
+}
+
+func (s *SoDRuleRepositoryTestSuite) AfterTest(_, _ string) {
+	if err := truncateTable(s.store, "sod_rules"); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *SoDRuleRepositoryTestSuite) TestCreateAndFind() {
+	ctx := context.Background()
+
+	rule := &domain.SoDRule{
+		Name: "finance-writer-vs-reporting-admin",
+		Selectors: []*domain.RequirementTrigger{
+			{ResourceURN: "finance-raw", Role: "writer"},
+			{ResourceURN: "reporting", Role: "admin"},
+		},
+		Action:    domain.SoDRuleActionRequireApproval,
+		Approvers: []string{"compliance@example.com"},
+		CreatedBy: "admin@example.com",
+	}
+	s.Require().NoError(s.repository.Create(ctx, rule))
+	s.NotEmpty(rule.ID)
+
+	s.Run("should return rule with its selectors and approvers", func() {
+		actual, err := s.repository.GetByID(ctx, rule.ID)
+
+		s.NoError(err)
+		s.Equal(rule.Name, actual.Name)
+		s.Equal(rule.Selectors, actual.Selectors)
+		s.Equal(rule.Action, actual.Action)
+		s.Equal(rule.Approvers, actual.Approvers)
+	})
+
+	s.Run("should filter rules by id and name", func() {
+		actual, err := s.repository.Find(ctx, domain.ListSoDRulesFilter{IDs: []string{rule.ID}})
+		s.NoError(err)
+		s.Len(actual, 1)
+
+		actual, err = s.repository.Find(ctx, domain.ListSoDRulesFilter{Names: []string{"unknown"}})
+		s.NoError(err)
+		s.Empty(actual)
+	})
+}
+
+func (s *SoDRuleRepositoryTestSuite) TestUpdateAndDelete() {
+	ctx := context.Background()
+
+	rule := &domain.SoDRule{
+		Name: "finance-writer-vs-reporting-admin",
+		Selectors: []*domain.RequirementTrigger{
+			{ResourceURN: "finance-raw", Role: "writer"},
+			{ResourceURN: "reporting", Role: "admin"},
+		},
+		Action:    domain.SoDRuleActionRequireApproval,
+		Approvers: []string{"compliance@example.com"},
+		CreatedBy: "admin@example.com",
+	}
+	s.Require().NoError(s.repository.Create(ctx, rule))
+
+	s.Run("should clear approvers on update", func() {
+		rule.Action = domain.SoDRuleActionReject
+		rule.Approvers = nil
+
+		err := s.repository.Update(ctx, rule)
+		s.NoError(err)
+
+		actual, err := s.repository.GetByID(ctx, rule.ID)
+		s.NoError(err)
+		s.Equal(domain.SoDRuleActionReject, actual.Action)
+		s.Empty(actual.Approvers)
+	})
+
+	s.Run("should return not found error after deletion", func() {
+		s.NoError(s.repository.Delete(ctx, rule.ID))
+
+		_, err := s.repository.GetByID(ctx, rule.ID)
+		s.ErrorIs(err, sod.ErrRuleNotFound)
+
+		err = s.repository.Delete(ctx, rule.ID)
+		s.ErrorIs(err, sod.ErrRuleNotFound)
+	})
+}
@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/audit"
+)
+
+func (h *handler) ActivateScheduledGrants(ctx context.Context, cfg Config) error {
+	ctx = audit.WithActor(ctx, domain.SystemActorName)
+	h.logger.Info(fmt.Sprintf("starting %q job", TypeActivateScheduledGrants))
+	defer h.logger.Info(fmt.Sprintf("finished %q job", TypeActivateScheduledGrants))
+
+	grants, err := h.grantService.ActivateScheduledGrants(ctx)
+	if err != nil {
+		return fmt.Errorf("activating scheduled grants: %w", err)
+	}
+
+	var grantIDs []string
+	for _, g := range grants {
+		grantIDs = append(grantIDs, g.ID)
+	}
+	h.logger.Info(fmt.Sprintf("activated %d scheduled grants", len(grants)), "grant_ids", grantIDs)
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/audit"
+)
+
+func (h *handler) ApprovalStepTimeout(ctx context.Context, cfg Config) error {
+	ctx = audit.WithActor(ctx, domain.SystemActorName)
+	h.logger.Info(fmt.Sprintf("starting %q job", TypeApprovalStepTimeout))
+	defer h.logger.Info(fmt.Sprintf("finished %q job", TypeApprovalStepTimeout))
+
+	appeals, err := h.appealService.HandleOverdueApprovals(ctx)
+	if err != nil {
+		return fmt.Errorf("handling overdue approvals: %w", err)
+	}
+
+	var appealIDs []string
+	for _, a := range appeals {
+		appealIDs = append(appealIDs, a.ID)
+	}
+	h.logger.Info(fmt.Sprintf("handled %d overdue approvals", len(appeals)), "appeal_ids", appealIDs)
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/audit"
+)
+
+func (h *handler) BreakGlassReviewReminder(ctx context.Context, cfg Config) error {
+	ctx = audit.WithActor(ctx, domain.SystemActorName)
+	h.logger.Info(fmt.Sprintf("starting %q job", TypeBreakGlassReviewReminder))
+	defer h.logger.Info(fmt.Sprintf("finished %q job", TypeBreakGlassReviewReminder))
+
+	appeals, err := h.appealService.RemindBreakGlassReviews(ctx)
+	if err != nil {
+		return fmt.Errorf("reminding break-glass reviews: %w", err)
+	}
+
+	var appealIDs []string
+	for _, a := range appeals {
+		appealIDs = append(appealIDs, a.ID)
+	}
+	h.logger.Info(fmt.Sprintf("sent review reminders for %d break-glass appeals", len(appeals)), "appeal_ids", appealIDs)
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/audit"
+)
+
+func (h *handler) ExpirePendingAppeals(ctx context.Context, cfg Config) error {
+	ctx = audit.WithActor(ctx, domain.SystemActorName)
+	h.logger.Info(fmt.Sprintf("starting %q job", TypeExpirePendingAppeals))
+	defer h.logger.Info(fmt.Sprintf("finished %q job", TypeExpirePendingAppeals))
+
+	appeals, err := h.appealService.ExpirePendingAppeals(ctx)
+	if err != nil {
+		return fmt.Errorf("expiring pending appeals: %w", err)
+	}
+
+	var appealIDs []string
+	for _, a := range appeals {
+		appealIDs = append(appealIDs, a.ID)
+	}
+	h.logger.Info(fmt.Sprintf("expired %d pending appeals", len(appeals)), "appeal_ids", appealIDs)
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/audit"
+)
+
+type GrantDriftCheckConfig struct {
+	// Mode is applied to providers not listed in ProviderModes, defaults to "report"
+	Mode string `mapstructure:"mode"`
+	// ProviderModes overrides the mode by provider urn
+	ProviderModes map[string]string `mapstructure:"provider_modes"`
+	// Recipients are notified with the drift summary of each provider
+	Recipients []string `mapstructure:"recipients"`
+}
+
+func (h *handler) GrantDriftCheck(ctx context.Context, c Config) error {
+	ctx = audit.WithActor(ctx, domain.SystemActorName)
+	h.logger.Info(fmt.Sprintf("starting %q job", TypeGrantDriftCheck))
+	defer h.logger.Info(fmt.Sprintf("finished %q job", TypeGrantDriftCheck))
+
+	var cfg GrantDriftCheckConfig
+	if err := c.Decode(&cfg); err != nil {
+		return fmt.Errorf("invalid config for %s job: %w", TypeGrantDriftCheck, err)
+	}
+
+	defaultMode := domain.GrantDriftModeReport
+	if cfg.Mode != "" {
+		defaultMode = domain.GrantDriftMode(cfg.Mode)
+	}
+	if !defaultMode.IsValid() {
+		return fmt.Errorf("invalid mode %q", cfg.Mode)
+	}
+	for urn, mode := range cfg.ProviderModes {
+		if !domain.GrantDriftMode(mode).IsValid() {
+			return fmt.Errorf("invalid mode %q for provider %q", mode, urn)
+		}
+	}
+
+	providers, err := h.providerService.Find(ctx, domain.ProviderFilter{})
+	if err != nil {
+		return fmt.Errorf("listing providers: %w", err)
+	}
+
+	var notifications []domain.Notification
+	for _, p := range providers {
+		mode := defaultMode
+		if m, ok := cfg.ProviderModes[p.URN]; ok {
+			mode = domain.GrantDriftMode(m)
+		}
+
+		h.logger.Info(fmt.Sprintf("checking grant drift for provider: %q", p.URN), "mode", mode)
+		drift, err := h.grantService.DriftCheck(ctx, domain.GrantDriftCheckCriteria{
+			ProviderID: p.ID,
+			Mode:       mode,
+		})
+		if err != nil {
+			h.logger.Error(fmt.Sprintf("failed to check grant drift for provider %q", p.URN), "error", err)
+			continue
+		}
+		if !drift.HasDrift() {
+			continue
+		}
+
+		for _, r := range cfg.Recipients {
+			notifications = append(notifications, domain.Notification{
+				User: r,
+				Labels: map[string]string{
+					"provider_urn": p.URN,
+				},
+				Message: domain.NotificationMessage{
+					Type: domain.NotificationTypeGrantDriftSummary,
+					Variables: map[string]interface{}{
+						"provider_urn":       p.URN,
+						"mode":               string(mode),
+						"out_of_band_grants": len(drift.OutOfBandGrants),
+						"missing_grants":     len(drift.MissingGrants),
+						"remediated_grants":  len(drift.RemediatedGrants),
+					},
+				},
+			})
+		}
+	}
+
+	if errs := h.notifier.Notify(notifications); errs != nil {
+		for _, err1 := range errs {
+			h.logger.Error("failed to send notifications", "error", err1)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/raystack/guardian/domain"
+)
+
+const (
+	defaultGrantRecommendationPeriod = "720h"
+
+	// wildcards of the downgrade url, replaced with the query-escaped values of the recommendation
+	grantIDWildcard     = "{grant_id}"
+	accountIDWildcard   = "{account_id}"
+	accountTypeWildcard = "{account_type}"
+	resourceIDWildcard  = "{resource_id}"
+	roleWildcard        = "{role}"
+)
+
+type GrantRecommendationNotificationConfig struct {
+	// Period of the activities to look back on, defaults to 720h
+	Period string `mapstructure:"period"`
+	// DowngradeURL is the link of the DowngradeGrant API creating an appeal for the recommended role and revoking the
+	// grant once approved, e.g. https://guardian.example.com/api/v1beta1/me/grants/{grant_id}/downgrade?role={role}
+	DowngradeURL string `mapstructure:"downgrade_url"`
+}
+
+func (h *handler) GrantRecommendationNotification(ctx context.Context, c Config) error {
+	h.logger.Info(fmt.Sprintf("starting %q job", TypeGrantRecommendationNotification))
+	defer h.logger.Info(fmt.Sprintf("finished %q job", TypeGrantRecommendationNotification))
+
+	var cfg GrantRecommendationNotificationConfig
+	if err := c.Decode(&cfg); err != nil {
+		return fmt.Errorf("invalid config for %s job: %w", TypeGrantRecommendationNotification, err)
+	}
+	if cfg.Period == "" {
+		cfg.Period = defaultGrantRecommendationPeriod
+	}
+	period, err := time.ParseDuration(cfg.Period)
+	if err != nil {
+		return fmt.Errorf("invalid period duration: %w", err)
+	}
+
+	providers, err := h.providerService.Find(ctx, domain.ProviderFilter{})
+	if err != nil {
+		return fmt.Errorf("listing providers: %w", err)
+	}
+
+	recommendationsByOwner := map[string][]map[string]interface{}{}
+	for _, p := range providers {
+		h.logger.Info(fmt.Sprintf("listing grant recommendations for provider: %q", p.URN))
+		recommendations, err := h.grantService.ListRecommendations(ctx, domain.ListGrantRecommendationsFilter{
+			ProviderID: p.ID,
+			Period:     period,
+		})
+		if err != nil {
+			h.logger.Error(fmt.Sprintf("failed to list grant recommendations for provider %q", p.URN), "error", err)
+			continue
+		}
+
+		for _, r := range recommendations {
+			g := r.Grant
+			if g.Owner == "" {
+				continue
+			}
+
+			item := map[string]interface{}{
+				"grant_id":         g.ID,
+				"account_id":       g.AccountID,
+				"role":             g.Role,
+				"type":             string(r.Type),
+				"recommended_role": r.RecommendedRole,
+			}
+			if g.Resource != nil {
+				item["resource_urn"] = g.Resource.URN
+				item["resource_type"] = g.Resource.Type
+				item["provider_type"] = g.Resource.ProviderType
+			}
+			if r.Type == domain.GrantRecommendationTypeDowngrade && cfg.DowngradeURL != "" {
+				item["downgrade_url"] = getDowngradeURL(cfg.DowngradeURL, r)
+			}
+			recommendationsByOwner[g.Owner] = append(recommendationsByOwner[g.Owner], item)
+		}
+	}
+
+	var notifications []domain.Notification
+	for owner, recommendations := range recommendationsByOwner {
+		notifications = append(notifications, domain.Notification{
+			User: owner,
+			Labels: map[string]string{
+				"owner": owner,
+			},
+			Message: domain.NotificationMessage{
+				Type: domain.NotificationTypeGrantRecommendation,
+				Variables: map[string]interface{}{
+					"period":          period.String(),
+					"recommendations": recommendations,
+				},
+			},
+		})
+	}
+	h.logger.Info(fmt.Sprintf("notifying %d owners", len(notifications)))
+
+	if errs := h.notifier.Notify(notifications); errs != nil {
+		for _, err1 := range errs {
+			h.logger.Error("failed to send notifications", "error", err1)
+		}
+	}
+
+	return nil
+}
+
+func getDowngradeURL(urlTemplate string, r *domain.GrantRecommendation) string {
+	return strings.NewReplacer(
+		grantIDWildcard, url.QueryEscape(r.Grant.ID),
+		accountIDWildcard, url.QueryEscape(r.Grant.AccountID),
+		accountTypeWildcard, url.QueryEscape(r.Grant.AccountType),
+		resourceIDWildcard, url.QueryEscape(r.Grant.ResourceID),
+		roleWildcard, url.QueryEscape(r.RecommendedRole),
+	).Replace(urlTemplate)
+}
@@ -17,6 +17,9 @@ type grantService interface {
 	BulkRevoke(ctx context.Context, filter domain.RevokeGrantsFilter, actor, reason string) ([]*domain.Grant, error)
 	Update(context.Context, *domain.Grant) error
 	DormancyCheck(context.Context, domain.DormancyCheckCriteria) error
+	ActivateScheduledGrants(context.Context) ([]*domain.Grant, error)
+	DriftCheck(context.Context, domain.GrantDriftCheckCriteria) (*domain.GrantDrift, error)
+	ListRecommendations(context.Context, domain.ListGrantRecommendationsFilter) ([]*domain.GrantRecommendation, error)
 }
 
 //go:generate mockery --name=providerService --exported
@@ -25,6 +28,14 @@ type providerService interface {
 	Find(context.Context, domain.ProviderFilter) ([]*domain.Provider, error)
 }
 
+//go:generate mockery --name=appealService --exported --with-expecter
+type appealService interface {
+	HandleOverdueApprovals(context.Context) ([]*domain.Appeal, error)
+	ExpirePendingAppeals(context.Context) ([]*domain.Appeal, error)
+	RemindBreakGlassReviews(context.Context) ([]*domain.Appeal, error)
+	RequestExternalApprovals(context.Context) ([]*domain.Appeal, error)
+}
+
 type crypto interface {
 	domain.Crypto
 }
@@ -33,6 +44,7 @@ type handler struct {
 	logger          log.Logger
 	grantService    grantService
 	providerService providerService
+	appealService   appealService
 	notifier        notifiers.Client
 	crypto          crypto
 	validator       *validator.Validate
@@ -42,6 +54,7 @@ func NewHandler(
 	logger log.Logger,
 	grantService grantService,
 	providerService providerService,
+	appealService appealService,
 	notifier notifiers.Client,
 	crypto crypto,
 	validator *validator.Validate,
@@ -50,6 +63,7 @@ func NewHandler(
 		logger:          logger,
 		grantService:    grantService,
 		providerService: providerService,
+		appealService:   appealService,
 		notifier:        notifier,
 		crypto:          crypto,
 		validator:       validator,
@@ -5,11 +5,18 @@ import "github.com/mitchellh/mapstructure"
 type Type string
 
 const (
-	TypeFetchResources             Type = "fetch_resources"
-	TypeExpiringGrantNotification  Type = "expiring_grant_notification"
-	TypeRevokeExpiredGrants        Type = "revoke_expired_grants"
-	TypeRevokeGrantsByUserCriteria Type = "revoke_grants_by_user_criteria"
-	TypeGrantDormancyCheck         Type = "grant_dormancy_check"
+	TypeFetchResources                  Type = "fetch_resources"
+	TypeExpiringGrantNotification       Type = "expiring_grant_notification"
+	TypeRevokeExpiredGrants             Type = "revoke_expired_grants"
+	TypeRevokeGrantsByUserCriteria      Type = "revoke_grants_by_user_criteria"
+	TypeGrantDormancyCheck              Type = "grant_dormancy_check"
+	TypeApprovalStepTimeout             Type = "approval_step_timeout"
+	TypeExpirePendingAppeals            Type = "expire_pending_appeals"
+	TypeBreakGlassReviewReminder        Type = "break_glass_review_reminder"
+	TypeActivateScheduledGrants         Type = "activate_scheduled_grants"
+	TypeRequestExternalApprovals        Type = "request_external_approvals"
+	TypeGrantDriftCheck                 Type = "grant_drift_check"
+	TypeGrantRecommendationNotification Type = "grant_recommendation_notification"
 
 	// Deprecated: use RevokeExpiredGrants instead
 	TypeRevokeExpiredAccess Type = "revoke_expired_access"
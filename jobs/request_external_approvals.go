@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/salt/audit"
+)
+
+func (h *handler) RequestExternalApprovals(ctx context.Context, cfg Config) error {
+	ctx = audit.WithActor(ctx, domain.SystemActorName)
+	h.logger.Info(fmt.Sprintf("starting %q job", TypeRequestExternalApprovals))
+	defer h.logger.Info(fmt.Sprintf("finished %q job", TypeRequestExternalApprovals))
+
+	appeals, err := h.appealService.RequestExternalApprovals(ctx)
+	if err != nil {
+		return fmt.Errorf("requesting external approvals: %w", err)
+	}
+
+	var appealIDs []string
+	for _, a := range appeals {
+		appealIDs = append(appealIDs, a.ID)
+	}
+	h.logger.Info(fmt.Sprintf("requested external approvals of %d appeals", len(appeals)), "appeal_ids", appealIDs)
+
+	return nil
+}
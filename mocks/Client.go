@@ -3,6 +3,9 @@
 package mocks
 
 import (
+	context "context"
+
+	domain "github.com/raystack/guardian/domain"
 	frontier "github.com/raystack/guardian/plugins/providers/frontier"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -158,6 +161,32 @@ func (_m *Client) GrantProjectAccess(project *frontier.Project, userId string, r
 	return r0
 }
 
+// ListAccess provides a mock function with given fields: ctx, resources
+func (_m *Client) ListAccess(ctx context.Context, resources []*domain.Resource) (domain.MapResourceAccess, error) {
+	ret := _m.Called(ctx, resources)
+
+	var r0 domain.MapResourceAccess
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.Resource) (domain.MapResourceAccess, error)); ok {
+		return rf(ctx, resources)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.Resource) domain.MapResourceAccess); ok {
+		r0 = rf(ctx, resources)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.MapResourceAccess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*domain.Resource) error); ok {
+		r1 = rf(ctx, resources)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RevokeGroupAccess provides a mock function with given fields: group, userId, role
 func (_m *Client) RevokeGroupAccess(group *frontier.Group, userId string, role string) error {
 	ret := _m.Called(group, userId, role)
@@ -3,6 +3,9 @@
 package mocks
 
 import (
+	context "context"
+
+	domain "github.com/raystack/guardian/domain"
 	grafana "github.com/raystack/guardian/plugins/providers/grafana"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -72,6 +75,52 @@ func (_m *GrafanaClient) GrantDashboardAccess(resource *grafana.Dashboard, user
 	return r0
 }
 
+// ListAccess provides a mock function with given fields: ctx, resources
+func (_m *GrafanaClient) ListAccess(ctx context.Context, resources []*domain.Resource) (domain.MapResourceAccess, error) {
+	ret := _m.Called(ctx, resources)
+
+	var r0 domain.MapResourceAccess
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.Resource) domain.MapResourceAccess); ok {
+		r0 = rf(ctx, resources)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.MapResourceAccess)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []*domain.Resource) error); ok {
+		r1 = rf(ctx, resources)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDashboardVersions provides a mock function with given fields: ctx, resources
+func (_m *GrafanaClient) ListDashboardVersions(ctx context.Context, resources []*domain.Resource) ([]*grafana.DashboardVersion, error) {
+	ret := _m.Called(ctx, resources)
+
+	var r0 []*grafana.DashboardVersion
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.Resource) []*grafana.DashboardVersion); ok {
+		r0 = rf(ctx, resources)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*grafana.DashboardVersion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []*domain.Resource) error); ok {
+		r1 = rf(ctx, resources)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RevokeDashboardAccess provides a mock function with given fields: resource, user, role
 func (_m *GrafanaClient) RevokeDashboardAccess(resource *grafana.Dashboard, user string, role string) error {
 	ret := _m.Called(resource, user, role)
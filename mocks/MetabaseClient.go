@@ -3,6 +3,9 @@
 package mocks
 
 import (
+	context "context"
+
+	domain "github.com/raystack/guardian/domain"
 	metabase "github.com/raystack/guardian/plugins/providers/metabase"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -155,6 +158,52 @@ func (_m *MetabaseClient) GrantTableAccess(resource *metabase.Table, user string
 	return r0
 }
 
+// ListAccess provides a mock function with given fields: ctx, resources
+func (_m *MetabaseClient) ListAccess(ctx context.Context, resources []*domain.Resource) (domain.MapResourceAccess, error) {
+	ret := _m.Called(ctx, resources)
+
+	var r0 domain.MapResourceAccess
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.Resource) domain.MapResourceAccess); ok {
+		r0 = rf(ctx, resources)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.MapResourceAccess)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []*domain.Resource) error); ok {
+		r1 = rf(ctx, resources)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListActivities provides a mock function with given fields: ctx
+func (_m *MetabaseClient) ListActivities(ctx context.Context) ([]*metabase.Activity, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*metabase.Activity
+	if rf, ok := ret.Get(0).(func(context.Context) []*metabase.Activity); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*metabase.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RevokeCollectionAccess provides a mock function with given fields: resource, user, role
 func (_m *MetabaseClient) RevokeCollectionAccess(resource *metabase.Collection, user string, role string) error {
 	ret := _m.Called(resource, user, role)
@@ -3,6 +3,9 @@
 package mocks
 
 import (
+	context "context"
+
+	domain "github.com/raystack/guardian/domain"
 	tableau "github.com/raystack/guardian/plugins/providers/tableau"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -197,6 +200,52 @@ func (_m *TableauClient) GrantWorkbookAccess(resource *tableau.Workbook, user st
 	return r0
 }
 
+// ListAccess provides a mock function with given fields: ctx, resources
+func (_m *TableauClient) ListAccess(ctx context.Context, resources []*domain.Resource) (domain.MapResourceAccess, error) {
+	ret := _m.Called(ctx, resources)
+
+	var r0 domain.MapResourceAccess
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.Resource) domain.MapResourceAccess); ok {
+		r0 = rf(ctx, resources)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.MapResourceAccess)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []*domain.Resource) error); ok {
+		r1 = rf(ctx, resources)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRevisions provides a mock function with given fields: ctx, resources
+func (_m *TableauClient) ListRevisions(ctx context.Context, resources []*domain.Resource) ([]*tableau.Revision, error) {
+	ret := _m.Called(ctx, resources)
+
+	var r0 []*tableau.Revision
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.Resource) []*tableau.Revision); ok {
+		r0 = rf(ctx, resources)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*tableau.Revision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []*domain.Resource) error); ok {
+		r1 = rf(ctx, resources)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RevokeDataSourceAccess provides a mock function with given fields: resource, user, role
 func (_m *TableauClient) RevokeDataSourceAccess(resource *tableau.DataSource, user string, role string) error {
 	ret := _m.Called(resource, user, role)
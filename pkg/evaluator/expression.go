@@ -24,6 +24,14 @@ func (m ExprParam) Split(s, sep string) []string {
 	return strings.Split(s, sep)
 }
 
+// Compile parses the expression without evaluating it
+func (e Expression) Compile() error {
+	if _, err := expr.Compile(e.String()); err != nil {
+		return fmt.Errorf("invalid expression: %w", err)
+	}
+	return nil
+}
+
 func (e Expression) EvaluateWithStruct(item interface{}) (interface{}, error) {
 	result := map[string]interface{}{}
 
@@ -0,0 +1,45 @@
+package evaluator
+
+import (
+	"strings"
+)
+
+type Language string
+
+const (
+	// LanguageExpr evaluates expressions with https://github.com/antonmedv/expr, variables are prefixed with "$"
+	LanguageExpr Language = "expr"
+	// LanguageRego evaluates expressions with the embedded OPA engine, variables are accessible through "input"
+	LanguageRego Language = "rego"
+)
+
+// Evaluator is an expression in any of the supported languages
+type Evaluator interface {
+	Compile() error
+	EvaluateWithVars(params map[string]interface{}) (interface{}, error)
+}
+
+// New returns the evaluator of the expression. The language can be set per expression with a "rego:" or "expr:"
+// prefix, otherwise defaultLanguage is used. An empty defaultLanguage falls back to expr.
+func New(expression string, defaultLanguage Language) Evaluator {
+	if e, ok := trimLanguagePrefix(expression, LanguageRego); ok {
+		return Rego(e)
+	}
+	if e, ok := trimLanguagePrefix(expression, LanguageExpr); ok {
+		return Expression(e)
+	}
+
+	if defaultLanguage == LanguageRego {
+		return Rego(expression)
+	}
+	return Expression(expression)
+}
+
+func trimLanguagePrefix(expression string, lang Language) (string, bool) {
+	prefix := string(lang) + ":"
+	trimmed := strings.TrimLeft(expression, " \t\n")
+	if strings.HasPrefix(trimmed, prefix) {
+		return strings.TrimPrefix(trimmed, prefix), true
+	}
+	return expression, false
+}
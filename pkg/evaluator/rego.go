@@ -0,0 +1,175 @@
+package evaluator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/rego"
+)
+
+const (
+	// RegoResultRule is the rule holding the result of Rego modules
+	RegoResultRule = "result"
+
+	regoTimeout            = 5 * time.Second
+	regoPreparedCacheLimit = 1000
+)
+
+var (
+	// regoDisallowedBuiltins reach outside of the evaluation, e.g. the network or the environment of the server
+	regoDisallowedBuiltins = map[string]bool{
+		"http.send":          true,
+		"net.lookup_ip_addr": true,
+		"opa.runtime":        true,
+	}
+	regoCapabilities = newRegoCapabilities()
+
+	preparedRegoQueries = newRegoQueryCache(regoPreparedCacheLimit)
+)
+
+// Rego is an expression written in Rego, evaluated by the embedded OPA engine. It can either be a query, e.g.
+// `input.appeal.resource.details.sensitive == true`, or a module declaring a package, in which case the value of its
+// `result` rule is returned. Variables are accessible through `input`, e.g. `input.appeal`.
+//
+// Builtins reaching outside of the evaluation, i.e. http.send, net.lookup_ip_addr and opa.runtime, are not available.
+type Rego string
+
+func (r Rego) String() string {
+	return string(r)
+}
+
+// Compile parses and compiles the expression without evaluating it
+func (r Rego) Compile() error {
+	_, err := r.prepare()
+	return err
+}
+
+// EvaluateWithVars evaluates the expression with params as the input.
+//
+// A query with a single expression having a single result, e.g. a reference or a module's result rule, returns the
+// value of the expression. Any other query returns a boolean following Rego's truthiness: true if it has at least one
+// result and none of the values of its expressions is false. Undefined queries, e.g. comparing values that are not
+// equal, evaluate to false.
+func (r Rego) EvaluateWithVars(params map[string]interface{}) (interface{}, error) {
+	query, err := r.prepare()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), regoTimeout)
+	defer cancel()
+	rs, err := query.Eval(ctx, rego.EvalInput(params))
+	if err != nil {
+		return nil, fmt.Errorf(`evaluating rego "%s": %w`, r, err)
+	}
+
+	if len(rs) == 0 {
+		return false, nil
+	}
+	if len(rs) == 1 && len(rs[0].Expressions) == 1 {
+		return rs[0].Expressions[0].Value, nil
+	}
+	for _, result := range rs {
+		if len(result.Expressions) == 0 {
+			return false, nil
+		}
+		for _, e := range result.Expressions {
+			if v, ok := e.Value.(bool); ok && !v {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+func (r Rego) prepare() (*rego.PreparedEvalQuery, error) {
+	if q, ok := preparedRegoQueries.get(r); ok {
+		return q, nil
+	}
+
+	source := strings.TrimSpace(r.String())
+	options := []func(*rego.Rego){
+		rego.Capabilities(regoCapabilities),
+		rego.StrictBuiltinErrors(true),
+	}
+	if strings.HasPrefix(source, "package ") {
+		module, err := ast.ParseModule("expression.rego", source)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rego module: %w", err)
+		}
+		options = append(options,
+			rego.ParsedModule(module),
+			rego.Query(fmt.Sprintf("%s.%s", module.Package.Path, RegoResultRule)),
+		)
+	} else {
+		options = append(options, rego.Query(source))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), regoTimeout)
+	defer cancel()
+	query, err := rego.New(options...).PrepareForEval(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rego expression: %w", err)
+	}
+	preparedRegoQueries.set(r, &query)
+	return &query, nil
+}
+
+func newRegoCapabilities() *ast.Capabilities {
+	capabilities := ast.CapabilitiesForThisVersion()
+	builtins := make([]*ast.Builtin, 0, len(capabilities.Builtins))
+	for _, b := range capabilities.Builtins {
+		if !regoDisallowedBuiltins[b.Name] {
+			builtins = append(builtins, b)
+		}
+	}
+	capabilities.Builtins = builtins
+	capabilities.AllowNet = []string{}
+	return capabilities
+}
+
+// regoQueryCache keeps up to limit prepared queries, evicting the oldest one once full
+type regoQueryCache struct {
+	mu      sync.Mutex
+	limit   int
+	queries map[Rego]*rego.PreparedEvalQuery
+	keys    []Rego
+}
+
+func newRegoQueryCache(limit int) *regoQueryCache {
+	return &regoQueryCache{
+		limit:   limit,
+		queries: map[Rego]*rego.PreparedEvalQuery{},
+	}
+}
+
+func (c *regoQueryCache) get(r Rego) (*rego.PreparedEvalQuery, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	q, ok := c.queries[r]
+	return q, ok
+}
+
+func (c *regoQueryCache) set(r Rego, q *rego.PreparedEvalQuery) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.queries[r]; ok {
+		return
+	}
+	if len(c.keys) >= c.limit {
+		delete(c.queries, c.keys[0])
+		c.keys = c.keys[1:]
+	}
+	c.queries[r] = q
+	c.keys = append(c.keys, r)
+}
+
+func (c *regoQueryCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.queries)
+}
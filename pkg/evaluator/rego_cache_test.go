@@ -0,0 +1,34 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/open-policy-agent/opa/rego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegoQueryCache(t *testing.T) {
+	t.Run("should evict the oldest query once the limit is reached", func(t *testing.T) {
+		c := newRegoQueryCache(2)
+
+		c.set("a", &rego.PreparedEvalQuery{})
+		c.set("b", &rego.PreparedEvalQuery{})
+		c.set("c", &rego.PreparedEvalQuery{})
+
+		assert.Equal(t, 2, c.len())
+		_, ok := c.get("a")
+		assert.False(t, ok)
+		_, ok = c.get("c")
+		assert.True(t, ok)
+	})
+
+	t.Run("should not grow when the same query is set again", func(t *testing.T) {
+		c := newRegoQueryCache(2)
+
+		c.set("a", &rego.PreparedEvalQuery{})
+		c.set("a", &rego.PreparedEvalQuery{})
+
+		assert.Equal(t, 1, c.len())
+		assert.Len(t, c.keys, 1)
+	})
+}
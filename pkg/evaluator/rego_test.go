@@ -0,0 +1,166 @@
+package evaluator_test
+
+import (
+	"testing"
+
+	"github.com/raystack/guardian/pkg/evaluator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegoEvaluate(t *testing.T) {
+	params := map[string]interface{}{
+		"appeal": map[string]interface{}{
+			"role": "editor",
+			"resource": map[string]interface{}{
+				"details": map[string]interface{}{
+					"sensitive": true,
+					"owners":    []interface{}{"a@example.com", "b@example.com"},
+				},
+			},
+			"creator": map[string]interface{}{
+				"manager": "manager@example.com",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		expression     string
+		expectedResult interface{}
+		expectedError  bool
+	}{
+		{
+			name:           "should return true if the comparison is satisfied",
+			expression:     `input.appeal.resource.details.sensitive == true`,
+			expectedResult: true,
+		},
+		{
+			name:           "should return false if the query is undefined",
+			expression:     `input.appeal.role == "viewer"`,
+			expectedResult: false,
+		},
+		{
+			name:           "should return true if all expressions of the query are satisfied",
+			expression:     `input.appeal.role == "editor"; input.appeal.resource.details.sensitive`,
+			expectedResult: true,
+		},
+		{
+			name:           "should return true if none of the values of the expressions is false",
+			expression:     `role := input.appeal.role; role`,
+			expectedResult: true,
+		},
+		{
+			name:           "should return true if all results of the query are satisfied",
+			expression:     `owner := input.appeal.resource.details.owners[_]; endswith(owner, "@example.com")`,
+			expectedResult: true,
+		},
+		{
+			name:           "should return true if a query with a single expression has multiple results",
+			expression:     `input.appeal.resource.details.owners[_]`,
+			expectedResult: true,
+		},
+		{
+			name:           "should return false if an expression of the query is false",
+			expression:     `input.appeal.role == "editor"; false`,
+			expectedResult: false,
+		},
+		{
+			name:           "should return the value of a reference",
+			expression:     `input.appeal.creator.manager`,
+			expectedResult: "manager@example.com",
+		},
+		{
+			name:           "should return the value of a comprehension",
+			expression:     `[o | o := input.appeal.resource.details.owners[_]]`,
+			expectedResult: []interface{}{"a@example.com", "b@example.com"},
+		},
+		{
+			name: "should return the result rule of a module",
+			expression: `package guardian.approvers
+
+default result := []
+
+result := [input.appeal.creator.manager] {
+	input.appeal.resource.details.sensitive
+}`,
+			expectedResult: []interface{}{"manager@example.com"},
+		},
+		{
+			name:          "should return error if the query is invalid",
+			expression:    `input.appeal.role ==`,
+			expectedError: true,
+		},
+		{
+			name:          "should return error if the module is invalid",
+			expression:    "package guardian\n\nresult := {",
+			expectedError: true,
+		},
+		{
+			name:          "should return error if the query calls http.send",
+			expression:    `http.send({"method": "get", "url": "http://169.254.169.254"}).status_code == 200`,
+			expectedError: true,
+		},
+		{
+			name:          "should return error if the query calls opa.runtime",
+			expression:    `opa.runtime().env`,
+			expectedError: true,
+		},
+		{
+			name:          "should return error if the module calls net.lookup_ip_addr",
+			expression:    "package guardian\n\nresult := net.lookup_ip_addr(\"example.com\")",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := evaluator.Rego(tc.expression).EvaluateWithVars(params)
+
+			if tc.expectedError {
+				assert.Error(t, err)
+				assert.Error(t, evaluator.Rego(tc.expression).Compile())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name            string
+		expression      string
+		defaultLanguage evaluator.Language
+		expected        evaluator.Evaluator
+	}{
+		{
+			name:       "should use expr by default",
+			expression: `$appeal.role == "editor"`,
+			expected:   evaluator.Expression(`$appeal.role == "editor"`),
+		},
+		{
+			name:            "should use the default language",
+			expression:      `input.appeal.role == "editor"`,
+			defaultLanguage: evaluator.LanguageRego,
+			expected:        evaluator.Rego(`input.appeal.role == "editor"`),
+		},
+		{
+			name:       "should use the language of the prefix",
+			expression: `rego:input.appeal.role == "editor"`,
+			expected:   evaluator.Rego(`input.appeal.role == "editor"`),
+		},
+		{
+			name:            "should use the language of the prefix over the default language",
+			expression:      `expr:$appeal.role == "editor"`,
+			defaultLanguage: evaluator.LanguageRego,
+			expected:        evaluator.Expression(`$appeal.role == "editor"`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, evaluator.New(tc.expression, tc.defaultLanguage))
+		})
+	}
+}
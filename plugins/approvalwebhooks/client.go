@@ -0,0 +1,157 @@
+package approvalwebhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mcuadros/go-defaults"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/plugins/identities"
+	"github.com/raystack/guardian/utils"
+)
+
+var (
+	ErrWebhookNotFound = errors.New("approval webhook not found")
+	ErrFailedRequest   = errors.New("request failed")
+	ErrInvalidResponse = errors.New("invalid approval webhook response")
+)
+
+// initialRetryBackoff is the wait before the first retry, doubled after each retry
+var initialRetryBackoff = 500 * time.Millisecond
+
+// Config is the configuration of an approval webhook called by the external approval steps. The url, headers and
+// auth options are the same as the http identity provider.
+type Config struct {
+	Name                        string `mapstructure:"name" validate:"required"`
+	identities.HTTPClientConfig `mapstructure:",squash"`
+
+	// TimeoutInSeconds is the maximum time in seconds a call to the webhook can take. Default = 5
+	TimeoutInSeconds int `mapstructure:"timeout_in_seconds" default:"5"`
+	// MaxRetries is the number of times a call is retried after a network error or a 5xx/429 response. Default = 2
+	MaxRetries int `mapstructure:"max_retries" default:"2"`
+	// CallbackActors are the users allowed to submit the decisions of the webhook through the external approval
+	// callback, used by webhooks that reply with a pending decision
+	CallbackActors []string `mapstructure:"callback_actors"`
+}
+
+type webhook struct {
+	config     *Config
+	httpClient *http.Client
+}
+
+// Client calls the approval webhooks of the external approval steps
+type Client struct {
+	webhooks  map[string]*webhook
+	validator *validator.Validate
+}
+
+func NewClient(configs []*Config) (*Client, error) {
+	c := &Client{
+		webhooks:  map[string]*webhook{},
+		validator: validator.New(),
+	}
+	for _, config := range configs {
+		defaults.SetDefaults(config)
+		if err := c.validator.Struct(config); err != nil {
+			return nil, fmt.Errorf("invalid approval webhook %q: %w", config.Name, err)
+		}
+		if _, exists := c.webhooks[config.Name]; exists {
+			return nil, fmt.Errorf("duplicate approval webhook %q", config.Name)
+		}
+
+		httpClient := config.HTTPClient
+		if httpClient == nil {
+			httpClient = &http.Client{Timeout: time.Duration(config.TimeoutInSeconds) * time.Second}
+		}
+		httpClient, err := config.Auth.NewHTTPClient(httpClient)
+		if err != nil {
+			return nil, fmt.Errorf("initializing approval webhook %q: %w", config.Name, err)
+		}
+
+		c.webhooks[config.Name] = &webhook{
+			config:     config,
+			httpClient: httpClient,
+		}
+	}
+	return c, nil
+}
+
+// Evaluate sends the appeal to the webhook and returns its decision. Failed calls are retried with an exponential
+// backoff.
+func (c *Client) Evaluate(ctx context.Context, name string, req *domain.ExternalApprovalRequest) (*domain.ExternalApprovalResponse, error) {
+	w, ok := c.webhooks[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrWebhookNotFound, name)
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	backoff := initialRetryBackoff
+	for attempt := 0; ; attempt++ {
+		res, isRetryable, err := c.send(ctx, w, body)
+		if err == nil {
+			return res, nil
+		}
+		if !isRetryable || attempt >= w.config.MaxRetries {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// IsCallbackActor returns true if the user is allowed to submit the decisions of the webhook
+func (c *Client) IsCallbackActor(name, user string) bool {
+	w, ok := c.webhooks[name]
+	if !ok {
+		return false
+	}
+	return utils.ContainsString(w.config.CallbackActors, user)
+}
+
+func (c *Client) send(ctx context.Context, w *webhook, body []byte) (*domain.ExternalApprovalResponse, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, false, err
+	}
+	for k, v := range w.config.Headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	w.config.Auth.SetAuth(req)
+
+	res, err := w.httpClient.Do(req)
+	if err != nil {
+		return nil, true, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		isRetryable := res.StatusCode >= 500 || res.StatusCode == http.StatusTooManyRequests
+		return nil, isRetryable, fmt.Errorf("%w: %s", ErrFailedRequest, res.Status)
+	}
+
+	var response domain.ExternalApprovalResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, false, fmt.Errorf("%w: %s", ErrInvalidResponse, err)
+	}
+	if err := c.validator.Struct(response); err != nil {
+		return nil, false, fmt.Errorf("%w: %s", ErrInvalidResponse, err)
+	}
+	return &response, false, nil
+}
@@ -0,0 +1,151 @@
+package approvalwebhooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/plugins/approvalwebhooks"
+	"github.com/raystack/guardian/plugins/identities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClient(t *testing.T) {
+	t.Run("should return error if config is invalid", func(t *testing.T) {
+		_, err := approvalwebhooks.NewClient([]*approvalwebhooks.Config{{Name: "nda"}})
+
+		assert.ErrorContains(t, err, `invalid approval webhook "nda"`)
+	})
+
+	t.Run("should return error if webhook names are duplicated", func(t *testing.T) {
+		_, err := approvalwebhooks.NewClient([]*approvalwebhooks.Config{
+			{Name: "nda", HTTPClientConfig: identities.HTTPClientConfig{URL: "http://localhost/nda"}},
+			{Name: "nda", HTTPClientConfig: identities.HTTPClientConfig{URL: "http://localhost/nda-v2"}},
+		})
+
+		assert.ErrorContains(t, err, `duplicate approval webhook "nda"`)
+	})
+}
+
+func TestClient_Evaluate(t *testing.T) {
+	request := &domain.ExternalApprovalRequest{
+		AppealID:     "appeal-id",
+		ApprovalName: "nda",
+		Appeal:       &domain.Appeal{ID: "appeal-id", AccountID: "user@example.com"},
+	}
+
+	t.Run("should send the appeal and return the decision of the webhook", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+			assert.Equal(t, "value", r.Header.Get("X-Custom"))
+
+			var body domain.ExternalApprovalRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "appeal-id", body.AppealID)
+			assert.Equal(t, "user@example.com", body.Appeal.AccountID)
+
+			w.Write([]byte(`{"decision":"reject","reason":"no signed NDA"}`))
+		}))
+		defer server.Close()
+		client, err := approvalwebhooks.NewClient([]*approvalwebhooks.Config{{
+			Name: "nda",
+			HTTPClientConfig: identities.HTTPClientConfig{
+				URL:     server.URL,
+				Headers: map[string]string{"X-Custom": "value"},
+				Auth:    &identities.HTTPAuthConfig{Type: "bearer", Token: "test-token"},
+			},
+		}})
+		require.NoError(t, err)
+
+		actual, err := client.Evaluate(context.Background(), "nda", request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.ExternalApprovalResponse{Decision: "reject", Reason: "no signed NDA"}, actual)
+	})
+
+	t.Run("should retry failed calls", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls < 2 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.Write([]byte(`{"decision":"approve"}`))
+		}))
+		defer server.Close()
+		client, err := approvalwebhooks.NewClient([]*approvalwebhooks.Config{{
+			Name:             "nda",
+			HTTPClientConfig: identities.HTTPClientConfig{URL: server.URL},
+		}})
+		require.NoError(t, err)
+
+		actual, err := client.Evaluate(context.Background(), "nda", request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.AppealActionNameApprove, actual.Decision)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should not retry client errors", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+		client, err := approvalwebhooks.NewClient([]*approvalwebhooks.Config{{
+			Name:             "nda",
+			HTTPClientConfig: identities.HTTPClientConfig{URL: server.URL},
+		}})
+		require.NoError(t, err)
+
+		_, err = client.Evaluate(context.Background(), "nda", request)
+
+		assert.ErrorIs(t, err, approvalwebhooks.ErrFailedRequest)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("should return error if the decision is invalid", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"decision":"maybe"}`))
+		}))
+		defer server.Close()
+		client, err := approvalwebhooks.NewClient([]*approvalwebhooks.Config{{
+			Name:             "nda",
+			HTTPClientConfig: identities.HTTPClientConfig{URL: server.URL},
+		}})
+		require.NoError(t, err)
+
+		_, err = client.Evaluate(context.Background(), "nda", request)
+
+		assert.ErrorIs(t, err, approvalwebhooks.ErrInvalidResponse)
+	})
+
+	t.Run("should return error if webhook is not configured", func(t *testing.T) {
+		client, err := approvalwebhooks.NewClient(nil)
+		require.NoError(t, err)
+
+		_, err = client.Evaluate(context.Background(), "nda", request)
+
+		assert.ErrorIs(t, err, approvalwebhooks.ErrWebhookNotFound)
+	})
+}
+
+func TestClient_IsCallbackActor(t *testing.T) {
+	client, err := approvalwebhooks.NewClient([]*approvalwebhooks.Config{{
+		Name:             "nda",
+		HTTPClientConfig: identities.HTTPClientConfig{URL: "http://localhost/nda"},
+		CallbackActors:   []string{"nda-service@example.com"},
+	}})
+	require.NoError(t, err)
+
+	assert.True(t, client.IsCallbackActor("nda", "nda-service@example.com"))
+	assert.False(t, client.IsCallbackActor("nda", "user@example.com"))
+	assert.False(t, client.IsCallbackActor("unknown", "nda-service@example.com"))
+}
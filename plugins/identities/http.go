@@ -190,28 +190,9 @@ func NewHTTPClient(config *HTTPClientConfig) (*HTTPClient, error) {
 	if httpClient == nil {
 		httpClient = http.DefaultClient
 	}
-
-	if config.Auth.Type == "google_idtoken" {
-		var creds []byte
-		switch {
-		case config.Auth.CredentialsJSONBase64 != "":
-			v, err := base64.StdEncoding.DecodeString(config.Auth.CredentialsJSONBase64)
-			if err != nil {
-				return nil, fmt.Errorf("decoding credentials_json_base64: %w", err)
-			}
-			creds = v
-		case config.Auth.CredentialsJSON != "":
-			creds = []byte(config.Auth.CredentialsJSON)
-		default:
-			return nil, fmt.Errorf("missing credentials for google_idtoken auth")
-		}
-
-		ctx := context.Background()
-		ts, err := idtoken.NewTokenSource(ctx, config.Auth.Audience, idtoken.WithCredentialsJSON(creds))
-		if err != nil {
-			return nil, err
-		}
-		httpClient = oauth2.NewClient(ctx, ts)
+	httpClient, err := config.Auth.NewHTTPClient(httpClient)
+	if err != nil {
+		return nil, err
 	}
 
 	return &HTTPClient{
@@ -271,24 +252,59 @@ func (c *HTTPClient) sendRequest(req *http.Request, v interface{}) error {
 }
 
 func (c *HTTPClient) setAuth(req *http.Request) {
-	if c.config.Auth != nil {
-		switch c.config.Auth.Type {
-		case "basic":
-			req.SetBasicAuth(c.config.Auth.Username, c.config.Auth.Password)
-		case "api_key":
-			switch c.config.Auth.In {
-			case "query":
-				q := req.URL.Query()
-				q.Add(c.config.Auth.Key, c.config.Auth.Value)
-				req.URL.RawQuery = q.Encode()
-			case "header":
-				req.Header.Add(c.config.Auth.Key, c.config.Auth.Value)
-			default:
-			}
-		case "bearer":
-			req.Header.Add("Authorization", "Bearer "+c.config.Auth.Token)
+	c.config.Auth.SetAuth(req)
+}
+
+// NewHTTPClient returns the client to send authenticated requests with. For google_idtoken auth, the returned client
+// attaches an ID token to every request, otherwise the given client is returned as is.
+func (c *HTTPAuthConfig) NewHTTPClient(httpClient *http.Client) (*http.Client, error) {
+	if c == nil || c.Type != "google_idtoken" {
+		return httpClient, nil
+	}
+
+	var creds []byte
+	switch {
+	case c.CredentialsJSONBase64 != "":
+		v, err := base64.StdEncoding.DecodeString(c.CredentialsJSONBase64)
+		if err != nil {
+			return nil, fmt.Errorf("decoding credentials_json_base64: %w", err)
+		}
+		creds = v
+	case c.CredentialsJSON != "":
+		creds = []byte(c.CredentialsJSON)
+	default:
+		return nil, fmt.Errorf("missing credentials for google_idtoken auth")
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
+	ts, err := idtoken.NewTokenSource(ctx, c.Audience, idtoken.WithCredentialsJSON(creds))
+	if err != nil {
+		return nil, err
+	}
+	return oauth2.NewClient(ctx, ts), nil
+}
+
+// SetAuth adds the credentials to the request according to the auth type
+func (c *HTTPAuthConfig) SetAuth(req *http.Request) {
+	if c == nil {
+		return
+	}
+	switch c.Type {
+	case "basic":
+		req.SetBasicAuth(c.Username, c.Password)
+	case "api_key":
+		switch c.In {
+		case "query":
+			q := req.URL.Query()
+			q.Add(c.Key, c.Value)
+			req.URL.RawQuery = q.Encode()
+		case "header":
+			req.Header.Add(c.Key, c.Value)
 		default:
 		}
+	case "bearer":
+		req.Header.Add("Authorization", "Bearer "+c.Token)
+	default:
 	}
 }
 
@@ -243,6 +243,13 @@ func ParseMessage(message domain.NotificationMessage, templates domain.Notificat
 		domain.NotificationTypeExpirationReminder:     templates.ExpirationReminder,
 		domain.NotificationTypeOnBehalfAppealApproved: templates.OthersAppealApproved,
 		domain.NotificationTypeGrantOwnerChanged:      templates.GrantOwnerChanged,
+		domain.NotificationTypeApprovalEscalated:      templates.ApprovalEscalated,
+		domain.NotificationTypeAppealExpired:          templates.AppealExpired,
+		domain.NotificationTypeBreakGlassReview:       templates.BreakGlassReview,
+		domain.NotificationTypeAppealNeedsInfo:        templates.AppealNeedsInfo,
+		domain.NotificationTypeNewComment:             templates.NewComment,
+		domain.NotificationTypeGrantDriftSummary:      templates.GrantDriftSummary,
+		domain.NotificationTypeGrantRecommendation:    templates.GrantRecommendation,
 	}
 
 	messageBlock, ok := messageTypeTemplateMap[message.Type]
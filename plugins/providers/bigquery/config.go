@@ -9,6 +9,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/mitchellh/mapstructure"
 	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/plugins/providers/cloudlogging"
 	"github.com/raystack/guardian/utils"
 	"google.golang.org/api/option"
 )
@@ -162,8 +163,7 @@ func (c *Config) parseAndValidate() error {
 	}
 
 	if c.ProviderConfig.Activity != nil {
-		ac := activityConfig{c.ProviderConfig.Activity}
-		if err := ac.Validate(); err != nil {
+		if err := (cloudlogging.ActivityConfig{ActivityConfig: c.ProviderConfig.Activity}).Validate(); err != nil {
 			return fmt.Errorf("validating activity config: %w", err)
 		}
 	}
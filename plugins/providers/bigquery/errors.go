@@ -1,6 +1,10 @@
 package bigquery
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/raystack/guardian/plugins/providers/cloudlogging"
+)
 
 var (
 	// ErrInvalidCredentials is the error value for invalid credentials
@@ -28,6 +32,6 @@ var (
 	ErrEmptyResource               = errors.New("this bigquery project has no resources")
 	ErrCannotVerifyTablePermission = errors.New("cannot verify the table permissions since this bigquery project does not have any tables")
 
-	ErrInvalidTimeRange                 = errors.New("specified time range exceeds the activity log retention period")
+	ErrInvalidTimeRange                 = cloudlogging.ErrInvalidTimeRange
 	ErrPrivateLogViewerAccessNotGranted = errors.New("private log viewer access not granted")
 )
@@ -5,7 +5,7 @@ package mocks
 import (
 	context "context"
 
-	bigquery "github.com/raystack/guardian/plugins/providers/bigquery"
+	cloudlogging "github.com/raystack/guardian/plugins/providers/cloudlogging"
 
 	logging "google.golang.org/api/logging/v2"
 
@@ -85,23 +85,23 @@ func (_c *CloudLoggingClientI_GetLogBucket_Call) RunAndReturn(run func(context.C
 }
 
 // ListLogEntries provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudLoggingClientI) ListLogEntries(_a0 context.Context, _a1 string, _a2 int) ([]*bigquery.Activity, error) {
+func (_m *CloudLoggingClientI) ListLogEntries(_a0 context.Context, _a1 string, _a2 int) ([]*cloudlogging.Entry, error) {
 	ret := _m.Called(_a0, _a1, _a2)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListLogEntries")
 	}
 
-	var r0 []*bigquery.Activity
+	var r0 []*cloudlogging.Entry
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]*bigquery.Activity, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]*cloudlogging.Entry, error)); ok {
 		return rf(_a0, _a1, _a2)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, int) []*bigquery.Activity); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []*cloudlogging.Entry); ok {
 		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*bigquery.Activity)
+			r0 = ret.Get(0).([]*cloudlogging.Entry)
 		}
 	}
 
@@ -134,12 +134,12 @@ func (_c *CloudLoggingClientI_ListLogEntries_Call) Run(run func(_a0 context.Cont
 	return _c
 }
 
-func (_c *CloudLoggingClientI_ListLogEntries_Call) Return(_a0 []*bigquery.Activity, _a1 error) *CloudLoggingClientI_ListLogEntries_Call {
+func (_c *CloudLoggingClientI_ListLogEntries_Call) Return(_a0 []*cloudlogging.Entry, _a1 error) *CloudLoggingClientI_ListLogEntries_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *CloudLoggingClientI_ListLogEntries_Call) RunAndReturn(run func(context.Context, string, int) ([]*bigquery.Activity, error)) *CloudLoggingClientI_ListLogEntries_Call {
+func (_c *CloudLoggingClientI_ListLogEntries_Call) RunAndReturn(run func(context.Context, string, int) ([]*cloudlogging.Entry, error)) *CloudLoggingClientI_ListLogEntries_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,10 +5,8 @@ import (
 	"strings"
 
 	bq "cloud.google.com/go/bigquery"
-	"github.com/mitchellh/mapstructure"
 	"github.com/raystack/guardian/core/resource"
 	"github.com/raystack/guardian/domain"
-	"github.com/raystack/guardian/utils"
 )
 
 const (
@@ -148,35 +146,3 @@ func (r BigQueryResourceName) BigQueryResourceID() string {
 	}
 	return urn
 }
-
-type cloudLoggingOptions struct {
-	LogBucket string `json:"log_bucket" yaml:"log_bucket" mapstructure:"log_bucket"`
-}
-
-type activityConfig struct {
-	*domain.ActivityConfig
-}
-
-func (c activityConfig) Validate() error {
-	validSources := []string{"default", "cloud_logging"}
-	if !utils.ContainsString(validSources, c.ActivityConfig.Source) {
-		return fmt.Errorf("invalid source: %q, allowed values %v", c.ActivityConfig.Source, validSources)
-	}
-
-	return nil
-}
-
-func (c activityConfig) GetCloudLoggingOptions() (*cloudLoggingOptions, error) {
-	if c.ActivityConfig == nil || (c.ActivityConfig.Source == "cloud_logging" && c.ActivityConfig.Options == nil) {
-		return &cloudLoggingOptions{}, nil
-	}
-	if c.ActivityConfig.Source != "cloud_logging" {
-		return nil, fmt.Errorf("invalid source: %q", c.ActivityConfig.Source)
-	}
-
-	result := &cloudLoggingOptions{}
-	if err := mapstructure.Decode(c.ActivityConfig.Options, result); err != nil {
-		return nil, fmt.Errorf("decoding options: %w", err)
-	}
-	return result, nil
-}
@@ -17,6 +17,7 @@ import (
 	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/pkg/evaluator"
 	"github.com/raystack/guardian/pkg/slices"
+	"github.com/raystack/guardian/plugins/providers/cloudlogging"
 	"github.com/raystack/guardian/utils"
 	"github.com/raystack/salt/log"
 	"golang.org/x/sync/errgroup"
@@ -60,7 +61,7 @@ type BigQueryClient interface {
 
 //go:generate mockery --name=cloudLoggingClientI --exported --with-expecter
 type cloudLoggingClientI interface {
-	ListLogEntries(context.Context, string, int) ([]*Activity, error)
+	ListLogEntries(context.Context, string, int) ([]*cloudlogging.Entry, error)
 	GetLogBucket(ctx context.Context, name string) (*logging.LogBucket, error)
 }
 
@@ -374,16 +375,7 @@ func (p *Provider) GetActivities(ctx context.Context, pd domain.Provider, filter
 	filters = append(filters,
 		`protoPayload.methodName=("`+strings.Join(BigQueryAuditMetadataMethods, `" OR "`)+`")`,
 	)
-	if filter.TimestampGte != nil {
-		filters = append(filters,
-			`timestamp>="`+filter.TimestampGte.Format(time.RFC3339)+`"`,
-		)
-	}
-	if filter.TimestampLte != nil {
-		filters = append(filters,
-			`timestamp<="`+filter.TimestampLte.Format(time.RFC3339)+`"`,
-		)
-	}
+	filters = append(filters, cloudlogging.TimestampFilters(filter)...)
 	entries, err := logClient.ListLogEntries(ctx, strings.Join(filters, " AND "), 0)
 	if err != nil {
 		return nil, fmt.Errorf("listing log entries: %w", err)
@@ -400,9 +392,9 @@ func (p *Provider) GetActivities(ctx context.Context, pd domain.Provider, filter
 	}
 
 	for _, e := range entries {
-		a, err := e.ToDomainActivity(pd)
+		a, err := toDomainActivity(e, pd)
 		if err != nil {
-			return nil, fmt.Errorf("converting log entry to provider activity: %w", err)
+			return nil, err
 		}
 
 		for _, gcloudPermission := range a.Authorizations {
@@ -438,8 +430,7 @@ func (p *Provider) ListActivities(ctx context.Context, pd domain.Provider, filte
 	}
 
 	// check time range against logging retention period
-	activityConfig := activityConfig{pd.Config.Activity}
-	clo, err := activityConfig.GetCloudLoggingOptions()
+	options, err := cloudlogging.ActivityConfig{ActivityConfig: pd.Config.Activity}.GetOptions()
 	if err != nil {
 		return nil, fmt.Errorf("getting cloud logging options: %w", err)
 	}
@@ -447,23 +438,12 @@ func (p *Provider) ListActivities(ctx context.Context, pd domain.Provider, filte
 	if err != nil {
 		return nil, fmt.Errorf("parsing credentials: %w", err)
 	}
-	bucketName := clo.LogBucket
+	bucketName := options.LogBucket
 	if bucketName == "" {
 		bucketName = decryptedCreds.ResourceName + "/locations/global/buckets/_Default"
 	}
-	logBucket, err := logClient.GetLogBucket(ctx, bucketName)
-	if err != nil {
-		return nil, fmt.Errorf("getting log bucket: %w", err)
-	}
-	retentionDuration, err := time.ParseDuration(fmt.Sprintf("%dh", 24*logBucket.RetentionDays))
-	if err != nil {
-		return nil, fmt.Errorf("invalid bucket's retention period: %q: %w", logBucket.RetentionDays, err)
-	}
-	if filter.TimestampGte != nil && time.Since(*filter.TimestampGte) > retentionDuration {
-		return nil, fmt.Errorf("%w: log bucket's retention in days: %q", ErrInvalidTimeRange, logBucket.RetentionDays)
-	} else {
-		t := time.Now().Add(-retentionDuration)
-		filter.TimestampGte = &t
+	if err := cloudlogging.ApplyRetention(ctx, logClient, bucketName, &filter); err != nil {
+		return nil, err
 	}
 
 	// check private log viewer access is granted
@@ -480,16 +460,9 @@ func (p *Provider) ListActivities(ctx context.Context, pd domain.Provider, filte
 		`protoPayload.authorizationInfo.permission!=null`,
 	}
 	if len(filter.AccountIDs) > 0 {
-		filters = append(filters,
-			`protoPayload.authenticationInfo.principalEmail=("`+strings.Join(filter.AccountIDs, `" OR "`)+`")`,
-		)
-	}
-	if filter.TimestampGte != nil && !filter.TimestampGte.IsZero() {
-		filters = append(filters, `timestamp>="`+filter.TimestampGte.Format(time.RFC3339)+`"`)
-	}
-	if filter.TimestampLte != nil && !filter.TimestampLte.IsZero() {
-		filters = append(filters, `timestamp<="`+filter.TimestampLte.Format(time.RFC3339)+`"`)
+		filters = append(filters, cloudlogging.In("protoPayload.authenticationInfo.principalEmail", filter.AccountIDs))
 	}
+	filters = append(filters, cloudlogging.TimestampFilters(filter)...)
 
 	entries, err := logClient.ListLogEntries(ctx, strings.Join(filters, " AND "), 0)
 	if err != nil {
@@ -498,9 +471,9 @@ func (p *Provider) ListActivities(ctx context.Context, pd domain.Provider, filte
 
 	var activities []*domain.Activity
 	for _, e := range entries {
-		a, err := e.ToDomainActivity(pd)
+		a, err := toDomainActivity(e, pd)
 		if err != nil {
-			return nil, fmt.Errorf("converting log entry to provider activity: %w", err)
+			return nil, err
 		}
 		activities = append(activities, a)
 	}
@@ -573,7 +546,7 @@ func (p *Provider) getCloudLoggingClient(ctx context.Context, pd domain.Provider
 		return p.LogClients[projectID], nil
 	}
 
-	client, err := NewCloudLoggingClient(ctx, projectID, []byte(decryptedCreds.ServiceAccountKey))
+	client, err := cloudlogging.NewClient(ctx, decryptedCreds.ResourceName, []byte(decryptedCreds.ServiceAccountKey))
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/plugins/providers/bigquery"
 	"github.com/raystack/guardian/plugins/providers/bigquery/mocks"
+	"github.com/raystack/guardian/plugins/providers/cloudlogging"
 	"github.com/raystack/salt/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -1079,9 +1080,9 @@ func (s *BigQueryProviderTestSuite) TestGetActivities_Success() {
 			s.Require().NoError(err)
 		}
 
-		expectedBigQueryActivities := []*bigquery.Activity{
+		expectedBigQueryActivities := []*cloudlogging.Entry{
 			{
-				&logging.LogEntry{
+				LogEntry: &logging.LogEntry{
 					Timestamp:    now.Format(time.RFC3339Nano),
 					InsertId:     "test-activity-id",
 					ProtoPayload: googleapi.RawMessage(auditLogBytes),
@@ -1166,6 +1167,31 @@ func (s *BigQueryProviderTestSuite) TestGetActivities_Success() {
 		s.Empty(cmp.Diff(expectedActivities, actualActivities))
 	})
 
+	s.Run("should map the activities on tables to the table resources", func() {
+		s.mockCloudLoggingClient.EXPECT().
+			ListLogEntries(mock.AnythingOfType("context.backgroundCtx"), mock.AnythingOfType("string"), 0).
+			Return([]*cloudlogging.Entry{
+				{
+					LogEntry: &logging.LogEntry{
+						Timestamp:    time.Now().Format(time.RFC3339Nano),
+						ProtoPayload: googleapi.RawMessage(`{"resourceName":"projects/test-project-id/datasets/test-dataset-id/tables/test-table-id"}`),
+					},
+				},
+			}, nil).Once()
+
+		actualActivities, err := s.provider.GetActivities(context.Background(), *s.validProvider, domain.ListActivitiesFilter{})
+
+		s.NoError(err)
+		s.Len(actualActivities, 1)
+		s.Equal(&domain.Resource{
+			ProviderType: s.validProvider.Type,
+			ProviderURN:  s.validProvider.URN,
+			Type:         bigquery.ResourceTypeTable,
+			URN:          "test-project-id:test-dataset-id.test-table-id",
+			Name:         "test-table-id",
+		}, actualActivities[0].Resource)
+	})
+
 	s.Run("should return error if there is an error on initializing logging client", func() {
 		expectedError := errors.New("error")
 
@@ -1212,7 +1238,7 @@ func (s *BigQueryProviderTestSuite) TestListActivities() {
 		s.mockBigQueryClient.EXPECT().
 			CheckGrantedPermission(mock.AnythingOfType("context.backgroundCtx"), []string{bigquery.PrivateLogViewerPermission}).
 			Return([]string{bigquery.PrivateLogViewerPermission}, nil).Once()
-		expectedBqActivities := []*bigquery.Activity{
+		expectedBqActivities := []*cloudlogging.Entry{
 			{
 				LogEntry: &logging.LogEntry{
 					Timestamp: timeNow.Format(time.RFC3339Nano),
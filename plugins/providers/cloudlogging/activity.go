@@ -0,0 +1,223 @@
+package cloudlogging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/utils"
+	"google.golang.org/api/logging/v2"
+	"google.golang.org/genproto/googleapis/cloud/audit"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	SourceDefault      = "default"
+	SourceCloudLogging = "cloud_logging"
+
+	AccountTypeUser           = "user"
+	AccountTypeServiceAccount = "serviceAccount"
+
+	serviceAccountEmailSuffix = ".gserviceaccount.com"
+)
+
+var (
+	ErrEmptyActivityPayload = errors.New("couldn't get payload from log entry")
+	ErrInvalidTimeRange     = errors.New("invalid time range")
+)
+
+// Options are the options of the "cloud_logging" activity source
+type Options struct {
+	LogBucket string `json:"log_bucket" yaml:"log_bucket" mapstructure:"log_bucket"`
+}
+
+// ActivityConfig wraps the provider activity config for providers reading activities from Cloud Audit Logs
+type ActivityConfig struct {
+	*domain.ActivityConfig
+}
+
+func (c ActivityConfig) Validate() error {
+	validSources := []string{SourceDefault, SourceCloudLogging}
+	if !utils.ContainsString(validSources, c.ActivityConfig.Source) {
+		return fmt.Errorf("invalid source: %q, allowed values %v", c.ActivityConfig.Source, validSources)
+	}
+
+	return nil
+}
+
+func (c ActivityConfig) GetOptions() (*Options, error) {
+	if c.ActivityConfig == nil || c.ActivityConfig.Source == SourceDefault || c.ActivityConfig.Options == nil {
+		return &Options{}, nil
+	}
+	if c.ActivityConfig.Source != SourceCloudLogging {
+		return nil, fmt.Errorf("invalid source: %q", c.ActivityConfig.Source)
+	}
+
+	result := &Options{}
+	if err := mapstructure.Decode(c.ActivityConfig.Options, result); err != nil {
+		return nil, fmt.Errorf("decoding options: %w", err)
+	}
+	return result, nil
+}
+
+type logBucketGetter interface {
+	GetLogBucket(ctx context.Context, name string) (*logging.LogBucket, error)
+}
+
+// ApplyRetention sets the start of the filter time range to the log bucket's retention if it's empty, and returns
+// an error if the time range starts before the retention
+func ApplyRetention(ctx context.Context, c logBucketGetter, bucketName string, filter *domain.ListActivitiesFilter) error {
+	logBucket, err := c.GetLogBucket(ctx, bucketName)
+	if err != nil {
+		return fmt.Errorf("getting log bucket: %w", err)
+	}
+	retentionDuration := time.Duration(logBucket.RetentionDays) * 24 * time.Hour
+
+	if filter.TimestampGte == nil || filter.TimestampGte.IsZero() {
+		t := time.Now().Add(-retentionDuration)
+		filter.TimestampGte = &t
+	} else if time.Since(*filter.TimestampGte) > retentionDuration {
+		return fmt.Errorf("%w: log bucket's retention in days: %d", ErrInvalidTimeRange, logBucket.RetentionDays)
+	}
+
+	return nil
+}
+
+// In returns a filter expression matching any of the values
+func In(field string, values []string) string {
+	return field + `=("` + strings.Join(values, `" OR "`) + `")`
+}
+
+// Has returns a filter expression matching the field containing any of the values
+func Has(field string, values []string) string {
+	return field + `:("` + strings.Join(values, `" OR "`) + `")`
+}
+
+// TimestampFilters returns the filter expressions of the time range
+func TimestampFilters(filter domain.ListActivitiesFilter) []string {
+	var filters []string
+	if filter.TimestampGte != nil && !filter.TimestampGte.IsZero() {
+		filters = append(filters, `timestamp>="`+filter.TimestampGte.Format(time.RFC3339)+`"`)
+	}
+	if filter.TimestampLte != nil && !filter.TimestampLte.IsZero() {
+		filters = append(filters, `timestamp<="`+filter.TimestampLte.Format(time.RFC3339)+`"`)
+	}
+	return filters
+}
+
+type Entry struct {
+	*logging.LogEntry
+}
+
+func (e Entry) GetAuditLog() (*audit.AuditLog, error) {
+	if e.ProtoPayload == nil {
+		return nil, ErrEmptyActivityPayload
+	}
+	payload, err := e.ProtoPayload.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("marshalling proto payload: %w", err)
+	}
+	var al audit.AuditLog
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(payload, &al); err != nil {
+		return nil, fmt.Errorf("unmarshalling proto payload: %w", err)
+	}
+	return &al, nil
+}
+
+// ToDomainActivity converts the entry to an activity along with its audit log. The activity resource is left empty
+// as it depends on the provider.
+func (e Entry) ToDomainActivity(p domain.Provider) (*domain.Activity, *audit.AuditLog, error) {
+	t, err := time.Parse(time.RFC3339Nano, e.Timestamp)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing timestamp: %w", err)
+	}
+
+	al, err := e.GetAuditLog()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	activity := &domain.Activity{
+		ProviderID:         p.ID,
+		Timestamp:          t,
+		ProviderActivityID: e.InsertId,
+		Type:               al.MethodName,
+	}
+	if al.AuthenticationInfo != nil {
+		activity.AccountID = al.AuthenticationInfo.PrincipalEmail
+		activity.AccountType = AccountTypeUser
+		if al.AuthenticationInfo.ServiceAccountKeyName != "" || strings.HasSuffix(activity.AccountID, serviceAccountEmailSuffix) {
+			activity.AccountType = AccountTypeServiceAccount
+		}
+	}
+
+	loggingEntryMetadata := map[string]interface{}{}
+	loggingEntryMap := map[string]interface{}{
+		"payload":         al,
+		"insert_id":       e.InsertId,
+		"severity":        e.Severity,
+		"resource":        e.Resource,
+		"labels":          e.Labels,
+		"operation":       e.Operation,
+		"trace":           e.Trace,
+		"source_location": e.SourceLocation,
+		"timestamp":       e.Timestamp,
+		"span_id":         e.SpanId,
+		"trace_sampled":   e.TraceSampled,
+	}
+	if jsonData, err := json.Marshal(loggingEntryMap); err != nil {
+		return nil, nil, fmt.Errorf("marshalling payload: %w", err)
+	} else if err := json.Unmarshal(jsonData, &loggingEntryMetadata); err != nil {
+		return nil, nil, fmt.Errorf("unmarshalling payload to metadata: %w", err)
+	}
+	activity.Metadata = map[string]interface{}{
+		"logging_entry": loggingEntryMetadata,
+	}
+
+	for _, ai := range al.AuthorizationInfo {
+		activity.Authorizations = append(activity.Authorizations, ai.Permission)
+	}
+
+	return activity, al, nil
+}
+
+// CorrelateGrantActivities appends to each grant the activities of the grant account on the same resource whose
+// authorizations are covered by the permissions of the grant roles. rolePermissions maps each role to its permissions.
+func CorrelateGrantActivities(grants []*domain.Grant, activities []*domain.Activity, rolePermissions map[string][]string, isSameResource func(grantResource, activityResource *domain.Resource) bool) {
+	for _, g := range grants {
+		if g.Resource == nil {
+			continue
+		}
+
+		var permissions []string
+		for _, role := range g.Permissions {
+			permissions = append(permissions, rolePermissions[role]...)
+		}
+
+		for _, a := range activities {
+			if a.AccountID != g.AccountID || a.Resource == nil || !isSameResource(g.Resource, a.Resource) {
+				continue
+			}
+			if isSubset(a.Authorizations, permissions) {
+				g.Activities = append(g.Activities, a)
+			}
+		}
+	}
+}
+
+func isSubset(authorizations, permissions []string) bool {
+	if len(authorizations) == 0 {
+		return false
+	}
+	for _, a := range authorizations {
+		if !utils.ContainsString(permissions, a) {
+			return false
+		}
+	}
+	return true
+}
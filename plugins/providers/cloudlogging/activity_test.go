@@ -0,0 +1,177 @@
+package cloudlogging_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/plugins/providers/cloudlogging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/logging/v2"
+	"google.golang.org/genproto/googleapis/cloud/audit"
+)
+
+func TestEntry_ToDomainActivity(t *testing.T) {
+	dummyProvider := domain.Provider{
+		ID:   "dummy-provider-id",
+		Type: "gcs",
+		URN:  "dummy-provider-urn",
+	}
+	newEntry := func(t *testing.T, al *audit.AuditLog) cloudlogging.Entry {
+		payload, err := json.Marshal(al)
+		require.NoError(t, err)
+		return cloudlogging.Entry{
+			LogEntry: &logging.LogEntry{
+				InsertId:     "dummy-insert-id",
+				Timestamp:    "2023-01-02T03:04:05.000000006Z",
+				ProtoPayload: googleapi.RawMessage(payload),
+			},
+		}
+	}
+
+	t.Run("should return activity of the principal", func(t *testing.T) {
+		e := newEntry(t, &audit.AuditLog{
+			MethodName:         "storage.objects.get",
+			ResourceName:       "projects/_/buckets/test-bucket/objects/test-object",
+			AuthenticationInfo: &audit.AuthenticationInfo{PrincipalEmail: "user@example.com"},
+			AuthorizationInfo: []*audit.AuthorizationInfo{
+				{Permission: "storage.objects.get", Granted: true},
+			},
+		})
+
+		activity, al, err := e.ToDomainActivity(dummyProvider)
+
+		require.NoError(t, err)
+		assert.Equal(t, "projects/_/buckets/test-bucket/objects/test-object", al.ResourceName)
+		assert.Equal(t, dummyProvider.ID, activity.ProviderID)
+		assert.Equal(t, "dummy-insert-id", activity.ProviderActivityID)
+		assert.Equal(t, time.Date(2023, 1, 2, 3, 4, 5, 6, time.UTC), activity.Timestamp)
+		assert.Equal(t, "storage.objects.get", activity.Type)
+		assert.Equal(t, "user@example.com", activity.AccountID)
+		assert.Equal(t, cloudlogging.AccountTypeUser, activity.AccountType)
+		assert.Equal(t, []string{"storage.objects.get"}, activity.Authorizations)
+		assert.Contains(t, activity.Metadata, "logging_entry")
+		assert.Nil(t, activity.Resource)
+	})
+
+	t.Run("should identify service account principals", func(t *testing.T) {
+		e := newEntry(t, &audit.AuditLog{
+			AuthenticationInfo: &audit.AuthenticationInfo{PrincipalEmail: "sa@my-project.iam.gserviceaccount.com"},
+		})
+
+		activity, _, err := e.ToDomainActivity(dummyProvider)
+
+		require.NoError(t, err)
+		assert.Equal(t, cloudlogging.AccountTypeServiceAccount, activity.AccountType)
+	})
+
+	t.Run("should return error if the entry has no payload", func(t *testing.T) {
+		e := cloudlogging.Entry{
+			LogEntry: &logging.LogEntry{Timestamp: "2023-01-02T03:04:05Z"},
+		}
+
+		activity, _, err := e.ToDomainActivity(dummyProvider)
+
+		assert.ErrorIs(t, err, cloudlogging.ErrEmptyActivityPayload)
+		assert.Nil(t, activity)
+	})
+}
+
+func TestActivityConfig(t *testing.T) {
+	t.Run("should return error if source is invalid", func(t *testing.T) {
+		c := cloudlogging.ActivityConfig{ActivityConfig: &domain.ActivityConfig{Source: "invalid"}}
+
+		assert.Error(t, c.Validate())
+	})
+
+	t.Run("should return the cloud logging options", func(t *testing.T) {
+		c := cloudlogging.ActivityConfig{ActivityConfig: &domain.ActivityConfig{
+			Source:  cloudlogging.SourceCloudLogging,
+			Options: map[string]interface{}{"log_bucket": "projects/my-project/locations/global/buckets/my-bucket"},
+		}}
+
+		options, err := c.GetOptions()
+
+		assert.NoError(t, c.Validate())
+		require.NoError(t, err)
+		assert.Equal(t, "projects/my-project/locations/global/buckets/my-bucket", options.LogBucket)
+	})
+
+	t.Run("should return empty options if config is empty", func(t *testing.T) {
+		options, err := cloudlogging.ActivityConfig{}.GetOptions()
+
+		require.NoError(t, err)
+		assert.Empty(t, options.LogBucket)
+	})
+}
+
+type logBucketGetter struct {
+	retentionDays int64
+}
+
+func (g logBucketGetter) GetLogBucket(context.Context, string) (*logging.LogBucket, error) {
+	return &logging.LogBucket{RetentionDays: g.retentionDays}, nil
+}
+
+func TestApplyRetention(t *testing.T) {
+	getter := logBucketGetter{retentionDays: 30}
+
+	t.Run("should set the start of the time range to the retention if empty", func(t *testing.T) {
+		filter := domain.ListActivitiesFilter{}
+
+		err := cloudlogging.ApplyRetention(context.Background(), getter, "test-bucket", &filter)
+
+		require.NoError(t, err)
+		require.NotNil(t, filter.TimestampGte)
+		assert.WithinDuration(t, time.Now().Add(-30*24*time.Hour), *filter.TimestampGte, time.Minute)
+	})
+
+	t.Run("should keep the start of the time range within the retention", func(t *testing.T) {
+		start := time.Now().Add(-24 * time.Hour)
+		filter := domain.ListActivitiesFilter{TimestampGte: &start}
+
+		err := cloudlogging.ApplyRetention(context.Background(), getter, "test-bucket", &filter)
+
+		require.NoError(t, err)
+		assert.Equal(t, start, *filter.TimestampGte)
+	})
+
+	t.Run("should return error if the time range starts before the retention", func(t *testing.T) {
+		start := time.Now().Add(-60 * 24 * time.Hour)
+		filter := domain.ListActivitiesFilter{TimestampGte: &start}
+
+		err := cloudlogging.ApplyRetention(context.Background(), getter, "test-bucket", &filter)
+
+		assert.ErrorIs(t, err, cloudlogging.ErrInvalidTimeRange)
+	})
+}
+
+func TestCorrelateGrantActivities(t *testing.T) {
+	bucket := &domain.Resource{URN: "test-bucket"}
+	otherBucket := &domain.Resource{URN: "other-bucket"}
+	readActivity := &domain.Activity{AccountID: "user@example.com", Resource: bucket, Authorizations: []string{"storage.objects.get"}}
+	writeActivity := &domain.Activity{AccountID: "user@example.com", Resource: bucket, Authorizations: []string{"storage.objects.create"}}
+	otherAccountActivity := &domain.Activity{AccountID: "other@example.com", Resource: bucket, Authorizations: []string{"storage.objects.get"}}
+	otherResourceActivity := &domain.Activity{AccountID: "user@example.com", Resource: otherBucket, Authorizations: []string{"storage.objects.get"}}
+
+	viewerGrant := &domain.Grant{AccountID: "user@example.com", Resource: bucket, Permissions: []string{"roles/storage.objectViewer"}}
+	creatorGrant := &domain.Grant{AccountID: "user@example.com", Resource: bucket, Permissions: []string{"roles/storage.objectCreator"}}
+	rolePermissions := map[string][]string{
+		"roles/storage.objectViewer":  {"storage.objects.get", "storage.objects.list"},
+		"roles/storage.objectCreator": {"storage.objects.create"},
+	}
+
+	cloudlogging.CorrelateGrantActivities(
+		[]*domain.Grant{viewerGrant, creatorGrant},
+		[]*domain.Activity{readActivity, writeActivity, otherAccountActivity, otherResourceActivity},
+		rolePermissions,
+		func(r1, r2 *domain.Resource) bool { return r1.URN == r2.URN },
+	)
+
+	assert.Equal(t, []*domain.Activity{readActivity}, viewerGrant.Activities)
+	assert.Equal(t, []*domain.Activity{writeActivity}, creatorGrant.Activities)
+}
@@ -0,0 +1,75 @@
+package cloudlogging
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/api/logging/v2"
+	"google.golang.org/api/option"
+)
+
+const (
+	// MaxLogEntries caps the entries read by a single listing so that a busy project doesn't exhaust the memory
+	MaxLogEntries = 10000
+
+	pageSize = 1000
+)
+
+// Client lists Cloud Audit Logs entries of a project or an organization
+type Client struct {
+	service      *logging.Service
+	resourceName string
+}
+
+// NewClient returns a client reading the logs of resourceName, e.g. "projects/my-project" or "organizations/1234"
+func NewClient(ctx context.Context, resourceName string, credentialsJSON []byte) (*Client, error) {
+	var options []option.ClientOption
+	if credentialsJSON != nil {
+		options = append(options, option.WithCredentialsJSON(credentialsJSON))
+	}
+	service, err := logging.NewService(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		service:      service,
+		resourceName: resourceName,
+	}, nil
+}
+
+// ListLogEntries returns the latest entries matching the filter up to limit, or all of them if limit is 0
+func (c *Client) ListLogEntries(ctx context.Context, filter string, limit int) ([]*Entry, error) {
+	var entries []*Entry
+
+	req := &logging.ListLogEntriesRequest{
+		Filter:        filter,
+		ResourceNames: []string{c.resourceName},
+		PageSize:      pageSize,
+	}
+	if limit > 0 {
+		req.OrderBy = "timestamp desc"
+		if limit < pageSize {
+			req.PageSize = int64(limit)
+		}
+	}
+
+	errLimitReached := errors.New("limit reached")
+	if err := c.service.Entries.List(req).Pages(ctx, func(page *logging.ListLogEntriesResponse) error {
+		for _, e := range page.Entries {
+			if limit != 0 && len(entries) >= limit {
+				return errLimitReached
+			}
+			entries = append(entries, &Entry{e})
+		}
+		return nil
+	}); err != nil && err != errLimitReached {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (c *Client) GetLogBucket(ctx context.Context, name string) (*logging.LogBucket, error) {
+	return c.service.Projects.Locations.Buckets.Get(name).Context(ctx).Do()
+}
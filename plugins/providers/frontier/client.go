@@ -2,6 +2,7 @@ package frontier
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,8 +10,10 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/pkg/tracing"
 	"github.com/raystack/salt/log"
 )
@@ -22,6 +25,11 @@ const (
 	organizationEndpoint   = "/v1beta1/organizations"
 	selfUserEndpoint       = "/v1beta1/users/self"
 	createPolicyEndpoint   = "/v1beta1/policies"
+	orgUsersEndpoint       = "/v1beta1/organizations/%s/users"
+	groupUsersEndpoint     = "/v1beta1/organizations/%s/groups/%s/users"
+
+	principalUserPrefix  = "app/user:"
+	principalGroupPrefix = "app/group:"
 
 	groupsConst        = "groups"
 	projectsConst      = "projects"
@@ -32,7 +40,10 @@ const (
 )
 
 type Policy struct {
-	ID string `json:"id"`
+	ID        string `json:"id"`
+	RoleID    string `json:"roleId,omitempty"`
+	Resource  string `json:"resource,omitempty"`
+	Principal string `json:"principal,omitempty"`
 }
 
 type Client interface {
@@ -46,6 +57,7 @@ type Client interface {
 	GrantOrganizationAccess(organization *Organization, userId string, role string) error
 	RevokeOrganizationAccess(organization *Organization, userId string, role string) error
 	GetSelfUser(email string) (*User, error)
+	ListAccess(ctx context.Context, resources []*domain.Resource) (domain.MapResourceAccess, error)
 }
 
 type client struct {
@@ -383,6 +395,125 @@ func (c *client) GetSelfUser(email string) (*User, error) {
 	return user, err
 }
 
+// ListAccess returns the users having access to the given resources based on the policies
+// of each resource. Policies granted to a group are expanded to the members of the group.
+func (c *client) ListAccess(ctx context.Context, resources []*domain.Resource) (domain.MapResourceAccess, error) {
+	orgUsers := map[string]map[string]string{}
+	groupMembers := map[string][]string{}
+	access := domain.MapResourceAccess{}
+	for _, r := range resources {
+		id, _ := r.Details["id"].(string)
+		orgID, _ := r.Details["orgId"].(string)
+
+		var filter, policyResource string
+		switch r.Type {
+		case ResourceTypeOrganization:
+			filter = "orgId"
+			policyResource = "app/organization:" + id
+			orgID = id
+		case ResourceTypeProject:
+			filter = "projectId"
+			policyResource = "app/project:" + id
+		case ResourceTypeGroup:
+			filter = "groupId"
+			policyResource = "app/group:" + id
+		default:
+			continue
+		}
+		if id == "" || orgID == "" {
+			continue
+		}
+
+		if _, ok := orgUsers[orgID]; !ok {
+			users, err := c.getUsers(fmt.Sprintf(orgUsersEndpoint, orgID))
+			if err != nil {
+				return nil, fmt.Errorf("getting users of organization %q: %w", orgID, err)
+			}
+			emails := map[string]string{}
+			for _, u := range users {
+				emails[u.ID] = u.Email
+			}
+			orgUsers[orgID] = emails
+		}
+
+		policies, err := c.getPolicies(fmt.Sprintf("%s?%s=%s", createPolicyEndpoint, filter, id))
+		if err != nil {
+			return nil, fmt.Errorf("getting policies of %s %q: %w", r.Type, id, err)
+		}
+
+		entries := map[domain.AccessEntry]bool{}
+		for _, policy := range policies {
+			if policy.Resource != "" && policy.Resource != policyResource {
+				continue
+			}
+
+			var userIDs []string
+			if strings.HasPrefix(policy.Principal, principalUserPrefix) {
+				userIDs = append(userIDs, strings.TrimPrefix(policy.Principal, principalUserPrefix))
+			} else if strings.HasPrefix(policy.Principal, principalGroupPrefix) {
+				groupID := strings.TrimPrefix(policy.Principal, principalGroupPrefix)
+				if _, ok := groupMembers[groupID]; !ok {
+					members, err := c.getUsers(fmt.Sprintf(groupUsersEndpoint, orgID, groupID))
+					if err != nil {
+						return nil, fmt.Errorf("getting members of group %q: %w", groupID, err)
+					}
+					for _, m := range members {
+						groupMembers[groupID] = append(groupMembers[groupID], m.ID)
+					}
+				}
+				userIDs = append(userIDs, groupMembers[groupID]...)
+			}
+
+			for _, userID := range userIDs {
+				email := orgUsers[orgID][userID]
+				if email == "" {
+					continue
+				}
+				entry := domain.AccessEntry{
+					AccountID:   email,
+					AccountType: AccountTypeUser,
+					Permission:  policy.RoleID,
+				}
+				if entries[entry] {
+					continue
+				}
+				entries[entry] = true
+				access[r.URN] = append(access[r.URN], entry)
+			}
+		}
+	}
+
+	return access, nil
+}
+
+func (c *client) getUsers(endpoint string) ([]*User, error) {
+	req, err := c.newRequest(http.MethodGet, endpoint, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var response map[string][]*User
+	if _, err := c.do(req, &response); err != nil {
+		return nil, err
+	}
+
+	return response[usersConst], nil
+}
+
+func (c *client) getPolicies(endpoint string) ([]*Policy, error) {
+	req, err := c.newRequest(http.MethodGet, endpoint, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var response map[string][]*Policy
+	if _, err := c.do(req, &response); err != nil {
+		return nil, err
+	}
+
+	return response[policiesConst], nil
+}
+
 func (c *client) do(req *http.Request, v interface{}) (*http.Response, error) {
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
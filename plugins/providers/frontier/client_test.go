@@ -2,6 +2,7 @@ package frontier_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/raystack/salt/log"
 
+	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/mocks"
 	"github.com/raystack/guardian/plugins/providers/frontier"
 	"github.com/stretchr/testify/assert"
@@ -551,3 +553,96 @@ func (s *ClientTestSuite) TestGetSelfUser() {
 		s.Nil(actualError)
 	})
 }
+
+func (s *ClientTestSuite) TestListAccess() {
+	s.Run("should return error if fetching policies fails", func() {
+		s.setup()
+
+		usersRequest, err := s.getTestRequest(http.MethodGet, "/v1beta1/organizations/org_id_1/users", nil, "")
+		s.Require().NoError(err)
+		usersResponse := http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"users": []}`)))}
+		s.mockHttpClient.On("Do", usersRequest).Return(&usersResponse, nil).Once()
+
+		policiesRequest, err := s.getTestRequest(http.MethodGet, "/v1beta1/policies?orgId=org_id_1", nil, "")
+		s.Require().NoError(err)
+		policiesResponse := http.Response{StatusCode: 500, Body: ioutil.NopCloser(bytes.NewReader([]byte("internal error")))}
+		s.mockHttpClient.On("Do", policiesRequest).Return(&policiesResponse, nil).Once()
+
+		resources := []*domain.Resource{
+			{Type: frontier.ResourceTypeOrganization, URN: "organization:org_id_1", Details: map[string]interface{}{"id": "org_id_1"}},
+		}
+
+		access, actualError := s.client.ListAccess(context.Background(), resources)
+
+		s.ErrorContains(actualError, "internal error")
+		s.Nil(access)
+	})
+
+	s.Run("should return the users having access from the resource policies", func() {
+		s.setup()
+
+		usersRequest, err := s.getTestRequest(http.MethodGet, "/v1beta1/organizations/org_id_1/users", nil, "")
+		s.Require().NoError(err)
+		usersResponseJSON := `{
+			"users": [
+				{"id": "user_id_1", "name": "user_1", "email": "user_1@email.com"},
+				{"id": "user_id_2", "name": "user_2", "email": "user_2@email.com"}
+			]
+		}`
+		usersResponse := http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader([]byte(usersResponseJSON)))}
+		s.mockHttpClient.On("Do", usersRequest).Return(&usersResponse, nil).Once()
+
+		orgPoliciesRequest, err := s.getTestRequest(http.MethodGet, "/v1beta1/policies?orgId=org_id_1", nil, "")
+		s.Require().NoError(err)
+		orgPoliciesResponseJSON := `{
+			"policies": [
+				{"id": "policy_1", "roleId": "app_organization_owner", "resource": "app/organization:org_id_1", "principal": "app/user:user_id_1"},
+				{"id": "policy_2", "roleId": "app_project_viewer", "resource": "app/project:project_id_1", "principal": "app/user:user_id_2"}
+			]
+		}`
+		orgPoliciesResponse := http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader([]byte(orgPoliciesResponseJSON)))}
+		s.mockHttpClient.On("Do", orgPoliciesRequest).Return(&orgPoliciesResponse, nil).Once()
+
+		projectPoliciesRequest, err := s.getTestRequest(http.MethodGet, "/v1beta1/policies?projectId=project_id_1", nil, "")
+		s.Require().NoError(err)
+		projectPoliciesResponseJSON := `{
+			"policies": [
+				{"id": "policy_2", "roleId": "app_project_viewer", "resource": "app/project:project_id_1", "principal": "app/user:user_id_2"},
+				{"id": "policy_3", "roleId": "app_project_manager", "resource": "app/project:project_id_1", "principal": "app/group:group_id_1"}
+			]
+		}`
+		projectPoliciesResponse := http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader([]byte(projectPoliciesResponseJSON)))}
+		s.mockHttpClient.On("Do", projectPoliciesRequest).Return(&projectPoliciesResponse, nil).Once()
+
+		groupUsersRequest, err := s.getTestRequest(http.MethodGet, "/v1beta1/organizations/org_id_1/groups/group_id_1/users", nil, "")
+		s.Require().NoError(err)
+		groupUsersResponseJSON := `{
+			"users": [
+				{"id": "user_id_1", "name": "user_1", "email": "user_1@email.com"},
+				{"id": "user_id_2", "name": "user_2", "email": "user_2@email.com"}
+			]
+		}`
+		groupUsersResponse := http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader([]byte(groupUsersResponseJSON)))}
+		s.mockHttpClient.On("Do", groupUsersRequest).Return(&groupUsersResponse, nil).Once()
+
+		resources := []*domain.Resource{
+			{Type: frontier.ResourceTypeOrganization, URN: "organization:org_id_1", Details: map[string]interface{}{"id": "org_id_1"}},
+			{Type: frontier.ResourceTypeProject, URN: "project:project_id_1", Details: map[string]interface{}{"id": "project_id_1", "orgId": "org_id_1"}},
+		}
+		expectedAccess := domain.MapResourceAccess{
+			"organization:org_id_1": {
+				{AccountID: "user_1@email.com", AccountType: frontier.AccountTypeUser, Permission: frontier.RoleOrgOwner},
+			},
+			"project:project_id_1": {
+				{AccountID: "user_2@email.com", AccountType: frontier.AccountTypeUser, Permission: frontier.RoleProjectViewer},
+				{AccountID: "user_1@email.com", AccountType: frontier.AccountTypeUser, Permission: frontier.RoleProjectManager},
+				{AccountID: "user_2@email.com", AccountType: frontier.AccountTypeUser, Permission: frontier.RoleProjectManager},
+			},
+		}
+
+		access, actualError := s.client.ListAccess(context.Background(), resources)
+
+		s.NoError(actualError)
+		s.Equal(expectedAccess, access)
+	})
+}
@@ -2,6 +2,7 @@ package frontier
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/mitchellh/mapstructure"
 	pv "github.com/raystack/guardian/core/provider"
@@ -176,6 +177,20 @@ func (p *provider) getClient(providerURN string, credentials Credentials) (Clien
 	return client, nil
 }
 
+func (p *provider) ListAccess(ctx context.Context, pc domain.ProviderConfig, resources []*domain.Resource) (domain.MapResourceAccess, error) {
+	var creds Credentials
+	if err := mapstructure.Decode(pc.Credentials, &creds); err != nil {
+		return nil, fmt.Errorf("parsing credentials: %w", err)
+	}
+
+	client, err := p.getClient(pc.URN, creds)
+	if err != nil {
+		return nil, fmt.Errorf("initializing frontier client: %w", err)
+	}
+
+	return client.ListAccess(ctx, resources)
+}
+
 func (p *provider) GetRoles(pc *domain.ProviderConfig, resourceType string) ([]*domain.Role, error) {
 	return pv.GetRoles(pc, resourceType)
 }
@@ -1,6 +1,7 @@
 package frontier_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -1377,6 +1378,51 @@ func TestRevokeAccess(t *testing.T) {
 	})
 }
 
+func TestListAccess(t *testing.T) {
+	t.Run("should return error if credentials is invalid", func(t *testing.T) {
+		logger := log.NewLogrus(log.LogrusWithLevel("info"))
+		p := frontier.NewProvider("", logger)
+
+		pc := domain.ProviderConfig{
+			Credentials: "invalid-creds",
+		}
+
+		actualAccess, actualError := p.ListAccess(context.Background(), pc, nil)
+
+		assert.Nil(t, actualAccess)
+		assert.Error(t, actualError)
+	})
+
+	t.Run("should return access from the client", func(t *testing.T) {
+		providerURN := "test-provider-urn"
+		logger := log.NewLogrus(log.LogrusWithLevel("info"))
+		client := mocks.NewClient(t)
+		p := frontier.NewProvider("", logger)
+		p.Clients = map[string]frontier.Client{
+			providerURN: client,
+		}
+
+		pc := domain.ProviderConfig{
+			URN:         providerURN,
+			Credentials: map[string]interface{}{},
+		}
+		resources := []*domain.Resource{
+			{Type: frontier.ResourceTypeGroup, URN: "group:group_id_1"},
+		}
+		expectedAccess := domain.MapResourceAccess{
+			"group:group_id_1": {
+				{AccountID: "user@example.com", AccountType: "user", Permission: frontier.RoleGroupMember},
+			},
+		}
+		client.On("ListAccess", mock.Anything, resources).Return(expectedAccess, nil).Once()
+
+		actualAccess, actualError := p.ListAccess(context.Background(), pc, resources)
+
+		assert.NoError(t, actualError)
+		assert.Equal(t, expectedAccess, actualAccess)
+	})
+}
+
 func TestGetAccountTypes(t *testing.T) {
 	expectedAccountType := []string{"user"}
 	logger := log.NewLogrus(log.LogrusWithLevel("info"))
@@ -0,0 +1,97 @@
+package gcloudiam
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/plugins/providers/cloudlogging"
+)
+
+const serviceAccountResourceNameSegment = "/serviceAccounts/"
+
+var (
+	// IAMAuditServices are the services recording the IAM activities in the audit logs:
+	// https://cloud.google.com/iam/docs/audit-logging
+	IAMAuditServices = []string{
+		"iam.googleapis.com",
+		"iamcredentials.googleapis.com",
+		"cloudresourcemanager.googleapis.com",
+	}
+
+	// IAMAuditMethods are matched as substrings of the method names as they are prefixed differently by each service,
+	// e.g. "SetIamPolicy" and "google.iam.admin.v1.SetIAMPolicy"
+	IAMAuditMethods = []string{
+		"IamPolicy",
+		"IAMPolicy",
+		"ServiceAccount",
+		"Role",
+		"GenerateAccessToken",
+		"GenerateIdToken",
+		"SignBlob",
+		"SignJwt",
+	}
+)
+
+func getLogFilters(resourceName string, filter domain.ListActivitiesFilter) []string {
+	filters := []string{
+		cloudlogging.In("protoPayload.serviceName", IAMAuditServices),
+		`logName:"` + resourceName + `/logs/cloudaudit.googleapis.com%2F"`, // `logName:"projects/{{project_id}}/logs/cloudaudit.googleapis.com%2F"`
+		cloudlogging.Has("protoPayload.methodName", IAMAuditMethods),
+		`protoPayload.authorizationInfo.permission!=null`,
+	}
+	if len(filter.AccountIDs) > 0 {
+		filters = append(filters, cloudlogging.In("protoPayload.authenticationInfo.principalEmail", filter.AccountIDs))
+	}
+	return append(filters, cloudlogging.TimestampFilters(filter)...)
+}
+
+// toDomainActivity converts the log entry to an activity on the service account it targets, or on the project or
+// organization otherwise
+func toDomainActivity(e *cloudlogging.Entry, pd domain.Provider, resourceName string) (*domain.Activity, error) {
+	a, al, err := e.ToDomainActivity(pd)
+	if err != nil {
+		return nil, fmt.Errorf("converting log entry to provider activity: %w", err)
+	}
+
+	if i := strings.Index(al.ResourceName, serviceAccountResourceNameSegment); i >= 0 {
+		email := al.ResourceName[i+len(serviceAccountResourceNameSegment):]
+		urn := al.ResourceName
+		if strings.HasPrefix(urn, "projects/-/") && strings.HasPrefix(resourceName, ResourceNameProjectPrefix) {
+			// audit logs use the "-" wildcard for the project, normalize it to match the service account resources
+			urn = resourceName + serviceAccountResourceNameSegment + email
+		}
+		a.Resource = &domain.Resource{
+			ProviderType: pd.Type,
+			ProviderURN:  pd.URN,
+			Type:         ResourceTypeServiceAccount,
+			URN:          urn,
+			Name:         email,
+		}
+		return a, nil
+	}
+
+	resourceType := ResourceTypeProject
+	if strings.HasPrefix(resourceName, ResourceNameOrganizationPrefix) {
+		resourceType = ResourceTypeOrganization
+	}
+	a.Resource = &domain.Resource{
+		ProviderType: pd.Type,
+		ProviderURN:  pd.URN,
+		Type:         resourceType,
+		URN:          resourceName,
+		Name:         fmt.Sprintf("%s - GCP IAM", resourceName),
+	}
+	return a, nil
+}
+
+// isSameResource compares service accounts by email as their resource name may refer to the project by id or number
+func isSameResource(r1, r2 *domain.Resource) bool {
+	if r1.Type != r2.Type {
+		return false
+	}
+	if r1.Type == ResourceTypeServiceAccount {
+		return r1.Name == r2.Name
+	}
+	return r1.URN == r2.URN
+}
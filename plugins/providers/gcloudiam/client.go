@@ -240,6 +240,27 @@ func (c *iamClient) ListAccess(ctx context.Context, resources []*domain.Resource
 	return access, nil
 }
 
+// GetRolePermissions returns the permissions included in a predefined or custom role
+func (c *iamClient) GetRolePermissions(ctx context.Context, role string) ([]string, error) {
+	var iamRole *iam.Role
+	var err error
+	switch {
+	case strings.HasPrefix(role, "roles/"):
+		iamRole, err = c.iamService.Roles.Get(role).Context(ctx).Do()
+	case strings.HasPrefix(role, ResourceNameProjectPrefix):
+		iamRole, err = c.iamService.Projects.Roles.Get(role).Context(ctx).Do()
+	case strings.HasPrefix(role, ResourceNameOrganizationPrefix):
+		iamRole, err = c.iamService.Organizations.Roles.Get(role).Context(ctx).Do()
+	default:
+		return nil, fmt.Errorf("invalid role signature: %q", role)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return iamRole.IncludedPermissions, nil
+}
+
 func (c *iamClient) getIamPolicy(ctx context.Context) (*cloudresourcemanager.Policy, error) {
 	if strings.HasPrefix(c.resourceName, ResourceNameProjectPrefix) {
 		projectID := strings.Replace(c.resourceName, ResourceNameProjectPrefix, "", 1)
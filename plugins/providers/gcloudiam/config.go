@@ -10,6 +10,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/mitchellh/mapstructure"
 	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/plugins/providers/cloudlogging"
 	"github.com/raystack/guardian/utils"
 )
 
@@ -129,6 +130,12 @@ func (c *Config) parseAndValidate() error {
 		}
 	}
 
+	if c.ProviderConfig.Activity != nil {
+		if err := (cloudlogging.ActivityConfig{ActivityConfig: c.ProviderConfig.Activity}).Validate(); err != nil {
+			validationErrors = append(validationErrors, fmt.Errorf("validating activity config: %w", err))
+		}
+	}
+
 	if len(validationErrors) > 0 {
 		errorStrings := []string{}
 		for _, err := range validationErrors {
@@ -15,4 +15,5 @@ var (
 	ErrInvalidResourceName           = errors.New("invalid resource name: resource name should be projects/{{project-id}} or organizations/{{org-id}}")
 	ErrRolesShouldNotBeEmpty         = errors.New("gcloud_iam provider should not have empty roles")
 	ErrInvalidProjectRole            = errors.New("provided role is not supported for project in gcloud")
+	ErrProviderTypeMismatch          = errors.New("provider type in the config and in the provider don't match")
 )
@@ -84,6 +84,65 @@ func (_c *GcloudIamClient_GetGrantableRoles_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetRolePermissions provides a mock function with given fields: ctx, role
+func (_m *GcloudIamClient) GetRolePermissions(ctx context.Context, role string) ([]string, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRolePermissions")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GcloudIamClient_GetRolePermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRolePermissions'
+type GcloudIamClient_GetRolePermissions_Call struct {
+	*mock.Call
+}
+
+// GetRolePermissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *GcloudIamClient_Expecter) GetRolePermissions(ctx interface{}, role interface{}) *GcloudIamClient_GetRolePermissions_Call {
+	return &GcloudIamClient_GetRolePermissions_Call{Call: _e.mock.On("GetRolePermissions", ctx, role)}
+}
+
+func (_c *GcloudIamClient_GetRolePermissions_Call) Run(run func(ctx context.Context, role string)) *GcloudIamClient_GetRolePermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *GcloudIamClient_GetRolePermissions_Call) Return(_a0 []string, _a1 error) *GcloudIamClient_GetRolePermissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GcloudIamClient_GetRolePermissions_Call) RunAndReturn(run func(context.Context, string) ([]string, error)) *GcloudIamClient_GetRolePermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GrantAccess provides a mock function with given fields: accountType, accountID, role
 func (_m *GcloudIamClient) GrantAccess(accountType string, accountID string, role string) error {
 	ret := _m.Called(accountType, accountID, role)
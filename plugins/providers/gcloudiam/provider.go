@@ -3,12 +3,15 @@ package gcloudiam
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/raystack/guardian/core/provider"
 	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/plugins/providers/cloudlogging"
 	"golang.org/x/net/context"
 	"google.golang.org/api/iam/v1"
+	"google.golang.org/api/logging/v2"
 )
 
 //go:generate mockery --name=GcloudIamClient --exported --with-expecter
@@ -20,6 +23,13 @@ type GcloudIamClient interface {
 	ListServiceAccounts(context.Context) ([]*iam.ServiceAccount, error)
 	GrantServiceAccountAccess(ctx context.Context, sa, accountType, accountID, roles string) error
 	RevokeServiceAccountAccess(ctx context.Context, sa, accountType, accountID, role string) error
+	GetRolePermissions(ctx context.Context, role string) ([]string, error)
+}
+
+//go:generate mockery --name=cloudLoggingClient --exported --with-expecter
+type cloudLoggingClient interface {
+	ListLogEntries(ctx context.Context, filter string, limit int) ([]*cloudlogging.Entry, error)
+	GetLogBucket(ctx context.Context, name string) (*logging.LogBucket, error)
 }
 
 //go:generate mockery --name=encryptor --exported --with-expecter
@@ -31,16 +41,18 @@ type Provider struct {
 	provider.PermissionManager
 	provider.UnimplementedClient
 
-	typeName string
-	Clients  map[string]GcloudIamClient
-	crypto   encryptor
+	typeName   string
+	Clients    map[string]GcloudIamClient
+	LogClients map[string]cloudLoggingClient
+	crypto     encryptor
 }
 
 func NewProvider(typeName string, crypto encryptor) *Provider {
 	return &Provider{
-		typeName: typeName,
-		Clients:  map[string]GcloudIamClient{},
-		crypto:   crypto,
+		typeName:   typeName,
+		Clients:    map[string]GcloudIamClient{},
+		LogClients: map[string]cloudLoggingClient{},
+		crypto:     crypto,
 	}
 }
 
@@ -230,6 +242,136 @@ func (p *Provider) ListAccess(ctx context.Context, pc domain.ProviderConfig, res
 	return client.ListAccess(ctx, resources)
 }
 
+// GetActivities returns the admin and data access activities of the principals in the project or organization
+func (p *Provider) GetActivities(ctx context.Context, pd domain.Provider, filter domain.ListActivitiesFilter) ([]*domain.Activity, error) {
+	var creds Credentials
+	if err := mapstructure.Decode(pd.Config.Credentials, &creds); err != nil {
+		return nil, fmt.Errorf("parsing credentials: %w", err)
+	}
+	logClient, err := p.getCloudLoggingClient(ctx, pd.Config)
+	if err != nil {
+		return nil, fmt.Errorf("initializing cloud logging client: %w", err)
+	}
+
+	entries, err := logClient.ListLogEntries(ctx, strings.Join(getLogFilters(creds.ResourceName, filter), " AND "), cloudlogging.MaxLogEntries)
+	if err != nil {
+		return nil, fmt.Errorf("listing log entries: %w", err)
+	}
+
+	resources := filter.GetResources()
+	activities := make([]*domain.Activity, 0, len(entries))
+	for _, e := range entries {
+		a, err := toDomainActivity(e, pd, creds.ResourceName)
+		if err != nil {
+			return nil, err
+		}
+		if len(resources) > 0 && !containsResource(resources, a.Resource) {
+			continue
+		}
+		activities = append(activities, a)
+	}
+
+	return activities, nil
+}
+
+// ListActivities returns the granted activities of the principals within the log bucket's retention
+func (p *Provider) ListActivities(ctx context.Context, pd domain.Provider, filter domain.ListActivitiesFilter) ([]*domain.Activity, error) {
+	if pd.Type != p.typeName {
+		return nil, ErrProviderTypeMismatch
+	}
+	var creds Credentials
+	if err := mapstructure.Decode(pd.Config.Credentials, &creds); err != nil {
+		return nil, fmt.Errorf("parsing credentials: %w", err)
+	}
+	logClient, err := p.getCloudLoggingClient(ctx, pd.Config)
+	if err != nil {
+		return nil, fmt.Errorf("initializing cloud logging client: %w", err)
+	}
+
+	options, err := cloudlogging.ActivityConfig{ActivityConfig: pd.Config.Activity}.GetOptions()
+	if err != nil {
+		return nil, fmt.Errorf("getting cloud logging options: %w", err)
+	}
+	bucketName := options.LogBucket
+	if bucketName == "" {
+		bucketName = creds.ResourceName + "/locations/global/buckets/_Default"
+	}
+	if err := cloudlogging.ApplyRetention(ctx, logClient, bucketName, &filter); err != nil {
+		return nil, err
+	}
+
+	filters := append(getLogFilters(creds.ResourceName, filter), `protoPayload.authorizationInfo.granted=true`)
+	entries, err := logClient.ListLogEntries(ctx, strings.Join(filters, " AND "), cloudlogging.MaxLogEntries)
+	if err != nil {
+		return nil, fmt.Errorf("listing log entries: %w", err)
+	}
+
+	var activities []*domain.Activity
+	for _, e := range entries {
+		a, err := toDomainActivity(e, pd, creds.ResourceName)
+		if err != nil {
+			return nil, err
+		}
+		activities = append(activities, a)
+	}
+
+	return activities, nil
+}
+
+func (p *Provider) CorrelateGrantActivities(ctx context.Context, pd domain.Provider, grants []*domain.Grant, activities []*domain.Activity) error {
+	client, err := p.getIamClient(pd.Config)
+	if err != nil {
+		return fmt.Errorf("initializing iam client: %w", err)
+	}
+
+	rolePermissions := map[string][]string{}
+	for _, g := range grants {
+		for _, role := range g.Permissions { // grant.Permissions is slice of gcloud roles
+			if _, ok := rolePermissions[role]; ok {
+				continue
+			}
+			permissions, err := client.GetRolePermissions(ctx, role)
+			if err != nil {
+				return fmt.Errorf("getting permissions of role %q: %w", role, err)
+			}
+			rolePermissions[role] = permissions
+		}
+	}
+
+	cloudlogging.CorrelateGrantActivities(grants, activities, rolePermissions, isSameResource)
+	return nil
+}
+
+func (p *Provider) getCloudLoggingClient(ctx context.Context, pc *domain.ProviderConfig) (cloudLoggingClient, error) {
+	if p.LogClients[pc.URN] != nil {
+		return p.LogClients[pc.URN], nil
+	}
+
+	var credentials Credentials
+	if err := mapstructure.Decode(pc.Credentials, &credentials); err != nil {
+		return nil, err
+	}
+	if err := credentials.Decrypt(p.crypto); err != nil {
+		return nil, fmt.Errorf("decrypting credentials: %w", err)
+	}
+	client, err := cloudlogging.NewClient(ctx, credentials.ResourceName, []byte(credentials.ServiceAccountKey))
+	if err != nil {
+		return nil, err
+	}
+
+	p.LogClients[pc.URN] = client
+	return client, nil
+}
+
+func containsResource(resources []*domain.Resource, r *domain.Resource) bool {
+	for _, resource := range resources {
+		if isSameResource(resource, r) {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *Provider) getIamClient(pc *domain.ProviderConfig) (GcloudIamClient, error) {
 	var credentials Credentials
 	if err := mapstructure.Decode(pc.Credentials, &credentials); err != nil {
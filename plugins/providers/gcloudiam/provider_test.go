@@ -4,14 +4,18 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/plugins/providers/cloudlogging"
 	"github.com/raystack/guardian/plugins/providers/gcloudiam"
 	"github.com/raystack/guardian/plugins/providers/gcloudiam/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iam/v1"
+	"google.golang.org/api/logging/v2"
 )
 
 func TestCreateConfig(t *testing.T) {
@@ -1081,3 +1085,176 @@ func TestGetAccountTypes(t *testing.T) {
 		assert.Equal(t, expectedAccountTypes, actualAccountTypes)
 	})
 }
+
+func TestGetActivities(t *testing.T) {
+	providerURN := "test-provider-urn"
+	logClient := new(mocks.CloudLoggingClient)
+	p := gcloudiam.NewProvider("gcloud_iam", new(mocks.Encryptor))
+	p.LogClients[providerURN] = logClient
+	dummyProvider := domain.Provider{
+		ID:   "test-provider-id",
+		Type: domain.ProviderTypeGCloudIAM,
+		URN:  providerURN,
+		Config: &domain.ProviderConfig{
+			URN:         providerURN,
+			Credentials: gcloudiam.Credentials{ResourceName: "projects/test-project"},
+		},
+	}
+	newEntry := func(payload string) *cloudlogging.Entry {
+		return &cloudlogging.Entry{
+			LogEntry: &logging.LogEntry{
+				Timestamp:    "2023-01-02T03:04:05Z",
+				ProtoPayload: googleapi.RawMessage(payload),
+			},
+		}
+	}
+
+	t.Run("should return activities on the project and its service accounts", func(t *testing.T) {
+		logClient.EXPECT().
+			ListLogEntries(mock.Anything, mock.MatchedBy(func(f string) bool {
+				return strings.Contains(f, `logName:"projects/test-project/logs/cloudaudit.googleapis.com%2F"`) &&
+					strings.Contains(f, `protoPayload.serviceName=("iam.googleapis.com" OR "iamcredentials.googleapis.com" OR "cloudresourcemanager.googleapis.com")`) &&
+					strings.Contains(f, `protoPayload.methodName:("IamPolicy" OR `)
+			}), cloudlogging.MaxLogEntries).
+			Return([]*cloudlogging.Entry{
+				newEntry(`{"methodName":"SetIamPolicy","resourceName":"projects/test-project","authenticationInfo":{"principalEmail":"user@example.com"},"authorizationInfo":[{"permission":"resourcemanager.projects.setIamPolicy","granted":true}]}`),
+				newEntry(`{"methodName":"GenerateAccessToken","resourceName":"projects/-/serviceAccounts/sa@test-project.iam.gserviceaccount.com","authenticationInfo":{"principalEmail":"user@example.com"},"authorizationInfo":[{"permission":"iam.serviceAccounts.getAccessToken","granted":true}]}`),
+			}, nil).Once()
+
+		activities, err := p.GetActivities(context.Background(), dummyProvider, domain.ListActivitiesFilter{})
+
+		assert.NoError(t, err)
+		assert.Len(t, activities, 2)
+		assert.Equal(t, &domain.Resource{
+			ProviderType: dummyProvider.Type,
+			ProviderURN:  providerURN,
+			Type:         gcloudiam.ResourceTypeProject,
+			URN:          "projects/test-project",
+			Name:         "projects/test-project - GCP IAM",
+		}, activities[0].Resource)
+		assert.Equal(t, &domain.Resource{
+			ProviderType: dummyProvider.Type,
+			ProviderURN:  providerURN,
+			Type:         gcloudiam.ResourceTypeServiceAccount,
+			URN:          "projects/test-project/serviceAccounts/sa@test-project.iam.gserviceaccount.com",
+			Name:         "sa@test-project.iam.gserviceaccount.com",
+		}, activities[1].Resource)
+		logClient.AssertExpectations(t)
+	})
+
+	t.Run("should only return activities on the filtered resources", func(t *testing.T) {
+		filter := domain.ListActivitiesFilter{ResourceIDs: []string{"test-resource-id"}}
+		err := filter.PopulateResources(map[string]*domain.Resource{
+			"test-resource-id": {
+				ID:   "test-resource-id",
+				Type: gcloudiam.ResourceTypeServiceAccount,
+				URN:  "projects/test-project/serviceAccounts/sa@test-project.iam.gserviceaccount.com",
+				Name: "sa@test-project.iam.gserviceaccount.com",
+			},
+		})
+		assert.NoError(t, err)
+		logClient.EXPECT().
+			ListLogEntries(mock.Anything, mock.Anything, cloudlogging.MaxLogEntries).
+			Return([]*cloudlogging.Entry{
+				newEntry(`{"resourceName":"projects/test-project","authenticationInfo":{"principalEmail":"user@example.com"}}`),
+				newEntry(`{"resourceName":"projects/-/serviceAccounts/sa@test-project.iam.gserviceaccount.com","authenticationInfo":{"principalEmail":"user@example.com"}}`),
+			}, nil).Once()
+
+		activities, err := p.GetActivities(context.Background(), dummyProvider, filter)
+
+		assert.NoError(t, err)
+		assert.Len(t, activities, 1)
+		assert.Equal(t, gcloudiam.ResourceTypeServiceAccount, activities[0].Resource.Type)
+	})
+}
+
+func TestListActivities(t *testing.T) {
+	t.Run("should return error if provider type is not gcloud_iam", func(t *testing.T) {
+		p := gcloudiam.NewProvider("gcloud_iam", new(mocks.Encryptor))
+
+		activities, err := p.ListActivities(context.Background(), domain.Provider{Type: "gcs"}, domain.ListActivitiesFilter{})
+
+		assert.ErrorIs(t, err, gcloudiam.ErrProviderTypeMismatch)
+		assert.Nil(t, activities)
+	})
+
+	t.Run("should list granted activities within the default log bucket retention", func(t *testing.T) {
+		providerURN := "test-provider-urn"
+		logClient := new(mocks.CloudLoggingClient)
+		p := gcloudiam.NewProvider("gcloud_iam", new(mocks.Encryptor))
+		p.LogClients[providerURN] = logClient
+		dummyProvider := domain.Provider{
+			Type: "gcloud_iam",
+			Config: &domain.ProviderConfig{
+				URN:         providerURN,
+				Credentials: gcloudiam.Credentials{ResourceName: "organizations/test-org"},
+			},
+		}
+		logClient.EXPECT().
+			GetLogBucket(mock.Anything, "organizations/test-org/locations/global/buckets/_Default").
+			Return(&logging.LogBucket{RetentionDays: 30}, nil).Once()
+		logClient.EXPECT().
+			ListLogEntries(mock.Anything, mock.MatchedBy(func(f string) bool {
+				return strings.Contains(f, "protoPayload.authorizationInfo.granted=true")
+			}), cloudlogging.MaxLogEntries).
+			Return([]*cloudlogging.Entry{
+				{
+					LogEntry: &logging.LogEntry{
+						Timestamp:    "2023-01-02T03:04:05Z",
+						ProtoPayload: googleapi.RawMessage(`{"resourceName":"organizations/test-org","authenticationInfo":{"principalEmail":"user@example.com"}}`),
+					},
+				},
+			}, nil).Once()
+
+		activities, err := p.ListActivities(context.Background(), dummyProvider, domain.ListActivitiesFilter{})
+
+		assert.NoError(t, err)
+		assert.Len(t, activities, 1)
+		assert.Equal(t, gcloudiam.ResourceTypeOrganization, activities[0].Resource.Type)
+		logClient.AssertExpectations(t)
+	})
+}
+
+func TestCorrelateGrantActivities(t *testing.T) {
+	providerURN := "test-provider-urn"
+	client := new(mocks.GcloudIamClient)
+	p := gcloudiam.NewProvider("gcloud_iam", new(mocks.Encryptor))
+	p.Clients[providerURN] = client
+	dummyProvider := domain.Provider{
+		Config: &domain.ProviderConfig{URN: providerURN},
+	}
+
+	saResource := &domain.Resource{
+		Type: gcloudiam.ResourceTypeServiceAccount,
+		URN:  "projects/test-project/serviceAccounts/sa@test-project.iam.gserviceaccount.com",
+		Name: "sa@test-project.iam.gserviceaccount.com",
+	}
+	saActivity := &domain.Activity{
+		AccountID: "user@example.com",
+		Resource: &domain.Resource{
+			Type: gcloudiam.ResourceTypeServiceAccount,
+			URN:  "projects/-/serviceAccounts/sa@test-project.iam.gserviceaccount.com",
+			Name: "sa@test-project.iam.gserviceaccount.com",
+		},
+		Authorizations: []string{"iam.serviceAccounts.getAccessToken"},
+	}
+	projectActivity := &domain.Activity{
+		AccountID:      "user@example.com",
+		Resource:       &domain.Resource{Type: gcloudiam.ResourceTypeProject, URN: "projects/test-project"},
+		Authorizations: []string{"iam.serviceAccounts.getAccessToken"},
+	}
+	grant := &domain.Grant{
+		AccountID:   "user@example.com",
+		Resource:    saResource,
+		Permissions: []string{"roles/iam.serviceAccountTokenCreator"},
+	}
+	client.EXPECT().
+		GetRolePermissions(mock.Anything, "roles/iam.serviceAccountTokenCreator").
+		Return([]string{"iam.serviceAccounts.getAccessToken", "iam.serviceAccounts.signBlob"}, nil).Once()
+
+	err := p.CorrelateGrantActivities(context.Background(), dummyProvider, []*domain.Grant{grant}, []*domain.Activity{saActivity, projectActivity})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*domain.Activity{saActivity}, grant.Activities)
+	client.AssertExpectations(t)
+}
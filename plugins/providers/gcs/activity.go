@@ -0,0 +1,54 @@
+package gcs
+
+import (
+	"fmt"
+
+	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/plugins/providers/cloudlogging"
+)
+
+var (
+	// GCSAuditMethods are the object read and write methods recorded in the data access logs:
+	// https://cloud.google.com/storage/docs/audit-logging
+	GCSAuditMethods = []string{
+		"storage.objects.get",
+		"storage.objects.list",
+		"storage.objects.create",
+		"storage.objects.update",
+		"storage.objects.delete",
+	}
+)
+
+func getLogFilters(resourceName string, filter domain.ListActivitiesFilter) []string {
+	filters := []string{
+		`protoPayload.serviceName="storage.googleapis.com"`,
+		`resource.type="gcs_bucket"`,
+		`logName:"` + resourceName + `/logs/cloudaudit.googleapis.com%2F"`, // `logName:"projects/{{project_id}}/logs/cloudaudit.googleapis.com%2F"`
+		cloudlogging.In("protoPayload.methodName", GCSAuditMethods),
+	}
+	if len(filter.AccountIDs) > 0 {
+		filters = append(filters, cloudlogging.In("protoPayload.authenticationInfo.principalEmail", filter.AccountIDs))
+	}
+	return append(filters, cloudlogging.TimestampFilters(filter)...)
+}
+
+func toDomainActivity(e *cloudlogging.Entry, pd domain.Provider) (*domain.Activity, error) {
+	a, _, err := e.ToDomainActivity(pd)
+	if err != nil {
+		return nil, fmt.Errorf("converting log entry to provider activity: %w", err)
+	}
+
+	var bucketName string
+	if e.Resource != nil {
+		bucketName = e.Resource.Labels["bucket_name"]
+	}
+	a.Resource = &domain.Resource{
+		ProviderType: pd.Type,
+		ProviderURN:  pd.URN,
+		Type:         ResourceTypeBucket,
+		URN:          bucketName,
+		Name:         bucketName,
+	}
+
+	return a, nil
+}
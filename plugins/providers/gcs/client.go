@@ -10,13 +10,15 @@ import (
 	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/utils"
 	"golang.org/x/sync/errgroup"
+	iamv1 "google.golang.org/api/iam/v1"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
 type gcsClient struct {
-	client    *storage.Client
-	projectID string
+	client     *storage.Client
+	iamService *iamv1.Service
+	projectID  string
 }
 
 func newGCSClient(projectID string, credentialsJSON []byte) (*gcsClient, error) {
@@ -25,9 +27,15 @@ func newGCSClient(projectID string, credentialsJSON []byte) (*gcsClient, error)
 		return nil, err
 	}
 
+	iamService, err := iamv1.NewService(context.TODO(), option.WithCredentialsJSON(credentialsJSON))
+	if err != nil {
+		return nil, err
+	}
+
 	return &gcsClient{
-		client:    client,
-		projectID: projectID,
+		client:     client,
+		iamService: iamService,
+		projectID:  projectID,
 	}, nil
 }
 
@@ -134,6 +142,29 @@ func (c *gcsClient) ListAccess(ctx context.Context, resources []*domain.Resource
 	return result, nil
 }
 
+// GetRolePermissions returns the permissions included in a predefined or custom role
+func (c *gcsClient) GetRolePermissions(ctx context.Context, role string) ([]string, error) {
+	role = strings.TrimSpace(role)
+
+	var iamRole *iamv1.Role
+	var err error
+	switch {
+	case strings.HasPrefix(role, "roles/"):
+		iamRole, err = c.iamService.Roles.Get(role).Context(ctx).Do()
+	case strings.HasPrefix(role, "projects/"):
+		iamRole, err = c.iamService.Projects.Roles.Get(role).Context(ctx).Do()
+	case strings.HasPrefix(role, "organizations/"):
+		iamRole, err = c.iamService.Organizations.Roles.Get(role).Context(ctx).Do()
+	default:
+		return nil, fmt.Errorf("invalid role signature: %q", role)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return iamRole.IncludedPermissions, nil
+}
+
 func parseMember(member string) (accountType, accountID string, err error) {
 	m := strings.Split(member, ":")
 	if len(m) == 0 || len(m) > 2 {
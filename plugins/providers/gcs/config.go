@@ -9,6 +9,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/mitchellh/mapstructure"
 	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/plugins/providers/cloudlogging"
 )
 
 const (
@@ -103,6 +104,12 @@ func (c *Config) parseAndValidate() error {
 		}
 	}
 
+	if c.ProviderConfig.Activity != nil {
+		if err := (cloudlogging.ActivityConfig{ActivityConfig: c.ProviderConfig.Activity}).Validate(); err != nil {
+			validationError = append(validationError, fmt.Errorf("validating activity config: %w", err))
+		}
+	}
+
 	if len(validationError) > 0 {
 		errorStrings := []string{}
 		for _, err := range validationError {
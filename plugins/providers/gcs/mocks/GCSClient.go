@@ -84,6 +84,65 @@ func (_c *GCSClient_GetBuckets_Call) RunAndReturn(run func(context.Context) ([]*
 	return _c
 }
 
+// GetRolePermissions provides a mock function with given fields: ctx, role
+func (_m *GCSClient) GetRolePermissions(ctx context.Context, role string) ([]string, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRolePermissions")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GCSClient_GetRolePermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRolePermissions'
+type GCSClient_GetRolePermissions_Call struct {
+	*mock.Call
+}
+
+// GetRolePermissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *GCSClient_Expecter) GetRolePermissions(ctx interface{}, role interface{}) *GCSClient_GetRolePermissions_Call {
+	return &GCSClient_GetRolePermissions_Call{Call: _e.mock.On("GetRolePermissions", ctx, role)}
+}
+
+func (_c *GCSClient_GetRolePermissions_Call) Run(run func(ctx context.Context, role string)) *GCSClient_GetRolePermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *GCSClient_GetRolePermissions_Call) Return(_a0 []string, _a1 error) *GCSClient_GetRolePermissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GCSClient_GetRolePermissions_Call) RunAndReturn(run func(context.Context, string) ([]string, error)) *GCSClient_GetRolePermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GrantBucketAccess provides a mock function with given fields: ctx, b, identity, roleName
 func (_m *GCSClient) GrantBucketAccess(ctx context.Context, b gcs.Bucket, identity string, roleName iam.RoleName) error {
 	ret := _m.Called(ctx, b, identity, roleName)
@@ -10,7 +10,9 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/raystack/guardian/core/provider"
 	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/plugins/providers/cloudlogging"
 	"github.com/raystack/guardian/utils"
+	"google.golang.org/api/logging/v2"
 )
 
 //go:generate mockery --name=GCSClient --exported --with-expecter
@@ -19,6 +21,13 @@ type GCSClient interface {
 	GrantBucketAccess(ctx context.Context, b Bucket, identity string, roleName iam.RoleName) error
 	RevokeBucketAccess(ctx context.Context, b Bucket, identity string, roleName iam.RoleName) error
 	ListAccess(context.Context, []*domain.Resource) (domain.MapResourceAccess, error)
+	GetRolePermissions(ctx context.Context, role string) ([]string, error)
+}
+
+//go:generate mockery --name=cloudLoggingClient --exported --with-expecter
+type cloudLoggingClient interface {
+	ListLogEntries(ctx context.Context, filter string, limit int) ([]*cloudlogging.Entry, error)
+	GetLogBucket(ctx context.Context, name string) (*logging.LogBucket, error)
 }
 
 //go:generate mockery --name=Crypto --exported --with-expecter
@@ -30,16 +39,18 @@ type Provider struct {
 	provider.UnimplementedClient
 	provider.PermissionManager
 
-	typeName string
-	Clients  map[string]GCSClient
-	crypto   Crypto
+	typeName   string
+	Clients    map[string]GCSClient
+	LogClients map[string]cloudLoggingClient
+	crypto     Crypto
 }
 
 func NewProvider(typeName string, crypto Crypto) *Provider {
 	return &Provider{
-		typeName: typeName,
-		Clients:  map[string]GCSClient{},
-		crypto:   crypto,
+		typeName:   typeName,
+		Clients:    map[string]GCSClient{},
+		LogClients: map[string]cloudLoggingClient{},
+		crypto:     crypto,
 	}
 }
 
@@ -222,6 +233,136 @@ func (p *Provider) ListAccess(ctx context.Context, pc domain.ProviderConfig, res
 	return client.ListAccess(ctx, resources)
 }
 
+// GetActivities returns the object read and write activities on the given buckets
+func (p *Provider) GetActivities(ctx context.Context, pd domain.Provider, filter domain.ListActivitiesFilter) ([]*domain.Activity, error) {
+	var creds Credentials
+	if err := mapstructure.Decode(pd.Config.Credentials, &creds); err != nil {
+		return nil, fmt.Errorf("parsing credentials: %w", err)
+	}
+	logClient, err := p.getCloudLoggingClient(ctx, *pd.Config)
+	if err != nil {
+		return nil, fmt.Errorf("initializing cloud logging client: %w", err)
+	}
+
+	filters := getLogFilters(creds.ResourceName, filter)
+	if resources := filter.GetResources(); len(resources) > 0 {
+		var bucketNames []string
+		for _, r := range resources {
+			bucketNames = append(bucketNames, r.URN)
+		}
+		filters = append(filters, cloudlogging.In("resource.labels.bucket_name", bucketNames))
+	}
+
+	entries, err := logClient.ListLogEntries(ctx, strings.Join(filters, " AND "), 0)
+	if err != nil {
+		return nil, fmt.Errorf("listing log entries: %w", err)
+	}
+
+	activities := make([]*domain.Activity, 0, len(entries))
+	for _, e := range entries {
+		a, err := toDomainActivity(e, pd)
+		if err != nil {
+			return nil, err
+		}
+		activities = append(activities, a)
+	}
+
+	return activities, nil
+}
+
+// ListActivities returns the granted object read and write activities within the log bucket's retention
+func (p *Provider) ListActivities(ctx context.Context, pd domain.Provider, filter domain.ListActivitiesFilter) ([]*domain.Activity, error) {
+	if pd.Type != p.typeName {
+		return nil, ErrProviderTypeMismatch
+	}
+	var creds Credentials
+	if err := mapstructure.Decode(pd.Config.Credentials, &creds); err != nil {
+		return nil, fmt.Errorf("parsing credentials: %w", err)
+	}
+	logClient, err := p.getCloudLoggingClient(ctx, *pd.Config)
+	if err != nil {
+		return nil, fmt.Errorf("initializing cloud logging client: %w", err)
+	}
+
+	options, err := cloudlogging.ActivityConfig{ActivityConfig: pd.Config.Activity}.GetOptions()
+	if err != nil {
+		return nil, fmt.Errorf("getting cloud logging options: %w", err)
+	}
+	bucketName := options.LogBucket
+	if bucketName == "" {
+		bucketName = creds.ResourceName + "/locations/global/buckets/_Default"
+	}
+	if err := cloudlogging.ApplyRetention(ctx, logClient, bucketName, &filter); err != nil {
+		return nil, err
+	}
+
+	filters := append(getLogFilters(creds.ResourceName, filter), `protoPayload.authorizationInfo.granted=true`)
+	entries, err := logClient.ListLogEntries(ctx, strings.Join(filters, " AND "), 0)
+	if err != nil {
+		return nil, fmt.Errorf("listing log entries: %w", err)
+	}
+
+	var activities []*domain.Activity
+	for _, e := range entries {
+		a, err := toDomainActivity(e, pd)
+		if err != nil {
+			return nil, err
+		}
+		activities = append(activities, a)
+	}
+
+	return activities, nil
+}
+
+func (p *Provider) CorrelateGrantActivities(ctx context.Context, pd domain.Provider, grants []*domain.Grant, activities []*domain.Activity) error {
+	client, err := p.getGCSClient(*pd.Config)
+	if err != nil {
+		return fmt.Errorf("initializing gcs client: %w", err)
+	}
+
+	rolePermissions := map[string][]string{}
+	for _, g := range grants {
+		for _, role := range g.Permissions { // grant.Permissions is slice of gcloud roles
+			if _, ok := rolePermissions[role]; ok {
+				continue
+			}
+			permissions, err := client.GetRolePermissions(ctx, role)
+			if err != nil {
+				return fmt.Errorf("getting permissions of role %q: %w", role, err)
+			}
+			rolePermissions[role] = permissions
+		}
+	}
+
+	cloudlogging.CorrelateGrantActivities(grants, activities, rolePermissions, func(grantResource, activityResource *domain.Resource) bool {
+		return grantResource.URN == activityResource.URN
+	})
+	return nil
+}
+
+func (p *Provider) getCloudLoggingClient(ctx context.Context, pc domain.ProviderConfig) (cloudLoggingClient, error) {
+	var creds Credentials
+	if err := mapstructure.Decode(pc.Credentials, &creds); err != nil {
+		return nil, fmt.Errorf("decoding credentials: %w", err)
+	}
+
+	projectID := strings.Replace(creds.ResourceName, "projects/", "", 1)
+	if p.LogClients[projectID] != nil {
+		return p.LogClients[projectID], nil
+	}
+
+	if err := creds.Decrypt(p.crypto); err != nil {
+		return nil, fmt.Errorf("decrypting credentials: %w", err)
+	}
+	client, err := cloudlogging.NewClient(ctx, creds.ResourceName, []byte(creds.ServiceAccountKey))
+	if err != nil {
+		return nil, err
+	}
+
+	p.LogClients[projectID] = client
+	return client, nil
+}
+
 func (p *Provider) getGCSClient(pc domain.ProviderConfig) (GCSClient, error) {
 	var creds Credentials
 	if err := mapstructure.Decode(pc.Credentials, &creds); err != nil {
@@ -5,13 +5,18 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/raystack/guardian/domain"
+	"github.com/raystack/guardian/plugins/providers/cloudlogging"
 	"github.com/raystack/guardian/plugins/providers/gcs"
 	"github.com/raystack/guardian/plugins/providers/gcs/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/logging/v2"
 )
 
 func TestGetType(t *testing.T) {
@@ -877,6 +882,184 @@ func TestListAccess(t *testing.T) {
 	client.AssertExpectations(t)
 }
 
+func TestGetActivities(t *testing.T) {
+	logClient := new(mocks.CloudLoggingClient)
+	p := gcs.NewProvider("gcs", new(mocks.Crypto))
+	p.LogClients["test-project"] = logClient
+	dummyProvider := domain.Provider{
+		ID:   "test-provider-id",
+		Type: domain.ProviderTypeGCS,
+		URN:  "test-provider-urn",
+		Config: &domain.ProviderConfig{
+			Credentials: gcs.Credentials{ResourceName: "projects/test-project"},
+		},
+	}
+
+	t.Run("should return activities on the filtered buckets", func(t *testing.T) {
+		filter := domain.ListActivitiesFilter{
+			ResourceIDs: []string{"test-resource-id"},
+			AccountIDs:  []string{"user@example.com"},
+		}
+		err := filter.PopulateResources(map[string]*domain.Resource{
+			"test-resource-id": {ID: "test-resource-id", URN: "test-bucket"},
+		})
+		assert.NoError(t, err)
+		payload := `{"@type":"type.googleapis.com/google.cloud.audit.AuditLog","methodName":"storage.objects.get","authenticationInfo":{"principalEmail":"user@example.com"},"authorizationInfo":[{"permission":"storage.objects.get","granted":true}]}`
+		entries := []*cloudlogging.Entry{
+			{
+				LogEntry: &logging.LogEntry{
+					InsertId:     "test-insert-id",
+					Timestamp:    "2023-01-02T03:04:05Z",
+					ProtoPayload: googleapi.RawMessage(payload),
+					Resource: &logging.MonitoredResource{
+						Type:   "gcs_bucket",
+						Labels: map[string]string{"bucket_name": "test-bucket"},
+					},
+				},
+			},
+		}
+		logClient.EXPECT().
+			ListLogEntries(mock.Anything, mock.MatchedBy(func(f string) bool {
+				return strings.Contains(f, `resource.labels.bucket_name=("test-bucket")`) &&
+					strings.Contains(f, `protoPayload.authenticationInfo.principalEmail=("user@example.com")`)
+			}), 0).
+			Return(entries, nil).Once()
+
+		activities, err := p.GetActivities(context.Background(), dummyProvider, filter)
+
+		assert.NoError(t, err)
+		assert.Len(t, activities, 1)
+		assert.Equal(t, "user@example.com", activities[0].AccountID)
+		assert.Equal(t, []string{"storage.objects.get"}, activities[0].Authorizations)
+		assert.Equal(t, &domain.Resource{
+			ProviderType: dummyProvider.Type,
+			ProviderURN:  dummyProvider.URN,
+			Type:         gcs.ResourceTypeBucket,
+			URN:          "test-bucket",
+			Name:         "test-bucket",
+		}, activities[0].Resource)
+		logClient.AssertExpectations(t)
+	})
+
+	t.Run("should return error if listing log entries fails", func(t *testing.T) {
+		expectedError := errors.New("unexpected error")
+		logClient.EXPECT().ListLogEntries(mock.Anything, mock.Anything, 0).Return(nil, expectedError).Once()
+
+		activities, err := p.GetActivities(context.Background(), dummyProvider, domain.ListActivitiesFilter{})
+
+		assert.ErrorIs(t, err, expectedError)
+		assert.Nil(t, activities)
+	})
+}
+
+func TestListActivities(t *testing.T) {
+	dummyProvider := domain.Provider{
+		Type: domain.ProviderTypeGCS,
+		Config: &domain.ProviderConfig{
+			Credentials: gcs.Credentials{ResourceName: "projects/test-project"},
+		},
+	}
+
+	t.Run("should return error if provider type is not gcs", func(t *testing.T) {
+		p := gcs.NewProvider("gcs", new(mocks.Crypto))
+
+		activities, err := p.ListActivities(context.Background(), domain.Provider{Type: "bigquery"}, domain.ListActivitiesFilter{})
+
+		assert.ErrorIs(t, err, gcs.ErrProviderTypeMismatch)
+		assert.Nil(t, activities)
+	})
+
+	t.Run("should return error if the time range is outside the log bucket retention", func(t *testing.T) {
+		logClient := new(mocks.CloudLoggingClient)
+		p := gcs.NewProvider("gcs", new(mocks.Crypto))
+		p.LogClients["test-project"] = logClient
+		start := time.Now().Add(-60 * 24 * time.Hour)
+		logClient.EXPECT().
+			GetLogBucket(mock.Anything, "projects/test-project/locations/global/buckets/_Default").
+			Return(&logging.LogBucket{RetentionDays: 30}, nil).Once()
+
+		activities, err := p.ListActivities(context.Background(), dummyProvider, domain.ListActivitiesFilter{TimestampGte: &start})
+
+		assert.ErrorIs(t, err, cloudlogging.ErrInvalidTimeRange)
+		assert.Nil(t, activities)
+	})
+
+	t.Run("should list granted activities from the configured log bucket", func(t *testing.T) {
+		logClient := new(mocks.CloudLoggingClient)
+		p := gcs.NewProvider("gcs", new(mocks.Crypto))
+		p.LogClients["test-project"] = logClient
+		pd := dummyProvider
+		pd.Config = &domain.ProviderConfig{
+			Credentials: gcs.Credentials{ResourceName: "projects/test-project"},
+			Activity: &domain.ActivityConfig{
+				Source:  cloudlogging.SourceCloudLogging,
+				Options: map[string]interface{}{"log_bucket": "projects/test-project/locations/global/buckets/audit"},
+			},
+		}
+		payload := `{"methodName":"storage.objects.create","authenticationInfo":{"principalEmail":"user@example.com"}}`
+		logClient.EXPECT().
+			GetLogBucket(mock.Anything, "projects/test-project/locations/global/buckets/audit").
+			Return(&logging.LogBucket{RetentionDays: 30}, nil).Once()
+		logClient.EXPECT().
+			ListLogEntries(mock.Anything, mock.MatchedBy(func(f string) bool {
+				return strings.Contains(f, "protoPayload.authorizationInfo.granted=true") &&
+					strings.Contains(f, "timestamp>=")
+			}), 0).
+			Return([]*cloudlogging.Entry{
+				{LogEntry: &logging.LogEntry{Timestamp: "2023-01-02T03:04:05Z", ProtoPayload: googleapi.RawMessage(payload)}},
+			}, nil).Once()
+
+		activities, err := p.ListActivities(context.Background(), pd, domain.ListActivitiesFilter{})
+
+		assert.NoError(t, err)
+		assert.Len(t, activities, 1)
+		assert.Equal(t, "storage.objects.create", activities[0].Type)
+		logClient.AssertExpectations(t)
+	})
+}
+
+func TestCorrelateGrantActivities(t *testing.T) {
+	crypto := new(mocks.Crypto)
+	client := new(mocks.GCSClient)
+	p := gcs.NewProvider("gcs", crypto)
+	p.Clients["test-project"] = client
+	dummyProvider := domain.Provider{
+		Config: &domain.ProviderConfig{
+			Credentials: gcs.Credentials{
+				ServiceAccountKey: "encrypted-service-account-key",
+				ResourceName:      "projects/test-project",
+			},
+		},
+	}
+	crypto.EXPECT().Decrypt("encrypted-service-account-key").Return("service-account-key", nil)
+
+	t.Run("should return error if getting role permissions fails", func(t *testing.T) {
+		expectedError := errors.New("unexpected error")
+		client.EXPECT().GetRolePermissions(mock.Anything, "roles/storage.admin").Return(nil, expectedError).Once()
+		grants := []*domain.Grant{{Permissions: []string{"roles/storage.admin"}}}
+
+		err := p.CorrelateGrantActivities(context.Background(), dummyProvider, grants, nil)
+
+		assert.ErrorIs(t, err, expectedError)
+	})
+
+	t.Run("should correlate activities with grants of the same account and bucket", func(t *testing.T) {
+		bucket := &domain.Resource{URN: "test-bucket"}
+		readActivity := &domain.Activity{AccountID: "user@example.com", Resource: bucket, Authorizations: []string{"storage.objects.get"}}
+		otherBucketActivity := &domain.Activity{AccountID: "user@example.com", Resource: &domain.Resource{URN: "other-bucket"}, Authorizations: []string{"storage.objects.get"}}
+		grant := &domain.Grant{AccountID: "user@example.com", Resource: bucket, Permissions: []string{"roles/storage.objectViewer"}}
+		client.EXPECT().
+			GetRolePermissions(mock.Anything, "roles/storage.objectViewer").
+			Return([]string{"storage.objects.get", "storage.objects.list"}, nil).Once()
+
+		err := p.CorrelateGrantActivities(context.Background(), dummyProvider, []*domain.Grant{grant}, []*domain.Activity{readActivity, otherBucketActivity})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.Activity{readActivity}, grant.Activities)
+		client.AssertExpectations(t)
+	})
+}
+
 func initProvider() *gcs.Provider {
 	crypto := new(mocks.Crypto)
 	return gcs.NewProvider("gcs", crypto)
@@ -0,0 +1,45 @@
+package grafana
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/raystack/guardian/domain"
+)
+
+const (
+	ActivityTypeDashboardVersion = "dashboard_version"
+)
+
+// DashboardVersion is a version of a dashboard saved by a user
+type DashboardVersion struct {
+	ID          int       `json:"id"`
+	DashboardID int       `json:"dashboardId"`
+	Version     int       `json:"version"`
+	Created     time.Time `json:"created"`
+	CreatedBy   string    `json:"createdBy"`
+	Message     string    `json:"message"`
+
+	// UserEmail is the email of the user creating the version
+	UserEmail string `json:"-"`
+}
+
+// ToDomainActivity returns the dashboard version as an activity of the user on the dashboard. Only dashboard editors
+// and admins are able to save a dashboard.
+func (v *DashboardVersion) ToDomainActivity(p domain.Provider, r *domain.Resource) *domain.Activity {
+	return &domain.Activity{
+		ProviderID:         p.ID,
+		ProviderActivityID: fmt.Sprintf("%d:%d", v.DashboardID, v.Version),
+		AccountType:        AccountTypeUser,
+		AccountID:          v.UserEmail,
+		Timestamp:          v.Created,
+		Type:               ActivityTypeDashboardVersion,
+		RelatedPermissions: []string{DashboardRoleAdmin, DashboardRoleEditor},
+		Metadata: map[string]interface{}{
+			"version":    v.Version,
+			"message":    v.Message,
+			"created_by": v.CreatedBy,
+		},
+		Resource: r,
+	}
+}
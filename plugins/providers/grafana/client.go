@@ -2,14 +2,17 @@ package grafana
 
 import (
 	"bytes"
+	"context"
 	b64 "encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/pkg/tracing"
 )
 
@@ -18,6 +21,8 @@ type GrafanaClient interface {
 	GetFolders() ([]*Folder, error)
 	GrantDashboardAccess(resource *Dashboard, user, role string) error
 	RevokeDashboardAccess(resource *Dashboard, user, role string) error
+	ListAccess(ctx context.Context, resources []*domain.Resource) (domain.MapResourceAccess, error)
+	ListDashboardVersions(ctx context.Context, resources []*domain.Resource) ([]*DashboardVersion, error)
 }
 
 type ClientConfig struct {
@@ -41,6 +46,20 @@ type user struct {
 	Email string `json:"email"`
 }
 
+// dashboardPermission is an item of the dashboard permissions list, including the ones inherited from its folder
+type dashboardPermission struct {
+	UserID     int    `json:"userId"`
+	UserEmail  string `json:"userEmail"`
+	TeamID     int    `json:"teamId"`
+	Permission int    `json:"permission"`
+	Inherited  bool   `json:"inherited"`
+}
+
+type teamMember struct {
+	UserID int    `json:"userId"`
+	Email  string `json:"email"`
+}
+
 type UpdatePermissionRequest struct {
 	Items []*PermissionRequest `json:"items"`
 }
@@ -86,7 +105,8 @@ func NewClient(config *ClientConfig) (*client, error) {
 }
 
 func (c *client) GrantDashboardAccess(resource *Dashboard, user, role string) error {
-	userDetails, err := c.getUser(user)
+	ctx := context.Background()
+	userDetails, err := c.getUser(ctx, user)
 	if err != nil {
 		return err
 	}
@@ -96,7 +116,7 @@ func (c *client) GrantDashboardAccess(resource *Dashboard, user, role string) er
 		return ErrInvalidPermissionType
 	}
 
-	permissions, err := c.getDashboardPermissions(resource.ID)
+	permissions, err := c.getDashboardPermissions(ctx, resource.ID)
 	if err != nil {
 		return err
 	}
@@ -121,11 +141,12 @@ func (c *client) GrantDashboardAccess(resource *Dashboard, user, role string) er
 			Permission: permissionCode,
 		})
 	}
-	return c.updateDashboardPermissions(resource.ID, nonInheritedPermissions)
+	return c.updateDashboardPermissions(ctx, resource.ID, nonInheritedPermissions)
 }
 
 func (c *client) RevokeDashboardAccess(resource *Dashboard, user, role string) error {
-	userDetails, err := c.getUser(user)
+	ctx := context.Background()
+	userDetails, err := c.getUser(ctx, user)
 	if err != nil {
 		return err
 	}
@@ -134,7 +155,7 @@ func (c *client) RevokeDashboardAccess(resource *Dashboard, user, role string) e
 		return ErrInvalidPermissionType
 	}
 
-	permissions, err := c.getDashboardPermissions(resource.ID)
+	permissions, err := c.getDashboardPermissions(ctx, resource.ID)
 	if err != nil {
 		return err
 	}
@@ -156,7 +177,116 @@ func (c *client) RevokeDashboardAccess(resource *Dashboard, user, role string) e
 		return ErrPermissionNotFound
 	}
 
-	return c.updateDashboardPermissions(resource.ID, nonInheritedPermissions)
+	return c.updateDashboardPermissions(ctx, resource.ID, nonInheritedPermissions)
+}
+
+// ListAccess returns the users having access to the dashboards, either directly, through a team, or through the
+// permissions of the dashboard folder. Permissions given to organization roles are not listed. Only dashboards are
+// supported, the folder permissions are listed as the inherited permissions of their dashboards.
+func (c *client) ListAccess(ctx context.Context, resources []*domain.Resource) (domain.MapResourceAccess, error) {
+	teamMembers := map[int][]*teamMember{}
+	access := domain.MapResourceAccess{}
+	for _, r := range resources {
+		if r.Type != ResourceTypeDashboard {
+			// folders are not fetched as resources, access is only given on dashboards
+			return nil, fmt.Errorf("%w: %q", ErrInvalidResourceType, r.Type)
+		}
+		d := new(Dashboard)
+		if err := d.FromDomain(r); err != nil {
+			return nil, fmt.Errorf("parsing dashboard %q: %w", r.URN, err)
+		}
+
+		permissions, err := c.listDashboardPermissions(ctx, d.ID)
+		if err != nil {
+			return nil, fmt.Errorf("getting permissions of dashboard %q: %w", r.URN, err)
+		}
+
+		entries := map[domain.AccessEntry]bool{}
+		for _, p := range permissions {
+			permissionName := getPermissionName(p.Permission)
+			if permissionName == "" {
+				continue
+			}
+
+			var emails []string
+			if p.UserID != 0 {
+				emails = append(emails, p.UserEmail)
+			} else if p.TeamID != 0 {
+				if _, ok := teamMembers[p.TeamID]; !ok {
+					members, err := c.getTeamMembers(ctx, p.TeamID)
+					if err != nil {
+						return nil, fmt.Errorf("getting members of team %d: %w", p.TeamID, err)
+					}
+					teamMembers[p.TeamID] = members
+				}
+				for _, m := range teamMembers[p.TeamID] {
+					emails = append(emails, m.Email)
+				}
+			}
+
+			for _, email := range emails {
+				entry := domain.AccessEntry{
+					AccountID:   email,
+					AccountType: AccountTypeUser,
+					Permission:  permissionName,
+				}
+				if email == "" || entries[entry] {
+					continue
+				}
+				entries[entry] = true
+				access[r.URN] = append(access[r.URN], entry)
+			}
+		}
+	}
+
+	return access, nil
+}
+
+// ListDashboardVersions returns the versions saved on the dashboards along with the email of the user creating them.
+// Versions of users that no longer exist are skipped.
+func (c *client) ListDashboardVersions(ctx context.Context, resources []*domain.Resource) ([]*DashboardVersion, error) {
+	emails := map[string]string{}
+	var versions []*DashboardVersion
+	for _, r := range resources {
+		if r.Type != ResourceTypeDashboard {
+			continue
+		}
+		d := new(Dashboard)
+		if err := d.FromDomain(r); err != nil {
+			return nil, fmt.Errorf("parsing dashboard %q: %w", r.URN, err)
+		}
+
+		dashboardVersions, err := c.getDashboardVersions(ctx, d.ID)
+		if err != nil {
+			return nil, fmt.Errorf("getting versions of dashboard %q: %w", r.URN, err)
+		}
+
+		for _, v := range dashboardVersions {
+			if v.CreatedBy == "" {
+				continue
+			}
+			if _, ok := emails[v.CreatedBy]; !ok {
+				u, err := c.getUser(ctx, v.CreatedBy)
+				if err != nil && !errors.Is(err, ErrUserNotFound) {
+					return nil, fmt.Errorf("getting user %q: %w", v.CreatedBy, err)
+				}
+				if u != nil {
+					emails[v.CreatedBy] = u.Email
+				} else {
+					emails[v.CreatedBy] = ""
+				}
+			}
+			if emails[v.CreatedBy] == "" {
+				continue
+			}
+
+			v.DashboardID = d.ID
+			v.UserEmail = emails[v.CreatedBy]
+			versions = append(versions, v)
+		}
+	}
+
+	return versions, nil
 }
 
 func (c *client) base64Encode() string {
@@ -166,7 +296,7 @@ func (c *client) base64Encode() string {
 	return basicKeyEncoded
 }
 
-func (c *client) newRequest(method, path string, body interface{}) (*http.Request, error) {
+func (c *client) newRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
 	basicKey := c.base64Encode()
 	u, err := c.baseURL.Parse(path)
 	if err != nil {
@@ -180,7 +310,7 @@ func (c *client) newRequest(method, path string, body interface{}) (*http.Reques
 			return nil, err
 		}
 	}
-	req, err := http.NewRequest(method, u.String(), buf)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
@@ -208,7 +338,7 @@ func (c *client) do(req *http.Request, v interface{}) (*http.Response, error) {
 }
 
 func (c *client) GetFolders() ([]*Folder, error) {
-	req, err := c.newRequest(http.MethodGet, "/api/folders", nil)
+	req, err := c.newRequest(context.Background(), http.MethodGet, "/api/folders", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -222,7 +352,7 @@ func (c *client) GetFolders() ([]*Folder, error) {
 
 func (c *client) GetDashboards(folderId int) ([]*Dashboard, error) {
 	url := fmt.Sprintf("/api/search?folderIds=%d&type=dash-db", folderId)
-	req, err := c.newRequest(http.MethodGet, url, nil)
+	req, err := c.newRequest(context.Background(), http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -235,9 +365,9 @@ func (c *client) GetDashboards(folderId int) ([]*Dashboard, error) {
 	return dashboard, nil
 }
 
-func (c *client) getDashboardPermissions(id int) ([]*PermissionRequest, error) {
+func (c *client) getDashboardPermissions(ctx context.Context, id int) ([]*PermissionRequest, error) {
 	url := fmt.Sprintf("/api/dashboards/id/%d/permissions", id)
-	req, err := c.newRequest(http.MethodGet, url, nil)
+	req, err := c.newRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -250,12 +380,68 @@ func (c *client) getDashboardPermissions(id int) ([]*PermissionRequest, error) {
 	return permissions, nil
 }
 
-func (c *client) updateDashboardPermissions(id int, permissions []*PermissionRequest) error {
+func (c *client) listDashboardPermissions(ctx context.Context, id int) ([]*dashboardPermission, error) {
+	url := fmt.Sprintf("/api/dashboards/id/%d/permissions", id)
+	req, err := c.newRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var permissions []*dashboardPermission
+	if _, err := c.do(req, &permissions); err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+func (c *client) getDashboardVersions(ctx context.Context, id int) ([]*DashboardVersion, error) {
+	url := fmt.Sprintf("/api/dashboards/id/%d/versions", id)
+	req, err := c.newRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response json.RawMessage
+	if _, err := c.do(req, &response); err != nil {
+		return nil, err
+	}
+
+	var versions []*DashboardVersion
+	if err := json.Unmarshal(response, &versions); err == nil {
+		return versions, nil
+	}
+	// newer grafana versions wrap the versions in an object
+	var paginatedVersions struct {
+		Versions []*DashboardVersion `json:"versions"`
+	}
+	if err := json.Unmarshal(response, &paginatedVersions); err != nil {
+		return nil, err
+	}
+	return paginatedVersions.Versions, nil
+}
+
+func (c *client) getTeamMembers(ctx context.Context, id int) ([]*teamMember, error) {
+	url := fmt.Sprintf("/api/teams/%d/members", id)
+	req, err := c.newRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []*teamMember
+	if _, err := c.do(req, &members); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func (c *client) updateDashboardPermissions(ctx context.Context, id int, permissions []*PermissionRequest) error {
 	body := UpdatePermissionRequest{
 		Items: permissions,
 	}
 	url := fmt.Sprintf("/api/dashboards/id/%d/permissions", id)
-	req, err := c.newRequest(http.MethodPost, url, body)
+	req, err := c.newRequest(ctx, http.MethodPost, url, body)
 	if err != nil {
 		return err
 	}
@@ -264,9 +450,9 @@ func (c *client) updateDashboardPermissions(id int, permissions []*PermissionReq
 	return err
 }
 
-func (c *client) getUser(email string) (*user, error) {
+func (c *client) getUser(ctx context.Context, email string) (*user, error) {
 	url := fmt.Sprintf("/api/users/lookup?loginOrEmail=%s", email)
-	req, err := c.newRequest(http.MethodGet, url, nil)
+	req, err := c.newRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2,18 +2,23 @@ package grafana_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/mocks"
 	"github.com/raystack/guardian/plugins/providers/grafana"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -506,3 +511,209 @@ func (s *ClientTestSuite) TestRevokeDashboardAccess() {
 		s.Nil(actualError)
 	})
 }
+
+func TestClientListAccess(t *testing.T) {
+	newServer := func(t *testing.T, routes map[string]string) *httptest.Server {
+		t.Helper()
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "test-org", r.Header.Get("X-Grafana-Org-Id"))
+			body, ok := routes[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"message":"not found"}`))
+				return
+			}
+			w.Write([]byte(body))
+		}))
+	}
+	newClient := func(t *testing.T, ts *httptest.Server) grafana.GrafanaClient {
+		t.Helper()
+		client, err := grafana.NewClient(&grafana.ClientConfig{
+			Host:     ts.URL,
+			Username: "test-username",
+			Password: "test-password",
+			Org:      "test-org",
+		})
+		require.NoError(t, err)
+		return client
+	}
+
+	t.Run("should return users with direct, team and folder permissions", func(t *testing.T) {
+		ts := newServer(t, map[string]string{
+			"/api/dashboards/id/1/permissions": `[
+				{"userId": 0, "teamId": 0, "role": "Viewer", "permission": 1, "inherited": true},
+				{"userId": 10, "userEmail": "folder-admin@example.com", "permission": 4, "inherited": true},
+				{"userId": 11, "userEmail": "editor@example.com", "permission": 2},
+				{"userId": 12, "userEmail": "viewer@example.com", "permission": 1},
+				{"teamId": 20, "team": "analysts", "permission": 1}
+			]`,
+			"/api/dashboards/id/2/permissions": `[
+				{"teamId": 20, "team": "analysts", "permission": 2}
+			]`,
+			"/api/teams/20/members": `[
+				{"userId": 12, "email": "viewer@example.com"},
+				{"userId": 13, "email": "analyst@example.com"}
+			]`,
+		})
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		actual, err := client.ListAccess(context.Background(), []*domain.Resource{
+			{Type: grafana.ResourceTypeDashboard, URN: "1"},
+			{Type: grafana.ResourceTypeDashboard, URN: "2"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.MapResourceAccess{
+			"1": {
+				{AccountID: "folder-admin@example.com", AccountType: "user", Permission: "admin"},
+				{AccountID: "editor@example.com", AccountType: "user", Permission: "edit"},
+				{AccountID: "viewer@example.com", AccountType: "user", Permission: "view"},
+				{AccountID: "analyst@example.com", AccountType: "user", Permission: "view"},
+			},
+			"2": {
+				{AccountID: "viewer@example.com", AccountType: "user", Permission: "edit"},
+				{AccountID: "analyst@example.com", AccountType: "user", Permission: "edit"},
+			},
+		}, actual)
+	})
+
+	t.Run("should return error if the dashboard urn is invalid", func(t *testing.T) {
+		ts := newServer(t, map[string]string{})
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		actual, err := client.ListAccess(context.Background(), []*domain.Resource{
+			{Type: grafana.ResourceTypeDashboard, URN: "invalid"},
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("should return error if getting the dashboard permissions fails", func(t *testing.T) {
+		ts := newServer(t, map[string]string{})
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		actual, err := client.ListAccess(context.Background(), []*domain.Resource{
+			{Type: grafana.ResourceTypeDashboard, URN: "1"},
+		})
+
+		assert.ErrorContains(t, err, `getting permissions of dashboard "1"`)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("should return error if the resource is a folder", func(t *testing.T) {
+		ts := newServer(t, map[string]string{})
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		actual, err := client.ListAccess(context.Background(), []*domain.Resource{
+			{Type: grafana.ResourceTypeFolder, URN: "1"},
+		})
+
+		assert.ErrorIs(t, err, grafana.ErrInvalidResourceType)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("should return error if the context is canceled", func(t *testing.T) {
+		ts := newServer(t, map[string]string{
+			"/api/dashboards/id/1/permissions": `[]`,
+		})
+		defer ts.Close()
+		client := newClient(t, ts)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		actual, err := client.ListAccess(ctx, []*domain.Resource{
+			{Type: grafana.ResourceTypeDashboard, URN: "1"},
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestClientListDashboardVersions(t *testing.T) {
+	newServer := func(t *testing.T, routes map[string]string) *httptest.Server {
+		t.Helper()
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, ok := routes[r.URL.RequestURI()]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"message":"not found"}`))
+				return
+			}
+			w.Write([]byte(body))
+		}))
+	}
+	newClient := func(t *testing.T, ts *httptest.Server) grafana.GrafanaClient {
+		t.Helper()
+		client, err := grafana.NewClient(&grafana.ClientConfig{
+			Host:     ts.URL,
+			Username: "test-username",
+			Password: "test-password",
+			Org:      "test-org",
+		})
+		require.NoError(t, err)
+		return client
+	}
+
+	t.Run("should return the versions along with the email of the users", func(t *testing.T) {
+		ts := newServer(t, map[string]string{
+			"/api/dashboards/id/1/versions": `[
+				{"id": 2, "dashboardId": 1, "version": 2, "created": "2023-01-02T03:04:05Z", "createdBy": "editor", "message": "update panels"},
+				{"id": 1, "dashboardId": 1, "version": 1, "created": "2023-01-01T03:04:05Z", "createdBy": "deleted-user"}
+			]`,
+			"/api/dashboards/id/2/versions": `{"continueToken": "", "versions": [
+				{"id": 3, "dashboardId": 2, "version": 5, "created": "2023-01-03T03:04:05Z", "createdBy": "editor"}
+			]}`,
+			"/api/users/lookup?loginOrEmail=editor": `{"id": 11, "email": "editor@example.com"}`,
+		})
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		actual, err := client.ListDashboardVersions(context.Background(), []*domain.Resource{
+			{Type: grafana.ResourceTypeDashboard, URN: "1"},
+			{Type: grafana.ResourceTypeDashboard, URN: "2"},
+			{Type: grafana.ResourceTypeFolder, URN: "3"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []*grafana.DashboardVersion{
+			{
+				ID:          2,
+				DashboardID: 1,
+				Version:     2,
+				Created:     time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+				CreatedBy:   "editor",
+				Message:     "update panels",
+				UserEmail:   "editor@example.com",
+			},
+			{
+				ID:          3,
+				DashboardID: 2,
+				Version:     5,
+				Created:     time.Date(2023, 1, 3, 3, 4, 5, 0, time.UTC),
+				CreatedBy:   "editor",
+				UserEmail:   "editor@example.com",
+			},
+		}, actual)
+	})
+
+	t.Run("should return error if getting the dashboard versions fails", func(t *testing.T) {
+		ts := newServer(t, map[string]string{
+			"/api/dashboards/id/1/versions": `invalid-json`,
+		})
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		actual, err := client.ListDashboardVersions(context.Background(), []*domain.Resource{
+			{Type: grafana.ResourceTypeDashboard, URN: "1"},
+		})
+
+		assert.ErrorContains(t, err, `getting versions of dashboard "1"`)
+		assert.Nil(t, actual)
+	})
+}
@@ -58,6 +58,15 @@ var permissionCodes = map[string]int{
 	"admin": 4,
 }
 
+func getPermissionName(code int) string {
+	for name, c := range permissionCodes {
+		if c == code {
+			return name
+		}
+	}
+	return ""
+}
+
 type Permission string
 
 type Config struct {
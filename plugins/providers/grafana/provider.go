@@ -2,6 +2,8 @@ package grafana
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/mitchellh/mapstructure"
 	pv "github.com/raystack/guardian/core/provider"
@@ -11,6 +13,7 @@ import (
 type provider struct {
 	pv.UnimplementedClient
 	pv.PermissionManager
+	pv.ActivityCorrelator
 
 	typeName string
 	Clients  map[string]GrafanaClient
@@ -19,6 +22,10 @@ type provider struct {
 
 func NewProvider(typeName string, crypto domain.Crypto) *provider {
 	return &provider{
+		// only saving a dashboard is recorded, viewers never produce an activity
+		ActivityCorrelator: pv.ActivityCorrelator{
+			ObservablePermissions: []string{DashboardRoleAdmin, DashboardRoleEditor},
+		},
 		typeName: typeName,
 		Clients:  map[string]GrafanaClient{},
 		crypto:   crypto,
@@ -142,6 +149,65 @@ func (p *provider) RevokeAccess(pc *domain.ProviderConfig, a domain.Grant) error
 	return ErrInvalidResourceType
 }
 
+func (p *provider) ListAccess(ctx context.Context, pc domain.ProviderConfig, resources []*domain.Resource) (domain.MapResourceAccess, error) {
+	var creds Credentials
+	if err := mapstructure.Decode(pc.Credentials, &creds); err != nil {
+		return nil, fmt.Errorf("parsing credentials: %w", err)
+	}
+	client, err := p.getClient(pc.URN, creds)
+	if err != nil {
+		return nil, fmt.Errorf("initializing grafana client: %w", err)
+	}
+
+	return client.ListAccess(ctx, resources)
+}
+
+// GetActivities returns the dashboard versions saved by the users as their activities on the dashboards
+func (p *provider) GetActivities(ctx context.Context, pd domain.Provider, filter domain.ListActivitiesFilter) ([]*domain.Activity, error) {
+	var creds Credentials
+	if err := mapstructure.Decode(pd.Config.Credentials, &creds); err != nil {
+		return nil, fmt.Errorf("parsing credentials: %w", err)
+	}
+	client, err := p.getClient(pd.Config.URN, creds)
+	if err != nil {
+		return nil, fmt.Errorf("initializing grafana client: %w", err)
+	}
+
+	resources := filter.GetResources()
+	if len(resources) == 0 {
+		if resources, err = p.GetResources(pd.Config); err != nil {
+			return nil, fmt.Errorf("getting resources: %w", err)
+		}
+	}
+	resourcesByURN := map[string]*domain.Resource{}
+	for _, r := range resources {
+		resourcesByURN[r.URN] = r
+	}
+
+	versions, err := client.ListDashboardVersions(ctx, resources)
+	if err != nil {
+		return nil, fmt.Errorf("listing dashboard versions: %w", err)
+	}
+
+	activities := make([]*domain.Activity, 0, len(versions))
+	for _, v := range versions {
+		a := v.ToDomainActivity(pd, resourcesByURN[strconv.Itoa(v.DashboardID)])
+		if filter.IsMatch(a) {
+			activities = append(activities, a)
+		}
+	}
+
+	return activities, nil
+}
+
+// ListActivities returns the activities used for checking the dormancy of the grants
+func (p *provider) ListActivities(ctx context.Context, pd domain.Provider, filter domain.ListActivitiesFilter) ([]*domain.Activity, error) {
+	if pd.Type != p.typeName {
+		return nil, ErrProviderTypeMismatch
+	}
+	return p.GetActivities(ctx, pd, filter)
+}
+
 func (p *provider) GetRoles(pc *domain.ProviderConfig, resourceType string) ([]*domain.Role, error) {
 	return pv.GetRoles(pc, resourceType)
 }
@@ -1,14 +1,17 @@
 package grafana_test
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/mocks"
 	"github.com/raystack/guardian/plugins/providers/grafana"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetType(t *testing.T) {
@@ -784,6 +787,194 @@ func TestGetRoles(t *testing.T) {
 	)
 }
 
+func TestListAccess(t *testing.T) {
+	t.Run("should return error if credentials is invalid", func(t *testing.T) {
+		crypto := new(mocks.Crypto)
+		p := grafana.NewProvider("", crypto)
+
+		pc := domain.ProviderConfig{
+			Credentials: "invalid-creds",
+		}
+
+		actualAccess, actualError := p.ListAccess(context.Background(), pc, nil)
+
+		assert.Nil(t, actualAccess)
+		assert.Error(t, actualError)
+	})
+
+	t.Run("should return access from the client", func(t *testing.T) {
+		providerURN := "test-provider-urn"
+		crypto := new(mocks.Crypto)
+		client := new(mocks.GrafanaClient)
+		p := grafana.NewProvider("", crypto)
+		p.Clients = map[string]grafana.GrafanaClient{
+			providerURN: client,
+		}
+
+		pc := domain.ProviderConfig{
+			URN:         providerURN,
+			Credentials: map[string]interface{}{},
+		}
+		resources := []*domain.Resource{
+			{Type: grafana.ResourceTypeDashboard, URN: "1"},
+		}
+		expectedAccess := domain.MapResourceAccess{
+			"1": {
+				{AccountID: "user@example.com", AccountType: "user", Permission: "view"},
+			},
+		}
+		client.On("ListAccess", mock.Anything, resources).Return(expectedAccess, nil).Once()
+
+		actualAccess, actualError := p.ListAccess(context.Background(), pc, resources)
+
+		assert.NoError(t, actualError)
+		assert.Equal(t, expectedAccess, actualAccess)
+		client.AssertExpectations(t)
+	})
+}
+
+func TestGetActivities(t *testing.T) {
+	providerURN := "test-provider-urn"
+	dummyProvider := domain.Provider{
+		ID:   "test-provider-id",
+		Type: "grafana",
+		URN:  providerURN,
+		Config: &domain.ProviderConfig{
+			Type:        "grafana",
+			URN:         providerURN,
+			Credentials: map[string]interface{}{},
+		},
+	}
+	newVersions := func() []*grafana.DashboardVersion {
+		return []*grafana.DashboardVersion{
+			{
+				DashboardID: 1,
+				Version:     2,
+				Created:     time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+				CreatedBy:   "editor",
+				UserEmail:   "editor@example.com",
+			},
+			{
+				DashboardID: 1,
+				Version:     1,
+				Created:     time.Date(2023, 1, 1, 3, 4, 5, 0, time.UTC),
+				CreatedBy:   "admin",
+				UserEmail:   "admin@example.com",
+			},
+		}
+	}
+
+	t.Run("should return error if credentials is invalid", func(t *testing.T) {
+		p := grafana.NewProvider("grafana", new(mocks.Crypto))
+
+		activities, err := p.GetActivities(context.Background(), domain.Provider{
+			Config: &domain.ProviderConfig{Credentials: "invalid-creds"},
+		}, domain.ListActivitiesFilter{})
+
+		assert.ErrorContains(t, err, "parsing credentials")
+		assert.Nil(t, activities)
+	})
+
+	t.Run("should return the dashboard versions of the filtered resources as activities", func(t *testing.T) {
+		client := new(mocks.GrafanaClient)
+		p := grafana.NewProvider("grafana", new(mocks.Crypto))
+		p.Clients[providerURN] = client
+		dashboard := &domain.Resource{ID: "test-resource-id", Type: grafana.ResourceTypeDashboard, URN: "1", Name: "dashboard 1"}
+		filter := domain.ListActivitiesFilter{
+			ResourceIDs: []string{dashboard.ID},
+			AccountIDs:  []string{"editor@example.com"},
+		}
+		require.NoError(t, filter.PopulateResources(map[string]*domain.Resource{dashboard.ID: dashboard}))
+		client.On("ListDashboardVersions", mock.Anything, []*domain.Resource{dashboard}).Return(newVersions(), nil).Once()
+
+		activities, err := p.GetActivities(context.Background(), dummyProvider, filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.Activity{
+			{
+				ProviderID:         dummyProvider.ID,
+				ProviderActivityID: "1:2",
+				AccountType:        "user",
+				AccountID:          "editor@example.com",
+				Timestamp:          time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+				Type:               grafana.ActivityTypeDashboardVersion,
+				RelatedPermissions: []string{"admin", "edit"},
+				Metadata: map[string]interface{}{
+					"version":    2,
+					"message":    "",
+					"created_by": "editor",
+				},
+				Resource: dashboard,
+			},
+		}, activities)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("should return error if listing dashboard versions fails", func(t *testing.T) {
+		client := new(mocks.GrafanaClient)
+		p := grafana.NewProvider("grafana", new(mocks.Crypto))
+		p.Clients[providerURN] = client
+		expectedError := errors.New("unexpected error")
+		client.On("GetFolders").Return([]*grafana.Folder{}, nil).Once()
+		client.On("ListDashboardVersions", mock.Anything, []*domain.Resource{}).Return(nil, expectedError).Once()
+
+		activities, err := p.GetActivities(context.Background(), dummyProvider, domain.ListActivitiesFilter{})
+
+		assert.ErrorIs(t, err, expectedError)
+		assert.Nil(t, activities)
+	})
+}
+
+func TestListActivities(t *testing.T) {
+	t.Run("should return error if provider type is not grafana", func(t *testing.T) {
+		p := grafana.NewProvider("grafana", new(mocks.Crypto))
+
+		activities, err := p.ListActivities(context.Background(), domain.Provider{Type: "metabase"}, domain.ListActivitiesFilter{})
+
+		assert.ErrorIs(t, err, grafana.ErrProviderTypeMismatch)
+		assert.Nil(t, activities)
+	})
+
+	t.Run("should return the activities on all dashboards within the time range", func(t *testing.T) {
+		providerURN := "test-provider-urn"
+		client := new(mocks.GrafanaClient)
+		p := grafana.NewProvider("grafana", new(mocks.Crypto))
+		p.Clients[providerURN] = client
+		pd := domain.Provider{
+			Type: "grafana",
+			URN:  providerURN,
+			Config: &domain.ProviderConfig{
+				Type:        "grafana",
+				URN:         providerURN,
+				Credentials: map[string]interface{}{},
+			},
+		}
+		expectedResource := &domain.Resource{
+			ProviderType: "grafana",
+			ProviderURN:  providerURN,
+			Type:         grafana.ResourceTypeDashboard,
+			URN:          "1",
+			Name:         "dashboard 1",
+			Details:      map[string]interface{}{"folder_id": 10},
+		}
+		client.On("GetFolders").Return([]*grafana.Folder{{ID: 10}}, nil).Once()
+		client.On("GetDashboards", 10).Return([]*grafana.Dashboard{{ID: 1, Title: "dashboard 1", FolderID: 10}}, nil).Once()
+		client.On("ListDashboardVersions", mock.Anything, []*domain.Resource{expectedResource}).Return([]*grafana.DashboardVersion{
+			{DashboardID: 1, Version: 2, Created: time.Now().Add(-time.Hour), UserEmail: "editor@example.com"},
+			{DashboardID: 1, Version: 1, Created: time.Now().Add(-30 * 24 * time.Hour), UserEmail: "editor@example.com"},
+		}, nil).Once()
+		startDate := time.Now().Add(-7 * 24 * time.Hour)
+
+		activities, err := p.ListActivities(context.Background(), pd, domain.ListActivitiesFilter{TimestampGte: &startDate})
+
+		assert.NoError(t, err)
+		assert.Len(t, activities, 1)
+		assert.Equal(t, "1:2", activities[0].ProviderActivityID)
+		assert.Equal(t, expectedResource, activities[0].Resource)
+		client.AssertExpectations(t)
+	})
+}
+
 func TestGetAccountTypes(t *testing.T) {
 	t.Run("should return the list of supported account types (user only)", func(t *testing.T) {
 		crypto := new(mocks.Crypto)
@@ -795,3 +986,10 @@ func TestGetAccountTypes(t *testing.T) {
 		assert.Equal(t, expectedAccountTypes, actualAccountTypes)
 	})
 }
+
+func TestIsGrantObservable(t *testing.T) {
+	p := grafana.NewProvider(domain.ProviderTypeGrafana, new(mocks.Crypto))
+
+	assert.False(t, p.IsGrantObservable(&domain.Grant{Permissions: []string{"view"}}))
+	assert.True(t, p.IsGrantObservable(&domain.Grant{Permissions: []string{"edit"}}))
+}
@@ -0,0 +1,64 @@
+package metabase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/raystack/guardian/domain"
+)
+
+// Activity is an event recorded in the metabase activity feed, e.g. creating a question or a dashboard
+type Activity struct {
+	ID         int                    `json:"id"`
+	Topic      string                 `json:"topic"`
+	Model      string                 `json:"model"`
+	ModelID    int                    `json:"model_id"`
+	DatabaseID int                    `json:"database_id"`
+	TableID    int                    `json:"table_id"`
+	UserID     int                    `json:"user_id"`
+	Timestamp  time.Time              `json:"timestamp"`
+	Details    map[string]interface{} `json:"details"`
+
+	// UserEmail is the email of the user doing the activity
+	UserEmail string `json:"-"`
+}
+
+// GetResourceURNs returns the urns of the table and database the activity is done on
+func (a *Activity) GetResourceURNs() []string {
+	var urns []string
+	if a.DatabaseID != 0 && a.TableID != 0 {
+		urns = append(urns, fmt.Sprintf("%s:%d.%d", table, a.DatabaseID, a.TableID))
+	}
+	if a.DatabaseID != 0 {
+		urns = append(urns, fmt.Sprintf("%s:%d", database, a.DatabaseID))
+	}
+	return urns
+}
+
+// ToDomainActivity returns the activity of the user on the given table or database resource. As an event may be done
+// on both the table and its database, the resource urn is part of the activity id.
+func (a *Activity) ToDomainActivity(p domain.Provider, r *domain.Resource) *domain.Activity {
+	var relatedPermissions []string
+	switch r.Type {
+	case ResourceTypeDatabase:
+		relatedPermissions = []string{DatabaseRoleEditor, DatabaseRoleViewer}
+	case ResourceTypeTable:
+		relatedPermissions = []string{TableRoleViewer}
+	}
+
+	return &domain.Activity{
+		ProviderID:         p.ID,
+		ProviderActivityID: fmt.Sprintf("%d:%s", a.ID, r.URN),
+		AccountType:        AccountTypeUser,
+		AccountID:          a.UserEmail,
+		Timestamp:          a.Timestamp,
+		Type:               a.Topic,
+		RelatedPermissions: relatedPermissions,
+		Metadata: map[string]interface{}{
+			"model":    a.Model,
+			"model_id": a.ModelID,
+			"details":  a.Details,
+		},
+		Resource: r,
+	}
+}
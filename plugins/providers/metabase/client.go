@@ -2,6 +2,7 @@ package metabase
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/pkg/tracing"
 	"github.com/raystack/salt/log"
 
@@ -27,6 +29,7 @@ const (
 	groupEndpoint                = "/api/permissions/group"
 	databasePermissionEndpoint   = "/api/permissions/graph"
 	collectionPermissionEndpoint = "/api/collection/graph"
+	activityEndpoint             = "/api/activity"
 
 	data             = "data"
 	database         = "database"
@@ -56,6 +59,8 @@ type MetabaseClient interface {
 	RevokeTableAccess(resource *Table, user, role string) error
 	GrantGroupAccess(groupID int, email string) error
 	RevokeGroupAccess(groupID int, email string) error
+	ListAccess(ctx context.Context, resources []*domain.Resource) (domain.MapResourceAccess, error)
+	ListActivities(ctx context.Context) ([]*Activity, error)
 }
 
 type ClientConfig struct {
@@ -652,6 +657,169 @@ func (c *client) RevokeGroupAccess(groupID int, email string) error {
 	return c.removeMembership(groupID, email)
 }
 
+// ListAccess returns the users having access to the resources through their group memberships. Database, table and
+// collection permissions are read from the permission graphs of the groups.
+func (c *client) ListAccess(ctx context.Context, resources []*domain.Resource) (domain.MapResourceAccess, error) {
+	resourceURNs := map[string]bool{}
+	for _, r := range resources {
+		resourceURNs[r.URN] = true
+	}
+
+	// groupPermissions is a map[group_id]map[resource_urn][]permission
+	groupPermissions := map[int]map[string][]string{}
+	addPermission := func(groupID string, resourceURN string, permission string) {
+		if !resourceURNs[resourceURN] {
+			return
+		}
+		id, err := strconv.Atoi(groupID)
+		if err != nil {
+			return
+		}
+		if groupPermissions[id] == nil {
+			groupPermissions[id] = map[string][]string{}
+		}
+		groupPermissions[id][resourceURN] = append(groupPermissions[id][resourceURN], permission)
+	}
+
+	dbGraph, err := c.getDatabaseAccess()
+	if err != nil {
+		return nil, fmt.Errorf("getting database permissions: %w", err)
+	}
+	for groupID, databases := range dbGraph.Groups {
+		for databaseID, permission := range databases {
+			databaseURN := fmt.Sprintf("%s:%s", database, databaseID)
+			for _, p := range getDatabasePermissions(permission) {
+				addPermission(groupID, databaseURN, p)
+			}
+			for tableID, p := range getTablePermissions(permission) {
+				addPermission(groupID, fmt.Sprintf("%s:%s.%s", table, databaseID, tableID), p)
+			}
+		}
+	}
+
+	collGraph, err := c.getCollectionAccess()
+	if err != nil {
+		return nil, fmt.Errorf("getting collection permissions: %w", err)
+	}
+	for groupID, collections := range collGraph.Groups {
+		for collectionID, permission := range collections {
+			if permission == CollectionRoleViewer || permission == CollectionRoleCurate {
+				addPermission(groupID, fmt.Sprintf("%s:%s", collection, collectionID), permission)
+			}
+		}
+	}
+
+	for urn := range resourceURNs {
+		if strings.HasPrefix(urn, groupConst+":") {
+			addPermission(strings.TrimPrefix(urn, groupConst+":"), urn, GroupRoleMember)
+		}
+	}
+
+	access := domain.MapResourceAccess{}
+	// a user can be a member of several groups having the same permission on a resource
+	entries := map[string]map[domain.AccessEntry]bool{}
+	for groupID, permissions := range groupPermissions {
+		g, err := c.getGroup(groupID)
+		if err != nil {
+			return nil, fmt.Errorf("getting members of group %d: %w", groupID, err)
+		}
+
+		for resourceURN, resourcePermissions := range permissions {
+			if entries[resourceURN] == nil {
+				entries[resourceURN] = map[domain.AccessEntry]bool{}
+			}
+			for _, member := range g.Members {
+				for _, p := range resourcePermissions {
+					entry := domain.AccessEntry{
+						AccountID:   member.Email,
+						AccountType: AccountTypeUser,
+						Permission:  p,
+					}
+					if member.Email == "" || entries[resourceURN][entry] {
+						continue
+					}
+					entries[resourceURN][entry] = true
+					access[resourceURN] = append(access[resourceURN], entry)
+				}
+			}
+		}
+	}
+
+	return access, nil
+}
+
+// ListActivities returns the events of the activity feed done by the users
+func (c *client) ListActivities(ctx context.Context) ([]*Activity, error) {
+	req, err := c.newRequest(http.MethodGet, activityEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []struct {
+		Activity
+		User *user `json:"user"`
+	}
+	if _, err := c.do(req, &response); err != nil {
+		return nil, err
+	}
+	c.logger.Info("Fetch activities from request", "total", len(response), req.URL)
+
+	activities := make([]*Activity, 0, len(response))
+	for _, r := range response {
+		if r.User == nil || r.User.Email == "" {
+			continue
+		}
+		a := r.Activity
+		a.UserEmail = r.User.Email
+		activities = append(activities, &a)
+	}
+	return activities, nil
+}
+
+// getDatabasePermissions returns the database level permissions of a database permission graph entry, e.g.
+// {"native": "write", "schemas": "all"} returns native:write and schemas:all
+func getDatabasePermissions(permission databasePermission) []string {
+	if nested, ok := permission[data].(map[string]interface{}); ok {
+		// newer metabase versions nest the data access permissions under "data"
+		permission = nested
+	}
+
+	var permissions []string
+	if native, ok := permission["native"].(string); ok && native == "write" {
+		permissions = append(permissions, DatabaseRoleEditor)
+	}
+	if schemas, ok := permission["schemas"].(string); ok && schemas == "all" {
+		permissions = append(permissions, DatabaseRoleViewer)
+	}
+	return permissions
+}
+
+// getTablePermissions returns the table permissions of a database permission graph entry, mapped by table id, e.g.
+// {"schemas": {"public": {"1": "all"}}} returns {"1": "all"}
+func getTablePermissions(permission databasePermission) map[string]string {
+	if nested, ok := permission[data].(map[string]interface{}); ok {
+		permission = nested
+	}
+
+	permissions := map[string]string{}
+	schemas, ok := permission["schemas"].(map[string]interface{})
+	if !ok {
+		return permissions
+	}
+	for _, tables := range schemas {
+		tables, ok := tables.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for tableID, p := range tables {
+			if p == TableRoleViewer {
+				permissions[tableID] = TableRoleViewer
+			}
+		}
+	}
+	return permissions
+}
+
 func (c *client) removeMembership(groupID int, user string) error {
 	group, err := c.getGroup(groupID)
 	if err != nil {
@@ -2,20 +2,25 @@ package metabase_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/raystack/salt/log"
 
+	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/mocks"
 	"github.com/raystack/guardian/plugins/providers/metabase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -642,3 +647,221 @@ func (s *ClientTestSuite) TestRevokeDatabaseAccess() {
 		s.Nil(actualError)
 	})
 }
+
+func TestClientListAccess(t *testing.T) {
+	newServer := func(t *testing.T, routes map[string]string) *httptest.Server {
+		t.Helper()
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/api/session" {
+				w.Write([]byte(`{"id":"test-session-token"}`))
+				return
+			}
+			assert.Equal(t, "test-session-token", r.Header.Get("X-Metabase-Session"))
+			body, ok := routes[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte("unexpected request " + r.URL.Path))
+				return
+			}
+			w.Write([]byte(body))
+		}))
+	}
+	newClient := func(t *testing.T, ts *httptest.Server) metabase.MetabaseClient {
+		t.Helper()
+		client, err := metabase.NewClient(&metabase.ClientConfig{
+			Host:     ts.URL,
+			Username: "test-username",
+			Password: "test-password",
+		}, log.NewNoop())
+		require.NoError(t, err)
+		return client
+	}
+
+	t.Run("should return access of the group members mapped to the resource permissions", func(t *testing.T) {
+		ts := newServer(t, map[string]string{
+			"/api/permissions/graph": `{
+				"revision": 1,
+				"groups": {
+					"3": {"1": {"native": "write", "schemas": "all"}},
+					"4": {"1": {"schemas": "all"}, "2": {"schemas": {"public": {"10": "all", "11": "none"}}}},
+					"5": {"1": {"data": {"schemas": "all"}}, "2": {"schemas": "none"}}
+				}
+			}`,
+			"/api/collection/graph": `{
+				"revision": 1,
+				"groups": {
+					"3": {"root": "none", "7": "write"},
+					"4": {"7": "read"},
+					"5": {"7": "none"}
+				}
+			}`,
+			"/api/permissions/group/3": `{"id": 3, "name": "analysts", "members": [{"email": "analyst@example.com"}]}`,
+			"/api/permissions/group/4": `{"id": 4, "name": "viewers", "members": [{"email": "viewer@example.com"}, {"email": "other-viewer@example.com"}]}`,
+			"/api/permissions/group/5": `{"id": 5, "name": "readers", "members": [{"email": "reader@example.com"}]}`,
+		})
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		resources := []*domain.Resource{
+			{Type: metabase.ResourceTypeDatabase, URN: "database:1"},
+			{Type: metabase.ResourceTypeTable, URN: "table:2.10"},
+			{Type: metabase.ResourceTypeCollection, URN: "collection:7"},
+			{Type: metabase.ResourceTypeGroup, URN: "group:5"},
+		}
+
+		actual, err := client.ListAccess(context.Background(), resources)
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []domain.AccessEntry{
+			{AccountID: "analyst@example.com", AccountType: "user", Permission: "native:write"},
+			{AccountID: "analyst@example.com", AccountType: "user", Permission: "schemas:all"},
+			{AccountID: "viewer@example.com", AccountType: "user", Permission: "schemas:all"},
+			{AccountID: "other-viewer@example.com", AccountType: "user", Permission: "schemas:all"},
+			{AccountID: "reader@example.com", AccountType: "user", Permission: "schemas:all"},
+		}, actual["database:1"])
+		assert.ElementsMatch(t, []domain.AccessEntry{
+			{AccountID: "viewer@example.com", AccountType: "user", Permission: "all"},
+			{AccountID: "other-viewer@example.com", AccountType: "user", Permission: "all"},
+		}, actual["table:2.10"])
+		assert.ElementsMatch(t, []domain.AccessEntry{
+			{AccountID: "analyst@example.com", AccountType: "user", Permission: "write"},
+			{AccountID: "viewer@example.com", AccountType: "user", Permission: "read"},
+			{AccountID: "other-viewer@example.com", AccountType: "user", Permission: "read"},
+		}, actual["collection:7"])
+		assert.Equal(t, []domain.AccessEntry{
+			{AccountID: "reader@example.com", AccountType: "user", Permission: "member"},
+		}, actual["group:5"])
+		assert.Len(t, actual, 4)
+	})
+
+	t.Run("should return a single entry for a member of several groups with the same permission", func(t *testing.T) {
+		ts := newServer(t, map[string]string{
+			"/api/permissions/graph":   `{"revision": 1, "groups": {"3": {"1": {"schemas": "all"}}, "4": {"1": {"schemas": "all"}}}}`,
+			"/api/collection/graph":    `{"revision": 1, "groups": {}}`,
+			"/api/permissions/group/3": `{"id": 3, "name": "analysts", "members": [{"email": "user@example.com"}]}`,
+			"/api/permissions/group/4": `{"id": 4, "name": "viewers", "members": [{"email": "user@example.com"}, {"email": "viewer@example.com"}]}`,
+		})
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		actual, err := client.ListAccess(context.Background(), []*domain.Resource{
+			{Type: metabase.ResourceTypeDatabase, URN: "database:1"},
+		})
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []domain.AccessEntry{
+			{AccountID: "user@example.com", AccountType: "user", Permission: "schemas:all"},
+			{AccountID: "viewer@example.com", AccountType: "user", Permission: "schemas:all"},
+		}, actual["database:1"])
+	})
+
+	t.Run("should skip groups without access to the given resources", func(t *testing.T) {
+		ts := newServer(t, map[string]string{
+			"/api/permissions/graph": `{"revision": 1, "groups": {"3": {"1": {"schemas": "all"}}}}`,
+			"/api/collection/graph":  `{"revision": 1, "groups": {"3": {"7": "read"}}}`,
+		})
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		actual, err := client.ListAccess(context.Background(), []*domain.Resource{
+			{Type: metabase.ResourceTypeDatabase, URN: "database:2"},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, actual)
+	})
+
+	t.Run("should return error if fetching the permission graph fails", func(t *testing.T) {
+		ts := newServer(t, map[string]string{})
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		actual, err := client.ListAccess(context.Background(), []*domain.Resource{
+			{Type: metabase.ResourceTypeDatabase, URN: "database:1"},
+		})
+
+		assert.ErrorContains(t, err, "getting database permissions")
+		assert.Nil(t, actual)
+	})
+}
+
+func TestClientListActivities(t *testing.T) {
+	newServer := func(t *testing.T, activityResponse string) *httptest.Server {
+		t.Helper()
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/api/session" {
+				w.Write([]byte(`{"id":"test-session-token"}`))
+				return
+			}
+			assert.Equal(t, "test-session-token", r.Header.Get("X-Metabase-Session"))
+			assert.Equal(t, "/api/activity", r.URL.Path)
+			w.Write([]byte(activityResponse))
+		}))
+	}
+	newClient := func(t *testing.T, ts *httptest.Server) metabase.MetabaseClient {
+		t.Helper()
+		client, err := metabase.NewClient(&metabase.ClientConfig{
+			Host:     ts.URL,
+			Username: "test-username",
+			Password: "test-password",
+		}, log.NewNoop())
+		require.NoError(t, err)
+		return client
+	}
+
+	t.Run("should return the activities done by the users", func(t *testing.T) {
+		ts := newServer(t, `[
+			{
+				"id": 2,
+				"topic": "card-create",
+				"model": "card",
+				"model_id": 5,
+				"database_id": 1,
+				"table_id": 10,
+				"user_id": 3,
+				"user": {"id": 3, "email": "user@example.com"},
+				"timestamp": "2023-01-02T03:04:05Z",
+				"details": {"name": "test-question"}
+			},
+			{
+				"id": 1,
+				"topic": "install",
+				"model": "install",
+				"user_id": null,
+				"user": null,
+				"timestamp": "2023-01-01T03:04:05Z"
+			}
+		]`)
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		actual, err := client.ListActivities(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, []*metabase.Activity{
+			{
+				ID:         2,
+				Topic:      "card-create",
+				Model:      "card",
+				ModelID:    5,
+				DatabaseID: 1,
+				TableID:    10,
+				UserID:     3,
+				Timestamp:  time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+				Details:    map[string]interface{}{"name": "test-question"},
+				UserEmail:  "user@example.com",
+			},
+		}, actual)
+	})
+
+	t.Run("should return error if the response is invalid", func(t *testing.T) {
+		ts := newServer(t, `{"message": "unexpected response"}`)
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		actual, err := client.ListActivities(context.Background())
+
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
@@ -16,6 +16,7 @@ const (
 	CollectionRoleViewer = "read"
 	CollectionRoleCurate = "write"
 	TableRoleViewer      = "all"
+	GroupRoleMember      = "member"
 
 	AccountTypeUser = "user"
 )
@@ -16,4 +16,5 @@ var (
 	ErrInvalidTableURN               = errors.New("table URN is invalid")
 	ErrInvalidGroupURN               = errors.New("group URN is invalid")
 	ErrInvalidCollectionURN          = errors.New("collection URN is invalid")
+	ErrProviderTypeMismatch          = errors.New("provider type in the config and in the provider don't match")
 )
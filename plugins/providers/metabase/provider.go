@@ -2,6 +2,7 @@ package metabase
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/mitchellh/mapstructure"
@@ -13,6 +14,7 @@ import (
 type provider struct {
 	pv.UnimplementedClient
 	pv.PermissionManager
+	pv.ActivityCorrelator
 
 	typeName string
 	Clients  map[string]MetabaseClient
@@ -22,6 +24,10 @@ type provider struct {
 
 func NewProvider(typeName string, crypto domain.Crypto, logger log.Logger) *provider {
 	return &provider{
+		// the activity feed only records created and updated items, running queries never produces an activity
+		ActivityCorrelator: pv.ActivityCorrelator{
+			ObservablePermissions: []string{DatabaseRoleEditor},
+		},
 		typeName: typeName,
 		Clients:  map[string]MetabaseClient{},
 		crypto:   crypto,
@@ -372,6 +378,70 @@ func (p *provider) RevokeAccess(pc *domain.ProviderConfig, a domain.Grant) error
 	return ErrInvalidResourceType
 }
 
+func (p *provider) ListAccess(ctx context.Context, pc domain.ProviderConfig, resources []*domain.Resource) (domain.MapResourceAccess, error) {
+	var creds Credentials
+	if err := mapstructure.Decode(pc.Credentials, &creds); err != nil {
+		return nil, fmt.Errorf("parsing credentials: %w", err)
+	}
+	client, err := p.getClient(pc.URN, creds)
+	if err != nil {
+		return nil, fmt.Errorf("initializing metabase client: %w", err)
+	}
+
+	return client.ListAccess(ctx, resources)
+}
+
+// GetActivities returns the events of the activity feed done on the databases and tables
+func (p *provider) GetActivities(ctx context.Context, pd domain.Provider, filter domain.ListActivitiesFilter) ([]*domain.Activity, error) {
+	var creds Credentials
+	if err := mapstructure.Decode(pd.Config.Credentials, &creds); err != nil {
+		return nil, fmt.Errorf("parsing credentials: %w", err)
+	}
+	client, err := p.getClient(pd.Config.URN, creds)
+	if err != nil {
+		return nil, fmt.Errorf("initializing metabase client: %w", err)
+	}
+
+	resources := filter.GetResources()
+	if len(resources) == 0 {
+		if resources, err = p.GetResources(pd.Config); err != nil {
+			return nil, fmt.Errorf("getting resources: %w", err)
+		}
+	}
+	resourcesByURN := map[string]*domain.Resource{}
+	for _, r := range resources {
+		resourcesByURN[r.URN] = r
+	}
+
+	metabaseActivities, err := client.ListActivities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing activities: %w", err)
+	}
+
+	activities := make([]*domain.Activity, 0, len(metabaseActivities))
+	for _, ma := range metabaseActivities {
+		for _, urn := range ma.GetResourceURNs() {
+			r, ok := resourcesByURN[urn]
+			if !ok {
+				continue
+			}
+			if a := ma.ToDomainActivity(pd, r); filter.IsMatch(a) {
+				activities = append(activities, a)
+			}
+		}
+	}
+
+	return activities, nil
+}
+
+// ListActivities returns the activities used for checking the dormancy of the grants
+func (p *provider) ListActivities(ctx context.Context, pd domain.Provider, filter domain.ListActivitiesFilter) ([]*domain.Activity, error) {
+	if pd.Type != p.typeName {
+		return nil, ErrProviderTypeMismatch
+	}
+	return p.GetActivities(ctx, pd, filter)
+}
+
 func (p *provider) GetRoles(pc *domain.ProviderConfig, resourceType string) ([]*domain.Role, error) {
 	return pv.GetRoles(pc, resourceType)
 }
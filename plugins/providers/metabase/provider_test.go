@@ -1,8 +1,10 @@
 package metabase_test
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/raystack/salt/log"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/raystack/guardian/plugins/providers/metabase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetType(t *testing.T) {
@@ -1474,6 +1477,161 @@ func TestRevokeAccess(t *testing.T) {
 	})
 }
 
+func TestListAccess(t *testing.T) {
+	t.Run("should return error if credentials is invalid", func(t *testing.T) {
+		crypto := new(mocks.Crypto)
+		p := metabase.NewProvider("", crypto, log.NewNoop())
+
+		pc := domain.ProviderConfig{
+			Credentials: "invalid-creds",
+		}
+
+		actualAccess, actualError := p.ListAccess(context.Background(), pc, nil)
+
+		assert.Nil(t, actualAccess)
+		assert.Error(t, actualError)
+	})
+
+	t.Run("should return access from the client", func(t *testing.T) {
+		providerURN := "test-provider-urn"
+		crypto := new(mocks.Crypto)
+		client := new(mocks.MetabaseClient)
+		p := metabase.NewProvider("", crypto, log.NewNoop())
+		p.Clients = map[string]metabase.MetabaseClient{
+			providerURN: client,
+		}
+
+		pc := domain.ProviderConfig{
+			URN:         providerURN,
+			Credentials: map[string]interface{}{},
+		}
+		resources := []*domain.Resource{
+			{Type: metabase.ResourceTypeDatabase, URN: "database:1"},
+		}
+		expectedAccess := domain.MapResourceAccess{
+			"database:1": {
+				{AccountID: "user@example.com", AccountType: "user", Permission: "schemas:all"},
+			},
+		}
+		client.On("ListAccess", mock.Anything, resources).Return(expectedAccess, nil).Once()
+
+		actualAccess, actualError := p.ListAccess(context.Background(), pc, resources)
+
+		assert.NoError(t, actualError)
+		assert.Equal(t, expectedAccess, actualAccess)
+		client.AssertExpectations(t)
+	})
+}
+
+func TestGetActivities(t *testing.T) {
+	providerURN := "test-provider-urn"
+	dummyProvider := domain.Provider{
+		ID:   "test-provider-id",
+		Type: "metabase",
+		URN:  providerURN,
+		Config: &domain.ProviderConfig{
+			Type:        "metabase",
+			URN:         providerURN,
+			Credentials: map[string]interface{}{},
+		},
+	}
+
+	t.Run("should return error if credentials is invalid", func(t *testing.T) {
+		p := metabase.NewProvider("metabase", new(mocks.Crypto), log.NewNoop())
+
+		activities, err := p.GetActivities(context.Background(), domain.Provider{
+			Config: &domain.ProviderConfig{Credentials: "invalid-creds"},
+		}, domain.ListActivitiesFilter{})
+
+		assert.ErrorContains(t, err, "parsing credentials")
+		assert.Nil(t, activities)
+	})
+
+	t.Run("should return the activities on the filtered tables and databases", func(t *testing.T) {
+		client := new(mocks.MetabaseClient)
+		p := metabase.NewProvider("metabase", new(mocks.Crypto), log.NewNoop())
+		p.Clients[providerURN] = client
+		tableResource := &domain.Resource{ID: "table-resource-id", Type: metabase.ResourceTypeTable, URN: "table:1.10"}
+		databaseResource := &domain.Resource{ID: "database-resource-id", Type: metabase.ResourceTypeDatabase, URN: "database:1"}
+		filter := domain.ListActivitiesFilter{
+			ResourceIDs: []string{tableResource.ID, databaseResource.ID},
+			AccountIDs:  []string{"user@example.com"},
+		}
+		require.NoError(t, filter.PopulateResources(map[string]*domain.Resource{
+			tableResource.ID:    tableResource,
+			databaseResource.ID: databaseResource,
+		}))
+		timestamp := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+		client.On("ListActivities", mock.Anything).Return([]*metabase.Activity{
+			{ID: 1, Topic: "card-create", Model: "card", ModelID: 5, DatabaseID: 1, TableID: 10, Timestamp: timestamp, UserEmail: "user@example.com"},
+			{ID: 2, Topic: "card-create", Model: "card", ModelID: 6, DatabaseID: 2, Timestamp: timestamp, UserEmail: "user@example.com"},
+			{ID: 3, Topic: "card-create", Model: "card", ModelID: 7, DatabaseID: 1, Timestamp: timestamp, UserEmail: "other@example.com"},
+			{ID: 4, Topic: "user-joined", Model: "user", Timestamp: timestamp, UserEmail: "user@example.com"},
+		}, nil).Once()
+
+		activities, err := p.GetActivities(context.Background(), dummyProvider, filter)
+
+		assert.NoError(t, err)
+		expectedMetadata := map[string]interface{}{
+			"model":    "card",
+			"model_id": 5,
+			"details":  map[string]interface{}(nil),
+		}
+		assert.Equal(t, []*domain.Activity{
+			{
+				ProviderID:         dummyProvider.ID,
+				ProviderActivityID: "1:table:1.10",
+				AccountType:        "user",
+				AccountID:          "user@example.com",
+				Timestamp:          timestamp,
+				Type:               "card-create",
+				RelatedPermissions: []string{"all"},
+				Metadata:           expectedMetadata,
+				Resource:           tableResource,
+			},
+			{
+				ProviderID:         dummyProvider.ID,
+				ProviderActivityID: "1:database:1",
+				AccountType:        "user",
+				AccountID:          "user@example.com",
+				Timestamp:          timestamp,
+				Type:               "card-create",
+				RelatedPermissions: []string{"native:write", "schemas:all"},
+				Metadata:           expectedMetadata,
+				Resource:           databaseResource,
+			},
+		}, activities)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("should return error if listing activities fails", func(t *testing.T) {
+		client := new(mocks.MetabaseClient)
+		p := metabase.NewProvider("metabase", new(mocks.Crypto), log.NewNoop())
+		p.Clients[providerURN] = client
+		resource := &domain.Resource{ID: "database-resource-id", Type: metabase.ResourceTypeDatabase, URN: "database:1"}
+		filter := domain.ListActivitiesFilter{ResourceIDs: []string{resource.ID}}
+		require.NoError(t, filter.PopulateResources(map[string]*domain.Resource{resource.ID: resource}))
+		expectedError := errors.New("unexpected error")
+		client.On("ListActivities", mock.Anything).Return(nil, expectedError).Once()
+
+		activities, err := p.GetActivities(context.Background(), dummyProvider, filter)
+
+		assert.ErrorIs(t, err, expectedError)
+		assert.Nil(t, activities)
+	})
+}
+
+func TestListActivities(t *testing.T) {
+	t.Run("should return error if provider type is not metabase", func(t *testing.T) {
+		p := metabase.NewProvider("metabase", new(mocks.Crypto), log.NewNoop())
+
+		activities, err := p.ListActivities(context.Background(), domain.Provider{Type: "grafana"}, domain.ListActivitiesFilter{})
+
+		assert.ErrorIs(t, err, metabase.ErrProviderTypeMismatch)
+		assert.Nil(t, activities)
+	})
+}
+
 func TestGetAccountTypes(t *testing.T) {
 	expectedAccountType := []string{"user"}
 	crypto := new(mocks.Crypto)
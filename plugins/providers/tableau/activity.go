@@ -0,0 +1,45 @@
+package tableau
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/raystack/guardian/domain"
+)
+
+const (
+	ActivityTypePublish = "publish"
+)
+
+// Revision is a version of a workbook or data source published by a user
+type Revision struct {
+	RevisionNumber string    `json:"revisionNumber"`
+	PublishedAt    time.Time `json:"publishedAt"`
+	Deleted        bool      `json:"deleted"`
+	Current        bool      `json:"current"`
+
+	// PublisherName is the name of the user publishing the revision
+	PublisherName string `json:"-"`
+	ResourceType  string `json:"-"`
+	ResourceURN   string `json:"-"`
+}
+
+// ToDomainActivity returns the revision as an activity of the publisher on the workbook or data source. Publishing a
+// revision requires the write capability.
+func (r *Revision) ToDomainActivity(p domain.Provider, resource *domain.Resource) *domain.Activity {
+	return &domain.Activity{
+		ProviderID:         p.ID,
+		ProviderActivityID: fmt.Sprintf("%s:%s:%s", r.ResourceType, r.ResourceURN, r.RevisionNumber),
+		AccountType:        AccountTypeUser,
+		AccountID:          r.PublisherName,
+		Timestamp:          r.PublishedAt,
+		Type:               ActivityTypePublish,
+		RelatedPermissions: []string{"Write:Allow"},
+		Metadata: map[string]interface{}{
+			"revision_number": r.RevisionNumber,
+			"current":         r.Current,
+			"deleted":         r.Deleted,
+		},
+		Resource: resource,
+	}
+}
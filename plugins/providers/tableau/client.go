@@ -2,16 +2,19 @@ package tableau
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/mcuadros/go-defaults"
+	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/pkg/tracing"
 )
 
@@ -32,6 +35,8 @@ type TableauClient interface {
 	RevokeViewAccess(resource *View, user, role string) error
 	GrantMetricAccess(resource *Metric, user, role string) error
 	RevokeMetricAccess(resource *Metric, user, role string) error
+	ListAccess(ctx context.Context, resources []*domain.Resource) (domain.MapResourceAccess, error)
+	ListRevisions(ctx context.Context, resources []*domain.Resource) ([]*Revision, error)
 }
 
 type ClientConfig struct {
@@ -68,7 +73,9 @@ type responseCredentials struct {
 }
 
 type responseUser struct {
-	ID string `json:"id"`
+	ID       string `json:"id"`
+	Name     string `json:"name,omitempty"`
+	SiteRole string `json:"siteRole,omitempty"`
 }
 
 type responseSite struct {
@@ -76,6 +83,11 @@ type responseSite struct {
 	ContentURL string `json:"contentUrl"`
 }
 
+const (
+	usersPageSize     = 1000
+	revisionsPageSize = 1000
+)
+
 type client struct {
 	baseURL *url.URL
 
@@ -125,8 +137,20 @@ type capabilities struct {
 	Capability []capability `json:"capability"`
 }
 type granteeCapabilities struct {
-	User         userDetails  `json:"user"`
-	Capabilities capabilities `json:"capabilities"`
+	User         userDetails   `json:"user"`
+	Group        *groupDetails `json:"group,omitempty"`
+	Capabilities capabilities  `json:"capabilities"`
+}
+
+type groupDetails struct {
+	ID string `json:"id"`
+}
+
+// resourcePermissions is the permissions response of any resource type
+type resourcePermissions struct {
+	Permissions struct {
+		GranteeCapabilities []granteeCapabilities `json:"granteeCapabilities"`
+	} `json:"permissions"`
 }
 type workbookPermission struct {
 	Workbook            resourceDetails       `json:"workbook"`
@@ -207,6 +231,16 @@ type metrics struct {
 	Metric []*Metric `json:"metric"`
 }
 
+type responseRevisions struct {
+	Pagination pagination `json:"pagination"`
+	Revisions  struct {
+		Revision []struct {
+			Revision
+			Publisher responseUser `json:"publisher"`
+		} `json:"revision"`
+	} `json:"revisions"`
+}
+
 type pagination struct {
 	PageNumber     string `json:"pageNumber"`
 	PageSize       string `json:"pageSize"`
@@ -569,6 +603,169 @@ func (c *client) RevokeViewAccess(resource *View, user, role string) error {
 	return err
 }
 
+// resourcePaths maps the resource types to their path in the REST API
+var resourcePaths = map[string]string{
+	ResourceTypeWorkbook:   "workbooks",
+	ResourceTypeFlow:       "flows",
+	ResourceTypeDataSource: "datasources",
+	ResourceTypeView:       "views",
+	ResourceTypeMetric:     "metrics",
+}
+
+// ListAccess returns the users having capabilities on the resources, either directly or through a group. The site
+// role of these users is listed as well, e.g. "Creator@site_role", so that roles combining capabilities and a site role
+// can be matched.
+func (c *client) ListAccess(ctx context.Context, resources []*domain.Resource) (domain.MapResourceAccess, error) {
+	users, err := c.listUsers(fmt.Sprintf("/api/%v/sites/%v/users", c.apiVersion, c.siteID))
+	if err != nil {
+		return nil, fmt.Errorf("listing users: %w", err)
+	}
+	usersByID := map[string]responseUser{}
+	for _, u := range users {
+		usersByID[u.ID] = u
+	}
+
+	groupMembers := map[string][]responseUser{}
+	access := domain.MapResourceAccess{}
+	for _, r := range resources {
+		path, ok := resourcePaths[r.Type]
+		if !ok {
+			continue
+		}
+
+		url := fmt.Sprintf("/api/%v/sites/%v/%v/%v/permissions", c.apiVersion, c.siteID, path, r.URN)
+		req, err := c.newRequest(http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		var permissions resourcePermissions
+		if _, err := c.do(req, &permissions); err != nil {
+			return nil, fmt.Errorf("getting permissions of %s %q: %w", r.Type, r.URN, err)
+		}
+
+		entries := map[domain.AccessEntry]bool{}
+		addEntry := func(u responseUser, permission string) {
+			entry := domain.AccessEntry{
+				AccountID:   u.Name,
+				AccountType: AccountTypeUser,
+				Permission:  permission,
+			}
+			if u.Name == "" || entries[entry] {
+				return
+			}
+			entries[entry] = true
+			access[r.URN] = append(access[r.URN], entry)
+		}
+
+		for _, gc := range permissions.Permissions.GranteeCapabilities {
+			var grantees []responseUser
+			if gc.User.ID != "" {
+				grantees = append(grantees, usersByID[gc.User.ID])
+			} else if gc.Group != nil && gc.Group.ID != "" {
+				if _, ok := groupMembers[gc.Group.ID]; !ok {
+					members, err := c.listUsers(fmt.Sprintf("/api/%v/sites/%v/groups/%v/users", c.apiVersion, c.siteID, gc.Group.ID))
+					if err != nil {
+						return nil, fmt.Errorf("listing members of group %q: %w", gc.Group.ID, err)
+					}
+					groupMembers[gc.Group.ID] = members
+				}
+				for _, m := range groupMembers[gc.Group.ID] {
+					grantees = append(grantees, usersByID[m.ID])
+				}
+			}
+
+			for _, u := range grantees {
+				for _, cp := range gc.Capabilities.Capability {
+					addEntry(u, fmt.Sprintf("%v:%v", cp.Name, cp.Mode))
+				}
+				if u.SiteRole != "" {
+					addEntry(u, Permission{Name: u.SiteRole, Type: PermissionTypeSiteRole}.String())
+				}
+			}
+		}
+	}
+
+	return access, nil
+}
+
+// listUsers returns the users of all pages of a users endpoint
+func (c *client) listUsers(path string) ([]responseUser, error) {
+	var users []responseUser
+	for pageNumber := 1; ; pageNumber++ {
+		url := fmt.Sprintf("%v?pageSize=%v&pageNumber=%v", path, usersPageSize, pageNumber)
+		req, err := c.newRequest(http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var res siteUsers
+		if _, err := c.do(req, &res); err != nil {
+			return nil, err
+		}
+		users = append(users, res.Users.User...)
+
+		total, err := strconv.Atoi(res.Pagination.TotalAvailable)
+		if err != nil || len(res.Users.User) == 0 || len(users) >= total {
+			return users, nil
+		}
+	}
+}
+
+// ListRevisions returns the revisions of the workbooks and data sources published by the users
+func (c *client) ListRevisions(ctx context.Context, resources []*domain.Resource) ([]*Revision, error) {
+	var revisions []*Revision
+	for _, r := range resources {
+		if r.Type != ResourceTypeWorkbook && r.Type != ResourceTypeDataSource {
+			continue
+		}
+
+		path := fmt.Sprintf("/api/%v/sites/%v/%v/%v/revisions", c.apiVersion, c.siteID, resourcePaths[r.Type], r.URN)
+		resourceRevisions, err := c.listRevisions(path)
+		if err != nil {
+			return nil, fmt.Errorf("getting revisions of %s %q: %w", r.Type, r.URN, err)
+		}
+		for _, rev := range resourceRevisions {
+			rev.ResourceType = r.Type
+			rev.ResourceURN = r.URN
+			revisions = append(revisions, rev)
+		}
+	}
+
+	return revisions, nil
+}
+
+// listRevisions returns the revisions of all pages of a revisions endpoint
+func (c *client) listRevisions(path string) ([]*Revision, error) {
+	var revisions []*Revision
+	var count int
+	for pageNumber := 1; ; pageNumber++ {
+		url := fmt.Sprintf("%v?pageSize=%v&pageNumber=%v", path, revisionsPageSize, pageNumber)
+		req, err := c.newRequest(http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var res responseRevisions
+		if _, err := c.do(req, &res); err != nil {
+			return nil, err
+		}
+		for _, r := range res.Revisions.Revision {
+			if r.Publisher.Name == "" {
+				continue
+			}
+			rev := r.Revision
+			rev.PublisherName = r.Publisher.Name
+			revisions = append(revisions, &rev)
+		}
+		count += len(res.Revisions.Revision)
+
+		total, err := strconv.Atoi(res.Pagination.TotalAvailable)
+		if err != nil || len(res.Revisions.Revision) == 0 || count >= total {
+			return revisions, nil
+		}
+	}
+}
+
 func (c *client) getUser(email string) (*siteUsers, error) {
 	filter := fmt.Sprintf("name:eq:%v", email)
 	url := fmt.Sprintf("/api/%v/sites/%v/users?filter=%v", c.apiVersion, c.siteID, filter)
@@ -2,19 +2,24 @@ package tableau_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/mocks"
 	"github.com/raystack/guardian/plugins/providers/tableau"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -1095,3 +1100,218 @@ func (s *ClientTestSuite) TestRevokeViewAccess() {
 // 	//mockHttpClient.AssertExpectations(t)
 // 	assert.Nil(t, actualError)
 // })
+
+func TestClientListAccess(t *testing.T) {
+	newServer := func(t *testing.T, routes map[string]string) *httptest.Server {
+		t.Helper()
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/api/3.12/auth/signin" {
+				w.Write([]byte(`{"credentials": {"token": "test-token", "site": {"id": "site-1"}, "user": {"id": "admin"}}}`))
+				return
+			}
+			assert.Equal(t, "test-token", r.Header.Get("X-Tableau-Auth"))
+			key := r.URL.Path
+			if page := r.URL.Query().Get("pageNumber"); page != "" {
+				key += "?page=" + page
+			}
+			body, ok := routes[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte("unexpected request " + key))
+				return
+			}
+			w.Write([]byte(body))
+		}))
+	}
+	newClient := func(t *testing.T, ts *httptest.Server) tableau.TableauClient {
+		t.Helper()
+		client, err := tableau.NewClient(&tableau.ClientConfig{
+			Host:       ts.URL,
+			Username:   "test-username",
+			Password:   "test-password",
+			ContentURL: "test-site",
+		})
+		require.NoError(t, err)
+		return client
+	}
+
+	t.Run("should return capabilities and site roles of users and group members", func(t *testing.T) {
+		ts := newServer(t, map[string]string{
+			"/api/3.12/sites/site-1/users?page=1": `{
+				"pagination": {"pageNumber": "1", "pageSize": "1000", "totalAvailable": "3"},
+				"users": {"user": [
+					{"id": "u1", "name": "creator@example.com", "siteRole": "Creator"},
+					{"id": "u2", "name": "viewer@example.com", "siteRole": "Viewer"}
+				]}
+			}`,
+			"/api/3.12/sites/site-1/users?page=2": `{
+				"pagination": {"pageNumber": "2", "pageSize": "1000", "totalAvailable": "3"},
+				"users": {"user": [
+					{"id": "u3", "name": "explorer@example.com", "siteRole": "Explorer"}
+				]}
+			}`,
+			"/api/3.12/sites/site-1/workbooks/wb-1/permissions": `{
+				"permissions": {
+					"workbook": {"id": "wb-1"},
+					"granteeCapabilities": [
+						{"user": {"id": "u1"}, "capabilities": {"capability": [{"name": "Read", "mode": "Allow"}, {"name": "Write", "mode": "Allow"}]}},
+						{"group": {"id": "g1"}, "capabilities": {"capability": [{"name": "Read", "mode": "Allow"}]}}
+					]
+				}
+			}`,
+			"/api/3.12/sites/site-1/datasources/ds-1/permissions": `{
+				"permissions": {
+					"datasource": {"id": "ds-1"},
+					"granteeCapabilities": [
+						{"user": {"id": "u3"}, "capabilities": {"capability": [{"name": "Connect", "mode": "Deny"}]}}
+					]
+				}
+			}`,
+			"/api/3.12/sites/site-1/groups/g1/users?page=1": `{
+				"pagination": {"pageNumber": "1", "pageSize": "1000", "totalAvailable": "2"},
+				"users": {"user": [{"id": "u1"}, {"id": "u2"}]}
+			}`,
+		})
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		actual, err := client.ListAccess(context.Background(), []*domain.Resource{
+			{Type: tableau.ResourceTypeWorkbook, URN: "wb-1"},
+			{Type: tableau.ResourceTypeDataSource, URN: "ds-1"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.MapResourceAccess{
+			"wb-1": {
+				{AccountID: "creator@example.com", AccountType: "user", Permission: "Read:Allow"},
+				{AccountID: "creator@example.com", AccountType: "user", Permission: "Write:Allow"},
+				{AccountID: "creator@example.com", AccountType: "user", Permission: "Creator@site_role"},
+				{AccountID: "viewer@example.com", AccountType: "user", Permission: "Read:Allow"},
+				{AccountID: "viewer@example.com", AccountType: "user", Permission: "Viewer@site_role"},
+			},
+			"ds-1": {
+				{AccountID: "explorer@example.com", AccountType: "user", Permission: "Connect:Deny"},
+				{AccountID: "explorer@example.com", AccountType: "user", Permission: "Explorer@site_role"},
+			},
+		}, actual)
+	})
+
+	t.Run("should return error if getting the resource permissions fails", func(t *testing.T) {
+		ts := newServer(t, map[string]string{
+			"/api/3.12/sites/site-1/users?page=1": `{"pagination": {"totalAvailable": "0"}, "users": {}}`,
+		})
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		actual, err := client.ListAccess(context.Background(), []*domain.Resource{
+			{Type: tableau.ResourceTypeView, URN: "view-1"},
+		})
+
+		assert.ErrorContains(t, err, `getting permissions of view "view-1"`)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestClientListRevisions(t *testing.T) {
+	newServer := func(t *testing.T, routes map[string]string) *httptest.Server {
+		t.Helper()
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/api/3.12/auth/signin" {
+				w.Write([]byte(`{"credentials": {"token": "test-token", "site": {"id": "site-1"}, "user": {"id": "admin"}}}`))
+				return
+			}
+			assert.Equal(t, "test-token", r.Header.Get("X-Tableau-Auth"))
+			key := r.URL.Path + "?page=" + r.URL.Query().Get("pageNumber")
+			body, ok := routes[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte("unexpected request " + key))
+				return
+			}
+			w.Write([]byte(body))
+		}))
+	}
+	newClient := func(t *testing.T, ts *httptest.Server) tableau.TableauClient {
+		t.Helper()
+		client, err := tableau.NewClient(&tableau.ClientConfig{
+			Host:       ts.URL,
+			Username:   "test-username",
+			Password:   "test-password",
+			ContentURL: "test-site",
+		})
+		require.NoError(t, err)
+		return client
+	}
+
+	t.Run("should return the revisions of all pages of the workbooks and data sources", func(t *testing.T) {
+		ts := newServer(t, map[string]string{
+			"/api/3.12/sites/site-1/workbooks/w1/revisions?page=1": `{
+				"pagination": {"pageNumber": "1", "pageSize": "1000", "totalAvailable": "2"},
+				"revisions": {"revision": [
+					{"revisionNumber": "1", "publishedAt": "2023-01-01T03:04:05Z", "deleted": false, "current": false, "publisher": {"id": "u1", "name": "creator@example.com"}}
+				]}
+			}`,
+			"/api/3.12/sites/site-1/workbooks/w1/revisions?page=2": `{
+				"pagination": {"pageNumber": "2", "pageSize": "1000", "totalAvailable": "2"},
+				"revisions": {"revision": [
+					{"revisionNumber": "2", "publishedAt": "2023-01-02T03:04:05Z", "deleted": false, "current": true, "publisher": {"id": "u1", "name": "creator@example.com"}}
+				]}
+			}`,
+			"/api/3.12/sites/site-1/datasources/d1/revisions?page=1": `{
+				"pagination": {"pageNumber": "1", "pageSize": "1000", "totalAvailable": "2"},
+				"revisions": {"revision": [
+					{"revisionNumber": "1", "publishedAt": "2023-01-03T03:04:05Z", "deleted": true, "current": false, "publisher": {}},
+					{"revisionNumber": "2", "publishedAt": "2023-01-04T03:04:05Z", "deleted": false, "current": true, "publisher": {"id": "u2", "name": "editor@example.com"}}
+				]}
+			}`,
+		})
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		actual, err := client.ListRevisions(context.Background(), []*domain.Resource{
+			{Type: tableau.ResourceTypeWorkbook, URN: "w1"},
+			{Type: tableau.ResourceTypeDataSource, URN: "d1"},
+			{Type: tableau.ResourceTypeView, URN: "v1"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []*tableau.Revision{
+			{
+				RevisionNumber: "1",
+				PublishedAt:    time.Date(2023, 1, 1, 3, 4, 5, 0, time.UTC),
+				PublisherName:  "creator@example.com",
+				ResourceType:   tableau.ResourceTypeWorkbook,
+				ResourceURN:    "w1",
+			},
+			{
+				RevisionNumber: "2",
+				PublishedAt:    time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+				Current:        true,
+				PublisherName:  "creator@example.com",
+				ResourceType:   tableau.ResourceTypeWorkbook,
+				ResourceURN:    "w1",
+			},
+			{
+				RevisionNumber: "2",
+				PublishedAt:    time.Date(2023, 1, 4, 3, 4, 5, 0, time.UTC),
+				Current:        true,
+				PublisherName:  "editor@example.com",
+				ResourceType:   tableau.ResourceTypeDataSource,
+				ResourceURN:    "d1",
+			},
+		}, actual)
+	})
+
+	t.Run("should return error if getting the revisions fails", func(t *testing.T) {
+		ts := newServer(t, map[string]string{})
+		defer ts.Close()
+		client := newClient(t, ts)
+
+		actual, err := client.ListRevisions(context.Background(), []*domain.Resource{
+			{Type: tableau.ResourceTypeWorkbook, URN: "w1"},
+		})
+
+		assert.ErrorContains(t, err, `getting revisions of workbook "w1"`)
+		assert.Nil(t, actual)
+	})
+}
@@ -12,6 +12,9 @@ import (
 
 const (
 	AccountTypeUser = "user"
+
+	// PermissionTypeSiteRole is the type of permissions updating the site role of the user
+	PermissionTypeSiteRole = "site_role"
 )
 
 type Credentials struct {
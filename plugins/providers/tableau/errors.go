@@ -10,4 +10,5 @@ var (
 	ErrInvalidPermissionConfig       = errors.New("invalid permission config type")
 	ErrUnableToEncryptNilCredentials = errors.New("unable to encrypt nil credentials")
 	ErrUnableToDecryptNilCredentials = errors.New("unable to decrypt nil credentials")
+	ErrProviderTypeMismatch          = errors.New("provider type in the config and in the provider don't match")
 )
@@ -2,6 +2,7 @@ package tableau
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/mitchellh/mapstructure"
 	pv "github.com/raystack/guardian/core/provider"
@@ -11,6 +12,7 @@ import (
 type provider struct {
 	pv.UnimplementedClient
 	pv.PermissionManager
+	pv.ActivityCorrelator
 
 	typeName string
 	Clients  map[string]TableauClient
@@ -19,6 +21,10 @@ type provider struct {
 
 func NewProvider(typeName string, crypto domain.Crypto) *provider {
 	return &provider{
+		// only publishing a revision is recorded, reading a workbook or a data source never produces an activity
+		ActivityCorrelator: pv.ActivityCorrelator{
+			ObservablePermissions: []string{"Write:Allow"},
+		},
 		typeName: typeName,
 		Clients:  map[string]TableauClient{},
 		crypto:   crypto,
@@ -345,6 +351,65 @@ func (p *provider) RevokeAccess(pc *domain.ProviderConfig, a domain.Grant) error
 	return ErrInvalidResourceType
 }
 
+func (p *provider) ListAccess(ctx context.Context, pc domain.ProviderConfig, resources []*domain.Resource) (domain.MapResourceAccess, error) {
+	var creds Credentials
+	if err := mapstructure.Decode(pc.Credentials, &creds); err != nil {
+		return nil, fmt.Errorf("parsing credentials: %w", err)
+	}
+	client, err := p.getClient(pc.URN, creds)
+	if err != nil {
+		return nil, fmt.Errorf("initializing tableau client: %w", err)
+	}
+
+	return client.ListAccess(ctx, resources)
+}
+
+// GetActivities returns the revisions published by the users as their activities on the workbooks and data sources
+func (p *provider) GetActivities(ctx context.Context, pd domain.Provider, filter domain.ListActivitiesFilter) ([]*domain.Activity, error) {
+	var creds Credentials
+	if err := mapstructure.Decode(pd.Config.Credentials, &creds); err != nil {
+		return nil, fmt.Errorf("parsing credentials: %w", err)
+	}
+	client, err := p.getClient(pd.Config.URN, creds)
+	if err != nil {
+		return nil, fmt.Errorf("initializing tableau client: %w", err)
+	}
+
+	resources := filter.GetResources()
+	if len(resources) == 0 {
+		if resources, err = p.GetResources(pd.Config); err != nil {
+			return nil, fmt.Errorf("getting resources: %w", err)
+		}
+	}
+	resourcesByURN := map[string]*domain.Resource{}
+	for _, r := range resources {
+		resourcesByURN[r.Type+":"+r.URN] = r
+	}
+
+	revisions, err := client.ListRevisions(ctx, resources)
+	if err != nil {
+		return nil, fmt.Errorf("listing revisions: %w", err)
+	}
+
+	activities := make([]*domain.Activity, 0, len(revisions))
+	for _, r := range revisions {
+		a := r.ToDomainActivity(pd, resourcesByURN[r.ResourceType+":"+r.ResourceURN])
+		if filter.IsMatch(a) {
+			activities = append(activities, a)
+		}
+	}
+
+	return activities, nil
+}
+
+// ListActivities returns the activities used for checking the dormancy of the grants
+func (p *provider) ListActivities(ctx context.Context, pd domain.Provider, filter domain.ListActivitiesFilter) ([]*domain.Activity, error) {
+	if pd.Type != p.typeName {
+		return nil, ErrProviderTypeMismatch
+	}
+	return p.GetActivities(ctx, pd, filter)
+}
+
 func (p *provider) GetRoles(pc *domain.ProviderConfig, resourceType string) ([]*domain.Role, error) {
 	return pv.GetRoles(pc, resourceType)
 }
@@ -1,14 +1,17 @@
 package tableau_test
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/raystack/guardian/domain"
 	"github.com/raystack/guardian/mocks"
 	"github.com/raystack/guardian/plugins/providers/tableau"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetType(t *testing.T) {
@@ -2223,6 +2226,159 @@ func TestRevokeAccess(t *testing.T) {
 	})
 }
 
+func TestListAccess(t *testing.T) {
+	t.Run("should return error if credentials is invalid", func(t *testing.T) {
+		crypto := new(mocks.Crypto)
+		p := tableau.NewProvider("", crypto)
+
+		pc := domain.ProviderConfig{
+			Credentials: "invalid-creds",
+		}
+
+		actualAccess, actualError := p.ListAccess(context.Background(), pc, nil)
+
+		assert.Nil(t, actualAccess)
+		assert.Error(t, actualError)
+	})
+
+	t.Run("should return access from the client", func(t *testing.T) {
+		providerURN := "test-provider-urn"
+		crypto := new(mocks.Crypto)
+		client := new(mocks.TableauClient)
+		p := tableau.NewProvider("", crypto)
+		p.Clients = map[string]tableau.TableauClient{
+			providerURN: client,
+		}
+
+		pc := domain.ProviderConfig{
+			URN:         providerURN,
+			Credentials: map[string]interface{}{},
+		}
+		resources := []*domain.Resource{
+			{Type: tableau.ResourceTypeWorkbook, URN: "wb-1"},
+		}
+		expectedAccess := domain.MapResourceAccess{
+			"wb-1": {
+				{AccountID: "user@example.com", AccountType: "user", Permission: "Read:Allow"},
+			},
+		}
+		client.On("ListAccess", mock.Anything, resources).Return(expectedAccess, nil).Once()
+
+		actualAccess, actualError := p.ListAccess(context.Background(), pc, resources)
+
+		assert.NoError(t, actualError)
+		assert.Equal(t, expectedAccess, actualAccess)
+		client.AssertExpectations(t)
+	})
+}
+
+func TestGetActivities(t *testing.T) {
+	providerURN := "test-provider-urn"
+	dummyProvider := domain.Provider{
+		ID:   "test-provider-id",
+		Type: "tableau",
+		URN:  providerURN,
+		Config: &domain.ProviderConfig{
+			Type:        "tableau",
+			URN:         providerURN,
+			Credentials: map[string]interface{}{},
+		},
+	}
+
+	t.Run("should return error if credentials is invalid", func(t *testing.T) {
+		p := tableau.NewProvider("tableau", new(mocks.Crypto))
+
+		activities, err := p.GetActivities(context.Background(), domain.Provider{
+			Config: &domain.ProviderConfig{Credentials: "invalid-creds"},
+		}, domain.ListActivitiesFilter{})
+
+		assert.ErrorContains(t, err, "parsing credentials")
+		assert.Nil(t, activities)
+	})
+
+	t.Run("should return the revisions of the filtered resources as activities", func(t *testing.T) {
+		client := new(mocks.TableauClient)
+		p := tableau.NewProvider("tableau", new(mocks.Crypto))
+		p.Clients[providerURN] = client
+		workbook := &domain.Resource{ID: "test-resource-id", Type: tableau.ResourceTypeWorkbook, URN: "w1"}
+		filter := domain.ListActivitiesFilter{
+			ResourceIDs: []string{workbook.ID},
+			AccountIDs:  []string{"creator@example.com"},
+		}
+		require.NoError(t, filter.PopulateResources(map[string]*domain.Resource{workbook.ID: workbook}))
+		publishedAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+		client.On("ListRevisions", mock.Anything, []*domain.Resource{workbook}).Return([]*tableau.Revision{
+			{RevisionNumber: "2", PublishedAt: publishedAt, Current: true, PublisherName: "creator@example.com", ResourceType: tableau.ResourceTypeWorkbook, ResourceURN: "w1"},
+			{RevisionNumber: "1", PublishedAt: publishedAt, PublisherName: "other@example.com", ResourceType: tableau.ResourceTypeWorkbook, ResourceURN: "w1"},
+		}, nil).Once()
+
+		activities, err := p.GetActivities(context.Background(), dummyProvider, filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.Activity{
+			{
+				ProviderID:         dummyProvider.ID,
+				ProviderActivityID: "workbook:w1:2",
+				AccountType:        "user",
+				AccountID:          "creator@example.com",
+				Timestamp:          publishedAt,
+				Type:               tableau.ActivityTypePublish,
+				RelatedPermissions: []string{"Write:Allow"},
+				Metadata: map[string]interface{}{
+					"revision_number": "2",
+					"current":         true,
+					"deleted":         false,
+				},
+				Resource: workbook,
+			},
+		}, activities)
+		client.AssertExpectations(t)
+	})
+}
+
+func TestListActivities(t *testing.T) {
+	t.Run("should return error if provider type is not tableau", func(t *testing.T) {
+		p := tableau.NewProvider("tableau", new(mocks.Crypto))
+
+		activities, err := p.ListActivities(context.Background(), domain.Provider{Type: "grafana"}, domain.ListActivitiesFilter{})
+
+		assert.ErrorIs(t, err, tableau.ErrProviderTypeMismatch)
+		assert.Nil(t, activities)
+	})
+
+	t.Run("should return the activities on all resources of the provider", func(t *testing.T) {
+		providerURN := "test-provider-urn"
+		client := new(mocks.TableauClient)
+		p := tableau.NewProvider("tableau", new(mocks.Crypto))
+		p.Clients[providerURN] = client
+		pd := domain.Provider{
+			Type: "tableau",
+			URN:  providerURN,
+			Config: &domain.ProviderConfig{
+				Type:        "tableau",
+				URN:         providerURN,
+				Credentials: map[string]interface{}{},
+				Resources: []*domain.ResourceConfig{
+					{Type: tableau.ResourceTypeWorkbook},
+				},
+			},
+		}
+		client.On("GetWorkbooks").Return([]*tableau.Workbook{{ID: "w1", Name: "workbook 1"}}, nil).Once()
+		client.On("ListRevisions", mock.Anything, mock.Anything).Return([]*tableau.Revision{
+			{RevisionNumber: "1", PublishedAt: time.Now(), PublisherName: "creator@example.com", ResourceType: tableau.ResourceTypeWorkbook, ResourceURN: "w1"},
+		}, nil).Once()
+
+		activities, err := p.ListActivities(context.Background(), pd, domain.ListActivitiesFilter{})
+
+		assert.NoError(t, err)
+		assert.Len(t, activities, 1)
+		assert.Equal(t, "w1", activities[0].Resource.URN)
+		assert.Equal(t, "workbook 1", activities[0].Resource.Name)
+		assert.Equal(t, providerURN, activities[0].Resource.ProviderURN)
+		client.AssertExpectations(t)
+	})
+}
+
 func TestGetAccountTypes(t *testing.T) {
 	t.Run("should return the valid Account Types \"user\"", func(t *testing.T) {
 		expectedAccountTypes := []string{"user"}
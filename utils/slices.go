@@ -29,3 +29,16 @@ func SubsliceExists(slice, subslice []string) (bool, int) {
 
 	return false, 0
 }
+
+// IsStrictSubset checks if all values of subset are in set, and set has more values than subset
+func IsStrictSubset(subset, set []string) bool {
+	if len(subset) >= len(set) {
+		return false
+	}
+	for _, v := range subset {
+		if !ContainsString(set, v) {
+			return false
+		}
+	}
+	return true
+}
@@ -93,3 +93,23 @@ func TestSubsliceExists(t *testing.T) {
 		assert.Equal(t, tc.expectedHeadIndex, headIndex)
 	}
 }
+
+func TestIsStrictSubset(t *testing.T) {
+	testCases := []struct {
+		subset         []string
+		set            []string
+		expectedResult bool
+	}{
+		{[]string{"a"}, []string{"a", "b"}, true},
+		{[]string{"b", "a"}, []string{"a", "b", "c"}, true},
+		{[]string{}, []string{"a"}, true},
+		{[]string{"a", "b"}, []string{"a", "b"}, false},
+		{[]string{"a", "x"}, []string{"a", "b", "c"}, false},
+		{[]string{"a", "b", "c"}, []string{"a", "b"}, false},
+		{[]string{}, []string{}, false},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expectedResult, utils.IsStrictSubset(tc.subset, tc.set))
+	}
+}